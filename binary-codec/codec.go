@@ -41,14 +41,94 @@ const (
 	batchPrefix               = "42434800"
 )
 
+// defaultCodec is the codec used by the package-level functions, built from the embedded definitions.
+var defaultCodec = NewCodec(definitions.Get())
+
+// Codec encodes and decodes the canonical binary format against a set of definitions, so that
+// networks running amendments newer than the embedded definitions can be supported.
+// The package-level functions use a Codec built from the embedded definitions.
+type Codec struct {
+	definitions *definitions.Definitions
+}
+
+// NewCodec returns a Codec that resolves fields, types and enums against the given definitions.
+func NewCodec(defs *definitions.Definitions) *Codec {
+	return &Codec{definitions: defs}
+}
+
+// NewCodecFromJSON returns a Codec built from a definitions document, in the format of the
+// embedded definitions.json or of the result of the server_definitions method.
+func NewCodecFromJSON(data []byte) (*Codec, error) {
+	defs, err := definitions.Load(data)
+	if err != nil {
+		return nil, err
+	}
+	return NewCodec(defs), nil
+}
+
+// NewCodecFromFile returns a Codec built from the definitions document at the given path.
+func NewCodecFromFile(path string) (*Codec, error) {
+	defs, err := definitions.LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewCodec(defs), nil
+}
+
+// DefaultCodec returns the Codec built from the embedded definitions, used by the package-level functions.
+func DefaultCodec() *Codec {
+	return defaultCodec
+}
+
+// Definitions returns the definitions the codec encodes and decodes against.
+func (c *Codec) Definitions() *definitions.Definitions {
+	return c.definitions
+}
+
 // Encode converts a JSON transaction object to a hex string in the canonical binary format.
 // The binary format is defined in XRPL's core codebase.
 func Encode(json map[string]any) (string, error) {
-	st := types.NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+	return defaultCodec.Encode(json)
+}
+
+// EncodeForMultisigning encodes a transaction into binary format in preparation for providing one
+// signature towards a multi-signed transaction.
+// Only encodes fields that are intended to be signed.
+// NOTE: The caller is responsible for setting SigningPubKey to "" for regular multisigning.
+// For counterparty signing (e.g. LoanSet), SigningPubKey must remain set to the first signer's
+// public key, so this function must not overwrite it.
+func EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, error) {
+	return defaultCodec.EncodeForMultisigning(json, xrpAccountID)
+}
+
+// EncodeForSigning encodes a transaction into binary format in preparation for signing.
+func EncodeForSigning(json map[string]any) (string, error) {
+	return defaultCodec.EncodeForSigning(json)
+}
+
+// EncodeForSigningClaim encodes a payment channel claim into binary format in preparation for signing.
+func EncodeForSigningClaim(json map[string]any) (string, error) {
+	return defaultCodec.EncodeForSigningClaim(json)
+}
+
+// EncodeForSigningBatch encodes a batch transaction into binary format in preparation for signing.
+func EncodeForSigningBatch(json map[string]any) (string, error) {
+	return defaultCodec.EncodeForSigningBatch(json)
+}
+
+// Decode decodes a hex string in the canonical binary format into a JSON transaction object.
+func Decode(hexEncoded string) (map[string]any, error) {
+	return defaultCodec.Decode(hexEncoded)
+}
+
+// Encode converts a JSON transaction object to a hex string in the canonical binary format,
+// using the codec's definitions.
+func (c *Codec) Encode(json map[string]any) (string, error) {
+	st := types.NewSTObjectWithDefinitions(c.definitions)
 
 	filteredJSON := make(map[string]any, len(json))
 	for k, v := range json {
-		if definitions.Get().Fields[k] != nil {
+		if c.definitions.Fields[k] != nil {
 			filteredJSON[k] = v
 		}
 	}
@@ -62,12 +142,8 @@ func Encode(json map[string]any) (string, error) {
 }
 
 // EncodeForMultisigning encodes a transaction into binary format in preparation for providing one
-// signature towards a multi-signed transaction.
-// Only encodes fields that are intended to be signed.
-// NOTE: The caller is responsible for setting SigningPubKey to "" for regular multisigning.
-// For counterparty signing (e.g. LoanSet), SigningPubKey must remain set to the first signer's
-// public key, so this function must not overwrite it.
-func EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, error) {
+// signature towards a multi-signed transaction, using the codec's definitions.
+func (c *Codec) EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, error) {
 	st := &types.AccountID{}
 
 	suffix, err := st.FromJSON(xrpAccountID)
@@ -75,7 +151,7 @@ func EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, er
 		return "", err
 	}
 
-	encoded, err := Encode(signingFieldsOnly(json))
+	encoded, err := c.Encode(c.signingFieldsOnly(json))
 	if err != nil {
 		return "", err
 	}
@@ -83,9 +159,10 @@ func EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, er
 	return strings.ToUpper(txMultiSigPrefix + encoded + hex.EncodeToString(suffix)), nil
 }
 
-// EncodeForSigning encodes a transaction into binary format in preparation for signing.
-func EncodeForSigning(json map[string]any) (string, error) {
-	encoded, err := Encode(signingFieldsOnly(json))
+// EncodeForSigning encodes a transaction into binary format in preparation for signing, using
+// the codec's definitions.
+func (c *Codec) EncodeForSigning(json map[string]any) (string, error) {
+	encoded, err := c.Encode(c.signingFieldsOnly(json))
 	if err != nil {
 		return "", err
 	}
@@ -94,7 +171,7 @@ func EncodeForSigning(json map[string]any) (string, error) {
 }
 
 // EncodeForSigningClaim encodes a payment channel claim into binary format in preparation for signing.
-func EncodeForSigningClaim(json map[string]any) (string, error) {
+func (*Codec) EncodeForSigningClaim(json map[string]any) (string, error) {
 	if json["Channel"] == nil || json["Amount"] == nil {
 		return "", ErrSigningClaimFieldNotFound
 	}
@@ -118,7 +195,7 @@ func EncodeForSigningClaim(json map[string]any) (string, error) {
 }
 
 // EncodeForSigningBatch encodes a batch transaction into binary format in preparation for signing.
-func EncodeForSigningBatch(json map[string]any) (string, error) {
+func (*Codec) EncodeForSigningBatch(json map[string]any) (string, error) {
 	if json["flags"] == nil {
 		return "", ErrBatchFlagsFieldNotFound
 	}
@@ -174,10 +251,10 @@ func EncodeForSigningBatch(json map[string]any) (string, error) {
 }
 
 // signingFieldsOnly returns a new map containing only the fields from the JSON transaction that are signing fields.
-func signingFieldsOnly(json map[string]any) map[string]any {
+func (c *Codec) signingFieldsOnly(json map[string]any) map[string]any {
 	signingFields := make(map[string]any, len(json))
 	for k, v := range json {
-		fi, _ := definitions.Get().GetFieldInstanceByFieldName(k)
+		fi, _ := c.definitions.GetFieldInstanceByFieldName(k)
 		if fi != nil && fi.IsSigningField {
 			signingFields[k] = v
 		}
@@ -186,14 +263,15 @@ func signingFieldsOnly(json map[string]any) map[string]any {
 	return signingFields
 }
 
-// Decode decodes a hex string in the canonical binary format into a JSON transaction object.
-func Decode(hexEncoded string) (map[string]any, error) {
+// Decode decodes a hex string in the canonical binary format into a JSON transaction object,
+// using the codec's definitions.
+func (c *Codec) Decode(hexEncoded string) (map[string]any, error) {
 	b, err := hex.DecodeString(hexEncoded)
 	if err != nil {
 		return nil, err
 	}
-	p := serdes.NewBinaryParser(b, definitions.Get())
-	st := types.NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+	p := serdes.NewBinaryParser(b, c.definitions)
+	st := types.NewSTObjectWithDefinitions(c.definitions)
 	m, err := st.ToJSON(p)
 	if err != nil {
 		return nil, err
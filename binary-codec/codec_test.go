@@ -1,9 +1,12 @@
 package binarycodec
 
 import (
+	"encoding/json"
 	"errors"
+	"os"
 	"testing"
 
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/binary-codec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -990,3 +993,88 @@ func TestAuthoritativeDefinitionFieldsRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+// futureDefinitions returns the embedded definitions document extended with a transaction type,
+// a transaction result and a field that the embedded definitions do not know about.
+func futureDefinitions(t *testing.T) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile("definitions/definitions.json")
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	doc["hash"] = "FUTURE"
+	doc["TRANSACTION_TYPES"].(map[string]any)["FutureTransaction"] = 250
+	doc["TRANSACTION_RESULTS"].(map[string]any)["tecFUTURE"] = 250
+	doc["FIELDS"] = append(doc["FIELDS"].([]any), []any{
+		"FutureCounter",
+		map[string]any{"nth": 250, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"},
+	})
+
+	out, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return out
+}
+
+func TestCodec(t *testing.T) {
+	codec, err := NewCodecFromJSON(futureDefinitions(t))
+	require.NoError(t, err)
+	require.Equal(t, "FUTURE", codec.Definitions().Hash)
+
+	tx := map[string]any{
+		"TransactionType": "FutureTransaction",
+		"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Fee":             "10",
+		"Sequence":        uint32(1),
+		"FutureCounter":   uint32(42),
+		"SigningPubKey":   "",
+	}
+
+	encoded, err := codec.Encode(tx)
+	require.NoError(t, err)
+
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, tx, decoded)
+
+	signing, err := codec.EncodeForSigning(tx)
+	require.NoError(t, err)
+	require.Contains(t, signing, encoded[6:])
+
+	_, err = Encode(tx)
+	require.Error(t, err, "the embedded definitions do not know FutureTransaction")
+
+	_, err = Decode(encoded)
+	require.Error(t, err, "the embedded definitions do not know FutureTransaction")
+}
+
+func TestDefaultCodec(t *testing.T) {
+	require.Same(t, definitions.Get(), DefaultCodec().Definitions())
+
+	tx := map[string]any{
+		"TransactionType": "Payment",
+		"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount":          "1000",
+		"Fee":             "10",
+		"Sequence":        uint32(1),
+	}
+
+	encoded, err := Encode(tx)
+	require.NoError(t, err)
+
+	viaCodec, err := DefaultCodec().Encode(tx)
+	require.NoError(t, err)
+	require.Equal(t, encoded, viaCodec)
+}
+
+func TestNewCodecFromFile(t *testing.T) {
+	codec, err := NewCodecFromFile("definitions/definitions.json")
+	require.NoError(t, err)
+	require.Equal(t, definitions.Get().Hash, codec.Definitions().Hash)
+
+	_, err = NewCodecFromFile("definitions/missing.json")
+	require.Error(t, err)
+}
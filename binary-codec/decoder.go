@@ -0,0 +1,475 @@
+package binarycodec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/binary-codec/types"
+	"github.com/Peersyst/xrpl-go/pkg/hexutil"
+)
+
+// Amount is an Amount field read by a Decoder. XRP amounts set XRP and Drops, issued currency
+// amounts set Value, Currency and Issuer, and MPT amounts set Value and MPTIssuanceID, as upper
+// case hex.
+type Amount struct {
+	XRP           bool
+	Drops         uint64
+	Value         string
+	Currency      string
+	Issuer        string
+	MPTIssuanceID string
+}
+
+// Decoder reads fields from the canonical binary format one at a time, without building a JSON
+// map. Next advances to the next field, whose value is then read with the typed method matching
+// its type, or skipped with Skip. Errors are sticky: after the first failed read Next returns
+// false and Err returns the error.
+//
+// When the current field is an STObject, the following calls to Next iterate over its fields and
+// return false at its end. When it is an STArray, they iterate over its elements, each of them
+// an STObject, and return false at its end.
+type Decoder struct {
+	defs  *definitions.Definitions
+	p     *serdes.BinaryParser
+	field *definitions.FieldInstance
+	err   error
+}
+
+// NewDecoder returns a Decoder that reads data using the embedded definitions.
+func NewDecoder(data []byte) *Decoder {
+	return defaultCodec.NewDecoder(data)
+}
+
+// NewDecoder returns a Decoder that reads data using the codec's definitions.
+func (c *Codec) NewDecoder(data []byte) *Decoder {
+	d := &Decoder{defs: c.definitions}
+	d.Reset(data)
+	return d
+}
+
+// Reset makes the decoder read data from the start.
+func (d *Decoder) Reset(data []byte) {
+	d.p = serdes.NewBinaryParser(data, d.defs)
+	d.field = nil
+	d.err = nil
+}
+
+// Err returns the first error encountered while reading fields.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// Next advances to the next field. It returns false at the end of the data, at the end of the
+// current object or array, or after an error.
+func (d *Decoder) Next() bool {
+	d.field = nil
+	if d.err != nil || !d.p.HasMore() {
+		return false
+	}
+	fi, err := d.p.ReadField()
+	if err != nil {
+		d.err = err
+		return false
+	}
+	if fi.FieldName == "ObjectEndMarker" || fi.FieldName == "ArrayEndMarker" {
+		return false
+	}
+	d.field = fi
+	return true
+}
+
+// Field returns the name of the current field.
+func (d *Decoder) Field() string {
+	if d.field == nil {
+		return ""
+	}
+	return d.field.FieldName
+}
+
+// Type returns the serialized type of the current field.
+func (d *Decoder) Type() string {
+	if d.field == nil {
+		return ""
+	}
+	return d.field.Type
+}
+
+// check reports whether the current field can be read as typ, recording an error otherwise.
+func (d *Decoder) check(typ string) bool {
+	if d.err != nil {
+		return false
+	}
+	if d.field == nil {
+		d.err = ErrNoCurrentField
+		return false
+	}
+	if d.field.Type != typ {
+		d.err = &FieldTypeError{Field: d.field.FieldName, Type: d.field.Type, Expected: typ}
+		return false
+	}
+	return true
+}
+
+func (d *Decoder) read(n int) []byte {
+	b, err := d.p.ReadBytes(n)
+	if err != nil {
+		d.err = err
+		return nil
+	}
+	return b
+}
+
+func (d *Decoder) readVariableLength() []byte {
+	n, err := d.p.ReadVariableLength()
+	if err != nil {
+		d.err = err
+		return nil
+	}
+	return d.read(n)
+}
+
+// UInt8 reads a UInt8 field.
+func (d *Decoder) UInt8() uint8 {
+	if !d.check("UInt8") {
+		return 0
+	}
+	if b := d.read(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+// UInt16 reads a UInt16 field.
+func (d *Decoder) UInt16() uint16 {
+	if !d.check("UInt16") {
+		return 0
+	}
+	if b := d.read(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+// UInt32 reads a UInt32 field.
+func (d *Decoder) UInt32() uint32 {
+	if !d.check("UInt32") {
+		return 0
+	}
+	if b := d.read(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+// UInt64 reads a UInt64 field.
+func (d *Decoder) UInt64() uint64 {
+	if !d.check("UInt64") {
+		return 0
+	}
+	if b := d.read(8); b != nil {
+		return binary.BigEndian.Uint64(b)
+	}
+	return 0
+}
+
+// TransactionType reads the TransactionType field as a transaction type name.
+func (d *Decoder) TransactionType() string {
+	code := d.UInt16()
+	if d.err != nil {
+		return ""
+	}
+	name, err := d.defs.GetTransactionTypeNameByTransactionTypeCode(int32(code))
+	if err != nil {
+		d.err = err
+	}
+	return name
+}
+
+// LedgerEntryType reads the LedgerEntryType field as a ledger entry type name.
+func (d *Decoder) LedgerEntryType() string {
+	code := d.UInt16()
+	if d.err != nil {
+		return ""
+	}
+	name, err := d.defs.GetLedgerEntryTypeNameByLedgerEntryTypeCode(int32(code))
+	if err != nil {
+		d.err = err
+	}
+	return name
+}
+
+// Hash reads a Hash128, Hash160, Hash192 or Hash256 field as an upper case hex string.
+func (d *Decoder) Hash() string {
+	if d.err != nil {
+		return ""
+	}
+	if d.field == nil {
+		d.err = ErrNoCurrentField
+		return ""
+	}
+	var size int
+	switch d.field.Type {
+	case "Hash128":
+		size = 16
+	case "Hash160":
+		size = 20
+	case "Hash192":
+		size = 24
+	case "Hash256":
+		size = 32
+	default:
+		d.check("Hash256")
+		return ""
+	}
+	if b := d.read(size); b != nil {
+		return hexutil.EncodeToUpperHex(b)
+	}
+	return ""
+}
+
+// Blob reads a Blob field as an upper case hex string.
+func (d *Decoder) Blob() string {
+	if !d.check("Blob") {
+		return ""
+	}
+	if b := d.readVariableLength(); b != nil {
+		return hexutil.EncodeToUpperHex(b)
+	}
+	return ""
+}
+
+// AccountID reads an AccountID field as a classic address.
+func (d *Decoder) AccountID() string {
+	if !d.check("AccountID") {
+		return ""
+	}
+	b := d.readVariableLength()
+	if b == nil {
+		return ""
+	}
+	if len(b) == 0 {
+		return ""
+	}
+	address, err := addresscodec.Encode(b, []byte{addresscodec.AccountAddressPrefix}, addresscodec.AccountAddressLength)
+	if err != nil {
+		d.err = err
+	}
+	return address
+}
+
+// Amount reads an Amount field.
+func (d *Decoder) Amount() Amount {
+	if !d.check("Amount") {
+		return Amount{}
+	}
+
+	first, err := d.p.Peek()
+	if err != nil {
+		d.err = err
+		return Amount{}
+	}
+	// Positive XRP amounts (native, not MPT, sign bit 0x40 set), the only ones found in
+	// transactions, are read directly.
+	if first&types.NotXRPBitMask == 0 && first&types.MPTAmountFlag == 0 && first&0x40 != 0 {
+		b := d.read(types.NativeAmountByteLength)
+		if b == nil {
+			return Amount{}
+		}
+		return Amount{XRP: true, Drops: binary.BigEndian.Uint64(b) &^ types.PosSignBitMask}
+	}
+
+	v, err := (&types.Amount{}).ToJSON(d.p)
+	if err != nil {
+		d.err = err
+		return Amount{}
+	}
+	switch v := v.(type) {
+	case string:
+		drops, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			d.err = err
+			return Amount{}
+		}
+		return Amount{XRP: true, Drops: drops}
+	case map[string]any:
+		value, _ := v["value"].(string)
+		currency, _ := v["currency"].(string)
+		issuer, _ := v["issuer"].(string)
+		issuanceID, _ := v["mpt_issuance_id"].(string)
+		return Amount{Value: value, Currency: currency, Issuer: issuer, MPTIssuanceID: strings.ToUpper(issuanceID)}
+	default:
+		d.err = fmt.Errorf("unexpected amount %T", v)
+		return Amount{}
+	}
+}
+
+// Vector256 reads a Vector256 field as upper case hex encoded hashes.
+func (d *Decoder) Vector256() []string {
+	if !d.check("Vector256") {
+		return nil
+	}
+	b := d.readVariableLength()
+	if b == nil {
+		return nil
+	}
+	hashes := make([]string, 0, len(b)/32)
+	for i := 0; i+32 <= len(b); i += 32 {
+		hashes = append(hashes, hexutil.EncodeToUpperHex(b[i:i+32]))
+	}
+	return hashes
+}
+
+// PathSet reads a PathSet field.
+func (d *Decoder) PathSet() [][]PathStep {
+	if !d.check("PathSet") {
+		return nil
+	}
+	v, err := types.PathSet{}.ToJSON(d.p)
+	if err != nil {
+		d.err = err
+		return nil
+	}
+	rawPaths, _ := v.([]any)
+	paths := make([][]PathStep, 0, len(rawPaths))
+	for _, rawPath := range rawPaths {
+		rawSteps, _ := rawPath.([]any)
+		path := make([]PathStep, 0, len(rawSteps))
+		for _, rawStep := range rawSteps {
+			step, _ := rawStep.(map[string]any)
+			account, _ := step["account"].(string)
+			currency, _ := step["currency"].(string)
+			issuer, _ := step["issuer"].(string)
+			path = append(path, PathStep{Account: account, Currency: currency, Issuer: issuer})
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// Int32 reads an Int32 field.
+func (d *Decoder) Int32() int32 {
+	if !d.check("Int32") {
+		return 0
+	}
+	if b := d.read(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b)) //nolint:gosec // G115: two's complement decoding
+	}
+	return 0
+}
+
+// PermissionValue reads a UInt32 PermissionValue field as the name of a delegatable permission.
+func (d *Decoder) PermissionValue() string {
+	v := d.UInt32()
+	if d.err != nil {
+		return ""
+	}
+	name, err := d.defs.GetDelegatablePermissionNameByValue(int32(v)) //nolint:gosec // G115: permission values fit in an int32
+	if err != nil {
+		d.err = err
+	}
+	return name
+}
+
+// Currency reads a Currency field as a standard currency code, or hex for non-standard codes.
+func (d *Decoder) Currency() string {
+	v, _ := d.toJSON("Currency", &types.Currency{}).(string)
+	return v
+}
+
+// Number reads a Number field as a decimal string.
+func (d *Decoder) Number() string {
+	v, _ := d.toJSON("Number", &types.Number{}).(string)
+	return v
+}
+
+// Issue reads an Issue field.
+func (d *Decoder) Issue() Issue {
+	v, _ := d.toJSON("Issue", &types.Issue{}).(map[string]any)
+	return issueFromJSON(v)
+}
+
+// XChainBridge reads an XChainBridge field.
+func (d *Decoder) XChainBridge() XChainBridge {
+	v, _ := d.toJSON("XChainBridge", &types.XChainBridge{}).(map[string]any)
+	if v == nil {
+		return XChainBridge{}
+	}
+	lockingChainDoor, _ := v["LockingChainDoor"].(string)
+	lockingChainIssue, _ := v["LockingChainIssue"].(map[string]any)
+	issuingChainDoor, _ := v["IssuingChainDoor"].(string)
+	issuingChainIssue, _ := v["IssuingChainIssue"].(map[string]any)
+	return XChainBridge{
+		LockingChainDoor:  lockingChainDoor,
+		LockingChainIssue: issueFromJSON(lockingChainIssue),
+		IssuingChainDoor:  issuingChainDoor,
+		IssuingChainIssue: issueFromJSON(issuingChainIssue),
+	}
+}
+
+// toJSON reads a fixed size field of type typ in its JSON representation.
+func (d *Decoder) toJSON(typ string, st types.SerializedType) any {
+	if !d.check(typ) {
+		return nil
+	}
+	v, err := st.ToJSON(d.p)
+	if err != nil {
+		d.err = err
+		return nil
+	}
+	return v
+}
+
+// issueFromJSON converts the JSON representation of an Issue.
+func issueFromJSON(v map[string]any) Issue {
+	currency, _ := v["currency"].(string)
+	issuer, _ := v["issuer"].(string)
+	issuanceID, _ := v["mpt_issuance_id"].(string)
+	return Issue{Currency: currency, Issuer: issuer, MPTIssuanceID: issuanceID}
+}
+
+// Value reads the current field in its JSON representation, as returned by Decode. It is the
+// fallback for fields without a typed reader, and reads STObject and STArray fields whole.
+func (d *Decoder) Value() any {
+	if d.err != nil {
+		return nil
+	}
+	if d.field == nil {
+		d.err = ErrNoCurrentField
+		return nil
+	}
+	st := types.GetSerializedTypeWithDefinitions(d.field.Type, d.defs)
+	if st == nil {
+		d.err = fmt.Errorf("unknown type %q for field %q", d.field.Type, d.field.FieldName)
+		return nil
+	}
+
+	var (
+		v   any
+		err error
+	)
+	if d.field.IsVLEncoded {
+		n, lerr := d.p.ReadVariableLength()
+		if lerr != nil {
+			d.err = lerr
+			return nil
+		}
+		v, err = st.ToJSON(d.p, n)
+	} else {
+		v, err = st.ToJSON(d.p)
+	}
+	if err != nil {
+		d.err = err
+		return nil
+	}
+	return v
+}
+
+// Skip skips the value of the current field, including the contents of STObject and STArray fields.
+func (d *Decoder) Skip() {
+	d.Value()
+}
@@ -4,6 +4,7 @@ package definitions
 import (
 	_ "embed"
 	"maps"
+	"os"
 
 	"github.com/ugorji/go/codec"
 )
@@ -18,6 +19,10 @@ var (
 
 // Definitions holds the binary serialization definitions for the XRP Ledger, loaded from the RFC JSON document.
 type Definitions struct {
+	// Hash is the definitions hash reported by the server_definitions method. It is empty
+	// for definitions loaded from a document without a hash. The embedded definitions have
+	// the hash C685734F5FEB756693B4BB978BBB3A158A65652E71EEB2977068B0D680689213.
+	Hash                   string
 	Types                  map[string]int32
 	LedgerEntryTypes       map[string]int32
 	Fields                 fieldInstanceMap
@@ -28,12 +33,13 @@ type Definitions struct {
 	DelegatablePermissions map[string]int32
 }
 
-// Get returns the singleton instance of Definitions.
+// Get returns the singleton instance of Definitions, loaded from the embedded definitions.json.
 func Get() *Definitions {
 	return definitions
 }
 
 type definitionsDoc struct {
+	Hash               string           `json:"hash"`
 	Types              map[string]int32 `json:"TYPES"`
 	LedgerEntryTypes   map[string]int32 `json:"LEDGER_ENTRY_TYPES"`
 	Fields             fieldInstanceMap `json:"FIELDS"`
@@ -41,31 +47,57 @@ type definitionsDoc struct {
 	TransactionTypes   map[string]int32 `json:"TRANSACTION_TYPES"`
 }
 
-// Loads JSON from the definitions file and converts it to a preferred format.
-// The definitions file contains information required for the XRP Ledger's
+// Load builds a Definitions instance from a definitions document. The document can be in the
+// format of the embedded definitions.json or the result of the server_definitions method.
+// The definitions document contains information required for the XRP Ledger's
 // canonical binary serialization format:
 // `Serialization <https://xrpl.org/serialization.html>`_
-func loadDefinitions() {
+func Load(data []byte) (*Definitions, error) {
 	var jh codec.JsonHandle
 
 	jh.MapKeyAsString = true
 	jh.SignedInteger = true
 
-	dec := codec.NewDecoderBytes(docBytes, &jh)
-	var data definitionsDoc
-	dec.MustDecode(&data)
+	var doc definitionsDoc
+	if err := codec.NewDecoderBytes(data, &jh).Decode(&doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Types) == 0 || len(doc.Fields) == 0 || len(doc.TransactionTypes) == 0 {
+		return nil, ErrIncompleteDefinitions
+	}
 
-	definitions = &Definitions{
-		Types:              data.Types,
-		Fields:             data.Fields,
-		LedgerEntryTypes:   data.LedgerEntryTypes,
-		TransactionResults: data.TransactionResults,
-		TransactionTypes:   data.TransactionTypes,
+	d := &Definitions{
+		Hash:               doc.Hash,
+		Types:              doc.Types,
+		Fields:             doc.Fields,
+		LedgerEntryTypes:   doc.LedgerEntryTypes,
+		TransactionResults: doc.TransactionResults,
+		TransactionTypes:   doc.TransactionTypes,
 	}
 
-	addFieldHeadersAndOrdinals()
-	createFieldIDNameMap()
-	initializePermissions()
+	d.addFieldHeadersAndOrdinals()
+	d.createFieldIDNameMap()
+	d.initializePermissions()
+
+	return d, nil
+}
+
+// LoadFile builds a Definitions instance from the definitions document at the given path.
+func LoadFile(path string) (*Definitions, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: the path is provided by the caller on purpose
+	if err != nil {
+		return nil, err
+	}
+	return Load(data)
+}
+
+// Loads the embedded definitions file into the singleton instance.
+func loadDefinitions() {
+	d, err := Load(docBytes)
+	if err != nil {
+		panic(err)
+	}
+	definitions = d
 }
 
 func convertToFieldInstanceMap(m [][]any) map[string]*FieldInstance {
@@ -98,32 +130,32 @@ func castFieldInfo(v any) (FieldInfo, error) {
 	return FieldInfo{}, ErrUnableToCastFieldInfo
 }
 
-func addFieldHeadersAndOrdinals() {
-	for k := range definitions.Fields {
-		t, _ := definitions.GetTypeCodeByTypeName(definitions.Fields[k].Type)
+func (d *Definitions) addFieldHeadersAndOrdinals() {
+	for k := range d.Fields {
+		t, _ := d.GetTypeCodeByTypeName(d.Fields[k].Type)
 
-		if fi, ok := definitions.Fields[k]; ok {
+		if fi, ok := d.Fields[k]; ok {
 			fi.FieldHeader = &FieldHeader{
 				TypeCode:  t,
-				FieldCode: definitions.Fields[k].Nth,
+				FieldCode: d.Fields[k].Nth,
 			}
-			fi.Ordinal = (t<<16 | definitions.Fields[k].Nth)
+			fi.Ordinal = (t<<16 | d.Fields[k].Nth)
 		}
 	}
 }
 
-func createFieldIDNameMap() {
-	definitions.FieldIDNameMap = make(map[FieldHeader]string, len(definitions.Fields))
-	for k := range definitions.Fields {
-		fh, _ := definitions.GetFieldHeaderByFieldName(k)
+func (d *Definitions) createFieldIDNameMap() {
+	d.FieldIDNameMap = make(map[FieldHeader]string, len(d.Fields))
+	for k := range d.Fields {
+		fh, _ := d.GetFieldHeaderByFieldName(k)
 
-		definitions.FieldIDNameMap[*fh] = k
+		d.FieldIDNameMap[*fh] = k
 	}
 }
 
 // Initializes granular permissions and delegatable permissions mappings for account permission delegation.
-func initializePermissions() {
-	definitions.GranularPermissions = map[string]int32{
+func (d *Definitions) initializePermissions() {
+	d.GranularPermissions = map[string]int32{
 		"TrustlineAuthorize":     65537,
 		"TrustlineFreeze":        65538,
 		"TrustlineUnfreeze":      65539,
@@ -138,11 +170,11 @@ func initializePermissions() {
 		"MPTokenIssuanceUnlock":  65548,
 	}
 
-	definitions.DelegatablePermissions = make(map[string]int32)
+	d.DelegatablePermissions = make(map[string]int32)
 
-	maps.Copy(definitions.DelegatablePermissions, definitions.GranularPermissions)
+	maps.Copy(d.DelegatablePermissions, d.GranularPermissions)
 
-	for txType, value := range definitions.TransactionTypes {
-		definitions.DelegatablePermissions[txType] = value + 1
+	for txType, value := range d.TransactionTypes {
+		d.DelegatablePermissions[txType] = value + 1
 	}
 }
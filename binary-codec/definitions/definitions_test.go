@@ -9,6 +9,7 @@ import (
 func TestLoadDefinitions(t *testing.T) {
 	loadDefinitions()
 	require.Equal(t, int32(-1), definitions.Types["Done"])
+	require.Equal(t, "C685734F5FEB756693B4BB978BBB3A158A65652E71EEB2977068B0D680689213", definitions.Hash)
 	require.Equal(t, int32(4), definitions.Types["Hash128"])
 	require.Equal(t, int32(22), definitions.Types["Hash384"])
 	require.Equal(t, int32(23), definitions.Types["Hash512"])
@@ -101,3 +102,52 @@ func TestGet(t *testing.T) {
 	loadDefinitions()
 	require.Equal(t, definitions, Get())
 }
+
+func TestLoad(t *testing.T) {
+	d, err := Load(docBytes)
+	require.NoError(t, err)
+	require.NotSame(t, Get(), d)
+	require.Equal(t, Get().Hash, d.Hash)
+	require.Equal(t, Get().TransactionTypes, d.TransactionTypes)
+	require.Equal(t, Get().FieldIDNameMap, d.FieldIDNameMap)
+	require.Equal(t, Get().DelegatablePermissions, d.DelegatablePermissions)
+
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{
+			name: "fail - invalid json",
+			data: `{"TYPES":`,
+		},
+		{
+			name: "fail - missing fields",
+			data: `{"TYPES":{"UInt32":2},"TRANSACTION_TYPES":{"Payment":0}}`,
+			err:  ErrIncompleteDefinitions,
+		},
+		{
+			name: "fail - malformed field",
+			data: `{"TYPES":{"UInt32":2},"FIELDS":[["Sequence"]],"TRANSACTION_TYPES":{"Payment":0}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load([]byte(tt.data))
+			require.Error(t, err)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	d, err := LoadFile("definitions.json")
+	require.NoError(t, err)
+	require.Equal(t, Get().Types, d.Types)
+
+	_, err = LoadFile("missing.json")
+	require.Error(t, err)
+}
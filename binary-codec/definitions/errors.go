@@ -10,6 +10,9 @@ import (
 // ErrUnableToCastFieldInfo is returned when the field info cannot be cast.
 var ErrUnableToCastFieldInfo = errors.New("unable to cast to field info")
 
+// ErrIncompleteDefinitions is returned when a definitions document is missing its types, fields or transaction types.
+var ErrIncompleteDefinitions = errors.New("definitions document must contain TYPES, FIELDS and TRANSACTION_TYPES")
+
 // Dynamic errors
 
 // NotFoundError is an error that occurs when a value is not found.
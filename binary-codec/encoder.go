@@ -0,0 +1,694 @@
+package binarycodec
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/binary-codec/types"
+)
+
+var (
+	// Static errors
+
+	// ErrUnclosedScope is returned when the encoded bytes are requested while an object or array is still open.
+	ErrUnclosedScope = errors.New("object or array was not closed")
+	// ErrNoOpenScope is returned when an object or array is closed without having been opened.
+	ErrNoOpenScope = errors.New("no open object or array to close")
+	// ErrDuplicateField is returned when the same field is written more than once in an object.
+	ErrDuplicateField = errors.New("field written more than once")
+	// ErrNoCurrentField is returned when a Decoder value is read before a call to Next succeeded.
+	ErrNoCurrentField = errors.New("no current field")
+	// ErrInvalidHex is returned when a hex encoded value is not valid hex.
+	ErrInvalidHex = errors.New("invalid hex string")
+)
+
+// FieldTypeError is returned when a field is written or read as a type other than its serialized type.
+type FieldTypeError struct {
+	Field    string
+	Type     string
+	Expected string
+}
+
+// Error implements the error interface.
+func (e *FieldTypeError) Error() string {
+	return fmt.Sprintf("field %s has type %s, not %s", e.Field, e.Type, e.Expected)
+}
+
+// PathStep is a single step of a payment path.
+type PathStep struct {
+	Account  string
+	Currency string
+	Issuer   string
+}
+
+// Issue is an Issue field: XRP when Currency is "XRP" and Issuer empty, an issued currency with
+// Currency and Issuer, or an MPT with MPTIssuanceID.
+type Issue struct {
+	Currency      string
+	Issuer        string
+	MPTIssuanceID string
+}
+
+// json returns the JSON representation of the issue, as accepted by Encode.
+func (i Issue) json() map[string]any {
+	switch {
+	case i.MPTIssuanceID != "":
+		return map[string]any{"mpt_issuance_id": i.MPTIssuanceID}
+	case i.Issuer != "":
+		return map[string]any{"currency": i.Currency, "issuer": i.Issuer}
+	default:
+		return map[string]any{"currency": i.Currency}
+	}
+}
+
+// XChainBridge is an XChainBridge field.
+type XChainBridge struct {
+	LockingChainDoor  string
+	LockingChainIssue Issue
+	IssuingChainDoor  string
+	IssuingChainIssue Issue
+}
+
+// Encoder serializes fields straight into the canonical binary format, without building a JSON
+// map first. Fields can be written in any order: objects are sorted in canonical field order when
+// they are closed, and the top level when the bytes are requested. Errors are sticky: after the
+// first failed write the following writes are ignored and Err, Bytes and SigningBytes return it.
+//
+// An Encoder keeps its buffers across Reset, so reusing one avoids most allocations. The slices
+// returned by Bytes and SigningBytes are only valid until the next call to Reset.
+type Encoder struct {
+	defs   *definitions.Definitions
+	buf    []byte
+	fields []encodedField
+	scopes []encoderScope
+	out    []byte
+	err    error
+}
+
+// encodedField is a serialized field, header included, stored in the encoder buffer.
+type encodedField struct {
+	field      *definitions.FieldInstance
+	start, end int
+}
+
+// encoderScope is an open object or array, whose fields start at index first of the encoder fields.
+type encoderScope struct {
+	field *definitions.FieldInstance
+	first int
+	array bool
+}
+
+// NewEncoder returns an Encoder that uses the embedded definitions.
+func NewEncoder() *Encoder {
+	return defaultCodec.NewEncoder()
+}
+
+// NewEncoder returns an Encoder that uses the codec's definitions.
+func (c *Codec) NewEncoder() *Encoder {
+	return &Encoder{defs: c.definitions}
+}
+
+// Reset clears the encoder so it can be reused, keeping its buffers.
+func (e *Encoder) Reset() {
+	e.buf = e.buf[:0]
+	e.fields = e.fields[:0]
+	e.scopes = e.scopes[:0]
+	e.out = e.out[:0]
+	e.err = nil
+}
+
+// Err returns the first error encountered while writing fields.
+func (e *Encoder) Err() error {
+	return e.err
+}
+
+// Bytes returns the canonical binary encoding of the written fields.
+func (e *Encoder) Bytes() ([]byte, error) {
+	return e.finish(nil, false)
+}
+
+// SigningBytes returns the binary encoding to sign for a single signature: the transaction
+// signing prefix followed by the written signing fields.
+func (e *Encoder) SigningBytes() ([]byte, error) {
+	return e.finish([]byte{0x53, 0x54, 0x58, 0x00}, true)
+}
+
+// MultisigningBytes returns the binary encoding to sign for one signature of a multi-signed
+// transaction: the multisigning prefix, the written signing fields and the signer's account ID.
+func (e *Encoder) MultisigningBytes(account string) ([]byte, error) {
+	out, err := e.finish([]byte{0x53, 0x4D, 0x54, 0x00}, true)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := (&types.AccountID{}).FromJSON(account)
+	if err != nil {
+		return nil, err
+	}
+	e.out = append(out, accountID...)
+	return e.out, nil
+}
+
+func (e *Encoder) finish(prefix []byte, signingOnly bool) ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	if len(e.scopes) > 0 {
+		return nil, ErrUnclosedScope
+	}
+	if err := sortFields(e.fields); err != nil {
+		e.err = err
+		return nil, err
+	}
+
+	e.out = append(e.out[:0], prefix...)
+	for _, f := range e.fields {
+		if signingOnly && !f.field.IsSigningField {
+			continue
+		}
+		e.out = append(e.out, e.buf[f.start:f.end]...)
+	}
+	return e.out, nil
+}
+
+// sortFields sorts fields in canonical order and rejects duplicates.
+func sortFields(fields []encodedField) error {
+	slices.SortStableFunc(fields, func(a, b encodedField) int {
+		return cmp.Compare(a.field.Ordinal, b.field.Ordinal)
+	})
+	for i := 1; i < len(fields); i++ {
+		if fields[i].field.Ordinal == fields[i-1].field.Ordinal {
+			return fmt.Errorf("%w: %s", ErrDuplicateField, fields[i].field.FieldName)
+		}
+	}
+	return nil
+}
+
+// begin looks up a field, checks its type and writes its header. An empty typ accepts any type.
+// It returns false when the write must be skipped, either because of an error or because the
+// field is not serialized.
+func (e *Encoder) begin(name, typ string) (*definitions.FieldInstance, int, bool) {
+	if e.err != nil {
+		return nil, 0, false
+	}
+
+	fi, err := e.defs.GetFieldInstanceByFieldName(name)
+	if err != nil {
+		e.err = err
+		return nil, 0, false
+	}
+	if typ != "" && fi.Type != typ {
+		e.err = &FieldTypeError{Field: name, Type: fi.Type, Expected: typ}
+		return nil, 0, false
+	}
+	if n := len(e.scopes); n > 0 && e.scopes[n-1].array && fi.Type != "STObject" {
+		e.err = types.ErrNotSTObjectInSTArray
+		return nil, 0, false
+	}
+	if !fi.IsSerialized {
+		return nil, 0, false
+	}
+
+	start := len(e.buf)
+	e.buf = appendFieldHeader(e.buf, fi.FieldHeader)
+	return fi, start, true
+}
+
+// end records the field written since start.
+func (e *Encoder) end(fi *definitions.FieldInstance, start int) {
+	e.fields = append(e.fields, encodedField{field: fi, start: start, end: len(e.buf)})
+}
+
+// fail records err, discarding the partially written field that starts at start.
+func (e *Encoder) fail(start int, err error) {
+	e.buf = e.buf[:start]
+	e.err = err
+}
+
+// UInt8 writes a UInt8 field.
+func (e *Encoder) UInt8(field string, v uint8) {
+	fi, start, ok := e.begin(field, "UInt8")
+	if !ok {
+		return
+	}
+	e.buf = append(e.buf, v)
+	e.end(fi, start)
+}
+
+// UInt16 writes a UInt16 field.
+func (e *Encoder) UInt16(field string, v uint16) {
+	fi, start, ok := e.begin(field, "UInt16")
+	if !ok {
+		return
+	}
+	e.buf = binary.BigEndian.AppendUint16(e.buf, v)
+	e.end(fi, start)
+}
+
+// UInt32 writes a UInt32 field.
+func (e *Encoder) UInt32(field string, v uint32) {
+	fi, start, ok := e.begin(field, "UInt32")
+	if !ok {
+		return
+	}
+	e.buf = binary.BigEndian.AppendUint32(e.buf, v)
+	e.end(fi, start)
+}
+
+// UInt64 writes a UInt64 field.
+func (e *Encoder) UInt64(field string, v uint64) {
+	fi, start, ok := e.begin(field, "UInt64")
+	if !ok {
+		return
+	}
+	e.buf = binary.BigEndian.AppendUint64(e.buf, v)
+	e.end(fi, start)
+}
+
+// TransactionType writes the TransactionType field from the transaction type name.
+func (e *Encoder) TransactionType(name string) {
+	if e.err != nil {
+		return
+	}
+	code, err := e.defs.GetTransactionTypeCodeByTransactionTypeName(name)
+	if err != nil {
+		e.err = err
+		return
+	}
+	e.UInt16("TransactionType", uint16(code)) //nolint:gosec // G115: transaction type codes fit in a UInt16
+}
+
+// LedgerEntryType writes the LedgerEntryType field from the ledger entry type name.
+func (e *Encoder) LedgerEntryType(name string) {
+	if e.err != nil {
+		return
+	}
+	code, err := e.defs.GetLedgerEntryTypeCodeByLedgerEntryTypeName(name)
+	if err != nil {
+		e.err = err
+		return
+	}
+	e.UInt16("LedgerEntryType", uint16(code)) //nolint:gosec // G115: ledger entry type codes fit in a UInt16
+}
+
+// Hash128 writes a hex encoded Hash128 field.
+func (e *Encoder) Hash128(field, value string) {
+	e.hash(field, "Hash128", 16, value)
+}
+
+// Hash160 writes a hex encoded Hash160 field.
+func (e *Encoder) Hash160(field, value string) {
+	e.hash(field, "Hash160", 20, value)
+}
+
+// Hash192 writes a hex encoded Hash192 field.
+func (e *Encoder) Hash192(field, value string) {
+	e.hash(field, "Hash192", 24, value)
+}
+
+// Hash256 writes a hex encoded Hash256 field.
+func (e *Encoder) Hash256(field, value string) {
+	e.hash(field, "Hash256", 32, value)
+}
+
+func (e *Encoder) hash(field, typ string, size int, value string) {
+	fi, start, ok := e.begin(field, typ)
+	if !ok {
+		return
+	}
+	if len(value) != size*2 {
+		e.fail(start, &types.ErrInvalidHashLength{Expected: size})
+		return
+	}
+	var err error
+	if e.buf, err = appendHex(e.buf, value); err != nil {
+		e.fail(start, err)
+		return
+	}
+	e.end(fi, start)
+}
+
+// Blob writes a hex encoded Blob field.
+func (e *Encoder) Blob(field, value string) {
+	fi, start, ok := e.begin(field, "Blob")
+	if !ok {
+		return
+	}
+	if len(value)%2 != 0 {
+		e.fail(start, ErrInvalidHex)
+		return
+	}
+	var err error
+	if e.buf, err = appendVariableLength(e.buf, len(value)/2); err != nil {
+		e.fail(start, err)
+		return
+	}
+	if e.buf, err = appendHex(e.buf, value); err != nil {
+		e.fail(start, err)
+		return
+	}
+	e.end(fi, start)
+}
+
+// AccountID writes an AccountID field from a classic address, or an X-address without tag.
+func (e *Encoder) AccountID(field, address string) {
+	fi, start, ok := e.begin(field, "AccountID")
+	if !ok {
+		return
+	}
+	accountID, err := (&types.AccountID{}).FromJSON(address)
+	if err != nil {
+		e.fail(start, err)
+		return
+	}
+	e.buf = append(e.buf, byte(len(accountID)))
+	e.buf = append(e.buf, accountID...)
+	e.end(fi, start)
+}
+
+// XRPAmount writes an Amount field holding an amount of XRP in drops.
+func (e *Encoder) XRPAmount(field string, drops uint64) {
+	fi, start, ok := e.begin(field, "Amount")
+	if !ok {
+		return
+	}
+	if drops > types.MaxDrops {
+		e.fail(start, &types.InvalidAmountError{Amount: strconv.FormatUint(drops, 10)})
+		return
+	}
+	e.buf = binary.BigEndian.AppendUint64(e.buf, drops|types.PosSignBitMask)
+	e.end(fi, start)
+}
+
+// IssuedAmount writes an Amount field holding an issued currency amount.
+func (e *Encoder) IssuedAmount(field, value, currency, issuer string) {
+	fi, start, ok := e.begin(field, "Amount")
+	if !ok {
+		return
+	}
+	b, err := types.SerializeIssuedCurrencyAmount(value, currency, issuer)
+	if err != nil {
+		e.fail(start, err)
+		return
+	}
+	e.buf = append(e.buf, b...)
+	e.end(fi, start)
+}
+
+// MPTAmount writes an Amount field holding an MPT amount.
+func (e *Encoder) MPTAmount(field, value, issuanceID string) {
+	fi, start, ok := e.begin(field, "Amount")
+	if !ok {
+		return
+	}
+	b, err := types.SerializeMPTCurrencyAmount(value, issuanceID)
+	if err != nil {
+		e.fail(start, err)
+		return
+	}
+	e.buf = append(e.buf, b...)
+	e.end(fi, start)
+}
+
+// Vector256 writes a Vector256 field from hex encoded 256-bit hashes.
+func (e *Encoder) Vector256(field string, hashes []string) {
+	fi, start, ok := e.begin(field, "Vector256")
+	if !ok {
+		return
+	}
+	var err error
+	if e.buf, err = appendVariableLength(e.buf, len(hashes)*32); err != nil {
+		e.fail(start, err)
+		return
+	}
+	for _, h := range hashes {
+		if len(h) != 64 {
+			e.fail(start, &types.ErrInvalidHashLength{Expected: 32})
+			return
+		}
+		if e.buf, err = appendHex(e.buf, h); err != nil {
+			e.fail(start, err)
+			return
+		}
+	}
+	e.end(fi, start)
+}
+
+// PathSet writes a PathSet field.
+func (e *Encoder) PathSet(field string, paths [][]PathStep) {
+	fi, start, ok := e.begin(field, "PathSet")
+	if !ok {
+		return
+	}
+	if len(paths) == 0 {
+		e.fail(start, fmt.Errorf("%w: empty path set", types.ErrInvalidPathSet))
+		return
+	}
+	for i, path := range paths {
+		if len(path) == 0 {
+			e.fail(start, fmt.Errorf("%w: empty path", types.ErrInvalidPathSet))
+			return
+		}
+		for _, step := range path {
+			b, err := types.SerializePathStep(step.Account, step.Currency, step.Issuer)
+			if err != nil {
+				e.fail(start, err)
+				return
+			}
+			e.buf = append(e.buf, b...)
+		}
+		if i < len(paths)-1 {
+			e.buf = append(e.buf, 0xFF)
+		}
+	}
+	e.buf = append(e.buf, 0x00)
+	e.end(fi, start)
+}
+
+// Int32 writes an Int32 field.
+func (e *Encoder) Int32(field string, v int32) {
+	fi, start, ok := e.begin(field, "Int32")
+	if !ok {
+		return
+	}
+	e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(v)) //nolint:gosec // G115: two's complement encoding
+	e.end(fi, start)
+}
+
+// PermissionValue writes a UInt32 PermissionValue field from the name of a delegatable permission.
+func (e *Encoder) PermissionValue(field, name string) {
+	if e.err != nil {
+		return
+	}
+	v, err := e.defs.GetDelegatablePermissionValueByName(name)
+	if err != nil {
+		e.err = err
+		return
+	}
+	e.UInt32(field, uint32(v)) //nolint:gosec // G115: permission values are non-negative
+}
+
+// Currency writes a Currency field from a standard or hex encoded currency code.
+func (e *Encoder) Currency(field, code string) {
+	e.fromJSON(field, "Currency", &types.Currency{}, code)
+}
+
+// Number writes a Number field from its decimal string representation.
+func (e *Encoder) Number(field, value string) {
+	e.fromJSON(field, "Number", &types.Number{}, value)
+}
+
+// Issue writes an Issue field.
+func (e *Encoder) Issue(field string, issue Issue) {
+	e.fromJSON(field, "Issue", &types.Issue{}, issue.json())
+}
+
+// XChainBridge writes an XChainBridge field.
+func (e *Encoder) XChainBridge(field string, bridge XChainBridge) {
+	e.fromJSON(field, "XChainBridge", &types.XChainBridge{}, map[string]any{
+		"LockingChainDoor":  bridge.LockingChainDoor,
+		"LockingChainIssue": bridge.LockingChainIssue.json(),
+		"IssuingChainDoor":  bridge.IssuingChainDoor,
+		"IssuingChainIssue": bridge.IssuingChainIssue.json(),
+	})
+}
+
+// fromJSON writes a fixed size field of type typ from its JSON representation.
+func (e *Encoder) fromJSON(field, typ string, st types.SerializedType, value any) {
+	fi, start, ok := e.begin(field, typ)
+	if !ok {
+		return
+	}
+	b, err := st.FromJSON(value)
+	if err != nil {
+		e.fail(start, err)
+		return
+	}
+	e.buf = append(e.buf, b...)
+	e.end(fi, start)
+}
+
+// Value writes a field of any type from its JSON representation, as accepted by Encode. It is
+// the fallback for fields without a typed writer.
+func (e *Encoder) Value(field string, value any) {
+	fi, start, ok := e.begin(field, "")
+	if !ok {
+		return
+	}
+	st := types.GetSerializedTypeWithDefinitions(fi.Type, e.defs)
+	if st == nil {
+		e.fail(start, fmt.Errorf("unknown type %q for field %q", fi.Type, fi.FieldName))
+		return
+	}
+	b, err := st.FromJSON(value)
+	if err != nil {
+		e.fail(start, err)
+		return
+	}
+	if fi.IsVLEncoded {
+		if e.buf, err = appendVariableLength(e.buf, len(b)); err != nil {
+			e.fail(start, err)
+			return
+		}
+	}
+	e.buf = append(e.buf, b...)
+	if fi.Type == "STObject" {
+		e.buf = append(e.buf, types.ObjectEndMarker)
+	}
+	e.end(fi, start)
+}
+
+// BeginObject opens an STObject field. The fields written until the matching EndObject are
+// written inside the object. Inside an array, BeginObject opens an array element.
+func (e *Encoder) BeginObject(field string) {
+	e.beginScope(field, "STObject", false)
+}
+
+// EndObject closes the innermost open STObject field.
+func (e *Encoder) EndObject() {
+	e.endScope(false, types.ObjectEndMarker)
+}
+
+// BeginArray opens an STArray field. Its elements are written with BeginObject and EndObject.
+func (e *Encoder) BeginArray(field string) {
+	e.beginScope(field, "STArray", true)
+}
+
+// EndArray closes the innermost open STArray field.
+func (e *Encoder) EndArray() {
+	e.endScope(true, types.ArrayEndMarker)
+}
+
+func (e *Encoder) beginScope(field, typ string, array bool) {
+	if e.err != nil {
+		return
+	}
+	fi, start, ok := e.begin(field, typ)
+	if !ok {
+		if e.err == nil {
+			e.err = fmt.Errorf("field %s is not serialized", field)
+		}
+		return
+	}
+	// The header is written again when the scope is closed, after the sorted fields.
+	e.buf = e.buf[:start]
+	e.scopes = append(e.scopes, encoderScope{field: fi, first: len(e.fields), array: array})
+}
+
+func (e *Encoder) endScope(array bool, marker byte) {
+	if e.err != nil {
+		return
+	}
+	n := len(e.scopes)
+	if n == 0 || e.scopes[n-1].array != array {
+		e.err = ErrNoOpenScope
+		return
+	}
+	scope := e.scopes[n-1]
+	e.scopes = e.scopes[:n-1]
+
+	children := e.fields[scope.first:]
+	if !array {
+		if err := sortFields(children); err != nil {
+			e.err = err
+			return
+		}
+	}
+
+	start := len(e.buf)
+	e.buf = appendFieldHeader(e.buf, scope.field.FieldHeader)
+	for _, f := range children {
+		e.buf = append(e.buf, e.buf[f.start:f.end]...)
+	}
+	e.buf = append(e.buf, marker)
+
+	e.fields = e.fields[:scope.first]
+	e.end(scope.field, start)
+}
+
+// appendFieldHeader appends the field ID of a field header, in 1 to 3 bytes.
+//
+//nolint:gosec // G115: TypeCode/FieldCode are small protocol field codes
+func appendFieldHeader(b []byte, fh *definitions.FieldHeader) []byte {
+	switch {
+	case fh.TypeCode < 16 && fh.FieldCode < 16:
+		return append(b, byte(fh.TypeCode<<4)|byte(fh.FieldCode))
+	case fh.TypeCode >= 16 && fh.FieldCode < 16:
+		return append(b, byte(fh.FieldCode), byte(fh.TypeCode))
+	case fh.TypeCode < 16 && fh.FieldCode >= 16:
+		return append(b, byte(fh.TypeCode<<4), byte(fh.FieldCode))
+	default:
+		return append(b, 0, byte(fh.TypeCode), byte(fh.FieldCode))
+	}
+}
+
+// appendVariableLength appends the length prefix of a variable length field.
+//
+//nolint:gosec // G115: length is bounds-checked in each branch before conversion
+func appendVariableLength(b []byte, length int) ([]byte, error) {
+	switch {
+	case length <= 192:
+		return append(b, byte(length)), nil
+	case length <= 12480:
+		length -= 193
+		return append(b, byte((length>>8)+193), byte(length&0xFF)), nil
+	case length <= 918744:
+		length -= 12481
+		return append(b, byte((length>>16)+241), byte((length>>8)&0xFF), byte(length&0xFF)), nil
+	default:
+		return b, serdes.ErrLengthPrefixTooLong
+	}
+}
+
+// appendHex appends the bytes of a hex string, without allocating an intermediate slice.
+func appendHex(b []byte, s string) ([]byte, error) {
+	if len(s)%2 != 0 {
+		return b, ErrInvalidHex
+	}
+	for i := 0; i < len(s); i += 2 {
+		hi, ok1 := fromHexChar(s[i])
+		lo, ok2 := fromHexChar(s[i+1])
+		if !ok1 || !ok2 {
+			return b, ErrInvalidHex
+		}
+		b = append(b, hi<<4|lo)
+	}
+	return b, nil
+}
+
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
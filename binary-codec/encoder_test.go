@@ -0,0 +1,464 @@
+package binarycodec
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// transcode reads every field of d with its typed reader and writes it back to e with the
+// matching typed writer, so that re-encoding a blob exercises both paths.
+func transcode(t *testing.T, d *Decoder, e *Encoder) {
+	t.Helper()
+
+	for d.Next() {
+		field := d.Field()
+		switch d.Type() {
+		case "UInt8":
+			e.UInt8(field, d.UInt8())
+		case "UInt16":
+			e.UInt16(field, d.UInt16())
+		case "UInt32":
+			if field == "PermissionValue" {
+				e.PermissionValue(field, d.PermissionValue())
+			} else {
+				e.UInt32(field, d.UInt32())
+			}
+		case "Int32":
+			e.Int32(field, d.Int32())
+		case "UInt64":
+			e.UInt64(field, d.UInt64())
+		case "Hash128":
+			e.Hash128(field, d.Hash())
+		case "Hash160":
+			e.Hash160(field, d.Hash())
+		case "Hash192":
+			e.Hash192(field, d.Hash())
+		case "Hash256":
+			e.Hash256(field, d.Hash())
+		case "Blob":
+			e.Blob(field, d.Blob())
+		case "AccountID":
+			e.AccountID(field, d.AccountID())
+		case "Amount":
+			a := d.Amount()
+			switch {
+			case a.XRP:
+				e.XRPAmount(field, a.Drops)
+			case a.MPTIssuanceID != "":
+				e.MPTAmount(field, a.Value, a.MPTIssuanceID)
+			default:
+				e.IssuedAmount(field, a.Value, a.Currency, a.Issuer)
+			}
+		case "Vector256":
+			e.Vector256(field, d.Vector256())
+		case "PathSet":
+			e.PathSet(field, d.PathSet())
+		case "Currency":
+			e.Currency(field, d.Currency())
+		case "Number":
+			e.Number(field, d.Number())
+		case "Issue":
+			e.Issue(field, d.Issue())
+		case "XChainBridge":
+			e.XChainBridge(field, d.XChainBridge())
+		case "STObject":
+			e.BeginObject(field)
+			transcode(t, d, e)
+			e.EndObject()
+		case "STArray":
+			e.BeginArray(field)
+			for d.Next() {
+				e.BeginObject(d.Field())
+				transcode(t, d, e)
+				e.EndObject()
+			}
+			e.EndArray()
+		default:
+			e.Value(field, d.Value())
+		}
+	}
+	require.NoError(t, d.Err())
+}
+
+func loadCodecFixtureBlobs(t *testing.T) map[string][]string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata/fixtures", "codec-fixtures.json"))
+	require.NoError(t, err)
+
+	var fixtures struct {
+		AccountState []struct {
+			Binary string `json:"binary"`
+		} `json:"accountState"`
+		Transactions []struct {
+			Binary string `json:"binary"`
+		} `json:"transactions"`
+	}
+	require.NoError(t, json.Unmarshal(data, &fixtures))
+
+	blobs := map[string][]string{}
+	for _, f := range fixtures.AccountState {
+		blobs["accountState"] = append(blobs["accountState"], f.Binary)
+	}
+	for _, f := range fixtures.Transactions {
+		blobs["transactions"] = append(blobs["transactions"], f.Binary)
+	}
+	return blobs
+}
+
+func TestEncoder_TranscodeFixtures(t *testing.T) {
+	e := NewEncoder()
+	for kind, blobs := range loadCodecFixtureBlobs(t) {
+		for i, blob := range blobs {
+			// The map-based codec is the reference.
+			_, err := Decode(blob)
+			require.NoError(t, err, "%s[%d]", kind, i)
+			data, err := hex.DecodeString(blob)
+			require.NoError(t, err)
+
+			e.Reset()
+			transcode(t, NewDecoder(data), e)
+
+			encoded, err := e.Bytes()
+			require.NoError(t, err, "%s[%d]", kind, i)
+			require.Equal(t, strings.ToUpper(blob), strings.ToUpper(hex.EncodeToString(encoded)), "%s[%d]", kind, i)
+		}
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	payment := map[string]any{
+		"TransactionType": "Payment",
+		"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount":          map[string]any{"value": "1.5", "currency": "USD", "issuer": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+		"SendMax":         "1000000",
+		"Fee":             "12",
+		"Flags":           uint32(2147483648),
+		"Sequence":        uint32(5),
+		"InvoiceID":       "6F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B",
+		"SigningPubKey":   "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+		"Memos": []any{
+			map[string]any{"Memo": map[string]any{"MemoType": "687474703A2F2F6578616D706C652E636F6D2F6D656D6F2F67656E65726963", "MemoData": "72656E74"}},
+		},
+		"Paths": []any{
+			[]any{map[string]any{"currency": "XRP"}, map[string]any{"account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"}},
+			[]any{map[string]any{"currency": "USD", "issuer": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}},
+		},
+	}
+
+	writePayment := func(e *Encoder) {
+		// Written out of canonical order on purpose.
+		e.Hash256("InvoiceID", "6F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B")
+		e.BeginArray("Memos")
+		e.BeginObject("Memo")
+		e.Blob("MemoData", "72656E74")
+		e.Blob("MemoType", "687474703A2F2F6578616D706C652E636F6D2F6D656D6F2F67656E65726963")
+		e.EndObject()
+		e.EndArray()
+		e.AccountID("Destination", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+		e.AccountID("Account", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+		e.IssuedAmount("Amount", "1.5", "USD", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+		e.XRPAmount("SendMax", 1000000)
+		e.XRPAmount("Fee", 12)
+		e.UInt32("Sequence", 5)
+		e.UInt32("Flags", 2147483648)
+		e.TransactionType("Payment")
+		e.Blob("SigningPubKey", "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A")
+		e.PathSet("Paths", [][]PathStep{
+			{{Currency: "XRP"}, {Account: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"}},
+			{{Currency: "USD", Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}},
+		})
+	}
+
+	t.Run("matches Encode", func(t *testing.T) {
+		expected, err := Encode(payment)
+		require.NoError(t, err)
+
+		e := NewEncoder()
+		writePayment(e)
+		b, err := e.Bytes()
+		require.NoError(t, err)
+		require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)))
+	})
+
+	t.Run("matches EncodeForSigning", func(t *testing.T) {
+		expected, err := EncodeForSigning(payment)
+		require.NoError(t, err)
+
+		e := NewEncoder()
+		writePayment(e)
+		b, err := e.SigningBytes()
+		require.NoError(t, err)
+		require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)))
+	})
+
+	t.Run("matches EncodeForMultisigning", func(t *testing.T) {
+		expected, err := EncodeForMultisigning(payment, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+		require.NoError(t, err)
+
+		e := NewEncoder()
+		writePayment(e)
+		b, err := e.MultisigningBytes("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+		require.NoError(t, err)
+		require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)))
+	})
+
+	t.Run("is reusable after Reset", func(t *testing.T) {
+		e := NewEncoder()
+		writePayment(e)
+		first, err := e.Bytes()
+		require.NoError(t, err)
+		first = append([]byte(nil), first...)
+
+		e.Reset()
+		writePayment(e)
+		second, err := e.Bytes()
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+	})
+
+	t.Run("decodes what it encodes", func(t *testing.T) {
+		e := NewEncoder()
+		writePayment(e)
+		b, err := e.Bytes()
+		require.NoError(t, err)
+
+		expected, err := Decode(hex.EncodeToString(b))
+		require.NoError(t, err)
+
+		decoded := map[string]any{}
+		d := NewDecoder(b)
+		for d.Next() {
+			decoded[d.Field()] = d.Value()
+		}
+		require.NoError(t, d.Err())
+		require.Equal(t, len(expected), len(decoded))
+		require.Equal(t, expected["Account"], decoded["Account"])
+		require.Equal(t, expected["Paths"], decoded["Paths"])
+	})
+}
+
+func TestEncoder_TypedFields(t *testing.T) {
+	usd := Issue{Currency: "USD", Issuer: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"}
+	mpt := Issue{MPTIssuanceID: "0000012FFD9EE5DA93AC614B4DB94D7E0FCE415CA51BED47"}
+	bridge := XChainBridge{
+		LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		LockingChainIssue: Issue{Currency: "XRP"},
+		IssuingChainDoor:  "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		IssuingChainIssue: Issue{Currency: "XRP"},
+	}
+	fields := map[string]any{
+		"LoanScale":       int32(-5),
+		"AssetsMaximum":   "1234.5",
+		"Asset":           map[string]any{"currency": "USD", "issuer": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+		"Asset2":          map[string]any{"mpt_issuance_id": "0000012FFD9EE5DA93AC614B4DB94D7E0FCE415CA51BED47"},
+		"BaseAsset":       "XRP",
+		"QuoteAsset":      "EUR",
+		"PermissionValue": "Payment",
+		"XChainBridge": map[string]any{
+			"LockingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			"LockingChainIssue": map[string]any{"currency": "XRP"},
+			"IssuingChainDoor":  "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			"IssuingChainIssue": map[string]any{"currency": "XRP"},
+		},
+	}
+	expected, err := Encode(fields)
+	require.NoError(t, err)
+
+	e := NewEncoder()
+	e.Int32("LoanScale", -5)
+	e.Number("AssetsMaximum", "1234.5")
+	e.Issue("Asset", usd)
+	e.Issue("Asset2", mpt)
+	e.Currency("BaseAsset", "XRP")
+	e.Currency("QuoteAsset", "EUR")
+	e.PermissionValue("PermissionValue", "Payment")
+	e.XChainBridge("XChainBridge", bridge)
+	b, err := e.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)))
+
+	d := NewDecoder(b)
+	for d.Next() {
+		switch d.Field() {
+		case "LoanScale":
+			require.Equal(t, int32(-5), d.Int32())
+		case "AssetsMaximum":
+			require.Equal(t, "1234.5", d.Number())
+		case "Asset":
+			require.Equal(t, usd, d.Issue())
+		case "Asset2":
+			require.Equal(t, mpt, d.Issue())
+		case "BaseAsset":
+			require.Equal(t, "XRP", d.Currency())
+		case "QuoteAsset":
+			require.Equal(t, "EUR", d.Currency())
+		case "PermissionValue":
+			require.Equal(t, "Payment", d.PermissionValue())
+		case "XChainBridge":
+			require.Equal(t, bridge, d.XChainBridge())
+		default:
+			t.Fatalf("unexpected field %s", d.Field())
+		}
+	}
+	require.NoError(t, d.Err())
+}
+
+func TestEncoder_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		write       func(e *Encoder)
+		expectedErr error
+	}{
+		{
+			name:        "unknown field",
+			write:       func(e *Encoder) { e.UInt32("NotAField", 1) },
+			expectedErr: nil,
+		},
+		{
+			name:        "wrong type",
+			write:       func(e *Encoder) { e.UInt16("Sequence", 1) },
+			expectedErr: &FieldTypeError{Field: "Sequence", Type: "UInt32", Expected: "UInt16"},
+		},
+		{
+			name:        "duplicate field",
+			write:       func(e *Encoder) { e.UInt32("Sequence", 1); e.UInt32("Sequence", 2) },
+			expectedErr: ErrDuplicateField,
+		},
+		{
+			name: "unclosed array",
+			write: func(e *Encoder) {
+				e.BeginArray("Memos")
+				e.BeginObject("Memo")
+				e.Blob("MemoData", "72656E74")
+				e.EndObject()
+			},
+			expectedErr: ErrUnclosedScope,
+		},
+		{
+			name:        "close without open",
+			write:       func(e *Encoder) { e.EndObject() },
+			expectedErr: ErrNoOpenScope,
+		},
+		{
+			name: "non object in array",
+			write: func(e *Encoder) {
+				e.BeginArray("Memos")
+				e.UInt32("Sequence", 1)
+			},
+			expectedErr: types.ErrNotSTObjectInSTArray,
+		},
+		{
+			name:        "invalid hex",
+			write:       func(e *Encoder) { e.Blob("SigningPubKey", "ZZ") },
+			expectedErr: ErrInvalidHex,
+		},
+		{
+			name:        "XRP amount out of range",
+			write:       func(e *Encoder) { e.XRPAmount("Fee", types.MaxDrops+1) },
+			expectedErr: &types.InvalidAmountError{Amount: "100000000000000001"},
+		},
+		{
+			name:        "unknown permission",
+			write:       func(e *Encoder) { e.PermissionValue("PermissionValue", "NotAPermission") },
+			expectedErr: nil,
+		},
+		{
+			name:        "invalid number",
+			write:       func(e *Encoder) { e.Number("AssetsMaximum", "1.2.3") },
+			expectedErr: types.ErrInvalidNumber,
+		},
+		{
+			name:        "hash length",
+			write:       func(e *Encoder) { e.Hash256("InvoiceID", "AB") },
+			expectedErr: &types.ErrInvalidHashLength{Expected: 32},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEncoder()
+			tt.write(e)
+
+			_, err := e.Bytes()
+			require.Error(t, err)
+			if tt.expectedErr != nil {
+				require.ErrorContains(t, err, tt.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestDecoder_Errors(t *testing.T) {
+	t.Run("read before Next", func(t *testing.T) {
+		d := NewDecoder([]byte{0x22, 0x00, 0x00, 0x00, 0x00})
+		d.UInt32()
+		require.ErrorIs(t, d.Err(), ErrNoCurrentField)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		d := NewDecoder([]byte{0x22, 0x00, 0x00, 0x00, 0x00})
+		require.True(t, d.Next())
+		d.UInt16()
+		require.Equal(t, &FieldTypeError{Field: "Flags", Type: "UInt32", Expected: "UInt16"}, d.Err())
+		require.False(t, d.Next())
+	})
+
+	t.Run("truncated value", func(t *testing.T) {
+		d := NewDecoder([]byte{0x22, 0x00, 0x00})
+		require.True(t, d.Next())
+		d.UInt32()
+		require.Error(t, d.Err())
+	})
+}
+
+func BenchmarkEncode(b *testing.B) {
+	tx := map[string]any{
+		"TransactionType":    "Payment",
+		"Account":            "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Destination":        "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount":             "1000000",
+		"Fee":                "12",
+		"Flags":              uint32(0),
+		"Sequence":           uint32(5),
+		"LastLedgerSequence": uint32(100),
+		"SigningPubKey":      "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+	}
+
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := Encode(tx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("encoder", func(b *testing.B) {
+		b.ReportAllocs()
+		e := NewEncoder()
+		for b.Loop() {
+			e.Reset()
+			e.TransactionType("Payment")
+			e.AccountID("Account", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+			e.AccountID("Destination", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+			e.XRPAmount("Amount", 1000000)
+			e.XRPAmount("Fee", 12)
+			e.UInt32("Flags", 0)
+			e.UInt32("Sequence", 5)
+			e.UInt32("LastLedgerSequence", 100)
+			e.Blob("SigningPubKey", "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A")
+			if _, err := e.Bytes(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
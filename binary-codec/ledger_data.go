@@ -3,6 +3,8 @@ package binarycodec
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
@@ -10,6 +12,16 @@ import (
 	"github.com/Peersyst/xrpl-go/pkg/hexutil"
 )
 
+const (
+	// ledgerDataLength is the length in bytes of an encoded ledger header.
+	ledgerDataLength = 4 + 8 + 32 + 32 + 32 + 4 + 4 + 1 + 1
+)
+
+// Static errors
+
+// ErrInvalidLedgerDataHash is returned when a ledger header hash is not a 32 byte hex string.
+var ErrInvalidLedgerDataHash = errors.New("ledger data hash must be a 32 byte hex string")
+
 // LedgerData represents the data of a ledger.
 type LedgerData struct {
 	LedgerIndex         uint32
@@ -99,3 +111,36 @@ func DecodeLedgerData(data string) (LedgerData, error) {
 
 	return ledgerData, nil
 }
+
+// EncodeLedgerData encodes a LedgerData object into a hex string in the canonical binary format.
+// It is the inverse of DecodeLedgerData.
+func EncodeLedgerData(ledgerData LedgerData) (string, error) {
+	b, err := AppendLedgerData(make([]byte, 0, ledgerDataLength), ledgerData)
+	if err != nil {
+		return "", err
+	}
+	return hexutil.EncodeToUpperHex(b), nil
+}
+
+// AppendLedgerData appends the canonical binary encoding of a LedgerData object to b.
+func AppendLedgerData(b []byte, ledgerData LedgerData) ([]byte, error) {
+	totalCoins, err := strconv.ParseUint(ledgerData.TotalCoins, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid total coins: %w", err)
+	}
+
+	b = binary.BigEndian.AppendUint32(b, ledgerData.LedgerIndex)
+	b = binary.BigEndian.AppendUint64(b, totalCoins)
+	for _, h := range []string{ledgerData.ParentHash, ledgerData.TransactionHash, ledgerData.AccountHash} {
+		decoded, err := hex.DecodeString(h)
+		if err != nil || len(decoded) != 32 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidLedgerDataHash, h)
+		}
+		b = append(b, decoded...)
+	}
+	b = binary.BigEndian.AppendUint32(b, ledgerData.ParentCloseTime)
+	b = binary.BigEndian.AppendUint32(b, ledgerData.CloseTime)
+	b = append(b, ledgerData.CloseTimeResolution, ledgerData.CloseFlags)
+
+	return b, nil
+}
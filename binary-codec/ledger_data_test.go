@@ -2,6 +2,7 @@ package binarycodec
 
 import (
 	"encoding/hex"
+	"strconv"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
@@ -95,3 +96,73 @@ func TestDecodeLedgerData(t *testing.T) {
 		})
 	}
 }
+
+func TestEncodeLedgerData(t *testing.T) {
+	valid := LedgerData{
+		AccountHash:         "3B5C3E520634D343EF5D9D9A4246643D64DAD278BA95DC0EAC6EB5350CF970D5",
+		CloseFlags:          0,
+		CloseTime:           556231910,
+		CloseTimeResolution: 10,
+		LedgerIndex:         32052277,
+		ParentCloseTime:     556231902,
+		ParentHash:          "EACEB081770D8ADE216C85445DD6FB002C6B5A2930F2DECE006DA18150CB18F6",
+		TotalCoins:          "99994494362043555",
+		TransactionHash:     "DD33F6F0990754C962A7CCE62F332FF9C13939B03B864117F0BDA86B6E9B4F87",
+	}
+
+	testcases := []struct {
+		name        string
+		input       func() LedgerData
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "pass - valid ledger data",
+			input:    func() LedgerData { return valid },
+			expected: "01E91435016340767BF1C4A3EACEB081770D8ADE216C85445DD6FB002C6B5A2930F2DECE006DA18150CB18F6DD33F6F0990754C962A7CCE62F332FF9C13939B03B864117F0BDA86B6E9B4F873B5C3E520634D343EF5D9D9A4246643D64DAD278BA95DC0EAC6EB5350CF970D521276CDE21276CE60A00",
+		},
+		{
+			name: "fail - invalid total coins",
+			input: func() LedgerData {
+				l := valid
+				l.TotalCoins = "-1"
+				return l
+			},
+			expectedErr: strconv.ErrSyntax,
+		},
+		{
+			name: "fail - invalid parent hash",
+			input: func() LedgerData {
+				l := valid
+				l.ParentHash = "EACE"
+				return l
+			},
+			expectedErr: ErrInvalidLedgerDataHash,
+		},
+		{
+			name: "fail - invalid account hash",
+			input: func() LedgerData {
+				l := valid
+				l.AccountHash = "not hex"
+				return l
+			},
+			expectedErr: ErrInvalidLedgerDataHash,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded, err := EncodeLedgerData(tc.input())
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, encoded)
+
+			decoded, err := DecodeLedgerData(encoded)
+			require.NoError(t, err)
+			require.Equal(t, tc.input(), decoded)
+		})
+	}
+}
@@ -57,6 +57,11 @@ func (s *BinarySerializer) WriteFieldAndValue(fi definitions.FieldInstance, valu
 	return nil
 }
 
+// EncodeVariableLength returns the length prefix of a variable length field holding length bytes.
+func EncodeVariableLength(length int) ([]byte, error) {
+	return encodeVariableLength(length)
+}
+
 func encodeVariableLength(length int) ([]byte, error) {
 	//nolint:gosec // G115: length is bounds-checked in each branch before conversion
 	if length <= 192 {
@@ -143,7 +143,7 @@ func (a *Amount) FromJSON(value any) ([]byte, error) {
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", errInvalidMPTIssuanceID.Error(), err)
 			}
-			return serializeMPTCurrencyAmount(val, id)
+			return SerializeMPTCurrencyAmount(val, id)
 		}
 
 		// Otherwise, assume issued‐currency → must have both currency & issuer
@@ -163,7 +163,7 @@ func (a *Amount) FromJSON(value any) ([]byte, error) {
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", errInvalidIssuerFormat.Error(), err)
 		}
-		return serializeIssuedCurrencyAmount(val, curr, iss)
+		return SerializeIssuedCurrencyAmount(val, curr, iss)
 
 	default:
 		return nil, errInvalidAmountType
@@ -543,7 +543,7 @@ func serializeIssuedCurrencyCodeChars(currency string) ([]byte, error) {
 // from value, currency code, and issuer address in string form (e.g. "USD", "r123456789").
 // The currency code can be 3 allowed string characters, or 20 bytes of hex in standard currency format (e.g. with "00" prefix)
 // or non-standard currency format (e.g. without "00" prefix)
-func serializeIssuedCurrencyAmount(value, currency, issuer string) ([]byte, error) {
+func SerializeIssuedCurrencyAmount(value, currency, issuer string) ([]byte, error) {
 	valBytes, err := SerializeIssuedCurrencyValue(value) // serialize the value
 	if err != nil {
 		return nil, err
@@ -594,9 +594,9 @@ func serializeMPTCurrencyIssuanceID(issuanceHex string) ([]byte, error) {
 	return idBytes, nil
 }
 
-// serializeMPTCurrencyAmount serializes a complete MPT amount by combining the value and issuance ID.
+// SerializeMPTCurrencyAmount serializes a complete MPT amount by combining the value and issuance ID.
 // It adds the MPT marker byte and arranges the components into a 33-byte sequence.
-func serializeMPTCurrencyAmount(valueStr, issuanceHex string) ([]byte, error) {
+func SerializeMPTCurrencyAmount(valueStr, issuanceHex string) ([]byte, error) {
 	if err := verifyMPTValue(valueStr); err != nil {
 		return nil, err
 	}
@@ -661,7 +661,7 @@ func TestSerializeIssuedCurrencyAmount(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := serializeIssuedCurrencyAmount(tt.inputValue, tt.inputCurrency, tt.inputIssuer)
+			got, err := SerializeIssuedCurrencyAmount(tt.inputValue, tt.inputCurrency, tt.inputIssuer)
 
 			if tt.expectedErr != nil {
 				require.EqualError(t, tt.expectedErr, err.Error())
@@ -832,7 +832,7 @@ func TestSerializeMPTCurrencyAmount(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := serializeMPTCurrencyAmount(tt.value, tt.issuanceID)
+			got, err := SerializeMPTCurrencyAmount(tt.value, tt.issuanceID)
 
 			if tt.expErr != nil {
 				require.Error(t, err)
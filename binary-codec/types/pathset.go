@@ -157,6 +157,44 @@ func newPathStep(v map[string]any) ([]byte, error) {
 	return append([]byte{byte(dataType)}, b...), nil
 }
 
+// SerializePathStep serializes a single path step from its account, currency and issuer, leaving
+// out the parts that are empty. It does not include the path separator or path set end bytes.
+func SerializePathStep(account, currency, issuer string) ([]byte, error) {
+	dataType := 0x00
+	b := make([]byte, 1, 61)
+
+	if account != "" {
+		_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(account)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid account path step: %w", ErrInvalidPathSet, err)
+		}
+		b = append(b, accountID...)
+		dataType |= typeAccount
+	}
+	if currency != "" {
+		currencyBytes, err := serializePathCurrency(currency)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid currency path step: %w", ErrInvalidPathSet, err)
+		}
+		b = append(b, currencyBytes...)
+		dataType |= typeCurrency
+	}
+	if issuer != "" {
+		_, issuerID, err := addresscodec.DecodeClassicAddressToAccountID(issuer)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid issuer path step: %w", ErrInvalidPathSet, err)
+		}
+		b = append(b, issuerID...)
+		dataType |= typeIssuer
+	}
+	if dataType == 0x00 {
+		return nil, fmt.Errorf("%w: path step has no account/currency/issuer", ErrInvalidPathSet)
+	}
+
+	b[0] = byte(dataType)
+	return b, nil
+}
+
 // ToJSON decodes a path set from a binary representation using a provided binary parser, then translates it to a JSON representation.
 // It returns a slice representing the JSON format of the path set, or an error if the path set could not be decoded or if an invalid step is encountered.
 func (p PathSet) ToJSON(parser interfaces.BinaryParser, _ ...int) (any, error) {
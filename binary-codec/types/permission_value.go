@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 
-	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 )
@@ -18,14 +17,16 @@ var (
 )
 
 // PermissionValue represents a 32-bit unsigned integer permission value.
-type PermissionValue struct{}
+type PermissionValue struct {
+	definitionsRef
+}
 
 // FromJSON converts a JSON value into a serialized byte slice representing a 32-bit unsigned integer permission value.
 // If the input value is a string, it's assumed to be a permission name, and the method will
 // attempt to convert it into a corresponding permission value. If the conversion fails, an error is returned.
 func (p *PermissionValue) FromJSON(value any) ([]byte, error) {
 	if s, ok := value.(string); ok {
-		pv, err := definitions.Get().GetDelegatablePermissionValueByName(s)
+		pv, err := p.defs().GetDelegatablePermissionValueByName(s)
 		if err != nil {
 			return nil, err
 		}
@@ -59,7 +60,7 @@ func (p *PermissionValue) ToJSON(parser interfaces.BinaryParser, _ ...int) (any,
 	permissionValue := binary.BigEndian.Uint32(b)
 
 	// #nosec G115
-	if name, err := definitions.Get().GetDelegatablePermissionNameByValue(int32(permissionValue)); err == nil {
+	if name, err := p.defs().GetDelegatablePermissionNameByValue(int32(permissionValue)); err == nil {
 		return name, nil
 	}
 
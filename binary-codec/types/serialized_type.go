@@ -3,7 +3,6 @@ package types
 
 import (
 	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
-	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
 	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
 )
 
@@ -17,17 +16,40 @@ type SerializedType interface {
 	ToJSON(parser interfaces.BinaryParser, opts ...int) (any, error)
 }
 
+// definitionsRef holds the definitions a serialized type resolves field and enum names against.
+// The zero value resolves against the embedded definitions.
+type definitionsRef struct {
+	definitions *definitions.Definitions
+}
+
+// defs returns the referenced definitions, or the embedded definitions if none are set.
+func (r definitionsRef) defs() *definitions.Definitions {
+	if r.definitions == nil {
+		return definitions.Get()
+	}
+	return r.definitions
+}
+
 // GetSerializedType is a function that returns the correct SerializedType instance
 // based on the string parameter.
 // It creates a new instance of the type described by the parameter, allowing
 // the appropriate methods of that type to be called.
 // If the input string does not match a known type, the function returns nil.
 func GetSerializedType(t string) SerializedType {
+	return GetSerializedTypeWithDefinitions(t, nil)
+}
+
+// GetSerializedTypeWithDefinitions returns the correct SerializedType instance based on the
+// string parameter, resolving field and enum names against the given definitions, or against
+// the embedded definitions if defs is nil.
+// If the input string does not match a known type, the function returns nil.
+func GetSerializedTypeWithDefinitions(t string, defs *definitions.Definitions) SerializedType {
+	ref := definitionsRef{definitions: defs}
 	switch t {
 	case "UInt8":
-		return &UInt8{}
+		return &UInt8{definitionsRef: ref}
 	case "UInt16":
-		return &UInt16{}
+		return &UInt16{definitionsRef: ref}
 	case "UInt32":
 		return &UInt32{}
 	case "Int32":
@@ -51,9 +73,9 @@ func GetSerializedType(t string) SerializedType {
 	case "Blob":
 		return &Blob{}
 	case "STObject":
-		return NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+		return NewSTObjectWithDefinitions(defs)
 	case "STArray":
-		return &STArray{}
+		return &STArray{definitionsRef: ref}
 	case "PathSet":
 		return &PathSet{}
 	case "XChainBridge":
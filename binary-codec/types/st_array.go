@@ -4,8 +4,6 @@ package types
 import (
 	"errors"
 
-	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
-	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
 	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
 )
 
@@ -17,7 +15,9 @@ const (
 )
 
 // STArray represents an array of STObject instances.
-type STArray struct{}
+type STArray struct {
+	definitionsRef
+}
 
 // ErrNotSTObjectInSTArray is returned when a non-STObject value is found in an STArray.
 var ErrNotSTObjectInSTArray = errors.New("not STObject in STArray. Array fields must be STObjects")
@@ -42,7 +42,7 @@ func (t *STArray) FromJSON(json any) ([]byte, error) {
 
 	var sink []byte
 	for _, v := range json.([]any) {
-		st := NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+		st := NewSTObjectWithDefinitions(t.defs())
 		b, err := st.FromJSON(v)
 		if err != nil {
 			return nil, err
@@ -78,7 +78,7 @@ func (t *STArray) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 			return nil, ErrNotSTObjectInSTArray
 		}
 
-		st := GetSerializedType(fi.Type)
+		st := GetSerializedTypeWithDefinitions(fi.Type, t.defs())
 		res, err := st.ToJSON(p)
 		if err != nil {
 			return nil, err
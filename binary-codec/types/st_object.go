@@ -7,6 +7,7 @@ import (
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
 	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
 	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
 )
 
@@ -14,6 +15,7 @@ import (
 // and the associated value is the field's value. This structure allows us to represent nested
 // and complex structures of the Ripple protocol.
 type STObject struct {
+	definitionsRef
 	binarySerializer interfaces.BinarySerializer
 }
 
@@ -22,6 +24,16 @@ func NewSTObject(bs interfaces.BinarySerializer) *STObject {
 	return &STObject{binarySerializer: bs}
 }
 
+// NewSTObjectWithDefinitions returns a new STObject that serializes and resolves fields
+// against the given definitions, or against the embedded definitions if defs is nil.
+func NewSTObjectWithDefinitions(defs *definitions.Definitions) *STObject {
+	ref := definitionsRef{definitions: defs}
+	return &STObject{
+		definitionsRef:   ref,
+		binarySerializer: serdes.NewBinarySerializer(serdes.NewFieldIDCodec(ref.defs())),
+	}
+}
+
 // FromJSON converts a JSON object into a serialized byte slice.
 // It works by converting the JSON object into a map of field instances (which include the field definition
 // and value), and then serializing each field instance.
@@ -30,7 +42,7 @@ func (t *STObject) FromJSON(json any) ([]byte, error) {
 	if _, ok := json.(map[string]any); !ok {
 		return nil, errNotValidJSON
 	}
-	fimap, err := createFieldInstanceMapFromJson(json.(map[string]any))
+	fimap, err := createFieldInstanceMapFromJson(t.defs(), json.(map[string]any))
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +54,7 @@ func (t *STObject) FromJSON(json any) ([]byte, error) {
 			continue
 		}
 
-		st := GetSerializedType(v.Type)
+		st := GetSerializedTypeWithDefinitions(v.Type, t.defs())
 		if st == nil {
 			return nil, fmt.Errorf("unknown type %q for field %q", v.Type, v.FieldName)
 		}
@@ -75,7 +87,7 @@ func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 			break
 		}
 
-		st := GetSerializedType(fi.Type)
+		st := GetSerializedTypeWithDefinitions(fi.Type, t.defs())
 		if st == nil {
 			return nil, fmt.Errorf("unknown type %q for field %q", fi.Type, fi.FieldName)
 		}
@@ -97,7 +109,7 @@ func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 				return nil, fmt.Errorf("ToJSON error for field %q (type=%s): %w", fi.FieldName, fi.Type, err)
 			}
 		}
-		res, err = enumToStr(fi.FieldName, res)
+		res, err = enumToStr(t.defs(), fi.FieldName, res)
 		if err != nil {
 			return nil, err
 		}
@@ -115,7 +127,7 @@ func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 // Also handles X-addresses by extracting embedded tags.
 //
 //lint:ignore U1000 // ignore this for now
-func createFieldInstanceMapFromJson(json map[string]any) (map[definitions.FieldInstance]any, error) {
+func createFieldInstanceMapFromJson(defs *definitions.Definitions, json map[string]any) (map[definitions.FieldInstance]any, error) {
 	// First pass: handle X-addresses and extract tags
 	processedJSON := make(map[string]any, len(json))
 	for k, v := range json {
@@ -165,12 +177,12 @@ func createFieldInstanceMapFromJson(json map[string]any) (map[definitions.FieldI
 	m := make(map[definitions.FieldInstance]any, len(processedJSON))
 
 	for k, v := range processedJSON {
-		fi, err := definitions.Get().GetFieldInstanceByFieldName(k)
+		fi, err := defs.GetFieldInstanceByFieldName(k)
 		if err != nil {
 			return nil, err
 		}
 
-		v, err = parseSpecialFields(k, v)
+		v, err = parseSpecialFields(defs, k, v)
 		if err != nil {
 			return nil, err
 		}
@@ -181,10 +193,22 @@ func createFieldInstanceMapFromJson(json map[string]any) (map[definitions.FieldI
 }
 
 // parseSpecialFields is a helper function that handles special fields that need type parsing.
-func parseSpecialFields(k string, v any) (any, error) {
+func parseSpecialFields(defs *definitions.Definitions, k string, v any) (any, error) {
+	// LedgerEntryType names are looked up here, as some of them (DepositPreauth) are also
+	// transaction type names, which UInt16 would try first.
+	if k == "LedgerEntryType" {
+		if strValue, ok := v.(string); ok {
+			ledgerEntryType, err := defs.GetLedgerEntryTypeCodeByLedgerEntryTypeName(strValue)
+			if err != nil {
+				return nil, err
+			}
+			return int(ledgerEntryType), nil
+		}
+	}
+
 	if k == "PermissionValue" {
 		if strValue, ok := v.(string); ok {
-			permissionValue, err := definitions.Get().GetDelegatablePermissionValueByName(strValue)
+			permissionValue, err := defs.GetDelegatablePermissionValueByName(strValue)
 			if err != nil {
 				return nil, err
 			}
@@ -220,24 +244,24 @@ func getSortedKeys(m map[definitions.FieldInstance]any) []definitions.FieldInsta
 // and returns a string representation of the value if the field is an enumerated type
 // (i.e., TransactionType, TransactionResult, LedgerEntryType, PermissionValue).
 // If the field is not an enumerated type, the original value is returned.
-func enumToStr(fieldName string, value any) (any, error) {
+func enumToStr(defs *definitions.Definitions, fieldName string, value any) (any, error) {
 	switch fieldName {
 	case "TransactionType":
 		// TODO: Check if this is still needed
 		//nolint:gosec // G115: integer overflow conversion int -> int32, value is bounded by protocol
-		return definitions.Get().GetTransactionTypeNameByTransactionTypeCode(int32(value.(int)))
+		return defs.GetTransactionTypeNameByTransactionTypeCode(int32(value.(int)))
 	case "TransactionResult":
 		// TODO: Check if this is still needed
 		//nolint:gosec // G115: integer overflow conversion int -> int32, value is bounded by protocol
-		return definitions.Get().GetTransactionResultNameByTransactionResultTypeCode(int32(value.(int)))
+		return defs.GetTransactionResultNameByTransactionResultTypeCode(int32(value.(int)))
 	case "LedgerEntryType":
 		// TODO: Check if this is still needed
 		//nolint:gosec // G115: integer overflow conversion int -> int32, value is bounded by protocol
-		return definitions.Get().GetLedgerEntryTypeNameByLedgerEntryTypeCode(int32(value.(int)))
+		return defs.GetLedgerEntryTypeNameByLedgerEntryTypeCode(int32(value.(int)))
 	case "PermissionValue":
 		// Convert permission value to permission name if available, otherwise return numeric value
 		//nolint:gosec // G115: integer overflow conversion int -> int32, value is bounded by protocol
-		if name, err := definitions.Get().GetDelegatablePermissionNameByValue(int32(value.(uint32))); err == nil {
+		if name, err := defs.GetDelegatablePermissionNameByValue(int32(value.(uint32))); err == nil {
 			return name, nil
 		}
 		return value, nil
@@ -80,7 +80,7 @@ func TestStObject_FromJson(t *testing.T) {
 }
 
 func TestCreateFieldInstanceMapFromJsonXAddressZeroTag(t *testing.T) {
-	got, err := createFieldInstanceMapFromJson(map[string]any{
+	got, err := createFieldInstanceMapFromJson(definitions.Get(), map[string]any{
 		"Destination": "XV5sbjUmgPpvXv4ixFWZ5ptAYZ6PD2m4Er6SnvjVLpMWPjR",
 	})
 	require.NoError(t, err)
@@ -88,6 +88,15 @@ func TestCreateFieldInstanceMapFromJsonXAddressZeroTag(t *testing.T) {
 	require.Equal(t, uint32(0), got[testutil.GetFieldInstance(t, "DestinationTag")])
 }
 
+func TestCreateFieldInstanceMapFromJsonLedgerEntryType(t *testing.T) {
+	// DepositPreauth is both a ledger entry type (0x70) and a transaction type (0x13).
+	got, err := createFieldInstanceMapFromJson(definitions.Get(), map[string]any{
+		"LedgerEntryType": "DepositPreauth",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0x70, got[testutil.GetFieldInstance(t, "LedgerEntryType")])
+}
+
 func TestCreateFieldInstanceMapFromJsonXAddressDuplicateZeroTag(t *testing.T) {
 	testcases := []struct {
 		name string
@@ -113,7 +122,7 @@ func TestCreateFieldInstanceMapFromJsonXAddressDuplicateZeroTag(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := createFieldInstanceMapFromJson(map[string]any{
+			_, err := createFieldInstanceMapFromJson(definitions.Get(), map[string]any{
 				"Destination":    "XV5sbjUmgPpvXv4ixFWZ5ptAYZ6PD2m4Er6SnvjVLpMWPjR",
 				"DestinationTag": tc.tag,
 			})
@@ -124,7 +133,7 @@ func TestCreateFieldInstanceMapFromJsonXAddressDuplicateZeroTag(t *testing.T) {
 
 func TestCreateFieldInstanceMapFromJsonXAddressNonZeroTag(t *testing.T) {
 	t.Run("Destination populates DestinationTag", func(t *testing.T) {
-		got, err := createFieldInstanceMapFromJson(map[string]any{
+		got, err := createFieldInstanceMapFromJson(definitions.Get(), map[string]any{
 			"Destination": "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGxLBw6rACm2heBxVn",
 		})
 		require.NoError(t, err)
@@ -133,7 +142,7 @@ func TestCreateFieldInstanceMapFromJsonXAddressNonZeroTag(t *testing.T) {
 	})
 
 	t.Run("Account populates SourceTag", func(t *testing.T) {
-		got, err := createFieldInstanceMapFromJson(map[string]any{
+		got, err := createFieldInstanceMapFromJson(definitions.Get(), map[string]any{
 			"Account": "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGxLBw6rACm2heBxVn",
 		})
 		require.NoError(t, err)
@@ -172,7 +181,7 @@ func TestCreateFieldInstanceMapFromJsonXAddressTagNotAllowed(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := createFieldInstanceMapFromJson(map[string]any{
+			_, err := createFieldInstanceMapFromJson(definitions.Get(), map[string]any{
 				tc.fieldName: tc.xAddress,
 			})
 			require.ErrorIs(t, err, ErrAccountIDTagNotAllowed)
@@ -197,7 +206,7 @@ func TestCreateFieldInstanceMapFromJsonXAddressDuplicateNonZeroTag(t *testing.T)
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := createFieldInstanceMapFromJson(map[string]any{
+			_, err := createFieldInstanceMapFromJson(definitions.Get(), map[string]any{
 				"Destination":    "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGxLBw6rACm2heBxVn",
 				"DestinationTag": tc.tag,
 			})
@@ -6,12 +6,13 @@ import (
 	"encoding/binary"
 	"math"
 
-	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
 )
 
 // UInt16 represents a 16-bit unsigned integer.
-type UInt16 struct{}
+type UInt16 struct {
+	definitionsRef
+}
 
 // checkRange validates that a value fits within the uint16 range (0-65535).
 func (u *UInt16) checkRange(value int64) error {
@@ -26,9 +27,9 @@ func (u *UInt16) checkRange(value int64) error {
 // method will attempt to convert it into a corresponding type code. If the conversion fails, an error is returned.
 func (u *UInt16) FromJSON(value any) ([]byte, error) {
 	if _, ok := value.(string); ok {
-		tc, err := definitions.Get().GetTransactionTypeCodeByTransactionTypeName(value.(string))
+		tc, err := u.defs().GetTransactionTypeCodeByTransactionTypeName(value.(string))
 		if err != nil {
-			tc, err = definitions.Get().GetLedgerEntryTypeCodeByLedgerEntryTypeName(value.(string))
+			tc, err = u.defs().GetLedgerEntryTypeCodeByLedgerEntryTypeName(value.(string))
 			if err != nil {
 				return nil, err
 			}
@@ -6,12 +6,13 @@ import (
 	"encoding/binary"
 	"math"
 
-	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
 )
 
 // UInt8 represents an 8-bit unsigned integer.
-type UInt8 struct{}
+type UInt8 struct {
+	definitionsRef
+}
 
 // checkRange validates that a value fits within the uint8 range (0-255).
 func (u *UInt8) checkRange(value int64) error {
@@ -26,7 +27,7 @@ func (u *UInt8) checkRange(value int64) error {
 // attempt to convert it into a transaction result type code. If the conversion fails, an error is returned.
 func (u *UInt8) FromJSON(value any) ([]byte, error) {
 	if s, ok := value.(string); ok {
-		tc, err := definitions.Get().GetTransactionResultTypeCodeByTransactionResultName(s)
+		tc, err := u.defs().GetTransactionResultTypeCodeByTransactionResultName(s)
 		if err != nil {
 			return nil, err
 		}
@@ -13,102 +13,94 @@ var (
 	errNotValidXChainBridge = errors.New("not a valid xchain bridge")
 )
 
-const xChainBridgeLength = 80
+// xChainBridgeDoorLength is the length prefix of each door account, as in rippled's STXChainBridge.
+const xChainBridgeDoorLength = 20
 
 // XChainBridge is a struct that represents an xchain bridge.
+// It is serialized as the locking chain door, the locking chain issue, the issuing chain door and
+// the issuing chain issue, where each door is a length-prefixed AccountID and each issue an Issue.
 type XChainBridge struct{}
 
 // FromJSON converts a json XChainBridge object to its byte slice representation.
-// It returns an error if the json is not valid or if the classic addresses are not valid.
+// It returns an error if the json is not valid, if the classic addresses are not valid or if
+// the issues are not valid Issue objects.
 func (x *XChainBridge) FromJSON(json any) ([]byte, error) {
 	v, ok := json.(map[string]any)
 	if !ok {
 		return nil, errNotValidJSON
 	}
 
-	lockingChainDoorStr, ok := v["LockingChainDoor"].(string)
-	if !ok {
-		return nil, errNotValidXChainBridge
-	}
+	bytes := make([]byte, 0, 2*(1+xChainBridgeDoorLength)+4*20)
 
-	lockingChainIssueStr, ok := v["LockingChainIssue"].(string)
-	if !ok {
-		return nil, errNotValidXChainBridge
-	}
+	for _, field := range []struct {
+		door  string
+		issue string
+	}{
+		{door: "LockingChainDoor", issue: "LockingChainIssue"},
+		{door: "IssuingChainDoor", issue: "IssuingChainIssue"},
+	} {
+		doorStr, ok := v[field.door].(string)
+		if !ok {
+			return nil, errNotValidXChainBridge
+		}
 
-	issuingChainDoorStr, ok := v["IssuingChainDoor"].(string)
-	if !ok {
-		return nil, errNotValidXChainBridge
-	}
+		issueJSON, ok := v[field.issue].(map[string]any)
+		if !ok {
+			return nil, errNotValidXChainBridge
+		}
 
-	issuingChainIssueStr, ok := v["IssuingChainIssue"].(string)
-	if !ok {
-		return nil, errNotValidXChainBridge
-	}
-
-	_, lockingChainDoor, err := addresscodec.DecodeClassicAddressToAccountID(lockingChainDoorStr)
-	if err != nil {
-		return nil, errDecodeClassicAddress
-	}
+		_, door, err := addresscodec.DecodeClassicAddressToAccountID(doorStr)
+		if err != nil {
+			return nil, errDecodeClassicAddress
+		}
 
-	_, lockingChainIssue, err := addresscodec.DecodeClassicAddressToAccountID(lockingChainIssueStr)
-	if err != nil {
-		return nil, errDecodeClassicAddress
-	}
-
-	_, issuingChainDoor, err := addresscodec.DecodeClassicAddressToAccountID(issuingChainDoorStr)
-	if err != nil {
-		return nil, errDecodeClassicAddress
-	}
+		issue, err := (&Issue{}).FromJSON(issueJSON)
+		if err != nil {
+			return nil, err
+		}
 
-	_, issuingChainIssue, err := addresscodec.DecodeClassicAddressToAccountID(issuingChainIssueStr)
-	if err != nil {
-		return nil, errDecodeClassicAddress
+		bytes = append(bytes, xChainBridgeDoorLength)
+		bytes = append(bytes, door...)
+		bytes = append(bytes, issue...)
 	}
 
-	bytes := make([]byte, 0, 80)
-
-	bytes = append(bytes, lockingChainDoor...)
-	bytes = append(bytes, lockingChainIssue...)
-	bytes = append(bytes, issuingChainDoor...)
-	bytes = append(bytes, issuingChainIssue...)
-
 	return bytes, nil
 }
 
 // ToJSON converts a byte slice representation of an XChainBridge object to its json representation.
 // It returns an error if the bytes are not valid or if the classic addresses are not valid.
-func (x *XChainBridge) ToJSON(p interfaces.BinaryParser, opts ...int) (any, error) {
-	if len(opts) == 0 {
-		return nil, ErrNoLengthPrefix
-	}
-
-	bytes, err := p.ReadBytes(opts[0])
-	if err != nil {
-		return nil, errReadBytes
-	}
-
-	if len(bytes) != xChainBridgeLength {
-		return nil, errNotValidXChainBridge
-	}
-
-	json := make(map[string]string)
-
-	json["LockingChainDoor"], err = addresscodec.Encode(bytes[:20], []byte{addresscodec.AccountAddressPrefix}, addresscodec.AccountAddressLength)
-	if err != nil {
-		return nil, err
-	}
-	json["LockingChainIssue"], err = addresscodec.Encode(bytes[20:40], []byte{addresscodec.AccountAddressPrefix}, addresscodec.AccountAddressLength)
-	if err != nil {
-		return nil, err
-	}
-	json["IssuingChainDoor"], err = addresscodec.Encode(bytes[40:60], []byte{addresscodec.AccountAddressPrefix}, addresscodec.AccountAddressLength)
-	if err != nil {
-		return nil, err
-	}
-	json["IssuingChainIssue"], err = addresscodec.Encode(bytes[60:80], []byte{addresscodec.AccountAddressPrefix}, addresscodec.AccountAddressLength)
-	if err != nil {
-		return nil, err
+func (x *XChainBridge) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
+	json := make(map[string]any)
+
+	for _, field := range []struct {
+		door  string
+		issue string
+	}{
+		{door: "LockingChainDoor", issue: "LockingChainIssue"},
+		{door: "IssuingChainDoor", issue: "IssuingChainIssue"},
+	} {
+		length, err := p.ReadByte()
+		if err != nil {
+			return nil, errReadBytes
+		}
+		if length != xChainBridgeDoorLength {
+			return nil, errNotValidXChainBridge
+		}
+
+		door, err := p.ReadBytes(xChainBridgeDoorLength)
+		if err != nil {
+			return nil, errReadBytes
+		}
+
+		json[field.door], err = addresscodec.Encode(door, []byte{addresscodec.AccountAddressPrefix}, addresscodec.AccountAddressLength)
+		if err != nil {
+			return nil, err
+		}
+
+		json[field.issue], err = (&Issue{}).ToJSON(p)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return json, nil
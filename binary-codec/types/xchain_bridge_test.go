@@ -1,9 +1,9 @@
 package types
 
 import (
-	"bytes"
 	"errors"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
@@ -13,6 +13,23 @@ import (
 	"github.com/golang/mock/gomock"
 )
 
+var (
+	// AccountID of r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p.
+	xChainBridgeTestDoor = []byte{83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18}
+	// Currency code of USD.
+	xChainBridgeTestUSD = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 'U', 'S', 'D', 0, 0, 0, 0, 0}
+	// XRP-XRP bridge with r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p as both doors.
+	xChainBridgeTestXRPBytes = slices.Concat(
+		[]byte{20}, xChainBridgeTestDoor, XRPBytes,
+		[]byte{20}, xChainBridgeTestDoor, XRPBytes,
+	)
+	// USD-USD bridge issued by r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p with it as both doors.
+	xChainBridgeTestIOUBytes = slices.Concat(
+		[]byte{20}, xChainBridgeTestDoor, xChainBridgeTestUSD, xChainBridgeTestDoor,
+		[]byte{20}, xChainBridgeTestDoor, xChainBridgeTestUSD, xChainBridgeTestDoor,
+	)
+)
+
 func TestXChainBridge_FromJson(t *testing.T) {
 	tt := []struct {
 		name string
@@ -24,31 +41,31 @@ func TestXChainBridge_FromJson(t *testing.T) {
 			name: "valid xchain bridge",
 			json: map[string]any{
 				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainIssue": map[string]any{"currency": "XRP"},
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "XRP"},
 			},
-			want: []byte{83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18},
+			want: xChainBridgeTestXRPBytes,
 			err:  nil,
 		},
 		{
-			name: "invalid LockingChainDoor classic address",
+			name: "valid xchain bridge with issued currencies",
 			json: map[string]any{
-				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p1",
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainIssue": map[string]any{"currency": "USD", "issuer": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p"},
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "USD", "issuer": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p"},
 			},
-			want: nil,
-			err:  errDecodeClassicAddress,
+			want: xChainBridgeTestIOUBytes,
+			err:  nil,
 		},
 		{
-			name: "invalid LockingChainIssue classic address",
+			name: "invalid LockingChainDoor classic address",
 			json: map[string]any{
-				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p1",
+				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p1",
+				"LockingChainIssue": map[string]any{"currency": "XRP"},
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "XRP"},
 			},
 			want: nil,
 			err:  errDecodeClassicAddress,
@@ -57,23 +74,23 @@ func TestXChainBridge_FromJson(t *testing.T) {
 			name: "invalid IssuingChainDoor classic address",
 			json: map[string]any{
 				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainIssue": map[string]any{"currency": "XRP"},
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p1",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "XRP"},
 			},
 			want: nil,
 			err:  errDecodeClassicAddress,
 		},
 		{
-			name: "invalid IssuingChainIssue classic address",
+			name: "invalid LockingChainIssue object",
 			json: map[string]any{
 				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainIssue": map[string]any{"issuer": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p"},
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p1",
+				"IssuingChainIssue": map[string]any{"currency": "XRP"},
 			},
 			want: nil,
-			err:  errDecodeClassicAddress,
+			err:  ErrInvalidIssueObject,
 		},
 		{
 			name: "not a valid json",
@@ -86,7 +103,7 @@ func TestXChainBridge_FromJson(t *testing.T) {
 			json: map[string]any{
 				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "XRP"},
 			},
 			want: nil,
 			err:  errNotValidXChainBridge,
@@ -95,20 +112,20 @@ func TestXChainBridge_FromJson(t *testing.T) {
 			name: "LockingChainDoor is not a string",
 			json: map[string]any{
 				"LockingChainDoor":  123,
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainIssue": map[string]any{"currency": "XRP"},
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "XRP"},
 			},
 			want: nil,
 			err:  errNotValidXChainBridge,
 		},
 		{
-			name: "LockingChainIssue is not a string",
+			name: "LockingChainIssue is not an object",
 			json: map[string]any{
 				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"LockingChainIssue": 123,
+				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "XRP"},
 			},
 			want: nil,
 			err:  errNotValidXChainBridge,
@@ -117,18 +134,18 @@ func TestXChainBridge_FromJson(t *testing.T) {
 			name: "IssuingChainDoor is not a string",
 			json: map[string]any{
 				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainIssue": map[string]any{"currency": "XRP"},
 				"IssuingChainDoor":  123,
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "XRP"},
 			},
 			want: nil,
 			err:  errNotValidXChainBridge,
 		},
 		{
-			name: "IssuingChainIssue is not a string",
+			name: "IssuingChainIssue is not an object",
 			json: map[string]any{
 				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainIssue": map[string]any{"currency": "XRP"},
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
 				"IssuingChainIssue": 123,
 			},
@@ -142,9 +159,9 @@ func TestXChainBridge_FromJson(t *testing.T) {
 			xcb := &XChainBridge{}
 			got, err := xcb.FromJSON(tc.json)
 			if !errors.Is(err, tc.err) {
-				t.Errorf("FromJson() error = %v, want %v", err.Error(), tc.err.Error())
+				t.Errorf("FromJson() error = %v, want %v", err, tc.err)
 			}
-			if !bytes.Equal(got, tc.want) {
+			if !reflect.DeepEqual(got, tc.want) {
 				t.Errorf("FromJson() got = %v, want %v", got, tc.want)
 			}
 		})
@@ -154,92 +171,67 @@ func TestXChainBridge_FromJson(t *testing.T) {
 func TestXChainBridge_ToJson(t *testing.T) {
 	tt := []struct {
 		name  string
-		input []byte
-		opts  []int
-		want  map[string]string
+		want  map[string]any
 		err   error
 		setup func(t *testing.T) (*XChainBridge, interfaces.BinaryParser)
 	}{
 		{
-			name:  "Valid xchain bridge",
-			input: []byte{83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18},
-			opts:  []int{80},
-			want: map[string]string{
+			name: "Valid xchain bridge",
+			want: map[string]any{
 				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainIssue": map[string]any{"currency": "XRP"},
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "XRP"},
 			},
 			err: nil,
-			setup: func(t *testing.T) (*XChainBridge, interfaces.BinaryParser) {
-				ctrl := gomock.NewController(t)
-				mock := testutil.NewMockBinaryParser(ctrl)
-				mock.EXPECT().ReadBytes(80).Return([]byte{83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18}, nil)
-				return &XChainBridge{}, mock
+			setup: func(_ *testing.T) (*XChainBridge, interfaces.BinaryParser) {
+				return &XChainBridge{}, serdes.NewBinaryParser(xChainBridgeTestXRPBytes, definitions.Get())
 			},
 		},
 		{
-			name:  "Valid xchain bridge - real parser",
-			input: []byte{83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18},
-			opts:  []int{80},
-			want: map[string]string{
+			name: "Valid xchain bridge with issued currencies",
+			want: map[string]any{
 				"LockingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"LockingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"LockingChainIssue": map[string]any{"currency": "USD", "issuer": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p"},
 				"IssuingChainDoor":  "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
-				"IssuingChainIssue": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p",
+				"IssuingChainIssue": map[string]any{"currency": "USD", "issuer": "r3e7qTG44Mg8pHXgxPtyRx286Re5Urtx2p"},
 			},
 			err: nil,
-			setup: func(t *testing.T) (*XChainBridge, interfaces.BinaryParser) {
-				payload := []byte{83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18}
-				return &XChainBridge{}, serdes.NewBinaryParser(payload, definitions.Get())
+			setup: func(_ *testing.T) (*XChainBridge, interfaces.BinaryParser) {
+				return &XChainBridge{}, serdes.NewBinaryParser(xChainBridgeTestIOUBytes, definitions.Get())
 			},
 		},
 		{
-			name:  "No length prefix",
-			input: []byte{83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18},
-			opts:  nil,
-			want:  nil,
-			err:   ErrNoLengthPrefix,
-			setup: func(t *testing.T) (*XChainBridge, interfaces.BinaryParser) {
-				return &XChainBridge{}, nil
-			},
-		},
-		{
-			name:  "ReadBytes error",
-			input: []byte{83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18, 83, 223, 129, 195, 127, 70, 21, 146, 66, 247, 202, 145, 99, 224, 159, 4, 64, 41, 204, 18},
-			opts:  []int{80},
-			want:  nil,
-			err:   errReadBytes,
+			name: "ReadByte error",
+			want: nil,
+			err:  errReadBytes,
 			setup: func(t *testing.T) (*XChainBridge, interfaces.BinaryParser) {
 				ctrl := gomock.NewController(t)
 				mock := testutil.NewMockBinaryParser(ctrl)
-				mock.EXPECT().ReadBytes(80).Return([]byte{}, errors.New("errReadBytes"))
+				mock.EXPECT().ReadByte().Return(byte(0), errors.New("errReadByte"))
 				return &XChainBridge{}, mock
 			},
 		},
 		{
-			name:  "Short bytes",
-			input: nil,
-			opts:  []int{80},
-			want:  nil,
-			err:   errNotValidXChainBridge,
+			name: "Invalid door length prefix",
+			want: nil,
+			err:  errNotValidXChainBridge,
 			setup: func(t *testing.T) (*XChainBridge, interfaces.BinaryParser) {
 				ctrl := gomock.NewController(t)
 				mock := testutil.NewMockBinaryParser(ctrl)
-				mock.EXPECT().ReadBytes(80).Return(make([]byte, 60), nil)
+				mock.EXPECT().ReadByte().Return(byte(19), nil)
 				return &XChainBridge{}, mock
 			},
 		},
 		{
-			name:  "Nil bytes",
-			input: nil,
-			opts:  []int{80},
-			want:  nil,
-			err:   errNotValidXChainBridge,
+			name: "ReadBytes error",
+			want: nil,
+			err:  errReadBytes,
 			setup: func(t *testing.T) (*XChainBridge, interfaces.BinaryParser) {
 				ctrl := gomock.NewController(t)
 				mock := testutil.NewMockBinaryParser(ctrl)
-				mock.EXPECT().ReadBytes(80).Return(nil, nil)
+				mock.EXPECT().ReadByte().Return(byte(20), nil)
+				mock.EXPECT().ReadBytes(20).Return(nil, errors.New("errReadBytes"))
 				return &XChainBridge{}, mock
 			},
 		},
@@ -248,10 +240,10 @@ func TestXChainBridge_ToJson(t *testing.T) {
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			xcb, parser := tc.setup(t)
-			got, err := xcb.ToJSON(parser, tc.opts...)
+			got, err := xcb.ToJSON(parser)
 			if !errors.Is(err, tc.err) {
-				t.Errorf("ToJson() error = %v, want %v", err.Error(), tc.err.Error())
-			} else if tc.err == nil && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ToJson() error = %v, want %v", err, tc.err)
+			} else if tc.err == nil && !reflect.DeepEqual(got, any(tc.want)) {
 				t.Errorf("ToJson() got = %v, want %v", got, tc.want)
 			}
 		})
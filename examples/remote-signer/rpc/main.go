@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/faucet"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet/remote"
+
+	rpctypes "github.com/Peersyst/xrpl-go/xrpl/rpc/types"
+)
+
+// Submits a payment signed by the signing process of examples/remote-signer/signer, which must
+// be running. This process never sees the private key.
+func main() {
+	socket := flag.String("socket", "/tmp/xrpl-signer.sock", "path of the signer Unix socket")
+	flag.Parse()
+
+	cfg, err := rpc.NewClientConfig(
+		"https://s.altnet.rippletest.net:51234/",
+		rpc.WithMaxFeeXRP(5.0),
+		rpc.WithFeeCushion(1.5),
+	)
+	if err != nil {
+		panic(err)
+	}
+	client := rpc.NewClient(cfg)
+
+	signer, err := remote.Dial("unix", *socket)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer signer.Close()
+
+	fmt.Printf("🔐 Connected to the signer of %s\n", signer.GetAddress())
+
+	fmt.Println("⏳ Funding account...")
+	if err := faucet.NewTestnetFaucetProvider().FundWallet(signer.GetAddress()); err != nil {
+		fmt.Println(err)
+		return
+	}
+	time.Sleep(5 * time.Second)
+	fmt.Println("💸 Account funded")
+
+	p := &transaction.Payment{
+		BaseTx: transaction.BaseTx{
+			Account: signer.GetAddress(),
+		},
+		Destination: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		Amount:      types.XRPCurrencyAmount(1000000),
+	}
+
+	fmt.Println("⏳ Sending 1 XRP to rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe...")
+	resp, err := client.SubmitTxAndWait(p.Flatten(), &rpctypes.SubmitOptions{
+		Autofill: true,
+		Wallet:   signer,
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println("✅ Payment submitted")
+	fmt.Printf("🌐 Hash: %s\n", resp.Hash)
+	fmt.Printf("🌐 Validated: %t\n", resp.Validated)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet/remote"
+)
+
+// The signing process: it holds the private key and signs the messages sent over a Unix socket
+// only readable and writable by its user. The seed is read from the XRPL_SIGNER_SEED environment
+// variable, or a new wallet is generated.
+func main() {
+	socket := flag.String("socket", "/tmp/xrpl-signer.sock", "path of the Unix socket to listen on")
+	flag.Parse()
+
+	w, err := loadWallet()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// Remove the socket left by a previous run.
+	if err := os.Remove(*socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		fmt.Println(err)
+		return
+	}
+	l, err := net.Listen("unix", *socket)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := os.Chmod(*socket, 0o600); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	server := remote.NewServer(w)
+	go func() {
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+		<-stop
+		_ = server.Close()
+	}()
+
+	fmt.Printf("🔐 Signing for %s on %s\n", w.ClassicAddress, *socket)
+	if err := server.Serve(l); err != nil && !errors.Is(err, remote.ErrServerClosed) {
+		fmt.Println(err)
+	}
+	fmt.Println("👋 Signer stopped")
+}
+
+func loadWallet() (wallet.Wallet, error) {
+	if seed := os.Getenv("XRPL_SIGNER_SEED"); seed != "" {
+		return wallet.FromSeed(seed, "")
+	}
+	return wallet.New(crypto.ED25519())
+}
@@ -12,3 +12,9 @@ type KeypairCryptoAlg interface {
 type NodeDerivationCryptoAlg interface {
 	DerivePublicKeyFromPublicGenerator(pubKey []byte) ([]byte, error)
 }
+
+// PublicKeyDerivationCryptoAlg is an interface that defines the methods for a crypto algorithm
+// deriving public keys from private keys.
+type PublicKeyDerivationCryptoAlg interface {
+	DerivePublicKey(privKey string) (string, error)
+}
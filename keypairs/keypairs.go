@@ -3,10 +3,13 @@ package keypairs
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
 	"github.com/Peersyst/xrpl-go/keypairs/interfaces"
 	xrplcrypto "github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/rfc1751"
+	"github.com/Peersyst/xrpl-go/pkg/secretnumbers"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
@@ -20,8 +23,8 @@ const (
 // If entropy is nil or empty, it generates a random seed using r, r must be non-nil in that case
 // (otherwise ErrRandomizerRequired is returned). When entropy is provided it must be exactly
 // addresscodec.FamilySeedLength bytes and r is not consulted, so callers may pass nil for r.
-// Do not pass passphrases directly. For deterministic passphrase-based seeds, hash or KDF the
-// passphrase outside this function and pass exactly 16 derived bytes.
+// Do not pass passphrases directly. For the passphrase-based seeds of rippled's wallet_propose,
+// use GenerateSeedFromPassphrase.
 // The seed is encoded using the addresscodec package.
 func GenerateSeed(entropy []byte, alg interfaces.KeypairCryptoAlg, r interfaces.Randomizer) (string, error) {
 	if len(entropy) == 0 {
@@ -46,6 +49,56 @@ func GenerateSeed(entropy []byte, alg interfaces.KeypairCryptoAlg, r interfaces.
 	return encoded, nil
 }
 
+// GenerateSeedFromPassphrase generates the seed rippled's wallet_propose derives from a
+// passphrase: its entropy is the first 16 bytes of the SHA-512Half of the passphrase. Such a seed
+// is only as strong as the passphrase, random seeds should be preferred for new accounts.
+func GenerateSeedFromPassphrase(passphrase string, alg interfaces.KeypairCryptoAlg) (string, error) {
+	entropy := xrplcrypto.Sha512Half([]byte(passphrase))[:addresscodec.FamilySeedLength]
+	return GenerateSeed(entropy, alg, nil)
+}
+
+// SeedToRFC1751 encodes a seed as the 12 RFC 1751 words returned by rippled's wallet_propose as
+// master_key. Like rippled, the seed bytes are encoded in reverse order.
+func SeedToRFC1751(seed string) (string, error) {
+	entropy, _, err := addresscodec.DecodeSeed(seed)
+	if err != nil {
+		return "", err
+	}
+	slices.Reverse(entropy)
+	return rfc1751.Encode(entropy)
+}
+
+// SeedFromRFC1751 decodes the 12 RFC 1751 words of a seed, as returned by rippled's
+// wallet_propose as master_key, and encodes the seed for a crypto algorithm.
+func SeedFromRFC1751(mnemonic string, alg interfaces.KeypairCryptoAlg) (string, error) {
+	entropy, err := rfc1751.Decode(mnemonic)
+	if err != nil {
+		return "", err
+	}
+	slices.Reverse(entropy)
+	return GenerateSeed(entropy, alg, nil)
+}
+
+// SeedToSecretNumbers encodes a seed as the 8 blocks of 6 digits of its XLS-12 secret numbers, as
+// used by Xaman. The secret numbers don't carry the algorithm of the seed.
+func SeedToSecretNumbers(seed string) ([]string, error) {
+	entropy, _, err := addresscodec.DecodeSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	return secretnumbers.Encode(entropy)
+}
+
+// SeedFromSecretNumbers decodes XLS-12 secret numbers, 8 blocks of 6 digits, and encodes the seed
+// for a crypto algorithm. Xaman derives secp256k1 keys from secret numbers.
+func SeedFromSecretNumbers(secret string, alg interfaces.KeypairCryptoAlg) (string, error) {
+	entropy, err := secretnumbers.Decode(secret)
+	if err != nil {
+		return "", err
+	}
+	return GenerateSeed(entropy, alg, nil)
+}
+
 // DeriveKeypair derives a key pair from a given seed. Returns a tuple of private key and public key.
 // The seed has to be encoded using the addresscodec package. Otherwise, it returns an error.
 func DeriveKeypair(seed string, validator bool) (private, public string, err error) {
@@ -82,6 +135,25 @@ func DeriveClassicAddress(pubKey string) (string, error) {
 	return addresscodec.EncodeAccountIDToClassicAddress(accountID)
 }
 
+// DerivePublicKey derives the public key of a private key in one of the formats returned by
+// DeriveKeypair: "ED" followed by 32 bytes for ed25519 keys, and 32 bytes, optionally prefixed
+// with "00", for secp256k1 keys. The key is returned as upper case hex.
+func DerivePublicKey(privKey string) (string, error) {
+	alg, _, err := getCryptoImplementationFromKey(privKey, privateKeyType)
+	if err != nil {
+		return "", err
+	}
+	deriver, ok := alg.(interfaces.PublicKeyDerivationCryptoAlg)
+	if !ok {
+		return "", ErrInvalidPrivateKeyFormat
+	}
+	public, err := deriver.DerivePublicKey(privKey)
+	if err != nil {
+		return "", ErrInvalidPrivateKeyFormat
+	}
+	return public, nil
+}
+
 // DeriveNodeAddress derives a node address from a given public key.
 // The public key has to be encoded using the addresscodec package. Otherwise, it returns an error.
 func DeriveNodeAddress(pubKey string, alg interfaces.NodeDerivationCryptoAlg) (string, error) {
@@ -9,6 +9,8 @@ import (
 	"github.com/Peersyst/xrpl-go/keypairs/interfaces"
 	"github.com/Peersyst/xrpl-go/keypairs/testutil"
 	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/rfc1751"
+	"github.com/Peersyst/xrpl-go/pkg/secretnumbers"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -621,3 +623,237 @@ func TestDeriveNodeAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestDerivePublicKey(t *testing.T) {
+	testcases := []struct {
+		name        string
+		input       string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "pass - ed25519 private key",
+			input:    "EDE01A1644C9FDE0367A7A285CA69798066C131C1133E1128B170CA65AEA5C6D19",
+			expected: "EDC9DA1AA7513D891B58B3C9BEBAE3EB12620AFF4ABBA806B23BB3FA62109CE87F",
+		},
+		{
+			name:     "pass - prefixed secp256k1 private key",
+			input:    "004265A28F3E18340A490421D47B2EB8DBC2C0BF2C24CEFEA971B61CED2CABD233",
+			expected: "03AEEFE1E8ED4BBC009DE996AC03A8C6B5713B1554794056C66E5B8D1753C7DD0E",
+		},
+		{
+			name:     "pass - raw secp256k1 private key",
+			input:    "4265A28F3E18340A490421D47B2EB8DBC2C0BF2C24CEFEA971B61CED2CABD233",
+			expected: "03AEEFE1E8ED4BBC009DE996AC03A8C6B5713B1554794056C66E5B8D1753C7DD0E",
+		},
+		{
+			name:        "fail - oversized ed25519 private key",
+			input:       "EDE01A1644C9FDE0367A7A285CA69798066C131C1133E1128B170CA65AEA5C6D1900",
+			expectedErr: ErrInvalidPrivateKeyFormat,
+		},
+		{
+			name:        "fail - secp256k1 private key out of range",
+			input:       "00FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF",
+			expectedErr: ErrInvalidPrivateKeyFormat,
+		},
+		{
+			name:        "fail - public key",
+			input:       "03AEEFE1E8ED4BBC009DE996AC03A8C6B5713B1554794056C66E5B8D1753C7DD0E",
+			expectedErr: ErrInvalidPrivateKeyFormat,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := DerivePublicKey(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.NotContains(t, err.Error(), tc.input[2:18])
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+// Passphrase vectors from rippled's wallet_propose. rippled encodes every seed with the family
+// seed prefix, whereas ed25519 seeds are encoded here with the "sEd" prefix.
+func TestGenerateSeedFromPassphrase(t *testing.T) {
+	testcases := []struct {
+		name       string
+		passphrase string
+		algorithm  interfaces.KeypairCryptoAlg
+		seed       string
+		publicKey  string
+		address    string
+	}{
+		{
+			name:       "pass - masterpassphrase secp256k1",
+			passphrase: "masterpassphrase",
+			algorithm:  crypto.SECP256K1(),
+			seed:       "snoPBrXtMeMyMHUVTgbuqAfg1SUTb",
+			publicKey:  "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+			address:    "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		},
+		{
+			name:       "pass - masterpassphrase ed25519",
+			passphrase: "masterpassphrase",
+			algorithm:  crypto.ED25519(),
+			seed:       "sEdVQ4wvD1AaTG6JA54qt38TengAuiz",
+			publicKey:  "EDAAC3F98BB94F451804EF5993C847DAAA4E6154F455635659D88AA5C80F156303",
+			address:    "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		},
+		{
+			name:       "pass - REINDEER FLOTILLA secp256k1",
+			passphrase: "REINDEER FLOTILLA",
+			algorithm:  crypto.SECP256K1(),
+			seed:       "snMwVWs2hZzfDUF3p2tHZ3EgmyhFs",
+			publicKey:  "038AAE247B2344B1837FBED8F57389C8C11774510A3F7D784F2A09F0CB6843236C",
+			address:    "r4Vtj2jrfmTVZGfSP3gH9hQPMqFPQFin8f",
+		},
+		{
+			name:       "pass - REINDEER FLOTILLA ed25519",
+			passphrase: "REINDEER FLOTILLA",
+			algorithm:  crypto.ED25519(),
+			seed:       "sEd7x9VQjZepnQZNZLAkwWVe8hvwiWK",
+			publicKey:  "ED54C3F5BEDA8BD588B203D23A27398FAD9D20F88A974007D6994659CD7273FE1D",
+			address:    "r4qV6xTXerqaZav3MJfSY79ynmc1BSBev1",
+		},
+		{
+			name:       "pass - Non-Random Passphrase secp256k1",
+			passphrase: "Non-Random Passphrase",
+			algorithm:  crypto.SECP256K1(),
+			seed:       "snMKnVku798EnBwUfxeSD8953sLYA",
+		},
+		{
+			name:       "pass - cookies excitement hand public secp256k1",
+			passphrase: "cookies excitement hand public",
+			algorithm:  crypto.SECP256K1(),
+			seed:       "sspUXGrmjQhq6mgc24jiRuevZiwKT",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			seed, err := GenerateSeedFromPassphrase(tc.passphrase, tc.algorithm)
+			require.NoError(t, err)
+			require.Equal(t, tc.seed, seed)
+			if tc.publicKey == "" {
+				return
+			}
+
+			_, publicKey, err := DeriveKeypair(seed, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.publicKey, publicKey)
+			address, err := DeriveClassicAddress(publicKey)
+			require.NoError(t, err)
+			require.Equal(t, tc.address, address)
+		})
+	}
+}
+
+func TestSeedRFC1751(t *testing.T) {
+	testcases := []struct {
+		name      string
+		mnemonic  string
+		algorithm interfaces.KeypairCryptoAlg
+		seed      string
+	}{
+		{
+			name:      "pass - masterpassphrase secp256k1",
+			mnemonic:  "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE",
+			algorithm: crypto.SECP256K1(),
+			seed:      "snoPBrXtMeMyMHUVTgbuqAfg1SUTb",
+		},
+		{
+			name:      "pass - masterpassphrase ed25519",
+			mnemonic:  "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE",
+			algorithm: crypto.ED25519(),
+			seed:      "sEdVQ4wvD1AaTG6JA54qt38TengAuiz",
+		},
+		{
+			name:      "pass - REINDEER FLOTILLA secp256k1",
+			mnemonic:  "SCAT BERN ISLE FOR ROIL BUS SOAK AQUA FREE FOR DRAM BRIG",
+			algorithm: crypto.SECP256K1(),
+			seed:      "snMwVWs2hZzfDUF3p2tHZ3EgmyhFs",
+		},
+		{
+			name:      "pass - REINDEER FLOTILLA ed25519",
+			mnemonic:  "SCAT BERN ISLE FOR ROIL BUS SOAK AQUA FREE FOR DRAM BRIG",
+			algorithm: crypto.ED25519(),
+			seed:      "sEd7x9VQjZepnQZNZLAkwWVe8hvwiWK",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			seed, err := SeedFromRFC1751(tc.mnemonic, tc.algorithm)
+			require.NoError(t, err)
+			require.Equal(t, tc.seed, seed)
+
+			mnemonic, err := SeedToRFC1751(tc.seed)
+			require.NoError(t, err)
+			require.Equal(t, tc.mnemonic, mnemonic)
+		})
+	}
+
+	t.Run("fail - 6 words", func(t *testing.T) {
+		_, err := SeedFromRFC1751("TIDE ITCH SLOW REIN RULE MOT", crypto.SECP256K1())
+		require.ErrorIs(t, err, ErrInvalidEntropyLength)
+	})
+
+	t.Run("fail - wrong parity", func(t *testing.T) {
+		_, err := SeedFromRFC1751("I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DART", crypto.SECP256K1())
+		require.ErrorIs(t, err, rfc1751.ErrInvalidParity)
+	})
+
+	t.Run("fail - invalid seed", func(t *testing.T) {
+		_, err := SeedToRFC1751("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+		require.Error(t, err)
+	})
+}
+
+func TestSeedSecretNumbers(t *testing.T) {
+	testcases := []struct {
+		name      string
+		secret    string
+		algorithm interfaces.KeypairCryptoAlg
+		seed      string
+	}{
+		{
+			name:      "pass - secp256k1",
+			secret:    "399150 474506 009147 088773 432160 282843 253738 605430",
+			algorithm: crypto.SECP256K1(),
+			seed:      "sh1HiK7SwjS1VxFdXi7qeMHRedrYX",
+		},
+		{
+			name:      "pass - ed25519",
+			secret:    "399150 474506 009147 088773 432160 282843 253738 605430",
+			algorithm: crypto.ED25519(),
+			seed:      "sEd77GiNwRkBYwqzZiTrmh21oovzSAC",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			seed, err := SeedFromSecretNumbers(tc.secret, tc.algorithm)
+			require.NoError(t, err)
+			require.Equal(t, tc.seed, seed)
+
+			blocks, err := SeedToSecretNumbers(tc.seed)
+			require.NoError(t, err)
+			require.Equal(t, strings.Fields(tc.secret), blocks)
+		})
+	}
+
+	t.Run("fail - invalid checksum", func(t *testing.T) {
+		_, err := SeedFromSecretNumbers("399150 474506 009147 088773 432160 282843 253738 605431", crypto.SECP256K1())
+		require.ErrorIs(t, err, secretnumbers.ErrInvalidChecksum)
+	})
+
+	t.Run("fail - invalid seed", func(t *testing.T) {
+		_, err := SeedToSecretNumbers("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+		require.Error(t, err)
+	})
+}
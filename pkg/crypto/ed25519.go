@@ -63,6 +63,16 @@ func (c ED25519CryptoAlgorithm) DeriveKeypair(decodedSeed []byte, validator bool
 	return private, public, nil
 }
 
+// DerivePublicKey derives the public key of a private key.
+func (c ED25519CryptoAlgorithm) DerivePublicKey(privKey string) (string, error) {
+	b, err := hex.DecodeString(privKey)
+	if err != nil || len(b) != ed25519PrivateKeyLength || b[0] != ed25519Prefix {
+		return "", ErrInvalidPrivateKey
+	}
+	pubKey := ed25519.NewKeyFromSeed(b[1:]).Public().(ed25519.PublicKey)
+	return hexutil.EncodeToUpperHex(append([]byte{c.prefix}, pubKey...)), nil
+}
+
 // Sign signs a message using the ED25519 algorithm with the provided private key.
 func (c ED25519CryptoAlgorithm) Sign(msg, privKey string) (string, error) {
 	b, err := hex.DecodeString(privKey)
@@ -107,6 +107,26 @@ func (c SECP256K1CryptoAlgorithm) DeriveKeypair(seed []byte, validator bool) (st
 	return privKeyHex, pubKeyHex, nil
 }
 
+// DerivePublicKey derives the compressed public key of a private key.
+func (c SECP256K1CryptoAlgorithm) DerivePublicKey(privKey string) (string, error) {
+	if len(privKey) == 66 {
+		if privKey[:2] != "00" {
+			return "", ErrInvalidPrivateKey
+		}
+		privKey = privKey[2:]
+	}
+	key, err := hex.DecodeString(privKey)
+	if err != nil || len(key) != 32 {
+		return "", ErrInvalidPrivateKey
+	}
+
+	var scalar secp256k1.ModNScalar
+	if overflow := scalar.SetByteSlice(key); overflow || scalar.IsZero() {
+		return "", ErrInvalidPrivateKey
+	}
+	return hexutil.EncodeToUpperHex(secp256k1.NewPrivateKey(&scalar).PubKey().SerializeCompressed()), nil
+}
+
 // Sign signs a message with a private key.
 func (c SECP256K1CryptoAlgorithm) Sign(msg, privKey string) (string, error) {
 	if len(privKey) != 64 && len(privKey) != 66 {
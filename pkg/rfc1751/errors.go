@@ -0,0 +1,14 @@
+package rfc1751
+
+import "errors"
+
+var (
+	// ErrInvalidKeyLength is returned when the key to encode is not a multiple of 8 bytes.
+	ErrInvalidKeyLength = errors.New("key length must be a non-zero multiple of 8 bytes")
+	// ErrInvalidWordCount is returned when the words to decode are not a multiple of 6 words.
+	ErrInvalidWordCount = errors.New("word count must be a non-zero multiple of 6")
+	// ErrUnknownWord is returned when a word to decode is not in the RFC 1751 dictionary.
+	ErrUnknownWord = errors.New("unknown word")
+	// ErrInvalidParity is returned when the parity bits of the words to decode do not match.
+	ErrInvalidParity = errors.New("invalid parity")
+)
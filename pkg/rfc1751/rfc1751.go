@@ -0,0 +1,91 @@
+// Package rfc1751 implements the RFC 1751 encoding of binary keys as sequences of short English
+// words. Every 8 bytes of key are encoded as 6 words, the last one carrying 2 parity bits.
+package rfc1751
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+const (
+	blockLength     = 8
+	wordsPerBlock   = 6
+	bitsPerWord     = 11
+	wordIndexMask   = 1<<bitsPerWord - 1
+	parityBitLength = 2
+)
+
+// digits replaces the digits that RFC 1751 reads as letters.
+var digits = strings.NewReplacer("1", "L", "0", "O", "5", "S")
+
+var wordIndex = func() map[string]uint64 {
+	index := make(map[string]uint64, len(words))
+	for i, w := range words {
+		index[w] = uint64(i)
+	}
+	return index
+}()
+
+// Encode encodes a key, whose length must be a multiple of 8 bytes, as space separated upper case
+// words.
+func Encode(key []byte) (string, error) {
+	if len(key) == 0 || len(key)%blockLength != 0 {
+		return "", ErrInvalidKeyLength
+	}
+
+	encoded := make([]string, 0, len(key)/blockLength*wordsPerBlock)
+	for i := 0; i < len(key); i += blockLength {
+		v := binary.BigEndian.Uint64(key[i : i+blockLength])
+		for j := 1; j < wordsPerBlock; j++ {
+			encoded = append(encoded, words[v>>(64-j*bitsPerWord)&wordIndexMask])
+		}
+		last := v<<parityBitLength | parity(v)
+		encoded = append(encoded, words[last&wordIndexMask])
+	}
+	return strings.Join(encoded, " "), nil
+}
+
+// Decode decodes space separated words, whose count must be a multiple of 6, into a key. Words
+// are case insensitive, and the digits 0, 1 and 5 are read as the letters O, L and S.
+func Decode(mnemonic string) ([]byte, error) {
+	fields := strings.Fields(mnemonic)
+	if len(fields) == 0 || len(fields)%wordsPerBlock != 0 {
+		return nil, ErrInvalidWordCount
+	}
+
+	key := make([]byte, 0, len(fields)/wordsPerBlock*blockLength)
+	for i := 0; i < len(fields); i += wordsPerBlock {
+		var v uint64
+		for j, field := range fields[i : i+wordsPerBlock] {
+			index, ok := wordIndex[standard(field)]
+			if !ok {
+				return nil, fmt.Errorf("%w: word %d", ErrUnknownWord, i+j+1)
+			}
+			if j < wordsPerBlock-1 {
+				v = v<<bitsPerWord | index
+				continue
+			}
+			v = v<<(bitsPerWord-parityBitLength) | index>>parityBitLength
+			if index&(1<<parityBitLength-1) != parity(v) {
+				return nil, ErrInvalidParity
+			}
+		}
+		key = binary.BigEndian.AppendUint64(key, v)
+	}
+	return key, nil
+}
+
+// parity returns the sum of the 2-bit groups of v, modulo 4.
+func parity(v uint64) uint64 {
+	var p uint64
+	for ; v != 0; v >>= parityBitLength {
+		p += v & (1<<parityBitLength - 1)
+	}
+	return p & (1<<parityBitLength - 1)
+}
+
+// standard upper cases a word and replaces the digits that RFC 1751 reads as letters.
+func standard(word string) string {
+	return digits.Replace(strings.ToUpper(word))
+}
@@ -0,0 +1,120 @@
+package rfc1751
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWords(t *testing.T) {
+	require.Len(t, wordIndex, len(words))
+	for i := 1; i < len(words); i++ {
+		if i == 571 {
+			require.Len(t, words[i-1], 3)
+			require.Len(t, words[i], 4)
+			continue
+		}
+		require.Less(t, words[i-1], words[i])
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	// Vectors from RFC 1751.
+	testcases := []struct {
+		name     string
+		key      string
+		mnemonic string
+	}{
+		{
+			name:     "pass - 8 bytes",
+			key:      "EB33F77EE73D4053",
+			mnemonic: "TIDE ITCH SLOW REIN RULE MOT",
+		},
+		{
+			name:     "pass - 16 bytes",
+			key:      "CCAC2AED591056BE4F90FD441C534766",
+			mnemonic: "RASH BUSH MILK LOOK BAD BRIM AVID GAFF BAIT ROT POD LOVE",
+		},
+		{
+			name:     "pass - 16 bytes with a one letter word",
+			key:      "EFF81F9BFBC65350920CDD7416DE8009",
+			mnemonic: "TROD MUTE TAIL WARM CHAR KONG HAAG CITY BORE O TEAL AWL",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := hex.DecodeString(tc.key)
+			require.NoError(t, err)
+
+			mnemonic, err := Encode(key)
+			require.NoError(t, err)
+			require.Equal(t, tc.mnemonic, mnemonic)
+
+			decoded, err := Decode(tc.mnemonic)
+			require.NoError(t, err)
+			require.Equal(t, key, decoded)
+		})
+	}
+}
+
+func TestEncode_Errors(t *testing.T) {
+	for _, length := range []int{0, 7, 12} {
+		_, err := Encode(make([]byte, length))
+		require.ErrorIs(t, err, ErrInvalidKeyLength)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	testcases := []struct {
+		name        string
+		mnemonic    string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "pass - lower case and extra spaces",
+			mnemonic: "  tide itch slow\trein rule mot ",
+			expected: "EB33F77EE73D4053",
+		},
+		{
+			name:     "pass - digits read as letters",
+			mnemonic: "TROD MUTE TAI1 WARM CHAR KONG HAAG CITY BORE 0 TEAL AW1",
+			expected: "EFF81F9BFBC65350920CDD7416DE8009",
+		},
+		{
+			name:        "fail - no words",
+			mnemonic:    " ",
+			expectedErr: ErrInvalidWordCount,
+		},
+		{
+			name:        "fail - incomplete block",
+			mnemonic:    "TIDE ITCH SLOW REIN RULE MOT TIDE",
+			expectedErr: ErrInvalidWordCount,
+		},
+		{
+			name:        "fail - unknown word",
+			mnemonic:    "TIDE ITCH SLOW REIN RULE MOTE",
+			expectedErr: ErrUnknownWord,
+		},
+		{
+			name:        "fail - wrong parity",
+			mnemonic:    "TIDE ITCH SLOW REIN RULE MOW",
+			expectedErr: ErrInvalidParity,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := Decode(tc.mnemonic)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, strings.ToUpper(hex.EncodeToString(key)))
+		})
+	}
+}
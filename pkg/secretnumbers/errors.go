@@ -0,0 +1,18 @@
+package secretnumbers
+
+import "errors"
+
+var (
+	// ErrInvalidEntropyLength is returned when the entropy to encode is not 16 bytes.
+	ErrInvalidEntropyLength = errors.New("entropy must be 16 bytes")
+	// ErrInvalidBlockCount is returned when the secret to decode does not have 8 blocks.
+	ErrInvalidBlockCount = errors.New("secret must have 8 blocks of 6 digits")
+	// ErrInvalidPosition is returned when a block position is not between 0 and 7.
+	ErrInvalidPosition = errors.New("block position must be between 0 and 7")
+	// ErrInvalidBlock is returned when a block is not 6 digits.
+	ErrInvalidBlock = errors.New("block must be 6 digits")
+	// ErrBlockOutOfRange is returned when the number of a block, its first 5 digits, is above 65535.
+	ErrBlockOutOfRange = errors.New("block number must not be above 65535")
+	// ErrInvalidChecksum is returned when the checksum digit of a block does not match its number.
+	ErrInvalidChecksum = errors.New("invalid block checksum")
+)
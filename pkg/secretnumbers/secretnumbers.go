@@ -0,0 +1,123 @@
+// Package secretnumbers implements the XLS-12 secret numbers used by Xaman to back up accounts:
+// the 16 bytes of entropy of a family seed as 8 blocks of 6 digits. Each block is a 2-byte big
+// endian number as 5 digits followed by a checksum digit depending on the block position.
+package secretnumbers
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// BlockCount is the number of blocks of a secret.
+	BlockCount = 8
+	// BlockLength is the number of digits of a block.
+	BlockLength = 6
+
+	entropyLength = BlockCount * 2
+	qrPrefix      = "xrplsn:"
+)
+
+// Encode encodes 16 bytes of entropy as 8 blocks of 6 digits.
+func Encode(entropy []byte) ([]string, error) {
+	if len(entropy) != entropyLength {
+		return nil, ErrInvalidEntropyLength
+	}
+
+	blocks := make([]string, BlockCount)
+	for i := range blocks {
+		number := binary.BigEndian.Uint16(entropy[i*2:])
+		blocks[i] = fmt.Sprintf("%05d%d", number, checksum(i, number))
+	}
+	return blocks, nil
+}
+
+// Decode decodes a secret into its 16 bytes of entropy. The 8 blocks may be separated by spaces,
+// commas or dashes, or not separated at all.
+func Decode(secret string) ([]byte, error) {
+	blocks := Split(secret)
+	if len(blocks) != BlockCount {
+		return nil, ErrInvalidBlockCount
+	}
+
+	entropy := make([]byte, 0, entropyLength)
+	for i, block := range blocks {
+		number, err := decodeBlock(i, block)
+		if err != nil {
+			return nil, err
+		}
+		entropy = binary.BigEndian.AppendUint16(entropy, number)
+	}
+	return entropy, nil
+}
+
+// Split splits a secret into its blocks. It accepts the representations of XLS-12: blocks
+// separated by spaces, commas or dashes, blocks labelled from "A." to "H.", and the 48 digits of
+// a QR code, with or without their "xrplsn:" prefix.
+func Split(secret string) []string {
+	secret = strings.TrimSpace(secret)
+	if len(secret) >= len(qrPrefix) && strings.EqualFold(secret[:len(qrPrefix)], qrPrefix) {
+		secret = secret[len(qrPrefix):]
+	}
+
+	fields := strings.FieldsFunc(secret, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == '-'
+	})
+	blocks := make([]string, 0, BlockCount)
+	for _, field := range fields {
+		if !isLabel(field) {
+			blocks = append(blocks, field)
+		}
+	}
+
+	if len(blocks) == 1 && len(blocks[0]) == BlockCount*BlockLength {
+		digits := blocks[0]
+		blocks = make([]string, BlockCount)
+		for i := range blocks {
+			blocks[i] = digits[i*BlockLength : (i+1)*BlockLength]
+		}
+	}
+	return blocks
+}
+
+// isLabel reports whether a field is a block label, such as "A." or "h:".
+func isLabel(field string) bool {
+	if len(field) != 2 || (field[1] != '.' && field[1] != ':') {
+		return false
+	}
+	letter := field[0] | 0x20
+	return letter >= 'a' && letter < 'a'+BlockCount
+}
+
+// ValidateBlock validates the block at a position, from 0 to 7, for instance while it is typed.
+func ValidateBlock(position int, block string) error {
+	if position < 0 || position >= BlockCount {
+		return ErrInvalidPosition
+	}
+	_, err := decodeBlock(position, block)
+	return err
+}
+
+// decodeBlock decodes the number of the block at a position, checking its checksum. Errors name
+// the block, counting from 1, but never include its digits.
+func decodeBlock(position int, block string) (uint16, error) {
+	if len(block) != BlockLength || strings.IndexFunc(block, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return 0, fmt.Errorf("%w: block %d", ErrInvalidBlock, position+1)
+	}
+	number, err := strconv.ParseUint(block[:BlockLength-1], 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("%w: block %d", ErrBlockOutOfRange, position+1)
+	}
+	if int(block[BlockLength-1]-'0') != checksum(position, uint16(number)) {
+		return 0, fmt.Errorf("%w: block %d", ErrInvalidChecksum, position+1)
+	}
+	return uint16(number), nil
+}
+
+// checksum returns the checksum digit of the number of the block at a position.
+func checksum(position int, number uint16) int {
+	return int(number) * (position*2 + 1) % 9
+}
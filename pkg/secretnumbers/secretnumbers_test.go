@@ -0,0 +1,157 @@
+package secretnumbers
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	testcases := []struct {
+		name    string
+		entropy string
+		secret  string
+	}{
+		{
+			name:    "pass - secret of the sh1HiK7SwjS1VxFdXi7qeMHRedrYX seed",
+			entropy: "9bebb95a039222ada8d06e7c631dec7f",
+			secret:  "399150 474506 009147 088773 432160 282843 253738 605430",
+		},
+		{
+			name:    "pass - XLS-12 secret",
+			entropy: "d8bf99ff51c97e7136c99756ff00650b",
+			secret:  "554872 394230 209376 323698 140250 387423 652803 258676",
+		},
+		{
+			name:    "pass - zero entropy",
+			entropy: "00000000000000000000000000000000",
+			secret:  "000000 000000 000000 000000 000000 000000 000000 000000",
+		},
+		{
+			name:    "pass - maximum entropy",
+			entropy: "ffffffffffffffffffffffffffffffff",
+			secret:  "655356 655350 655353 655356 655350 655353 655356 655350",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			entropy, err := hex.DecodeString(tc.entropy)
+			require.NoError(t, err)
+
+			blocks, err := Encode(entropy)
+			require.NoError(t, err)
+			require.Equal(t, strings.Fields(tc.secret), blocks)
+
+			for _, secret := range []string{
+				tc.secret,
+				strings.Join(blocks, ""),
+				strings.Join(blocks, "-"),
+				" " + strings.Join(blocks, ",\n") + " ",
+				"xrplsn:" + strings.Join(blocks, ""),
+				"A. " + strings.Join(blocks[:4], "\nb: ") + "\nE. " + strings.Join(blocks[4:], "\nf: "),
+			} {
+				decoded, err := Decode(secret)
+				require.NoError(t, err)
+				require.Equal(t, entropy, decoded)
+			}
+		})
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	// Samples of XLS-12.
+	testcases := []struct {
+		position int
+		number   uint16
+		block    string
+	}{
+		{position: 0, number: 0xAF71, block: "449133"},
+		{position: 2, number: 0x0000, block: "000000"},
+		{position: 3, number: 0xFFFF, block: "655356"},
+		{position: 4, number: 0xFFFF, block: "655350"},
+		{position: 7, number: 0xCD91, block: "526253"},
+	}
+
+	for _, tc := range testcases {
+		require.Equal(t, tc.block[5:], strconv.Itoa(checksum(tc.position, tc.number)))
+		require.NoError(t, ValidateBlock(tc.position, tc.block))
+	}
+}
+
+func TestEncode_Errors(t *testing.T) {
+	_, err := Encode(make([]byte, 15))
+	require.ErrorIs(t, err, ErrInvalidEntropyLength)
+	_, err = Encode(make([]byte, 32))
+	require.ErrorIs(t, err, ErrInvalidEntropyLength)
+}
+
+func TestDecode_Errors(t *testing.T) {
+	testcases := []struct {
+		name        string
+		secret      string
+		expectedErr error
+		message     string
+	}{
+		{
+			name:        "fail - empty secret",
+			secret:      "",
+			expectedErr: ErrInvalidBlockCount,
+		},
+		{
+			name:        "fail - missing block",
+			secret:      "399150 474506 009147 088773 432160 282843 253738",
+			expectedErr: ErrInvalidBlockCount,
+		},
+		{
+			name:        "fail - short block",
+			secret:      "399150 474506 009147 88773 432160 282843 253738 605430",
+			expectedErr: ErrInvalidBlock,
+			message:     "block 4",
+		},
+		{
+			name:        "fail - letter in block",
+			secret:      "399150 474506 009147 088773 432160 282843 253738 6O5430",
+			expectedErr: ErrInvalidBlock,
+			message:     "block 8",
+		},
+		{
+			name:        "fail - block out of range",
+			secret:      "399150 474506 655360 088773 432160 282843 253738 605430",
+			expectedErr: ErrBlockOutOfRange,
+			message:     "block 3",
+		},
+		{
+			name:        "fail - invalid checksum",
+			secret:      "399150 474506 009147 088773 432160 282843 253738 605431",
+			expectedErr: ErrInvalidChecksum,
+			message:     "block 8",
+		},
+		{
+			name:        "fail - swapped blocks",
+			secret:      "474506 399150 009147 088773 432160 282843 253738 605430",
+			expectedErr: ErrInvalidChecksum,
+			message:     "block 1",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Decode(tc.secret)
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Contains(t, err.Error(), tc.message)
+		})
+	}
+}
+
+func TestValidateBlock(t *testing.T) {
+	require.NoError(t, ValidateBlock(0, "399150"))
+	require.NoError(t, ValidateBlock(7, "605430"))
+	require.ErrorIs(t, ValidateBlock(0, "474506"), ErrInvalidChecksum)
+	require.ErrorIs(t, ValidateBlock(0, "3991"), ErrInvalidBlock)
+	require.ErrorIs(t, ValidateBlock(8, "399150"), ErrInvalidPosition)
+	require.ErrorIs(t, ValidateBlock(-1, "399150"), ErrInvalidPosition)
+}
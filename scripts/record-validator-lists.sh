@@ -0,0 +1,18 @@
+#!/bin/sh
+
+# Records the validator lists of vl.ripple.com and vl.xrplf.org as the testdata of
+# TestValidatorList_Recorded in xrpl/validator.
+
+set -eu
+
+TESTDATA_DIR="$(dirname "$0")/../xrpl/validator/testdata"
+
+mkdir -p "$TESTDATA_DIR"
+
+record() {
+	echo "Recording $1..."
+	curl -fsS "https://$1" -o "$TESTDATA_DIR/$1.json"
+}
+
+record vl.ripple.com
+record vl.xrplf.org
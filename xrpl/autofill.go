@@ -0,0 +1,201 @@
+package xrpl
+
+import (
+	"slices"
+
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/internal/autofill"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// AutofillOffline fills in the missing fields of a transaction from a network snapshot,
+// without contacting the network. It converts X-addresses and fills NetworkID, Sequence, Fee
+// and LastLedgerSequence with the same address, sequence, fee and Batch rules as the client
+// Autofill: a transaction with a TicketSequence gets a zero Sequence.
+//
+// The snapshot is updated with the used sequences and tickets, so several transactions can be
+// autofilled from the same snapshot. Pseudo-transactions are rejected, as they can never be
+// submitted. Account deletion blockers are not checked offline.
+func AutofillOffline(tx *transaction.FlatTransaction, snapshot *NetworkSnapshot) error {
+	if snapshot == nil {
+		return ErrNilNetworkSnapshot
+	}
+
+	if err := autofill.SetValidAddresses(tx); err != nil {
+		return err
+	}
+
+	if err := tx.RequireTransactionType(); err != nil {
+		return err
+	}
+
+	if err := tx.RequireNotPseudo(); err != nil {
+		return err
+	}
+
+	if err := tx.NormalizeFlags(); err != nil {
+		return err
+	}
+
+	if account, ok := (*tx)["Account"].(string); !ok || account == "" {
+		return ErrMissingAccountInTransaction
+	}
+
+	if _, ok := (*tx)["NetworkID"]; !ok {
+		if snapshot.NetworkID != 0 {
+			(*tx)["NetworkID"] = snapshot.NetworkID
+		}
+	}
+	if _, ok := (*tx)["Sequence"]; !ok {
+		if err := autofill.Sequence(tx, snapshot.accountSequence); err != nil {
+			return err
+		}
+	}
+	if _, ok := (*tx)["Fee"]; !ok {
+		err := autofill.CalculateFee(tx, 0, snapshot.maxFeeXRP(), snapshot.feeSource())
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := (*tx)["LastLedgerSequence"]; !ok {
+		if snapshot.LedgerIndex == 0 {
+			return ErrSnapshotMissingLedgerIndex
+		}
+		(*tx)["LastLedgerSequence"] = snapshot.LedgerIndex + common.LedgerOffset
+	}
+
+	switch tx.TxType() {
+	case transaction.PaymentTx:
+		if err := checkPaymentAmounts(tx); err != nil {
+			return err
+		}
+	case transaction.BatchTx:
+		err := autofill.RawTransactions(tx, autofill.BatchSource{
+			NetworkID: snapshot.NetworkID,
+			NeedsNetworkID: func() (bool, error) {
+				return autofill.TxNeedsNetworkID(snapshot.NetworkID, snapshot.ServerVersion), nil
+			},
+			AccountSequence: snapshot.accountSequence,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	snapshot.consume(*tx)
+	return nil
+}
+
+// AutofillOfflineMultisigned fills in the missing fields of a multisigned transaction from a
+// network snapshot. It works like AutofillOffline and calculates the fee per number of signers.
+func AutofillOfflineMultisigned(tx *transaction.FlatTransaction, snapshot *NetworkSnapshot, nSigners uint64) error {
+	if err := AutofillOffline(tx, snapshot); err != nil {
+		return err
+	}
+
+	return autofill.CalculateFee(tx, nSigners, snapshot.maxFeeXRP(), snapshot.feeSource())
+}
+
+func checkPaymentAmounts(tx *transaction.FlatTransaction) error {
+	if _, ok := (*tx)["DeliverMax"]; ok {
+		if _, ok := (*tx)["Amount"]; !ok {
+			(*tx)["Amount"] = (*tx)["DeliverMax"]
+		} else if (*tx)["Amount"] != (*tx)["DeliverMax"] {
+			return ErrAmountAndDeliverMaxMustBeIdentical
+		}
+	}
+	return nil
+}
+
+// accountSequence returns the next sequence of an account of the snapshot.
+func (s *NetworkSnapshot) accountSequence(account string) (uint32, error) {
+	acc, err := s.account(account)
+	if err != nil {
+		return 0, err
+	}
+	return acc.Sequence, nil
+}
+
+// consume advances the snapshot past the sequences and tickets used by the transaction
+// and its Batch inner transactions.
+func (s *NetworkSnapshot) consume(tx map[string]any) {
+	s.consumeSequence(tx)
+
+	rawTxs, _ := tx["RawTransactions"].([]map[string]any)
+	for _, rawTx := range rawTxs {
+		if innerTx, ok := rawTx["RawTransaction"].(map[string]any); ok {
+			s.consumeSequence(innerTx)
+		}
+	}
+}
+
+func (s *NetworkSnapshot) consumeSequence(tx map[string]any) {
+	account, _ := tx["Account"].(string)
+	acc, ok := s.Accounts[types.Address(account)]
+	if !ok || acc == nil {
+		return
+	}
+
+	if ticket, ok := tx["TicketSequence"].(uint32); ok {
+		acc.Tickets = slices.DeleteFunc(acc.Tickets, func(t uint32) bool {
+			return t == ticket
+		})
+		return
+	}
+
+	if sequence, ok := tx["Sequence"].(uint32); ok && sequence >= acc.Sequence {
+		acc.Sequence = sequence + 1
+	}
+}
+
+func (s *NetworkSnapshot) feeSource() autofill.FeeSource {
+	return autofill.FeeSource{
+		NetworkFee: func() (uint64, error) {
+			if s.BaseFeeXRP == 0 {
+				return 0, ErrSnapshotMissingBaseFee
+			}
+			return autofill.NetworkFee(s.BaseFeeXRP, s.LoadFactor, s.feeCushion(), s.maxFeeXRP())
+		},
+		OwnerReserve: func() (uint64, error) {
+			if s.ReserveInc == 0 {
+				return 0, ErrSnapshotMissingOwnerReserve
+			}
+			return s.ReserveInc, nil
+		},
+		CounterpartySignersCount: s.counterpartySignersCount,
+	}
+}
+
+// counterpartySignersCount returns the number of signers of the LoanSet counterparty,
+// or 1 if it has no signer list.
+func (s *NetworkSnapshot) counterpartySignersCount(tx transaction.FlatTransaction) (uint64, error) {
+	counterparty, _ := tx["Counterparty"].(string)
+	if counterparty == "" {
+		return 0, ErrOfflineCounterpartyRequired
+	}
+
+	acc, err := s.account(counterparty)
+	if err != nil {
+		return 0, err
+	}
+
+	if acc.SignerListSize > 0 {
+		return uint64(acc.SignerListSize), nil
+	}
+	return 1, nil
+}
+
+func (s *NetworkSnapshot) feeCushion() float32 {
+	if s.FeeCushion == 0 {
+		return common.DefaultFeeCushion
+	}
+	return s.FeeCushion
+}
+
+func (s *NetworkSnapshot) maxFeeXRP() float32 {
+	if s.MaxFeeXRP == 0 {
+		return common.DefaultMaxFeeXRP
+	}
+	return s.MaxFeeXRP
+}
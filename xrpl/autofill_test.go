@@ -0,0 +1,354 @@
+package xrpl
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	offlineAccountA = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+	offlineAccountB = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+)
+
+func testNetworkSnapshot() *NetworkSnapshot {
+	return &NetworkSnapshot{
+		ServerVersion: "2.4.0",
+		LedgerIndex:   1000,
+		BaseFeeXRP:    0.00001,
+		LoadFactor:    1,
+		ReserveBase:   1000000,
+		ReserveInc:    200000,
+		Accounts: map[types.Address]*AccountSnapshot{
+			offlineAccountA: {Sequence: 10},
+			offlineAccountB: {Sequence: 5, Tickets: []uint32{8, 7}, SignerListSize: 3},
+		},
+	}
+}
+
+func TestAutofillOffline(t *testing.T) {
+	testCases := []struct {
+		name             string
+		tx               transaction.FlatTransaction
+		snapshot         func() *NetworkSnapshot
+		expectedTx       transaction.FlatTransaction
+		expectedAccounts map[types.Address]*AccountSnapshot
+		err              error
+	}{
+		{
+			name: "pass - sequence, fee and last ledger sequence",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         offlineAccountA,
+			},
+			expectedTx: transaction.FlatTransaction{
+				"TransactionType":    "AccountSet",
+				"Account":            offlineAccountA,
+				"Flags":              uint32(0),
+				"Sequence":           uint32(10),
+				"Fee":                "12",
+				"LastLedgerSequence": uint32(1020),
+			},
+			expectedAccounts: map[types.Address]*AccountSnapshot{
+				offlineAccountA: {Sequence: 11},
+				offlineAccountB: {Sequence: 5, Tickets: []uint32{8, 7}, SignerListSize: 3},
+			},
+		},
+		{
+			name: "pass - ticket sequence gets a zero sequence",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         offlineAccountB,
+				"TicketSequence":  uint32(7),
+			},
+			expectedTx: transaction.FlatTransaction{
+				"TransactionType":    "AccountSet",
+				"Account":            offlineAccountB,
+				"Flags":              uint32(0),
+				"Sequence":           uint32(0),
+				"TicketSequence":     uint32(7),
+				"Fee":                "12",
+				"LastLedgerSequence": uint32(1020),
+			},
+			expectedAccounts: map[types.Address]*AccountSnapshot{
+				offlineAccountA: {Sequence: 10},
+				offlineAccountB: {Sequence: 5, Tickets: []uint32{8}, SignerListSize: 3},
+			},
+		},
+		{
+			name: "pass - account sequence is used when the account has tickets",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         offlineAccountB,
+			},
+			expectedTx: transaction.FlatTransaction{
+				"TransactionType":    "AccountSet",
+				"Account":            offlineAccountB,
+				"Flags":              uint32(0),
+				"Sequence":           uint32(5),
+				"Fee":                "12",
+				"LastLedgerSequence": uint32(1020),
+			},
+			expectedAccounts: map[types.Address]*AccountSnapshot{
+				offlineAccountA: {Sequence: 10},
+				offlineAccountB: {Sequence: 6, Tickets: []uint32{8, 7}, SignerListSize: 3},
+			},
+		},
+		{
+			name: "pass - X-addresses are converted to classic addresses",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Payment",
+				"Account":         "XVDRzcTbNA6v2WnbxjKp1CHmyyYrKJh23t4buUdRme6q4XY",
+				"Destination":     "XV5kHfQmzDQjbFNv4jX3FX9Y7ig5QhfHfuo7rTzSFVRy6hS",
+				"Amount":          "1000000",
+			},
+			expectedTx: transaction.FlatTransaction{
+				"TransactionType":    "Payment",
+				"Account":            offlineAccountA,
+				"SourceTag":          uint32(12345),
+				"Destination":        offlineAccountB,
+				"DestinationTag":     uint32(7),
+				"Amount":             "1000000",
+				"Flags":              uint32(0),
+				"Sequence":           uint32(10),
+				"Fee":                "12",
+				"LastLedgerSequence": uint32(1020),
+			},
+			expectedAccounts: map[types.Address]*AccountSnapshot{
+				offlineAccountA: {Sequence: 11},
+				offlineAccountB: {Sequence: 5, Tickets: []uint32{8, 7}, SignerListSize: 3},
+			},
+		},
+		{
+			name: "pass - X-address without tag",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         "XV5kHfQmzDQjbFNv4jX3FX9Y7ig5QhpKGEFCq4mdLfhdxMq",
+			},
+			expectedTx: transaction.FlatTransaction{
+				"TransactionType":    "AccountSet",
+				"Account":            offlineAccountB,
+				"Flags":              uint32(0),
+				"Sequence":           uint32(5),
+				"Fee":                "12",
+				"LastLedgerSequence": uint32(1020),
+			},
+			expectedAccounts: map[types.Address]*AccountSnapshot{
+				offlineAccountA: {Sequence: 10},
+				offlineAccountB: {Sequence: 6, Tickets: []uint32{8, 7}, SignerListSize: 3},
+			},
+		},
+		{
+			name: "pass - network ID and owner reserve fee",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountDelete",
+				"Account":         offlineAccountA,
+				"Destination":     offlineAccountB,
+			},
+			snapshot: func() *NetworkSnapshot {
+				snapshot := testNetworkSnapshot()
+				snapshot.NetworkID = 21338
+				return snapshot
+			},
+			expectedTx: transaction.FlatTransaction{
+				"TransactionType":    "AccountDelete",
+				"Account":            offlineAccountA,
+				"Destination":        offlineAccountB,
+				"Flags":              uint32(0),
+				"NetworkID":          uint32(21338),
+				"Sequence":           uint32(10),
+				"Fee":                "200000",
+				"LastLedgerSequence": uint32(1020),
+			},
+			expectedAccounts: map[types.Address]*AccountSnapshot{
+				offlineAccountA: {Sequence: 11},
+				offlineAccountB: {Sequence: 5, Tickets: []uint32{8, 7}, SignerListSize: 3},
+			},
+		},
+		{
+			name: "pass - LoanSet fee includes the counterparty signers",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "LoanSet",
+				"Account":         offlineAccountA,
+				"Counterparty":    offlineAccountB,
+				"Sequence":        uint32(10),
+			},
+			expectedTx: transaction.FlatTransaction{
+				"TransactionType":    "LoanSet",
+				"Account":            offlineAccountA,
+				"Counterparty":       offlineAccountB,
+				"Flags":              uint32(0),
+				"Sequence":           uint32(10),
+				"Fee":                "48",
+				"LastLedgerSequence": uint32(1020),
+			},
+			expectedAccounts: map[types.Address]*AccountSnapshot{
+				offlineAccountA: {Sequence: 11},
+				offlineAccountB: {Sequence: 5, Tickets: []uint32{8, 7}, SignerListSize: 3},
+			},
+		},
+		{
+			name: "pass - Batch inner transactions",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Batch",
+				"Account":         offlineAccountA,
+				"RawTransactions": []map[string]any{
+					{"RawTransaction": map[string]any{
+						"TransactionType": "AccountSet",
+						"Account":         offlineAccountA,
+					}},
+					{"RawTransaction": map[string]any{
+						"TransactionType": "AccountSet",
+						"Account":         offlineAccountB,
+					}},
+				},
+			},
+			expectedTx: transaction.FlatTransaction{
+				"TransactionType": "Batch",
+				"Account":         offlineAccountA,
+				"Flags":           uint32(0),
+				"RawTransactions": []map[string]any{
+					{"RawTransaction": map[string]any{
+						"TransactionType": "AccountSet",
+						"Account":         offlineAccountA,
+						"Fee":             "0",
+						"SigningPubKey":   "",
+						"Sequence":        uint32(11),
+					}},
+					{"RawTransaction": map[string]any{
+						"TransactionType": "AccountSet",
+						"Account":         offlineAccountB,
+						"Fee":             "0",
+						"SigningPubKey":   "",
+						"Sequence":        uint32(5),
+					}},
+				},
+				"Sequence":           uint32(10),
+				"Fee":                "48",
+				"LastLedgerSequence": uint32(1020),
+			},
+			expectedAccounts: map[types.Address]*AccountSnapshot{
+				offlineAccountA: {Sequence: 12},
+				offlineAccountB: {Sequence: 6, Tickets: []uint32{8, 7}, SignerListSize: 3},
+			},
+		},
+		{
+			name: "fail - nil snapshot",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         offlineAccountA,
+			},
+			snapshot: func() *NetworkSnapshot { return nil },
+			err:      ErrNilNetworkSnapshot,
+		},
+		{
+			name: "fail - account not in snapshot",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         "rLHzPsX6oXkzU2qL12kHCH8G8cnZv1rBJh",
+			},
+			err: ErrAccountNotInSnapshot{Account: "rLHzPsX6oXkzU2qL12kHCH8G8cnZv1rBJh"},
+		},
+		{
+			name: "fail - X-address tag does not match source tag",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         "XVDRzcTbNA6v2WnbxjKp1CHmyyYrKJh23t4buUdRme6q4XY",
+				"SourceTag":       uint32(1),
+			},
+			err: ErrMismatchedTag{Expected: "Account", Actual: "SourceTag"},
+		},
+		{
+			name: "fail - EnableAmendment pseudo-transaction",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "EnableAmendment",
+				"Account":         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+			},
+			err: transaction.ErrPseudoTransaction,
+		},
+		{
+			name: "fail - SetFee pseudo-transaction",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "SetFee",
+				"Account":         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+			},
+			err: transaction.ErrPseudoTransaction,
+		},
+		{
+			name: "fail - UNLModify pseudo-transaction",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "UNLModify",
+				"Account":         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+			},
+			err: transaction.ErrPseudoTransaction,
+		},
+		{
+			name: "fail - missing base fee",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         offlineAccountA,
+			},
+			snapshot: func() *NetworkSnapshot {
+				snapshot := testNetworkSnapshot()
+				snapshot.BaseFeeXRP = 0
+				return snapshot
+			},
+			err: ErrSnapshotMissingBaseFee,
+		},
+		{
+			name: "fail - LoanSet without counterparty",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "LoanSet",
+				"Account":         offlineAccountA,
+				"LoanBrokerID":    "DB303FC1C7611B22C09E773B51044F6BEA02EF917DF59A2E2860871E167066A5",
+			},
+			err: ErrOfflineCounterpartyRequired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			snapshot := testNetworkSnapshot()
+			if tc.snapshot != nil {
+				snapshot = tc.snapshot()
+			}
+
+			err := AutofillOffline(&tc.tx, snapshot)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTx, tc.tx)
+			require.Equal(t, tc.expectedAccounts, snapshot.Accounts)
+		})
+	}
+}
+
+func TestAutofillOfflineMultisigned(t *testing.T) {
+	snapshot := testNetworkSnapshot()
+	tx := transaction.FlatTransaction{
+		"TransactionType": "AccountSet",
+		"Account":         offlineAccountA,
+	}
+
+	require.NoError(t, AutofillOfflineMultisigned(&tx, snapshot, 2))
+	require.Equal(t, "36", tx["Fee"])
+	require.Equal(t, uint32(10), tx["Sequence"])
+}
+
+func TestAutofillOfflineAdvancesSnapshot(t *testing.T) {
+	snapshot := testNetworkSnapshot()
+
+	for _, expected := range []uint32{10, 11, 12} {
+		tx := transaction.FlatTransaction{
+			"TransactionType": "AccountSet",
+			"Account":         offlineAccountA,
+		}
+		require.NoError(t, AutofillOffline(&tx, snapshot))
+		require.Equal(t, expected, tx["Sequence"])
+	}
+}
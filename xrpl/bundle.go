@@ -0,0 +1,791 @@
+package xrpl
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	wallettypes "github.com/Peersyst/xrpl-go/xrpl/wallet/types"
+)
+
+// BundleVersion is the version of the bundle format written by NewBundle.
+const BundleVersion = 1
+
+// BundleMode is the way the signatures of a bundled transaction are collected.
+type BundleMode string
+
+const (
+	// BundleModeSingle collects the signature of the transaction account, as returned by wallet.Sign.
+	BundleModeSingle BundleMode = "single"
+	// BundleModeMultisign collects the Signers of a multisigned transaction, as returned by wallet.Multisign.
+	BundleModeMultisign BundleMode = "multisign"
+	// BundleModeBatch collects the BatchSigners of a Batch transaction, as set by wallet.SignMultiBatch.
+	// The finalized Batch transaction must still be signed by its submitting account.
+	BundleModeBatch BundleMode = "batch"
+	// BundleModeLoanSetCounterparty collects the CounterpartySignature of a LoanSet transaction already
+	// signed by the LoanBroker, as returned by wallet.SignLoanSetByCounterparty.
+	BundleModeLoanSetCounterparty BundleMode = "loan_set_counterparty"
+)
+
+// Bundle is a portable set of transactions to sign offline or by several parties.
+// It is created with NewBundle, serialized with encoding/json and read back with ParseBundle.
+// Signatures are added with AddSignature, partial bundles combined with MergeBundles and
+// the submittable transaction blobs built with Finalize.
+type Bundle struct {
+	// Version is the version of the bundle format.
+	Version int `json:"version"`
+	// NetworkID is the network the transactions are for, 0 for networks that do not require it.
+	NetworkID uint32 `json:"network_id,omitempty"`
+	// Expiration is the time after which the bundle can no longer be signed or finalized.
+	// A zero expiration means the bundle does not expire.
+	Expiration time.Time `json:"expiration,omitzero"`
+	// Transactions are the bundled transactions.
+	Transactions []BundleTransaction `json:"transactions"`
+
+	codec *binarycodec.Codec
+}
+
+// BundleTransaction is a transaction of a bundle and the signatures collected for it.
+type BundleTransaction struct {
+	// Mode is the way the signatures of the transaction are collected.
+	Mode BundleMode `json:"mode"`
+	// TxBlob is the encoded transaction, without the signatures collected by the bundle.
+	TxBlob string `json:"tx_blob"`
+	// SigningData is the signing preimage of the transaction, as encoded by binarycodec.EncodeForSigning,
+	// or by binarycodec.EncodeForSigningBatch for Batch transactions. Signers can check it against
+	// the transaction they are asked to sign.
+	SigningData string `json:"signing_data"`
+	// Signers are the accounts expected to sign the transaction. Any account can sign if empty.
+	Signers []BundleSigner `json:"signers,omitempty"`
+	// Quorum is the sum of signer weights needed to finalize the transaction.
+	// If 0, every signer must sign, or at least one signature is needed if there are no signers.
+	Quorum uint32 `json:"quorum,omitempty"`
+	// Signatures are the signatures collected so far.
+	Signatures []BundleSignature `json:"signatures,omitempty"`
+}
+
+// BundleSigner is an account expected to sign a bundled transaction.
+type BundleSigner struct {
+	// Account is the signing account.
+	Account types.Address `json:"account"`
+	// Weight is the weight of the signature towards the quorum. Defaults to 1.
+	Weight uint16 `json:"weight,omitempty"`
+	// RegularKey is the regular key of the account, if it signs with it. Otherwise signatures
+	// must be made with the master key of the account.
+	RegularKey types.Address `json:"regular_key,omitempty"`
+}
+
+// BundleSignature is a signature collected for a bundled transaction.
+type BundleSignature struct {
+	// Account is the account the signature is for: the transaction account, the Batch inner
+	// account or the LoanSet counterparty. It is empty for LoanSet transactions without Counterparty.
+	Account types.Address `json:"account,omitempty"`
+	// Signer is the multisig signer account, empty for a single signature.
+	Signer types.Address `json:"signer,omitempty"`
+	// SigningPubKey is the public key of the signature.
+	SigningPubKey string `json:"signing_pub_key"`
+	// TxnSignature is the signature.
+	TxnSignature string `json:"txn_signature"`
+}
+
+// BundleTransactionOptions configures the signatures collected for a bundled transaction.
+type BundleTransactionOptions struct {
+	// Signers are the accounts expected to sign the transaction.
+	Signers []BundleSigner
+	// Quorum is the sum of signer weights needed to finalize the transaction.
+	Quorum uint32
+}
+
+// NewBundle returns an empty bundle for the given network.
+// A zero expiration means the bundle does not expire.
+func NewBundle(networkID uint32, expiration time.Time) *Bundle {
+	return NewBundleWithCodec(networkID, expiration, binarycodec.DefaultCodec())
+}
+
+// NewBundleWithCodec returns an empty bundle like NewBundle, encoding its transactions with the given codec.
+func NewBundleWithCodec(networkID uint32, expiration time.Time, codec *binarycodec.Codec) *Bundle {
+	return &Bundle{
+		Version:      BundleVersion,
+		NetworkID:    networkID,
+		Expiration:   expiration,
+		Transactions: []BundleTransaction{},
+		codec:        codec,
+	}
+}
+
+// ParseBundle parses a JSON encoded bundle and verifies all its signatures.
+func ParseBundle(data []byte) (*Bundle, error) {
+	return ParseBundleWithCodec(data, binarycodec.DefaultCodec())
+}
+
+// ParseBundleWithCodec parses a bundle like ParseBundle, decoding its transactions with the given codec.
+func ParseBundleWithCodec(data []byte, codec *binarycodec.Codec) (*Bundle, error) {
+	bundle := Bundle{codec: codec}
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, err
+	}
+
+	if bundle.Version != BundleVersion {
+		return nil, ErrUnsupportedBundleVersion{Version: bundle.Version}
+	}
+
+	if err := bundle.Verify(); err != nil {
+		return nil, err
+	}
+
+	return &bundle, nil
+}
+
+// AddTransaction adds an unsigned transaction to the bundle.
+// LoanSet transactions in BundleModeLoanSetCounterparty must already be signed by the LoanBroker.
+func (b *Bundle) AddTransaction(tx transaction.FlatTransaction, mode BundleMode, opts *BundleTransactionOptions) error {
+	switch mode {
+	case BundleModeSingle, BundleModeMultisign:
+	case BundleModeBatch:
+		if tx.TxType() != transaction.BatchTx {
+			return ErrBundleTxMustBeBatch
+		}
+	case BundleModeLoanSetCounterparty:
+		if tx.TxType() != transaction.LoanSetTx {
+			return ErrBundleTxMustBeLoanSet
+		}
+		if txnSignature, _ := tx["TxnSignature"].(string); txnSignature == "" {
+			return ErrBundleLoanSetNotSignedByBroker
+		}
+	default:
+		return ErrInvalidBundleMode
+	}
+
+	if networkID, ok := tx["NetworkID"]; ok && networkID != b.NetworkID {
+		return ErrBundleNetworkIDMismatch
+	} else if !ok && b.NetworkID != 0 {
+		return ErrBundleNetworkIDMismatch
+	}
+
+	codec := b.getCodec()
+	txBlob, err := codec.Encode(withoutBundleSignatures(tx, mode))
+	if err != nil {
+		return err
+	}
+
+	bundleTx := BundleTransaction{
+		Mode:   mode,
+		TxBlob: txBlob,
+	}
+	if opts != nil {
+		bundleTx.Signers = opts.Signers
+		bundleTx.Quorum = opts.Quorum
+	}
+
+	unsignedTx, err := bundleTx.unsignedTx(codec)
+	if err != nil {
+		return err
+	}
+	bundleTx.SigningData, err = signingData(codec, unsignedTx, mode)
+	if err != nil {
+		return err
+	}
+
+	b.Transactions = append(b.Transactions, bundleTx)
+	return nil
+}
+
+// AddSignature adds the signatures of a signed transaction blob to the matching bundled transaction.
+// The blob is the result of wallet.Sign, wallet.Multisign, wallet.SignLoanSetByCounterparty, or the
+// encoding of a Batch transaction signed with wallet.SignMultiBatch. Every signature is verified
+// before it is added; signatures already in the bundle are ignored.
+func (b *Bundle) AddSignature(blob string) error {
+	if b.isExpired() {
+		return ErrBundleExpired
+	}
+
+	codec := b.getCodec()
+	signedTx, err := codec.Decode(blob)
+	if err != nil {
+		return err
+	}
+
+	for i := range b.Transactions {
+		bundleTx := &b.Transactions[i]
+
+		encoded, err := codec.Encode(withoutBundleSignatures(signedTx, bundleTx.Mode))
+		if err != nil {
+			return err
+		}
+		if encoded != bundleTx.TxBlob {
+			continue
+		}
+
+		signatures, err := bundleSignatures(signedTx, bundleTx.Mode)
+		if err != nil {
+			return err
+		}
+		if len(signatures) == 0 {
+			return ErrBundleNoSignature
+		}
+
+		for _, signature := range signatures {
+			if err := bundleTx.addSignature(codec, signature); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return ErrBundleTransactionNotFound
+}
+
+// Verify verifies the signing data and every signature of the bundle.
+func (b *Bundle) Verify() error {
+	codec := b.getCodec()
+	for i := range b.Transactions {
+		bundleTx := &b.Transactions[i]
+
+		unsignedTx, err := bundleTx.unsignedTx(codec)
+		if err != nil {
+			return err
+		}
+
+		data, err := signingData(codec, unsignedTx, bundleTx.Mode)
+		if err != nil {
+			return err
+		}
+		if data != bundleTx.SigningData {
+			return ErrBundleSigningDataMismatch
+		}
+
+		for _, signature := range bundleTx.Signatures {
+			if err := bundleTx.verifySignature(codec, unsignedTx, signature); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Finalize returns the submittable blob of every bundled transaction, in order.
+// It fails if the bundle has expired or the quorum of a transaction has not been met.
+func (b *Bundle) Finalize() ([]string, error) {
+	if b.isExpired() {
+		return nil, ErrBundleExpired
+	}
+
+	if err := b.Verify(); err != nil {
+		return nil, err
+	}
+
+	codec := b.getCodec()
+	blobs := make([]string, len(b.Transactions))
+	for i := range b.Transactions {
+		bundleTx := &b.Transactions[i]
+
+		unsignedTx, err := bundleTx.unsignedTx(codec)
+		if err != nil {
+			return nil, err
+		}
+		if !bundleTx.quorumMet(unsignedTx) {
+			return nil, ErrBundleQuorumNotMet
+		}
+
+		blob, err := bundleTx.finalize(codec)
+		if err != nil {
+			return nil, err
+		}
+		blobs[i] = blob
+	}
+
+	return blobs, nil
+}
+
+// MergeBundles merges the signatures of partial copies of the same bundle into a new bundle.
+// All bundles must hold the same transactions; otherwise ErrBundleMismatch is returned.
+// The merged bundle uses the codec of the first bundle.
+func MergeBundles(bundles ...*Bundle) (*Bundle, error) {
+	if len(bundles) == 0 {
+		return nil, ErrNoBundlesToMerge
+	}
+
+	first := bundles[0]
+	merged := &Bundle{
+		Version:      first.Version,
+		NetworkID:    first.NetworkID,
+		Expiration:   first.Expiration,
+		Transactions: make([]BundleTransaction, len(first.Transactions)),
+		codec:        first.codec,
+	}
+	codec := merged.getCodec()
+	for i, bundleTx := range first.Transactions {
+		bundleTx.Signers = slices.Clone(bundleTx.Signers)
+		bundleTx.Signatures = nil
+		merged.Transactions[i] = bundleTx
+	}
+
+	for _, bundle := range bundles {
+		if !merged.sameTransactions(bundle) {
+			return nil, ErrBundleMismatch
+		}
+
+		for i, bundleTx := range bundle.Transactions {
+			for _, signature := range bundleTx.Signatures {
+				if err := merged.Transactions[i].addSignature(codec, signature); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return merged, nil
+}
+
+// getCodec returns the codec of the bundle, or the default codec for bundles built as a literal
+// or decoded with encoding/json.
+func (b *Bundle) getCodec() *binarycodec.Codec {
+	if b.codec == nil {
+		return binarycodec.DefaultCodec()
+	}
+	return b.codec
+}
+
+func (b *Bundle) isExpired() bool {
+	return !b.Expiration.IsZero() && time.Now().After(b.Expiration)
+}
+
+func (b *Bundle) sameTransactions(other *Bundle) bool {
+	if b.Version != other.Version || b.NetworkID != other.NetworkID || !b.Expiration.Equal(other.Expiration) {
+		return false
+	}
+
+	return slices.EqualFunc(b.Transactions, other.Transactions, func(a, b BundleTransaction) bool {
+		return a.Mode == b.Mode &&
+			a.TxBlob == b.TxBlob &&
+			a.SigningData == b.SigningData &&
+			a.Quorum == b.Quorum &&
+			slices.Equal(a.Signers, b.Signers)
+	})
+}
+
+// unsignedTx decodes the transaction blob. Batch inner transactions are returned as
+// []map[string]any, as expected by the Batch signing helpers.
+func (t *BundleTransaction) unsignedTx(codec *binarycodec.Codec) (map[string]any, error) {
+	tx, err := codec.Decode(t.TxBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	if rawTxs, ok := tx["RawTransactions"].([]any); ok {
+		wrappers := make([]map[string]any, 0, len(rawTxs))
+		for _, rawTx := range rawTxs {
+			wrapper, ok := rawTx.(map[string]any)
+			if !ok {
+				return nil, wallettypes.ErrRawTransactionFieldIsNotAnObject
+			}
+			wrappers = append(wrappers, wrapper)
+		}
+		tx["RawTransactions"] = wrappers
+	}
+
+	return tx, nil
+}
+
+// addSignature verifies and adds a signature, ignoring signatures already collected.
+func (t *BundleTransaction) addSignature(codec *binarycodec.Codec, signature BundleSignature) error {
+	for _, collected := range t.Signatures {
+		if collected.Account != signature.Account {
+			continue
+		}
+		if (collected.Signer == "") != (signature.Signer == "") {
+			return ErrBundleConflictingSignature
+		}
+		if collected.Signer == signature.Signer {
+			return nil
+		}
+	}
+
+	if len(t.Signers) > 0 && !slices.ContainsFunc(t.Signers, func(signer BundleSigner) bool {
+		return signer.Account == signature.signingAccount()
+	}) {
+		return ErrBundleUnexpectedSigner{Account: signature.signingAccount().String()}
+	}
+
+	unsignedTx, err := t.unsignedTx(codec)
+	if err != nil {
+		return err
+	}
+	if err := t.verifySignature(codec, unsignedTx, signature); err != nil {
+		return err
+	}
+
+	t.Signatures = append(t.Signatures, signature)
+	return nil
+}
+
+// verifySignature checks that the signature is a valid signature of the transaction, made with
+// the master key or the known regular key of the signing account.
+func (t *BundleTransaction) verifySignature(codec *binarycodec.Codec, unsignedTx map[string]any, signature BundleSignature) error {
+	account, _ := unsignedTx["Account"].(string)
+	signedTx := maps.Clone(unsignedTx)
+
+	var payload string
+	var err error
+	switch t.Mode {
+	case BundleModeSingle:
+		if signature.Account.String() != account || signature.Signer != "" {
+			return ErrBundleUnexpectedSigner{Account: signature.signingAccount().String()}
+		}
+		signedTx["SigningPubKey"] = signature.SigningPubKey
+		payload, err = codec.EncodeForSigning(signedTx)
+	case BundleModeMultisign:
+		if signature.Account.String() != account || signature.Signer == "" {
+			return ErrBundleUnexpectedSigner{Account: signature.signingAccount().String()}
+		}
+		signedTx["SigningPubKey"] = ""
+		payload, err = codec.EncodeForMultisigning(signedTx, signature.Signer.String())
+	case BundleModeBatch:
+		if !slices.Contains(batchAccounts(unsignedTx), signature.Account.String()) {
+			return ErrBundleUnexpectedSigner{Account: signature.Account.String()}
+		}
+		payload, err = signingData(codec, unsignedTx, t.Mode)
+	case BundleModeLoanSetCounterparty:
+		counterparty, _ := unsignedTx["Counterparty"].(string)
+		if signature.Account.String() != counterparty {
+			return ErrBundleUnexpectedSigner{Account: signature.Account.String()}
+		}
+		if signature.Signer != "" {
+			payload, err = codec.EncodeForMultisigning(signedTx, signature.Signer.String())
+		} else {
+			payload, err = codec.EncodeForSigning(signedTx)
+		}
+	default:
+		return ErrInvalidBundleMode
+	}
+	if err != nil {
+		return err
+	}
+
+	payloadBytes, err := hex.DecodeString(payload)
+	if err != nil {
+		return err
+	}
+
+	valid, err := keypairs.Validate(string(payloadBytes), signature.SigningPubKey, signature.TxnSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrBundleInvalidSignature, err)
+	}
+	if !valid {
+		return ErrBundleInvalidSignature
+	}
+
+	return t.verifySigningKey(signature)
+}
+
+// verifySigningKey checks that the signature key is the master key of the signing account, or
+// the regular key set for it in Signers.
+func (t *BundleTransaction) verifySigningKey(signature BundleSignature) error {
+	keyAddress, err := keypairs.DeriveClassicAddress(signature.SigningPubKey)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrBundleInvalidSignature, err)
+	}
+
+	account := signature.signingAccount()
+	if types.Address(keyAddress) == account {
+		return nil
+	}
+	if slices.ContainsFunc(t.Signers, func(signer BundleSigner) bool {
+		return signer.Account == account && signer.RegularKey != "" && signer.RegularKey.String() == keyAddress
+	}) {
+		return nil
+	}
+	return ErrBundleUnauthorizedKey{Account: account.String()}
+}
+
+// quorumMet reports whether enough signatures have been collected to finalize the transaction.
+// Without signers, a Batch transaction needs a signature for every inner account other than
+// the submitting account, and other transactions need at least one signature.
+func (t *BundleTransaction) quorumMet(unsignedTx map[string]any) bool {
+	signers := t.Signers
+	if len(signers) == 0 && t.Mode == BundleModeBatch {
+		account, _ := unsignedTx["Account"].(string)
+		for _, batchAccount := range batchAccounts(unsignedTx) {
+			if batchAccount != account && !slices.ContainsFunc(signers, func(signer BundleSigner) bool {
+				return signer.Account.String() == batchAccount
+			}) {
+				signers = append(signers, BundleSigner{Account: types.Address(batchAccount)})
+			}
+		}
+	}
+
+	if len(signers) == 0 {
+		return len(t.Signatures) > 0 && (t.Quorum == 0 || uint32(len(t.Signatures)) >= t.Quorum)
+	}
+
+	var weight, total uint32
+	for _, signer := range signers {
+		signerWeight := uint32(max(signer.Weight, 1))
+		total += signerWeight
+		if slices.ContainsFunc(t.Signatures, func(signature BundleSignature) bool {
+			return signature.signingAccount() == signer.Account
+		}) {
+			weight += signerWeight
+		}
+	}
+
+	if t.Quorum == 0 {
+		return weight == total
+	}
+	return weight >= t.Quorum
+}
+
+// finalize adds the collected signatures to the transaction and encodes it.
+func (t *BundleTransaction) finalize(codec *binarycodec.Codec) (string, error) {
+	tx, err := codec.Decode(t.TxBlob)
+	if err != nil {
+		return "", err
+	}
+
+	switch t.Mode {
+	case BundleModeSingle:
+		tx["SigningPubKey"] = t.Signatures[0].SigningPubKey
+		tx["TxnSignature"] = t.Signatures[0].TxnSignature
+	case BundleModeMultisign:
+		signers, err := multisigSigners(t.Signatures)
+		if err != nil {
+			return "", err
+		}
+		tx["SigningPubKey"] = ""
+		tx["Signers"] = signers
+	case BundleModeBatch:
+		batchSigners, err := t.batchSigners()
+		if err != nil {
+			return "", err
+		}
+		tx["BatchSigners"] = batchSigners
+	case BundleModeLoanSetCounterparty:
+		if t.Signatures[0].Signer == "" {
+			tx["CounterpartySignature"] = map[string]any{
+				"SigningPubKey": t.Signatures[0].SigningPubKey,
+				"TxnSignature":  t.Signatures[0].TxnSignature,
+			}
+			break
+		}
+		signers, err := multisigSigners(t.Signatures)
+		if err != nil {
+			return "", err
+		}
+		tx["CounterpartySignature"] = map[string]any{"Signers": signers}
+	default:
+		return "", ErrInvalidBundleMode
+	}
+
+	return codec.Encode(tx)
+}
+
+// batchSigners groups the signatures by inner account into BatchSigners.
+func (t *BundleTransaction) batchSigners() ([]any, error) {
+	accounts := make([]types.Address, 0, len(t.Signatures))
+	byAccount := make(map[types.Address][]BundleSignature, len(t.Signatures))
+	for _, signature := range t.Signatures {
+		if _, ok := byAccount[signature.Account]; !ok {
+			accounts = append(accounts, signature.Account)
+		}
+		byAccount[signature.Account] = append(byAccount[signature.Account], signature)
+	}
+
+	if err := SortByAccountID(accounts, func(account types.Address) (string, error) {
+		return account.String(), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	batchSigners := make([]any, 0, len(accounts))
+	for _, account := range accounts {
+		signatures := byAccount[account]
+
+		batchSigner := map[string]any{"Account": account.String()}
+		if signatures[0].Signer == "" {
+			batchSigner["SigningPubKey"] = signatures[0].SigningPubKey
+			batchSigner["TxnSignature"] = signatures[0].TxnSignature
+		} else {
+			signers, err := multisigSigners(signatures)
+			if err != nil {
+				return nil, err
+			}
+			batchSigner["Signers"] = signers
+		}
+		batchSigners = append(batchSigners, map[string]any{"BatchSigner": batchSigner})
+	}
+
+	return batchSigners, nil
+}
+
+// signingAccount returns the account that produced the signature.
+func (s BundleSignature) signingAccount() types.Address {
+	if s.Signer != "" {
+		return s.Signer
+	}
+	return s.Account
+}
+
+// multisigSigners returns the Signers field for multisig signatures, sorted by account.
+func multisigSigners(signatures []BundleSignature) ([]any, error) {
+	signers := make([]any, 0, len(signatures))
+	for _, signature := range signatures {
+		signers = append(signers, map[string]any{
+			"Signer": map[string]any{
+				"Account":       signature.Signer.String(),
+				"SigningPubKey": signature.SigningPubKey,
+				"TxnSignature":  signature.TxnSignature,
+			},
+		})
+	}
+
+	if err := SortSigners(signers); err != nil {
+		return nil, err
+	}
+	return signers, nil
+}
+
+// withoutBundleSignatures returns a shallow copy of tx without the fields the bundle collects
+// for the given mode.
+func withoutBundleSignatures(tx map[string]any, mode BundleMode) map[string]any {
+	stripped := maps.Clone(tx)
+
+	switch mode {
+	case BundleModeSingle, BundleModeMultisign:
+		delete(stripped, "SigningPubKey")
+		delete(stripped, "TxnSignature")
+		delete(stripped, "Signers")
+	case BundleModeBatch:
+		delete(stripped, "BatchSigners")
+	case BundleModeLoanSetCounterparty:
+		delete(stripped, "CounterpartySignature")
+	}
+
+	return stripped
+}
+
+// signingData returns the signing preimage of the unsigned transaction.
+func signingData(codec *binarycodec.Codec, unsignedTx map[string]any, mode BundleMode) (string, error) {
+	if mode != BundleModeBatch {
+		return codec.EncodeForSigning(unsignedTx)
+	}
+
+	flatTx := transaction.FlatTransaction(unsignedTx)
+	batchSignable, err := wallettypes.FromFlatBatchTransaction(&flatTx)
+	if err != nil {
+		return "", err
+	}
+	return codec.EncodeForSigningBatch(batchSignable.Flatten())
+}
+
+// bundleSignatures extracts the signatures collected by the bundle from a signed transaction.
+func bundleSignatures(signedTx map[string]any, mode BundleMode) ([]BundleSignature, error) {
+	account, _ := signedTx["Account"].(string)
+
+	switch mode {
+	case BundleModeSingle:
+		signingPubKey, _ := signedTx["SigningPubKey"].(string)
+		txnSignature, _ := signedTx["TxnSignature"].(string)
+		if signingPubKey == "" || txnSignature == "" {
+			return nil, nil
+		}
+		return []BundleSignature{{
+			Account:       types.Address(account),
+			SigningPubKey: signingPubKey,
+			TxnSignature:  txnSignature,
+		}}, nil
+	case BundleModeMultisign:
+		signers, _ := signedTx["Signers"].([]any)
+		return multisigSignatures(types.Address(account), signers)
+	case BundleModeBatch:
+		batchSigners, _ := signedTx["BatchSigners"].([]any)
+		signatures := make([]BundleSignature, 0, len(batchSigners))
+		for _, rawBatchSigner := range batchSigners {
+			wrapper, _ := rawBatchSigner.(map[string]any)
+			batchSigner, ok := wrapper["BatchSigner"].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%w: BatchSigner must be an object", ErrInvalidSigner)
+			}
+
+			batchSignatures, err := counterpartySignatures(batchSigner)
+			if err != nil {
+				return nil, err
+			}
+			signatures = append(signatures, batchSignatures...)
+		}
+		return signatures, nil
+	case BundleModeLoanSetCounterparty:
+		counterpartySignature, ok := signedTx["CounterpartySignature"].(map[string]any)
+		if !ok {
+			return nil, nil
+		}
+		counterparty, _ := signedTx["Counterparty"].(string)
+
+		withAccount := maps.Clone(counterpartySignature)
+		withAccount["Account"] = counterparty
+		return counterpartySignatures(withAccount)
+	default:
+		return nil, ErrInvalidBundleMode
+	}
+}
+
+// counterpartySignatures extracts the signatures of an object holding either a single signature
+// or multisig Signers on behalf of its Account, such as a BatchSigner.
+func counterpartySignatures(signature map[string]any) ([]BundleSignature, error) {
+	account, _ := signature["Account"].(string)
+
+	if signers, ok := signature["Signers"].([]any); ok {
+		return multisigSignatures(types.Address(account), signers)
+	}
+
+	signingPubKey, _ := signature["SigningPubKey"].(string)
+	txnSignature, _ := signature["TxnSignature"].(string)
+	if signingPubKey == "" || txnSignature == "" {
+		return nil, fmt.Errorf("%w: missing SigningPubKey or TxnSignature", ErrInvalidSigner)
+	}
+
+	return []BundleSignature{{
+		Account:       types.Address(account),
+		SigningPubKey: signingPubKey,
+		TxnSignature:  txnSignature,
+	}}, nil
+}
+
+// multisigSignatures extracts the signatures of multisig Signers on behalf of account.
+func multisigSignatures(account types.Address, signers []any) ([]BundleSignature, error) {
+	signatures := make([]BundleSignature, 0, len(signers))
+	for _, signer := range signers {
+		signerAccount, signingPubKey, txnSignature, err := signerFields(signer)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, BundleSignature{
+			Account:       account,
+			Signer:        types.Address(signerAccount),
+			SigningPubKey: signingPubKey,
+			TxnSignature:  txnSignature,
+		})
+	}
+	return signatures, nil
+}
+
+// batchAccounts returns the accounts of the inner transactions of a Batch.
+func batchAccounts(unsignedTx map[string]any) []string {
+	rawTxs, _ := unsignedTx["RawTransactions"].([]map[string]any)
+
+	accounts := make([]string, 0, len(rawTxs))
+	for _, rawTx := range rawTxs {
+		if innerTx, ok := rawTx["RawTransaction"].(map[string]any); ok {
+			if account, ok := innerTx["Account"].(string); ok {
+				accounts = append(accounts, account)
+			}
+		}
+	}
+	return accounts
+}
@@ -0,0 +1,333 @@
+package xrpl_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func bundleTestWallets(t *testing.T) (wallet.Wallet, wallet.Wallet, wallet.Wallet) {
+	t.Helper()
+
+	a, err := wallet.FromSeed("sEdTCFHBquP36KursdZ17ZiuZenJZHg", "")
+	require.NoError(t, err)
+	b, err := wallet.FromSeed("sEd7HmQFsoyj5TAm6d98gytM9LJA1MF", "")
+	require.NoError(t, err)
+	c, err := wallet.FromSeed("sEdStM1pngFcLQqVfH3RQcg2Qr6ov9e", "")
+	require.NoError(t, err)
+
+	return a, b, c
+}
+
+func bundleTestTx(account string) transaction.FlatTransaction {
+	return transaction.FlatTransaction{
+		"TransactionType": "AccountSet",
+		"Account":         account,
+		"Fee":             "36",
+		"Sequence":        uint32(10),
+		"Flags":           uint32(0),
+	}
+}
+
+// roundTrip serializes the bundle to JSON and parses it back, as done when passing it to a signer.
+func roundTrip(t *testing.T, bundle *xrpl.Bundle) *xrpl.Bundle {
+	t.Helper()
+
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+
+	parsed, err := xrpl.ParseBundle(data)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestBundle_Single(t *testing.T) {
+	a, _, _ := bundleTestWallets(t)
+	tx := bundleTestTx(a.ClassicAddress.String())
+
+	bundle := xrpl.NewBundle(0, time.Time{})
+	require.NoError(t, bundle.AddTransaction(tx, xrpl.BundleModeSingle, nil))
+
+	_, err := bundle.Finalize()
+	require.ErrorIs(t, err, xrpl.ErrBundleQuorumNotMet)
+
+	signed, _, err := a.Sign(tx)
+	require.NoError(t, err)
+
+	bundle = roundTrip(t, bundle)
+	require.NoError(t, bundle.AddSignature(signed))
+	// Adding the same signature twice is a no-op.
+	require.NoError(t, bundle.AddSignature(signed))
+	require.Len(t, bundle.Transactions[0].Signatures, 1)
+
+	blobs, err := roundTrip(t, bundle).Finalize()
+	require.NoError(t, err)
+	require.Equal(t, []string{signed}, blobs)
+}
+
+func TestBundle_WithCodec(t *testing.T) {
+	a, _, _ := bundleTestWallets(t)
+	tx := bundleTestTx(a.ClassicAddress.String())
+
+	t.Run("pass - encodes and parses with the given codec", func(t *testing.T) {
+		codec := binarycodec.NewCodec(definitions.Get())
+		bundle := xrpl.NewBundleWithCodec(0, time.Time{}, codec)
+		require.NoError(t, bundle.AddTransaction(tx, xrpl.BundleModeSingle, nil))
+
+		signed, _, err := a.SignWithCodec(tx, codec)
+		require.NoError(t, err)
+		require.NoError(t, bundle.AddSignature(signed))
+
+		data, err := json.Marshal(bundle)
+		require.NoError(t, err)
+		parsed, err := xrpl.ParseBundleWithCodec(data, codec)
+		require.NoError(t, err)
+
+		blobs, err := parsed.Finalize()
+		require.NoError(t, err)
+		require.Equal(t, []string{signed}, blobs)
+	})
+
+	t.Run("pass - codec without the transaction fields", func(t *testing.T) {
+		codec, err := binarycodec.NewCodecFromJSON([]byte(`{
+			"TYPES": {"UInt32": 2},
+			"FIELDS": [["Flags", {"nth": 2, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}]],
+			"TRANSACTION_TYPES": {"Payment": 0}
+		}`))
+		require.NoError(t, err)
+
+		bundle := xrpl.NewBundleWithCodec(0, time.Time{}, codec)
+		require.NoError(t, bundle.AddTransaction(tx, xrpl.BundleModeSingle, nil))
+		// Only Flags is known to the codec.
+		require.Equal(t, "2200000000", bundle.Transactions[0].TxBlob)
+	})
+}
+
+func TestBundle_MultisignMerge(t *testing.T) {
+	a, b, c := bundleTestWallets(t)
+	tx := bundleTestTx(a.ClassicAddress.String())
+
+	bundle := xrpl.NewBundle(0, time.Time{})
+	require.NoError(t, bundle.AddTransaction(tx, xrpl.BundleModeMultisign, &xrpl.BundleTransactionOptions{
+		Signers: []xrpl.BundleSigner{
+			{Account: b.ClassicAddress, Weight: 1},
+			{Account: c.ClassicAddress, Weight: 1},
+		},
+		Quorum: 2,
+	}))
+
+	signedB, _, err := b.Multisign(tx)
+	require.NoError(t, err)
+	signedC, _, err := c.Multisign(tx)
+	require.NoError(t, err)
+
+	partialB := roundTrip(t, bundle)
+	require.NoError(t, partialB.AddSignature(signedB))
+	_, err = partialB.Finalize()
+	require.ErrorIs(t, err, xrpl.ErrBundleQuorumNotMet)
+
+	partialC := roundTrip(t, bundle)
+	require.NoError(t, partialC.AddSignature(signedC))
+
+	merged, err := xrpl.MergeBundles(partialB, partialC)
+	require.NoError(t, err)
+	require.NoError(t, merged.Verify())
+
+	blobs, err := merged.Finalize()
+	require.NoError(t, err)
+
+	expected, err := xrpl.Multisign(signedB, signedC)
+	require.NoError(t, err)
+	require.Equal(t, []string{expected}, blobs)
+}
+
+func TestBundle_Batch(t *testing.T) {
+	a, b, _ := bundleTestWallets(t)
+	tx := transaction.FlatTransaction{
+		"TransactionType": "Batch",
+		"Account":         a.ClassicAddress.String(),
+		"Fee":             "40",
+		"Sequence":        uint32(10),
+		"Flags":           transaction.TfAllOrNothing,
+		"RawTransactions": []map[string]any{
+			{"RawTransaction": map[string]any{
+				"TransactionType": "AccountSet",
+				"Account":         a.ClassicAddress.String(),
+				"Fee":             "0",
+				"Sequence":        uint32(11),
+				"Flags":           types.TfInnerBatchTxn,
+				"SigningPubKey":   "",
+			}},
+			{"RawTransaction": map[string]any{
+				"TransactionType": "AccountSet",
+				"Account":         b.ClassicAddress.String(),
+				"Fee":             "0",
+				"Sequence":        uint32(5),
+				"Flags":           types.TfInnerBatchTxn,
+				"SigningPubKey":   "",
+			}},
+		},
+	}
+
+	bundle := xrpl.NewBundle(0, time.Time{})
+	require.NoError(t, bundle.AddTransaction(tx, xrpl.BundleModeBatch, nil))
+
+	require.NoError(t, wallet.SignMultiBatch(b, &tx, nil))
+	signed, err := binarycodec.Encode(tx)
+	require.NoError(t, err)
+
+	bundle = roundTrip(t, bundle)
+	require.NoError(t, bundle.AddSignature(signed))
+
+	blobs, err := bundle.Finalize()
+	require.NoError(t, err)
+	require.Equal(t, []string{signed}, blobs)
+}
+
+func TestBundle_LoanSetCounterparty(t *testing.T) {
+	broker, borrower, _ := bundleTestWallets(t)
+	tx := transaction.FlatTransaction{
+		"TransactionType": "LoanSet",
+		"Account":         broker.ClassicAddress.String(),
+		"Counterparty":    borrower.ClassicAddress.String(),
+		"LoanBrokerID":    "DB303FC1C7611B22C09E773B51044F6BEA02EF917DF59A2E2860871E167066A5",
+		"Fee":             "36",
+		"Sequence":        uint32(10),
+		"Flags":           uint32(0),
+	}
+
+	bundle := xrpl.NewBundle(0, time.Time{})
+	require.ErrorIs(t, bundle.AddTransaction(tx, xrpl.BundleModeLoanSetCounterparty, nil), xrpl.ErrBundleLoanSetNotSignedByBroker)
+
+	brokerSigned, _, err := broker.Sign(tx)
+	require.NoError(t, err)
+	decoded, err := binarycodec.Decode(brokerSigned)
+	require.NoError(t, err)
+	brokerTx := transaction.FlatTransaction(decoded)
+
+	require.NoError(t, bundle.AddTransaction(brokerTx, xrpl.BundleModeLoanSetCounterparty, nil))
+
+	_, signed, _, err := wallet.SignLoanSetByCounterpartyBlob(borrower, brokerSigned, nil)
+	require.NoError(t, err)
+
+	bundle = roundTrip(t, bundle)
+	require.NoError(t, bundle.AddSignature(signed))
+
+	blobs, err := bundle.Finalize()
+	require.NoError(t, err)
+	require.Equal(t, []string{signed}, blobs)
+}
+
+func TestBundle_Errors(t *testing.T) {
+	a, b, c := bundleTestWallets(t)
+	tx := bundleTestTx(a.ClassicAddress.String())
+
+	newBundle := func(t *testing.T, expiration time.Time) *xrpl.Bundle {
+		t.Helper()
+		bundle := xrpl.NewBundle(0, expiration)
+		require.NoError(t, bundle.AddTransaction(tx, xrpl.BundleModeMultisign, &xrpl.BundleTransactionOptions{
+			Signers: []xrpl.BundleSigner{{Account: b.ClassicAddress}},
+		}))
+		return bundle
+	}
+
+	t.Run("fail - unexpected signer", func(t *testing.T) {
+		signed, _, err := c.Multisign(tx)
+		require.NoError(t, err)
+
+		err = newBundle(t, time.Time{}).AddSignature(signed)
+		require.ErrorIs(t, err, xrpl.ErrBundleUnexpectedSigner{Account: c.ClassicAddress.String()})
+	})
+
+	t.Run("fail - transaction not in bundle", func(t *testing.T) {
+		other := bundleTestTx(a.ClassicAddress.String())
+		other["Sequence"] = uint32(11)
+		signed, _, err := b.Multisign(other)
+		require.NoError(t, err)
+
+		err = newBundle(t, time.Time{}).AddSignature(signed)
+		require.ErrorIs(t, err, xrpl.ErrBundleTransactionNotFound)
+	})
+
+	t.Run("fail - expired bundle", func(t *testing.T) {
+		signed, _, err := b.Multisign(tx)
+		require.NoError(t, err)
+
+		err = newBundle(t, time.Now().Add(-time.Minute)).AddSignature(signed)
+		require.ErrorIs(t, err, xrpl.ErrBundleExpired)
+	})
+
+	t.Run("fail - tampered signature", func(t *testing.T) {
+		signed, _, err := b.Multisign(tx)
+		require.NoError(t, err)
+
+		bundle := newBundle(t, time.Time{})
+		require.NoError(t, bundle.AddSignature(signed))
+		bundle.Transactions[0].Signatures[0].SigningPubKey = c.PublicKey
+
+		require.ErrorIs(t, bundle.Verify(), xrpl.ErrBundleInvalidSignature)
+	})
+
+	t.Run("fail - single signature made with a foreign key", func(t *testing.T) {
+		foreign := c
+		foreign.ClassicAddress = a.ClassicAddress
+		signed, _, err := foreign.Sign(tx)
+		require.NoError(t, err)
+
+		bundle := xrpl.NewBundle(0, time.Time{})
+		require.NoError(t, bundle.AddTransaction(tx, xrpl.BundleModeSingle, nil))
+
+		err = bundle.AddSignature(signed)
+		require.ErrorIs(t, err, xrpl.ErrBundleUnauthorizedKey{Account: a.ClassicAddress.String()})
+	})
+
+	t.Run("fail - multisig signature made with a foreign key", func(t *testing.T) {
+		foreign := c
+		foreign.ClassicAddress = b.ClassicAddress
+		signed, _, err := foreign.Multisign(tx)
+		require.NoError(t, err)
+
+		err = newBundle(t, time.Time{}).AddSignature(signed)
+		require.ErrorIs(t, err, xrpl.ErrBundleUnauthorizedKey{Account: b.ClassicAddress.String()})
+	})
+
+	t.Run("pass - signature made with the regular key of the account", func(t *testing.T) {
+		regularKeySigner := c
+		regularKeySigner.ClassicAddress = b.ClassicAddress
+		signed, _, err := regularKeySigner.Multisign(tx)
+		require.NoError(t, err)
+
+		bundle := xrpl.NewBundle(0, time.Time{})
+		require.NoError(t, bundle.AddTransaction(tx, xrpl.BundleModeMultisign, &xrpl.BundleTransactionOptions{
+			Signers: []xrpl.BundleSigner{{Account: b.ClassicAddress, RegularKey: c.ClassicAddress}},
+		}))
+		require.NoError(t, bundle.AddSignature(signed))
+		require.NoError(t, roundTrip(t, bundle).Verify())
+	})
+
+	t.Run("fail - unsupported version", func(t *testing.T) {
+		_, err := xrpl.ParseBundle([]byte(`{"version": 2, "transactions": []}`))
+		require.ErrorIs(t, err, xrpl.ErrUnsupportedBundleVersion{Version: 2})
+	})
+
+	t.Run("fail - merge different bundles", func(t *testing.T) {
+		other := xrpl.NewBundle(0, time.Time{})
+		require.NoError(t, other.AddTransaction(tx, xrpl.BundleModeSingle, nil))
+
+		_, err := xrpl.MergeBundles(newBundle(t, time.Time{}), other)
+		require.ErrorIs(t, err, xrpl.ErrBundleMismatch)
+	})
+
+	t.Run("fail - network ID mismatch", func(t *testing.T) {
+		err := xrpl.NewBundle(21338, time.Time{}).AddTransaction(tx, xrpl.BundleModeSingle, nil)
+		require.ErrorIs(t, err, xrpl.ErrBundleNetworkIDMismatch)
+	})
+}
@@ -22,4 +22,9 @@ const (
 
 	// DefaultTimeout is the default timeout for RPC calls (5 seconds).
 	DefaultTimeout = 5 * time.Second
+
+	// QueueRetryFeePercent is the percentage by which a replacement transaction's fee
+	// must exceed the fee of the queued transaction it replaces (rippled's
+	// retry_sequence_percent default).
+	QueueRetryFeePercent uint64 = 25
 )
@@ -0,0 +1,17 @@
+package common
+
+// DefinitionsCheck selects how a client reacts when the server's binary codec definitions
+// differ from the definitions embedded in the binary codec.
+type DefinitionsCheck int
+
+const (
+	// DefinitionsCheckNone skips the definitions check.
+	DefinitionsCheckNone DefinitionsCheck = iota
+	// DefinitionsCheckWarn logs a warning when the definitions differ.
+	DefinitionsCheckWarn
+	// DefinitionsCheckFail returns an error when the definitions differ.
+	DefinitionsCheckFail
+	// DefinitionsCheckLoad loads the server's definitions when they differ and uses them
+	// for all encoding and decoding done through the client.
+	DefinitionsCheckLoad
+)
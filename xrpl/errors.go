@@ -1,6 +1,13 @@
 package xrpl
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Peersyst/xrpl-go/xrpl/internal/autofill"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 var (
 	// ErrNoTxToMultisign is returned when no transaction blobs are provided to Multisign.
@@ -16,4 +23,155 @@ var (
 	ErrMultisignInvalidSignature = errors.New("invalid multisign signer signature")
 	// ErrInvalidSigner is returned when a signer entry is malformed.
 	ErrInvalidSigner = errors.New("invalid signer")
+
+	// offline autofill
+
+	// ErrNilNetworkSnapshot is returned when no network snapshot is provided to AutofillOffline.
+	ErrNilNetworkSnapshot = errors.New("network snapshot is required for offline autofill")
+	// ErrSnapshotMissingBaseFee is returned when the network snapshot has no base fee.
+	ErrSnapshotMissingBaseFee = errors.New("network snapshot is missing the base fee")
+	// ErrSnapshotMissingOwnerReserve is returned when the network snapshot has no owner reserve.
+	ErrSnapshotMissingOwnerReserve = errors.New("network snapshot is missing the owner reserve")
+	// ErrSnapshotMissingLedgerIndex is returned when the network snapshot has no ledger index.
+	ErrSnapshotMissingLedgerIndex = errors.New("network snapshot is missing the ledger index")
+	// ErrMissingAccountInTransaction is returned when the transaction has no Account.
+	ErrMissingAccountInTransaction = errors.New("missing Account in transaction")
+	// ErrOfflineCounterpartyRequired is returned when a LoanSet transaction autofilled offline
+	// has no Counterparty, since the LoanBroker owner cannot be looked up without a network.
+	ErrOfflineCounterpartyRequired = errors.New("counterparty is required to autofill LoanSet offline")
+	// ErrAmountAndDeliverMaxMustBeIdentical is returned when Amount and DeliverMax are both set
+	// on a Payment but differ.
+	ErrAmountAndDeliverMaxMustBeIdentical = errors.New("payment transaction: Amount and DeliverMax fields must be identical when both are provided")
+
+	// bundle
+
+	// ErrInvalidBundleMode is returned when a bundled transaction has an unknown mode.
+	ErrInvalidBundleMode = errors.New("invalid bundle mode")
+	// ErrBundleTxMustBeBatch is returned when a transaction bundled in batch mode is not a Batch.
+	ErrBundleTxMustBeBatch = errors.New("bundled transaction must be a Batch transaction in batch mode")
+	// ErrBundleTxMustBeLoanSet is returned when a transaction bundled in LoanSet counterparty mode is not a LoanSet.
+	ErrBundleTxMustBeLoanSet = errors.New("bundled transaction must be a LoanSet transaction in LoanSet counterparty mode")
+	// ErrBundleLoanSetNotSignedByBroker is returned when a LoanSet transaction is bundled before the LoanBroker signed it.
+	ErrBundleLoanSetNotSignedByBroker = errors.New("LoanSet transaction must be signed by the LoanBroker before it is bundled")
+	// ErrBundleNetworkIDMismatch is returned when a transaction NetworkID does not match the bundle NetworkID.
+	ErrBundleNetworkIDMismatch = errors.New("transaction NetworkID does not match the bundle NetworkID")
+	// ErrBundleExpired is returned when a bundle is signed or finalized after its expiration.
+	ErrBundleExpired = errors.New("bundle has expired")
+	// ErrBundleTransactionNotFound is returned when a signed transaction does not match any bundled transaction.
+	ErrBundleTransactionNotFound = errors.New("signed transaction does not match any bundled transaction")
+	// ErrBundleNoSignature is returned when a signed transaction holds no signature for the bundle.
+	ErrBundleNoSignature = errors.New("signed transaction holds no signature for the bundle")
+	// ErrBundleConflictingSignature is returned when an account has both a single signature and multisig signatures.
+	ErrBundleConflictingSignature = errors.New("account cannot have both a single signature and multisig signatures")
+	// ErrBundleInvalidSignature is returned when a bundle signature is invalid.
+	ErrBundleInvalidSignature = errors.New("invalid bundle signature")
+	// ErrBundleSigningDataMismatch is returned when the signing data of a bundled transaction does not match its blob.
+	ErrBundleSigningDataMismatch = errors.New("bundle signing data does not match the transaction")
+	// ErrBundleQuorumNotMet is returned when a bundled transaction is finalized without enough signatures.
+	ErrBundleQuorumNotMet = errors.New("bundle signature quorum not met")
+	// ErrNoBundlesToMerge is returned when no bundles are provided to MergeBundles.
+	ErrNoBundlesToMerge = errors.New("no bundles to merge")
+	// ErrBundleMismatch is returned when bundles passed to MergeBundles do not hold the same transactions.
+	ErrBundleMismatch = errors.New("all bundles to merge must hold the same transactions")
+
+	// multisign coordinator
+
+	// ErrNoSignerList is returned when the account of a transaction to multisign has no SignerList.
+	ErrNoSignerList = errors.New("account has no signer list")
+
+	// preclaim
+
+	// ErrPreclaimInvalidNumber is returned when a numeric field read by Preclaim has an unexpected type.
+	ErrPreclaimInvalidNumber = errors.New("invalid numeric field in preclaim state")
 )
+
+// ErrAccountNotInSnapshot is returned when an account needed to autofill a transaction
+// is not part of the network snapshot.
+type ErrAccountNotInSnapshot struct {
+	Account string
+}
+
+// Error implements the error interface for ErrAccountNotInSnapshot
+func (e ErrAccountNotInSnapshot) Error() string {
+	return fmt.Sprintf("account %s is not in the network snapshot", e.Account)
+}
+
+// ErrUnsupportedBundleVersion is returned when a bundle has a version this package cannot read.
+type ErrUnsupportedBundleVersion struct {
+	Version int
+}
+
+// Error implements the error interface for ErrUnsupportedBundleVersion
+func (e ErrUnsupportedBundleVersion) Error() string {
+	return fmt.Sprintf("unsupported bundle version: %d", e.Version)
+}
+
+// ErrBundleUnexpectedSigner is returned when a signature is from an account not expected to sign.
+type ErrBundleUnexpectedSigner struct {
+	Account string
+}
+
+// Error implements the error interface for ErrBundleUnexpectedSigner
+func (e ErrBundleUnexpectedSigner) Error() string {
+	return fmt.Sprintf("unexpected bundle signer: %s", e.Account)
+}
+
+// ErrBundleUnauthorizedKey is returned when a bundle signature is made with a key that is neither
+// the master key nor the known regular key of the signing account.
+type ErrBundleUnauthorizedKey struct {
+	Account string
+}
+
+// Error implements the error interface for ErrBundleUnauthorizedKey
+func (e ErrBundleUnauthorizedKey) Error() string {
+	return fmt.Sprintf("bundle signature key is not authorized to sign for %s", e.Account)
+}
+
+// ErrMultisignUnexpectedSigner is returned when a signature is from an account that is not in the SignerList.
+type ErrMultisignUnexpectedSigner struct {
+	Account string
+}
+
+// Error implements the error interface for ErrMultisignUnexpectedSigner
+func (e ErrMultisignUnexpectedSigner) Error() string {
+	return fmt.Sprintf("signer %s is not in the signer list", e.Account)
+}
+
+// ErrMultisignUnauthorizedKey is returned when a signature is made with a key that is neither
+// the enabled master key nor the regular key of the signer account.
+type ErrMultisignUnauthorizedKey struct {
+	Account string
+}
+
+// Error implements the error interface for ErrMultisignUnauthorizedKey
+func (e ErrMultisignUnauthorizedKey) Error() string {
+	return fmt.Sprintf("signature key is not authorized to sign for %s", e.Account)
+}
+
+// ErrMultisignQuorumNotMet is returned when the weight of the collected signatures does not reach the SignerQuorum.
+type ErrMultisignQuorumNotMet struct {
+	Weight uint32
+	Quorum uint32
+}
+
+// Error implements the error interface for ErrMultisignQuorumNotMet
+func (e ErrMultisignQuorumNotMet) Error() string {
+	return fmt.Sprintf("multisign quorum not met: weight %d of %d", e.Weight, e.Quorum)
+}
+
+// ErrPreclaim is returned by Preclaim when a transaction fails a ledger state check.
+// Result is the engine result the ledger would most likely return for it.
+type ErrPreclaim struct {
+	Reason  PreclaimReason
+	Result  transaction.TxResult
+	Account types.Address
+}
+
+// Error implements the error interface for ErrPreclaim
+func (e ErrPreclaim) Error() string {
+	return fmt.Sprintf("preclaim check failed for %s: %s (%s)", e.Account, e.Reason, e.Result)
+}
+
+// ErrMismatchedTag is returned when the tag of an X-address does not match the tag field of the
+// transaction.
+type ErrMismatchedTag = autofill.ErrMismatchedTag
@@ -16,4 +16,18 @@ const (
 	// TransactionPrefix is the 4-byte prefix for hashing a transaction plus signature
 	// to generate the transaction ID ('TXN').
 	TransactionPrefix uint32 = 0x54584E00
+	// LedgerPrefix is the 4-byte prefix for hashing a ledger header to generate the
+	// ledger hash ('LWR').
+	LedgerPrefix uint32 = 0x4C575200
+	// InnerNodePrefix is the 4-byte prefix for hashing a SHAMap inner node ('MIN').
+	InnerNodePrefix uint32 = 0x4D494E00
+	// LeafNodePrefix is the 4-byte prefix for hashing a SHAMap account state leaf node ('MLN').
+	LeafNodePrefix uint32 = 0x4D4C4E00
+	// TransactionNodePrefix is the 4-byte prefix for hashing a SHAMap transaction with
+	// metadata leaf node ('SND').
+	TransactionNodePrefix uint32 = 0x534E4400
+	// ManifestPrefix is the 4-byte prefix for signing a validator manifest ('MAN').
+	ManifestPrefix uint32 = 0x4D414E00
+	// ValidationPrefix is the 4-byte prefix for signing a ledger validation ('VAL').
+	ValidationPrefix uint32 = 0x56414C00
 )
@@ -11,4 +11,13 @@ var (
 	// A transaction must have at least one of: TxnSignature, Signers, or SigningPubKey,
 	// unless it's an inner batch transaction (has TfInnerBatchTxn flag set).
 	ErrMissingSignature = errors.New("transaction must have at least one of TxnSignature, Signers, or SigningPubKey")
+
+	// keylets
+
+	// ErrInvalidCurrency is returned when a currency is neither a 3 character code nor a 40 character hex string.
+	ErrInvalidCurrency = errors.New("currency must be a 3 character code or a 40 character hex string")
+	// ErrInvalidHash is returned when a ledger index, token ID or domain ID is not a 32 byte hex string.
+	ErrInvalidHash = errors.New("hash must be a 32 byte hex string")
+	// ErrInvalidChainType is returned when a bridge chain type is neither LockingChain nor IssuingChain.
+	ErrInvalidChainType = errors.New("invalid bridge chain type")
 )
@@ -0,0 +1,560 @@
+package hash
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/hexutil"
+)
+
+// LedgerSpace is the 2-byte namespace hashed in front of the data identifying a ledger entry.
+// It keeps entries of different types built from the same data, such as the AccountRoot and the
+// owner directory of an account, at different indexes.
+type LedgerSpace uint16
+
+// Ledger spaces, as defined by rippled.
+const (
+	AccountSpace                    LedgerSpace = 'a'
+	DirectoryNodeSpace              LedgerSpace = 'd'
+	RippleStateSpace                LedgerSpace = 'r'
+	OfferSpace                      LedgerSpace = 'o'
+	OwnerDirectorySpace             LedgerSpace = 'O'
+	BookDirectorySpace              LedgerSpace = 'B'
+	SkipListSpace                   LedgerSpace = 's'
+	EscrowSpace                     LedgerSpace = 'u'
+	AmendmentsSpace                 LedgerSpace = 'f'
+	FeeSettingsSpace                LedgerSpace = 'e'
+	TicketSpace                     LedgerSpace = 'T'
+	SignerListSpace                 LedgerSpace = 'S'
+	PaymentChannelSpace             LedgerSpace = 'x'
+	CheckSpace                      LedgerSpace = 'C'
+	DepositPreauthSpace             LedgerSpace = 'p'
+	DepositPreauthCredentialsSpace  LedgerSpace = 'P'
+	NegativeUNLSpace                LedgerSpace = 'N'
+	NFTokenOfferSpace               LedgerSpace = 'q'
+	NFTokenBuyOffersSpace           LedgerSpace = 'h'
+	NFTokenSellOffersSpace          LedgerSpace = 'i'
+	AMMSpace                        LedgerSpace = 'A'
+	BridgeSpace                     LedgerSpace = 'H'
+	XChainClaimIDSpace              LedgerSpace = 'Q'
+	XChainCreateAccountClaimIDSpace LedgerSpace = 'K'
+	DIDSpace                        LedgerSpace = 'I'
+	OracleSpace                     LedgerSpace = 'R'
+	MPTokenIssuanceSpace            LedgerSpace = '~'
+	MPTokenSpace                    LedgerSpace = 't'
+	CredentialSpace                 LedgerSpace = 'D'
+	PermissionedDomainSpace         LedgerSpace = 'm'
+	DelegateSpace                   LedgerSpace = 'E'
+	VaultSpace                      LedgerSpace = 'V'
+	LoanBrokerSpace                 LedgerSpace = 'l'
+	LoanSpace                       LedgerSpace = 'L'
+)
+
+const (
+	// nftokenPageMaskLength is the number of low bytes of an NFTokenID that select its NFTokenPage.
+	nftokenPageMaskLength = 12
+)
+
+// Issue identifies a currency: XRP, with an empty or "XRP" Currency and no Issuer, or a
+// currency code, as a 3 character code or 40 character hex string, with its issuer.
+type Issue struct {
+	Currency string
+	Issuer   string
+}
+
+// ChainType selects one of the two chains of a bridge.
+type ChainType int
+
+const (
+	// LockingChain is the chain where the bridged asset is locked and unlocked.
+	LockingChain ChainType = iota
+	// IssuingChain is the chain where the bridged asset is minted and burned.
+	IssuingChain
+)
+
+// Bridge identifies a cross-chain bridge by its door accounts and the asset it carries on each chain.
+type Bridge struct {
+	LockingChainDoor  string
+	LockingChainIssue Issue
+	IssuingChainDoor  string
+	IssuingChainIssue Issue
+}
+
+// AuthorizeCredential identifies a credential by its issuer and hex encoded credential type.
+type AuthorizeCredential struct {
+	Issuer         string
+	CredentialType string
+}
+
+// indexHash computes SHA-512Half(space + parts) as an uppercase hex string.
+func indexHash(space LedgerSpace, parts ...[]byte) string {
+	size := 2
+	for _, p := range parts {
+		size += len(p)
+	}
+	payload := binary.BigEndian.AppendUint16(make([]byte, 0, size), uint16(space))
+	for _, p := range parts {
+		payload = append(payload, p...)
+	}
+	return EncodeToHashString(payload)
+}
+
+func uint32Bytes(v uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, v)
+}
+
+func uint64Bytes(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
+
+// decodeAccountID decodes a classic address, naming its role in the error.
+func decodeAccountID(address, role string) ([]byte, error) {
+	_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s classic address: %w", role, err)
+	}
+	return accountID, nil
+}
+
+// decodeHash decodes a 32 byte hex string, naming its role in the error.
+func decodeHash(h, role string) ([]byte, error) {
+	b, err := hex.DecodeString(h)
+	if err != nil || len(b) != 32 {
+		return nil, fmt.Errorf("%w: %s %q", ErrInvalidHash, role, h)
+	}
+	return b, nil
+}
+
+// currencyBytes returns the 20 byte form of a currency. XRP is all zeros.
+func currencyBytes(currency string) ([]byte, error) {
+	b := make([]byte, 20)
+	switch len(currency) {
+	case 0:
+		return b, nil
+	case 3:
+		if currency != "XRP" {
+			copy(b[12:], currency)
+		}
+		return b, nil
+	case 40:
+		if _, err := hex.Decode(b, []byte(currency)); err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidCurrency, currency)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCurrency, currency)
+	}
+}
+
+// issueBytes returns the 20 byte currency and the 20 byte issuer account of an issue. The
+// issuer of XRP is all zeros.
+func issueBytes(issue Issue) ([]byte, []byte, error) {
+	currency, err := currencyBytes(issue.Currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	if issue.Issuer == "" {
+		return currency, make([]byte, 20), nil
+	}
+	issuer, err := decodeAccountID(issue.Issuer, "issuer")
+	if err != nil {
+		return nil, nil, err
+	}
+	return currency, issuer, nil
+}
+
+// accountKeylet computes the index of an entry identified by a single account.
+func accountKeylet(space LedgerSpace, address string) (string, error) {
+	accountID, err := decodeAccountID(address, "account")
+	if err != nil {
+		return "", err
+	}
+	return indexHash(space, accountID), nil
+}
+
+// sequenceKeylet computes the index of an entry identified by an account and a sequence number.
+func sequenceKeylet(space LedgerSpace, address string, sequence uint32) (string, error) {
+	accountID, err := decodeAccountID(address, "account")
+	if err != nil {
+		return "", err
+	}
+	return indexHash(space, accountID, uint32Bytes(sequence)), nil
+}
+
+// accountPairKeylet computes the index of an entry identified by two accounts, in order.
+func accountPairKeylet(space LedgerSpace, address, other, otherRole string) (string, error) {
+	accountID, err := decodeAccountID(address, "account")
+	if err != nil {
+		return "", err
+	}
+	otherID, err := decodeAccountID(other, otherRole)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(space, accountID, otherID), nil
+}
+
+// AccountRoot computes the index of the AccountRoot ledger entry of an account.
+func AccountRoot(address string) (string, error) {
+	return accountKeylet(AccountSpace, address)
+}
+
+// RippleState computes the index of the RippleState ledger entry (trust line) between two
+// accounts for a currency. The accounts can be given in either order.
+func RippleState(address1, address2, currency string) (string, error) {
+	id1, err := decodeAccountID(address1, "account")
+	if err != nil {
+		return "", err
+	}
+	id2, err := decodeAccountID(address2, "account")
+	if err != nil {
+		return "", err
+	}
+	currencyID, err := currencyBytes(currency)
+	if err != nil {
+		return "", err
+	}
+
+	low, high := id1, id2
+	if bytes.Compare(low, high) > 0 {
+		low, high = high, low
+	}
+	return indexHash(RippleStateSpace, low, high, currencyID), nil
+}
+
+// Offer computes the index of the Offer ledger entry created by an account with a sequence number.
+func Offer(address string, sequence uint32) (string, error) {
+	return sequenceKeylet(OfferSpace, address, sequence)
+}
+
+// Check computes the index of the Check ledger entry created by an account with a sequence number.
+func Check(address string, sequence uint32) (string, error) {
+	return sequenceKeylet(CheckSpace, address, sequence)
+}
+
+// Escrow computes the index of the Escrow ledger entry created by an account with a sequence number.
+func Escrow(address string, sequence uint32) (string, error) {
+	return sequenceKeylet(EscrowSpace, address, sequence)
+}
+
+// Ticket computes the index of the Ticket ledger entry of an account with a ticket sequence number.
+func Ticket(address string, ticketSequence uint32) (string, error) {
+	return sequenceKeylet(TicketSpace, address, ticketSequence)
+}
+
+// SignerList computes the index of the SignerList ledger entry of an account.
+func SignerList(address string) (string, error) {
+	// Accounts have a single signer list, with SignerListID 0.
+	return sequenceKeylet(SignerListSpace, address, 0)
+}
+
+// DepositPreauth computes the index of the DepositPreauth ledger entry by which owner
+// preauthorizes an account.
+func DepositPreauth(owner, authorized string) (string, error) {
+	return accountPairKeylet(DepositPreauthSpace, owner, authorized, "authorized")
+}
+
+// DepositPreauthCredentials computes the index of the DepositPreauth ledger entry by which owner
+// preauthorizes a set of credentials. The credentials can be given in any order.
+func DepositPreauthCredentials(owner string, credentials []AuthorizeCredential) (string, error) {
+	ownerID, err := decodeAccountID(owner, "owner")
+	if err != nil {
+		return "", err
+	}
+
+	hashes := make([][]byte, len(credentials))
+	for i, c := range credentials {
+		issuerID, err := decodeAccountID(c.Issuer, "issuer")
+		if err != nil {
+			return "", err
+		}
+		credentialType, err := hex.DecodeString(c.CredentialType)
+		if err != nil {
+			return "", fmt.Errorf("failed to decode credential type: %w", err)
+		}
+		hashes[i] = crypto.Sha512Half(append(append([]byte{}, issuerID...), credentialType...))
+	}
+	// rippled hashes the credentials in the order of their own hashes.
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i], hashes[j]) < 0
+	})
+
+	// rippled hashes the sorted std::vector of hashes with beast's hash_append, which appends
+	// the element count as a 64-bit integer after the elements.
+	parts := make([][]byte, 0, len(hashes)+2)
+	parts = append(parts, ownerID)
+	parts = append(parts, hashes...)
+	parts = append(parts, uint64Bytes(uint64(len(hashes))))
+	return indexHash(DepositPreauthCredentialsSpace, parts...), nil
+}
+
+// OwnerDirectory computes the index of the owner directory of an account, which is also the
+// index of its first page.
+func OwnerDirectory(address string) (string, error) {
+	return accountKeylet(OwnerDirectorySpace, address)
+}
+
+// DirectoryPage computes the index of a page of a directory from the index of its root. Page 0
+// is the root itself.
+func DirectoryPage(rootIndex string, page uint64) (string, error) {
+	root, err := decodeHash(rootIndex, "root index")
+	if err != nil {
+		return "", err
+	}
+	if page == 0 {
+		return hexutil.EncodeToUpperHex(root), nil
+	}
+	return indexHash(DirectoryNodeSpace, root, uint64Bytes(page)), nil
+}
+
+// BookDirectory computes the base index of the order book directories of offers taking
+// takerGets for takerPays. The directories of the book, one per exchange rate, are at the
+// indexes returned by Quality.
+func BookDirectory(takerPays, takerGets Issue) (string, error) {
+	return bookDirectory(takerPays, takerGets, nil)
+}
+
+// PermissionedBookDirectory computes the base index of the order book directories of a
+// permissioned DEX book, restricted to the permissioned domain domainID.
+func PermissionedBookDirectory(takerPays, takerGets Issue, domainID string) (string, error) {
+	domain, err := decodeHash(domainID, "domain ID")
+	if err != nil {
+		return "", err
+	}
+	return bookDirectory(takerPays, takerGets, domain)
+}
+
+func bookDirectory(takerPays, takerGets Issue, domain []byte) (string, error) {
+	paysCurrency, paysIssuer, err := issueBytes(takerPays)
+	if err != nil {
+		return "", err
+	}
+	getsCurrency, getsIssuer, err := issueBytes(takerGets)
+	if err != nil {
+		return "", err
+	}
+
+	parts := [][]byte{paysCurrency, getsCurrency, paysIssuer, getsIssuer}
+	if domain != nil {
+		parts = append(parts, domain)
+	}
+	return Quality(indexHash(BookDirectorySpace, parts...), 0)
+}
+
+// Quality computes the index of the directory of a book for an exchange rate, by replacing the
+// low 64 bits of the book base index with the rate, as stored in the ExchangeRate field of the
+// directory.
+func Quality(bookDirectory string, quality uint64) (string, error) {
+	base, err := decodeHash(bookDirectory, "book directory")
+	if err != nil {
+		return "", err
+	}
+	binary.BigEndian.PutUint64(base[24:], quality)
+	return hexutil.EncodeToUpperHex(base), nil
+}
+
+// NFTokenPageMin computes the lowest possible index of an NFTokenPage of an account. The
+// NFTokenPage entries of an account are ordered between NFTokenPageMin and NFTokenPageMax.
+func NFTokenPageMin(owner string) (string, error) {
+	return nftokenPage(owner, make([]byte, nftokenPageMaskLength))
+}
+
+// NFTokenPageMax computes the highest possible index of an NFTokenPage of an account, the index
+// of its last page.
+func NFTokenPageMax(owner string) (string, error) {
+	return nftokenPage(owner, bytes.Repeat([]byte{0xFF}, nftokenPageMaskLength))
+}
+
+// NFTokenPage computes the index an NFTokenPage holding the given token would have: the owner
+// account ID followed by the low 96 bits of the token ID. The page holding the token is the
+// first existing page at or after this index.
+func NFTokenPage(owner, nftokenID string) (string, error) {
+	tokenID, err := decodeHash(nftokenID, "NFTokenID")
+	if err != nil {
+		return "", err
+	}
+	return nftokenPage(owner, tokenID[32-nftokenPageMaskLength:])
+}
+
+func nftokenPage(owner string, low []byte) (string, error) {
+	ownerID, err := decodeAccountID(owner, "owner")
+	if err != nil {
+		return "", err
+	}
+	return hexutil.EncodeToUpperHex(append(ownerID, low...)), nil
+}
+
+// NFTokenOffer computes the index of the NFTokenOffer ledger entry created by an account with a
+// sequence number.
+func NFTokenOffer(owner string, sequence uint32) (string, error) {
+	return sequenceKeylet(NFTokenOfferSpace, owner, sequence)
+}
+
+// NFTokenBuyOffers computes the index of the directory of buy offers for a token.
+func NFTokenBuyOffers(nftokenID string) (string, error) {
+	tokenID, err := decodeHash(nftokenID, "NFTokenID")
+	if err != nil {
+		return "", err
+	}
+	return indexHash(NFTokenBuyOffersSpace, tokenID), nil
+}
+
+// NFTokenSellOffers computes the index of the directory of sell offers for a token.
+func NFTokenSellOffers(nftokenID string) (string, error) {
+	tokenID, err := decodeHash(nftokenID, "NFTokenID")
+	if err != nil {
+		return "", err
+	}
+	return indexHash(NFTokenSellOffersSpace, tokenID), nil
+}
+
+// AMM computes the index of the AMM ledger entry of the pool of two assets. The assets can be
+// given in either order.
+func AMM(asset1, asset2 Issue) (string, error) {
+	currency1, issuer1, err := issueBytes(asset1)
+	if err != nil {
+		return "", err
+	}
+	currency2, issuer2, err := issueBytes(asset2)
+	if err != nil {
+		return "", err
+	}
+
+	// rippled orders issues by currency, then by issuer.
+	c := bytes.Compare(currency1, currency2)
+	if c > 0 || (c == 0 && bytes.Compare(issuer1, issuer2) > 0) {
+		currency1, issuer1, currency2, issuer2 = currency2, issuer2, currency1, issuer1
+	}
+	return indexHash(AMMSpace, issuer1, currency1, issuer2, currency2), nil
+}
+
+// DID computes the index of the DID ledger entry of an account.
+func DID(address string) (string, error) {
+	return accountKeylet(DIDSpace, address)
+}
+
+// Oracle computes the index of the Oracle ledger entry of an account with a document ID.
+func Oracle(owner string, documentID uint32) (string, error) {
+	return sequenceKeylet(OracleSpace, owner, documentID)
+}
+
+// Credential computes the index of the Credential ledger entry issued by issuer to subject with
+// a hex encoded credential type.
+func Credential(subject, issuer, credentialType string) (string, error) {
+	subjectID, err := decodeAccountID(subject, "subject")
+	if err != nil {
+		return "", err
+	}
+	issuerID, err := decodeAccountID(issuer, "issuer")
+	if err != nil {
+		return "", err
+	}
+	credentialTypeBytes, err := hex.DecodeString(credentialType)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode credential type: %w", err)
+	}
+	return indexHash(CredentialSpace, subjectID, issuerID, credentialTypeBytes), nil
+}
+
+// Delegate computes the index of the Delegate ledger entry by which an account delegates
+// permissions to authorized.
+func Delegate(address, authorized string) (string, error) {
+	return accountPairKeylet(DelegateSpace, address, authorized, "authorized")
+}
+
+// PermissionedDomain computes the index of the PermissionedDomain ledger entry created by an
+// account with a sequence number.
+func PermissionedDomain(owner string, sequence uint32) (string, error) {
+	return sequenceKeylet(PermissionedDomainSpace, owner, sequence)
+}
+
+// BridgeEntry computes the index of the Bridge ledger entry of a bridge on one of its chains.
+func BridgeEntry(bridge Bridge, chain ChainType) (string, error) {
+	var (
+		door  string
+		issue Issue
+	)
+	switch chain {
+	case LockingChain:
+		door, issue = bridge.LockingChainDoor, bridge.LockingChainIssue
+	case IssuingChain:
+		door, issue = bridge.IssuingChainDoor, bridge.IssuingChainIssue
+	default:
+		return "", fmt.Errorf("%w: %d", ErrInvalidChainType, chain)
+	}
+
+	doorID, err := decodeAccountID(door, "door")
+	if err != nil {
+		return "", err
+	}
+	currency, _, err := issueBytes(issue)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(BridgeSpace, doorID, currency), nil
+}
+
+// XChainOwnedClaimID computes the index of the XChainOwnedClaimID ledger entry of a bridge with
+// a claim ID.
+func XChainOwnedClaimID(bridge Bridge, claimID uint64) (string, error) {
+	return bridgeSequenceKeylet(XChainClaimIDSpace, bridge, claimID)
+}
+
+// XChainOwnedCreateAccountClaimID computes the index of the XChainOwnedCreateAccountClaimID
+// ledger entry of a bridge with an account create count.
+func XChainOwnedCreateAccountClaimID(bridge Bridge, createCount uint64) (string, error) {
+	return bridgeSequenceKeylet(XChainCreateAccountClaimIDSpace, bridge, createCount)
+}
+
+func bridgeSequenceKeylet(space LedgerSpace, bridge Bridge, sequence uint64) (string, error) {
+	lockingDoor, err := decodeAccountID(bridge.LockingChainDoor, "locking chain door")
+	if err != nil {
+		return "", err
+	}
+	lockingCurrency, lockingIssuer, err := issueBytes(bridge.LockingChainIssue)
+	if err != nil {
+		return "", err
+	}
+	issuingDoor, err := decodeAccountID(bridge.IssuingChainDoor, "issuing chain door")
+	if err != nil {
+		return "", err
+	}
+	issuingCurrency, issuingIssuer, err := issueBytes(bridge.IssuingChainIssue)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(space, lockingDoor, lockingCurrency, lockingIssuer, issuingDoor, issuingCurrency, issuingIssuer, uint64Bytes(sequence)), nil
+}
+
+// Amendments computes the index of the Amendments singleton ledger entry.
+func Amendments() string {
+	return indexHash(AmendmentsSpace)
+}
+
+// FeeSettings computes the index of the FeeSettings singleton ledger entry.
+func FeeSettings() string {
+	return indexHash(FeeSettingsSpace)
+}
+
+// NegativeUNL computes the index of the NegativeUNL singleton ledger entry.
+func NegativeUNL() string {
+	return indexHash(NegativeUNLSpace)
+}
+
+// LedgerHashes computes the index of the LedgerHashes ledger entry holding the hashes of the
+// most recent 256 ledgers.
+func LedgerHashes() string {
+	return indexHash(SkipListSpace)
+}
+
+// LedgerHashesFor computes the index of the LedgerHashes ledger entry holding the hash of a
+// flag ledger, one every 256 ledgers, older than the most recent 256 ledgers. Each of these
+// entries holds the hashes of 65536 ledgers.
+func LedgerHashesFor(ledgerIndex uint32) string {
+	return indexHash(SkipListSpace, uint32Bytes(ledgerIndex>>16))
+}
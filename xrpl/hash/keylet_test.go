@@ -0,0 +1,432 @@
+package hash
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// testBridge is an XRP bridge between two test accounts.
+	testBridge = Bridge{
+		LockingChainDoor:  "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+		LockingChainIssue: Issue{Currency: "XRP"},
+		IssuingChainDoor:  "rU6K7V3Po4snVhBBaU29sesqs2qTQJWDw1",
+		IssuingChainIssue: Issue{Currency: "XRP"},
+	}
+	// xrplOrgBridge is the bridge of the xrpl.org cross-chain ledger entry examples.
+	xrplOrgBridge = Bridge{
+		LockingChainDoor:  "rMAXACCrp3Y8PpswXcg3bKggHX76V3F8M4",
+		LockingChainIssue: Issue{Currency: "XRP"},
+		IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		IssuingChainIssue: Issue{Currency: "XRP"},
+	}
+)
+
+// TestKeylets checks the keylets against indexes published by rippled ledgers, xrpl.js tests,
+// xrpl.org and the XLS specifications. Keylets without a published index are checked by
+// TestKeylets_Layout.
+func TestKeylets(t *testing.T) {
+	tests := []struct {
+		name      string
+		keylet    func() (string, error)
+		want      string
+		wantError bool
+	}{
+
+		{
+			// xrpl.js calcOfferEntryHash vector.
+			name:   "pass - Offer",
+			keylet: func() (string, error) { return Offer("r32UufnaCGL82HubijgJGDmdE5hac7ZvLw", 137) },
+			want:   "03F0AED09DEEE74CEF85CD57A0429D6113507CF759C597BABB4ADB752F734CE3",
+		},
+		{
+			// XLS-82 permissioned DEX Offer ledger_entry example.
+			name:   "pass - Offer XLS-82",
+			keylet: func() (string, error) { return Offer("rG1QQv2nh2gr7RCZ1P8YYcBUKCCN633jCn", 6) },
+			want:   "F4715C7C5957FE81246F259315494C671AF12C8B180BB32398DE5148B82ADD93",
+		},
+		{
+			// xrpl.js hashEscrow vector.
+			name:   "pass - Escrow",
+			keylet: func() (string, error) { return Escrow("rDx69ebzbowuqztksVDmZXjizTd12BVr4x", 84) },
+			want:   "61E8E8ED53FA2CEBE192B23897071E9A75217BF5A410E9CB5B45AAB7AECA567A",
+		},
+		{
+			// xrpl.org Check ledger entry example.
+			name:   "pass - Check",
+			keylet: func() (string, error) { return Check("rUn84CUYbNjRoTQ6mSW7BVJPSVJNLb1QLo", 2) },
+			want:   "49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0",
+		},
+		{
+			// xrpl.js hashPaymentChannel vector.
+			name: "pass - PaymentChannel",
+			keylet: func() (string, error) {
+				return PaymentChannel("rDx69ebzbowuqztksVDmZXjizTd12BVr4x", "rLFtVprxUEfsH54eCWKsZrEQzMDsx1wqso", 82)
+			},
+			want: "E35708503B3C3143FB522D749AAFCC296E8060F0FB371A9A56FAE0B1ED127366",
+		},
+		{
+			// xrpl.org SignerList ledger entry example.
+			name:   "pass - SignerList",
+			keylet: func() (string, error) { return SignerList("rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn") },
+			want:   "A9C28A28B85CD533217F5C0A0C7767666B093FA58A0F2D80026FCC4CD932DDC7",
+		},
+		{
+			// signerlistset-tx.json binary codec fixture.
+			name:   "pass - SignerList fixture",
+			keylet: func() (string, error) { return SignerList("rfCp6hiUS4qqN1i4hTyX4ogA49MEbXgCau") },
+			want:   "16F6AEEC6B85C9658B4BF604671677B7A7B2FAAFDB2FA5A0B72CBB49CAE80924",
+		},
+		{
+			// deposit-preauth-tx.json binary codec fixture.
+			name: "pass - DepositPreauth",
+			keylet: func() (string, error) {
+				return DepositPreauth("rDd6FpNbeY2CrQajSmP178BmNGusmQiYMM", "rDJFnv5sEfp42LMFiX3mVQKczpFTdxYDzM")
+			},
+			want: "C2D0317AD266B93CB3B36AEB0ABB673B0AFFAB134809CCACFD7158F539603C3A",
+		},
+		{
+			// XLS-40 DID ledger_entry example.
+			name:   "pass - DID",
+			keylet: func() (string, error) { return DID("rpfqJrXg5uidNo2ZsRhRY6TiF1cvYmV9Fg") },
+			want:   "46813BE38B798B3752CA590D44E7FEADB17485649074403AD1761A2835CE91FF",
+		},
+		{
+			// xrpl.org XChainOwnedClaimID ledger entry example.
+			name:   "pass - XChainOwnedClaimID",
+			keylet: func() (string, error) { return XChainOwnedClaimID(xrplOrgBridge, 0xb5) },
+			want:   "20B136D7BF6D2E3D610E28E3E6BE09F5C8F4F0241BBF6E2D072AE1BACB1388F5",
+		},
+		{
+			// xrpl.org XChainOwnedCreateAccountClaimID ledger entry example.
+			name:   "pass - XChainOwnedCreateAccountClaimID",
+			keylet: func() (string, error) { return XChainOwnedCreateAccountClaimID(xrplOrgBridge, 0x66) },
+			want:   "5A92F6ED33FDA68FB4B9FD140EA38C056CD2BA9673ECA5B4CEF40F2166BB6F0C",
+		},
+
+		{
+			name:   "pass - Amendments",
+			keylet: func() (string, error) { return Amendments(), nil },
+			want:   "7DB0788C020F02780A673DC74757F23823FA3014C1866E72CC4CD8B226CD6EF4",
+		},
+		{
+			name:   "pass - FeeSettings",
+			keylet: func() (string, error) { return FeeSettings(), nil },
+			want:   "4BC50C9B0D8515D3EAAE1E74B29A95804346C491EE1A95BF25E4AAB854A6A651",
+		},
+		{
+			name:   "pass - NegativeUNL",
+			keylet: func() (string, error) { return NegativeUNL(), nil },
+			want:   "2E8A59AA9D3B5B186B0B9E0F62E6C02587CA74A4D778938E957B6357D364B244",
+		},
+
+		{
+			name:      "fail - invalid address",
+			keylet:    func() (string, error) { return AccountRoot("invalid") },
+			wantError: true,
+		},
+		{
+			name: "fail - invalid currency",
+			keylet: func() (string, error) {
+				return RippleState("r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", "rB5TihdPbKgMrkFqrqUC3yLdE8hhv4BdeY", "USDC")
+			},
+			wantError: true,
+		},
+		{
+			name:      "fail - invalid root index",
+			keylet:    func() (string, error) { return DirectoryPage("ABCD", 1) },
+			wantError: true,
+		},
+		{
+			name:      "fail - invalid chain type",
+			keylet:    func() (string, error) { return BridgeEntry(Bridge{}, ChainType(2)) },
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.keylet()
+			if tt.wantError {
+				require.Error(t, err)
+				require.Empty(t, got)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNFTokenPage(t *testing.T) {
+	owner := "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD"
+	_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(owner)
+	require.NoError(t, err)
+	prefix := strings.ToUpper(hex.EncodeToString(accountID))
+
+	pageMin, err := NFTokenPageMin(owner)
+	require.NoError(t, err)
+	require.Equal(t, prefix+strings.Repeat("00", 12), pageMin)
+
+	pageMax, err := NFTokenPageMax(owner)
+	require.NoError(t, err)
+	require.Equal(t, prefix+strings.Repeat("FF", 12), pageMax)
+
+	page, err := NFTokenPage(owner, "000B013A95F14B0044F78A264E41713C64B5F89242540EE208C3098E00000D65")
+	require.NoError(t, err)
+	require.Equal(t, prefix+"42540EE208C3098E00000D65", page)
+
+	_, err = NFTokenPage(owner, "000B")
+	require.ErrorIs(t, err, ErrInvalidHash)
+}
+
+// TestKeylets_Layout checks the keylets that have no published index against the key layout of
+// the matching keylet functions of rippled's Indexes.cpp, assembled byte by byte: the two byte
+// ledger namespace followed by the fields in the order rippled hashes them.
+func TestKeylets_Layout(t *testing.T) {
+	account := func(address string) []byte {
+		t.Helper()
+		_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(address)
+		require.NoError(t, err)
+		return accountID
+	}
+	be32 := func(v uint32) []byte { return binary.BigEndian.AppendUint32(nil, v) }
+	be64 := func(v uint64) []byte { return binary.BigEndian.AppendUint64(nil, v) }
+	currency := func(code string) []byte {
+		c := make([]byte, 20)
+		copy(c[12:], code)
+		return c
+	}
+	index := func(space byte, parts ...[]byte) string {
+		payload := []byte{0, space}
+		for _, p := range parts {
+			payload = append(payload, p...)
+		}
+		return strings.ToUpper(hex.EncodeToString(crypto.Sha512Half(payload)))
+	}
+
+	owner, other := "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD", "rU6K7V3Po4snVhBBaU29sesqs2qTQJWDw1"
+	usdIssuer := "rhxbkK9jGqPVLZSWPvCEmmf15xHBfJfCEy"
+	xrp := make([]byte, 20)
+
+	credentialHashes := [][]byte{
+		crypto.Sha512Half(append(account(owner), 0x01)),
+		crypto.Sha512Half(append(account(other), 0x02)),
+	}
+	sort.Slice(credentialHashes, func(i, j int) bool {
+		return bytes.Compare(credentialHashes[i], credentialHashes[j]) < 0
+	})
+
+	tests := []struct {
+		name   string
+		keylet func() (string, error)
+		want   string
+	}{
+		{
+			name:   "pass - Ticket",
+			keylet: func() (string, error) { return Ticket(owner, 3) },
+			want:   index('T', account(owner), be32(3)),
+		},
+		{
+			// The sorted credential hashes are followed by their count, as beast::hash_append
+			// appends the size of a std::vector.
+			name: "pass - DepositPreauth credentials",
+			keylet: func() (string, error) {
+				return DepositPreauthCredentials(owner, []AuthorizeCredential{
+					{Issuer: owner, CredentialType: "01"},
+					{Issuer: other, CredentialType: "02"},
+				})
+			},
+			want: index('P', account(owner), credentialHashes[0], credentialHashes[1], be64(2)),
+		},
+		{
+			// The issues are ordered by currency, and each is hashed as issuer then currency.
+			name: "pass - AMM",
+			keylet: func() (string, error) {
+				return AMM(Issue{Currency: "USD", Issuer: usdIssuer}, Issue{Currency: "XRP"})
+			},
+			want: index('A', xrp, xrp, account(usdIssuer), currency("USD")),
+		},
+		{
+			name:   "pass - Oracle",
+			keylet: func() (string, error) { return Oracle(owner, 34) },
+			want:   index('R', account(owner), be32(34)),
+		},
+		{
+			name: "pass - Credential",
+			keylet: func() (string, error) {
+				return Credential(owner, other, "6D795F63726564656E7469616C")
+			},
+			want: index('D', account(owner), account(other), []byte("my_credential")),
+		},
+		{
+			name:   "pass - Delegate",
+			keylet: func() (string, error) { return Delegate(owner, other) },
+			want:   index('E', account(owner), account(other)),
+		},
+		{
+			name:   "pass - PermissionedDomain",
+			keylet: func() (string, error) { return PermissionedDomain(owner, 390) },
+			want:   index('m', account(owner), be32(390)),
+		},
+		{
+			name:   "pass - Bridge locking chain",
+			keylet: func() (string, error) { return BridgeEntry(testBridge, LockingChain) },
+			want:   index('H', account(owner), xrp),
+		},
+		{
+			name:   "pass - Bridge issuing chain",
+			keylet: func() (string, error) { return BridgeEntry(testBridge, IssuingChain) },
+			want:   index('H', account(other), xrp),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.keylet()
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKeylets_Symmetric(t *testing.T) {
+	usd := Issue{Currency: "USD", Issuer: "rhxbkK9jGqPVLZSWPvCEmmf15xHBfJfCEy"}
+	a, err := AMM(Issue{Currency: "XRP"}, usd)
+	require.NoError(t, err)
+	b, err := AMM(usd, Issue{})
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+
+	issuer1, issuer2 := "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD", "rU6K7V3Po4snVhBBaU29sesqs2qTQJWDw1"
+	a, err = DepositPreauthCredentials(issuer1, []AuthorizeCredential{
+		{Issuer: issuer1, CredentialType: "01"},
+		{Issuer: issuer2, CredentialType: "02"},
+	})
+	require.NoError(t, err)
+	b, err = DepositPreauthCredentials(issuer1, []AuthorizeCredential{
+		{Issuer: issuer2, CredentialType: "02"},
+		{Issuer: issuer1, CredentialType: "01"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+
+	a, err = RippleState("rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD", "rU6K7V3Po4snVhBBaU29sesqs2qTQJWDw1", "USD")
+	require.NoError(t, err)
+	b, err = RippleState("rU6K7V3Po4snVhBBaU29sesqs2qTQJWDw1", "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD", "USD")
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+
+	// The Bridge entry is keyed by the door and currency only, so the issuer does not change it.
+	iou := testBridge
+	iou.LockingChainIssue = Issue{Currency: "USD", Issuer: issuer2}
+	a, err = BridgeEntry(iou, LockingChain)
+	require.NoError(t, err)
+	iou.LockingChainIssue.Issuer = issuer1
+	b, err = BridgeEntry(iou, LockingChain)
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+}
+
+// TestKeylets_Ledger checks the keylets against the index of every entry of a ledger produced by
+// rippled.
+func TestKeylets_Ledger(t *testing.T) {
+	raw, err := os.ReadFile("../shamap/testdata/ledger-full-38129.json")
+	require.NoError(t, err)
+	var ledger struct {
+		LedgerIndex  string           `json:"ledger_index"`
+		AccountState []map[string]any `json:"accountState"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &ledger))
+	ledgerIndex, err := strconv.ParseUint(ledger.LedgerIndex, 10, 32)
+	require.NoError(t, err)
+
+	str := func(e map[string]any, field string) string {
+		s, _ := e[field].(string)
+		return s
+	}
+	issuer := func(accountID string) string {
+		if accountID == "0000000000000000000000000000000000000000" {
+			return ""
+		}
+		b, err := hex.DecodeString(accountID)
+		require.NoError(t, err)
+		address, err := addresscodec.EncodeAccountIDToClassicAddress(b)
+		require.NoError(t, err)
+		return address
+	}
+
+	// Directory pages are checked once every root index is known.
+	roots := map[string]bool{}
+	var pages []map[string]any
+
+	for _, e := range ledger.AccountState {
+		index := str(e, "index")
+		var (
+			got string
+			err error
+		)
+		switch str(e, "LedgerEntryType") {
+		case "AccountRoot":
+			got, err = AccountRoot(str(e, "Account"))
+		case "RippleState":
+			low, _ := e["LowLimit"].(map[string]any)
+			high, _ := e["HighLimit"].(map[string]any)
+			got, err = RippleState(str(high, "issuer"), str(low, "issuer"), str(low, "currency"))
+		case "Offer":
+			got, err = Offer(str(e, "Account"), uint32(e["Sequence"].(float64)))
+		case "LedgerHashes":
+			if uint32(e["LastLedgerSequence"].(float64)) == uint32(ledgerIndex)-1 {
+				got = LedgerHashes()
+			} else {
+				got = LedgerHashesFor(uint32(e["LastLedgerSequence"].(float64)))
+			}
+		case "DirectoryNode":
+			roots[str(e, "RootIndex")] = true
+			if index != str(e, "RootIndex") {
+				pages = append(pages, e)
+				continue
+			}
+			if owner := str(e, "Owner"); owner != "" {
+				got, err = OwnerDirectory(owner)
+				break
+			}
+			var base string
+			base, err = BookDirectory(
+				Issue{Currency: str(e, "TakerPaysCurrency"), Issuer: issuer(str(e, "TakerPaysIssuer"))},
+				Issue{Currency: str(e, "TakerGetsCurrency"), Issuer: issuer(str(e, "TakerGetsIssuer"))},
+			)
+			require.NoError(t, err)
+			rate, perr := strconv.ParseUint(str(e, "ExchangeRate"), 16, 64)
+			require.NoError(t, perr)
+			got, err = Quality(base, rate)
+		default:
+			t.Fatalf("unexpected ledger entry type %q", str(e, "LedgerEntryType"))
+		}
+		require.NoError(t, err)
+		require.Equal(t, index, got, "%s %s", str(e, "LedgerEntryType"), index)
+	}
+
+	for _, e := range pages {
+		root := str(e, "RootIndex")
+		require.True(t, roots[root])
+		found := false
+		for page := uint64(1); page < 64 && !found; page++ {
+			got, err := DirectoryPage(root, page)
+			require.NoError(t, err)
+			found = got == str(e, "index")
+		}
+		require.True(t, found, "directory page %s", str(e, "index"))
+	}
+}
@@ -12,7 +12,7 @@ import (
 )
 
 // Vault computes the hash of a Vault ledger entry.
-// The hash is computed as SHA-512Half(ledgerSpaceHex('vault') + addressToHex(address) + sequence as 8-char hex).
+// The hash is computed as SHA-512Half(VaultSpace + addressToHex(address) + sequence as 8-char hex).
 //
 // address is the account of the Vault Owner (Account submitting VaultCreate transaction).
 // sequence is the sequence number of the Transaction that created the Vault object.
@@ -23,21 +23,11 @@ func Vault(address string, sequence uint32) (string, error) {
 		return "", fmt.Errorf("failed to decode address: %w", err)
 	}
 
-	ledgerSpaceHex := "0056"
-	addressHex := hex.EncodeToString(accountID)
-	sequenceHex := fmt.Sprintf("%08x", sequence)
-
-	payload := ledgerSpaceHex + addressHex + sequenceHex
-	payloadBytes, err := hex.DecodeString(payload)
-	if err != nil {
-		return "", fmt.Errorf("failed to decode hex payload: %w", err)
-	}
-
-	return EncodeToHashString(payloadBytes), nil
+	return indexHash(VaultSpace, accountID, uint32Bytes(sequence)), nil
 }
 
 // LoanBroker computes the hash of a LoanBroker ledger entry.
-// The hash is computed as SHA-512Half(ledgerSpaceHex('loanBroker') + addressToHex(address) + sequence as 8-char hex).
+// The hash is computed as SHA-512Half(LoanBrokerSpace + addressToHex(address) + sequence as 8-char hex).
 //
 // address is the account of the Lender (Account submitting LoanBrokerSet transaction, i.e. Lender).
 // sequence is the sequence number of the Transaction that created the LoanBroker object.
@@ -48,36 +38,22 @@ func LoanBroker(address string, sequence uint32) (string, error) {
 		return "", fmt.Errorf("failed to decode address: %w", err)
 	}
 
-	ledgerSpaceHex := "006C"
-	addressHex := hex.EncodeToString(accountID)
-	sequenceHex := fmt.Sprintf("%08x", sequence)
-
-	payload := ledgerSpaceHex + addressHex + sequenceHex
-	payloadBytes, err := hex.DecodeString(payload)
-	if err != nil {
-		return "", fmt.Errorf("failed to decode hex payload: %w", err)
-	}
-
-	return EncodeToHashString(payloadBytes), nil
+	return indexHash(LoanBrokerSpace, accountID, uint32Bytes(sequence)), nil
 }
 
 // Loan computes the hash of a Loan ledger entry.
-// The hash is computed as SHA-512Half(ledgerSpaceHex('loan') + loanBrokerID + loanSequence as 8-char hex).
+// The hash is computed as SHA-512Half(LoanSpace + loanBrokerID + loanSequence as 8-char hex).
 //
 // loanBrokerID is the LoanBrokerID of the associated LoanBroker object.
 // loanSequence is the sequence number of the Loan.
 // Returns the computed hash of the Loan object.
 func Loan(loanBrokerID string, loanSequence uint32) (string, error) {
-	ledgerSpaceHex := "004C"
-	sequenceHex := fmt.Sprintf("%08x", loanSequence)
-
-	payload := ledgerSpaceHex + loanBrokerID + sequenceHex
-	payloadBytes, err := hex.DecodeString(payload)
+	loanBrokerIDBytes, err := hex.DecodeString(loanBrokerID)
 	if err != nil {
 		return "", fmt.Errorf("failed to decode hex payload: %w", err)
 	}
 
-	return EncodeToHashString(payloadBytes), nil
+	return indexHash(LoanSpace, loanBrokerIDBytes, uint32Bytes(loanSequence)), nil
 }
 
 // EncodeToHashString computes SHA-512Half of the given bytes and returns it as an uppercase hex string.
@@ -86,7 +62,7 @@ func EncodeToHashString(bytes []byte) string {
 }
 
 // PaymentChannel computes the hash (channel ID) of a PaymentChannel ledger entry.
-// The hash is computed as SHA-512Half(0x0078 + sourceAccountID + destAccountID + sequence as 8-char hex).
+// The hash is computed as SHA-512Half(PaymentChannelSpace + sourceAccountID + destAccountID + sequence as 8-char hex).
 //
 // source is the source address of the payment channel.
 // destination is the destination address of the payment channel.
@@ -103,18 +79,7 @@ func PaymentChannel(source, destination string, sequence uint32) (string, error)
 		return "", fmt.Errorf("failed to decode destination classic address: %w", err)
 	}
 
-	ledgerSpaceHex := "0078"
-	sourceHex := hex.EncodeToString(sourceID)
-	destHex := hex.EncodeToString(destID)
-	sequenceHex := fmt.Sprintf("%08x", sequence)
-
-	payload := ledgerSpaceHex + sourceHex + destHex + sequenceHex
-	payloadBytes, err := hex.DecodeString(payload)
-	if err != nil {
-		return "", fmt.Errorf("failed to decode hex payload: %w", err)
-	}
-
-	return EncodeToHashString(payloadBytes), nil
+	return indexHash(PaymentChannelSpace, sourceID, destID, uint32Bytes(sequence)), nil
 }
 
 // MPTID computes the unique identifier for a Multi-Purpose Token (MPT).
@@ -134,3 +99,42 @@ func MPTID(sequence uint32, issuer string) (string, error) {
 	binary.BigEndian.PutUint32(seqBytes, sequence)
 	return strings.ToUpper(hex.EncodeToString(seqBytes) + hex.EncodeToString(accountID)), nil
 }
+
+// MPTokenIssuance computes the hash of an MPTokenIssuance ledger entry.
+// The hash is computed as SHA-512Half(MPTokenIssuanceSpace + mptIssuanceID).
+//
+// mptIssuanceID is the 24-byte MPTokenIssuanceID, as returned by MPTID.
+// Returns the computed hash of the MPTokenIssuance object.
+func MPTokenIssuance(mptIssuanceID string) (string, error) {
+	issuanceID, err := hex.DecodeString(mptIssuanceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode hex payload: %w", err)
+	}
+
+	return indexHash(MPTokenIssuanceSpace, issuanceID), nil
+}
+
+// MPToken computes the hash of an MPToken ledger entry.
+// The hash is computed as SHA-512Half(MPTokenSpace + MPTokenIssuance hash + holderAccountID).
+//
+// mptIssuanceID is the 24-byte MPTokenIssuanceID of the token.
+// holder is the address of the account holding the token.
+// Returns the computed hash of the MPToken object.
+func MPToken(mptIssuanceID, holder string) (string, error) {
+	_, holderID, err := addresscodec.DecodeClassicAddressToAccountID(holder)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode holder classic address: %w", err)
+	}
+
+	issuanceHash, err := MPTokenIssuance(mptIssuanceID)
+	if err != nil {
+		return "", err
+	}
+
+	issuanceHashBytes, err := hex.DecodeString(issuanceHash)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode hex payload: %w", err)
+	}
+
+	return indexHash(MPTokenSpace, issuanceHashBytes, holderID), nil
+}
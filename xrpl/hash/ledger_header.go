@@ -0,0 +1,19 @@
+package hash
+
+import (
+	"encoding/binary"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
+
+// Ledger computes the hash of a ledger header.
+// The hash is computed as SHA-512Half(LedgerPrefix + the canonical binary encoding of the header).
+func Ledger(header binarycodec.LedgerData) (string, error) {
+	payload := binary.BigEndian.AppendUint32(make([]byte, 0, 4+118), LedgerPrefix)
+	payload, err := binarycodec.AppendLedgerData(payload, header)
+	if err != nil {
+		return "", err
+	}
+
+	return EncodeToHashString(payload), nil
+}
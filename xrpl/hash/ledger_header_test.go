@@ -0,0 +1,67 @@
+package hash
+
+import (
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedger(t *testing.T) {
+	testcases := []struct {
+		name        string
+		header      binarycodec.LedgerData
+		expected    string
+		expectedErr error
+	}{
+		{
+			name: "pass - ledger 38129",
+			header: binarycodec.LedgerData{
+				LedgerIndex:         38129,
+				TotalCoins:          "99999999999996310",
+				ParentHash:          "3401E5B2E5D3A53EB0891088A5F2D9364BBB6CE5B37A337D2C0660DAF9C4175E",
+				TransactionHash:     "DB83BF807416C5B3499A73130F843CF615AB8E797D79FE7D330ADF1BFA93951A",
+				AccountHash:         "2C23D15B6B549123FB351E4B5CDE81C564318EB845449CD43C3EA7953C4DB452",
+				ParentCloseTime:     410424200,
+				CloseTime:           410424200,
+				CloseTimeResolution: 10,
+			},
+			expected: "E6DB7365949BF9814D76BCC730B01818EB9136A89DB224F3F9F5AAE4569D758E",
+		},
+		{
+			name: "pass - ledger 40000",
+			header: binarycodec.LedgerData{
+				LedgerIndex:         40000,
+				TotalCoins:          "99999999999996310",
+				ParentHash:          "CDFD329A6E418591770695D0FB859113641AC20CB3A1F39AB3D721CEA2685EFE",
+				TransactionHash:     "0000000000000000000000000000000000000000000000000000000000000000",
+				AccountHash:         "1B536BFBDFC92B9550F2F63D32F7269D451885FFB2CAB374332EBC2D663320E0",
+				ParentCloseTime:     410459110,
+				CloseTime:           410459130,
+				CloseTimeResolution: 10,
+			},
+			expected: "16BB8E41DD96D643BC72E1981865C5D76B990464E2EA151FEAC16CDF1AE29388",
+		},
+		{
+			name: "fail - invalid parent hash",
+			header: binarycodec.LedgerData{
+				LedgerIndex: 40000,
+				TotalCoins:  "99999999999996310",
+				ParentHash:  "CDFD",
+			},
+			expectedErr: binarycodec.ErrInvalidLedgerDataHash,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			hash, err := Ledger(tc.header)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, hash)
+		})
+	}
+}
@@ -95,3 +95,72 @@ func TestLoan(t *testing.T) {
 		})
 	}
 }
+
+func TestMPTokenIssuance(t *testing.T) {
+	tests := []struct {
+		name          string
+		mptIssuanceID string
+		want          string
+		wantError     bool
+	}{
+		{
+			name:          "calcMPTokenIssuanceEntryHash",
+			mptIssuanceID: "000000018A5182C75B3FB0B57A011EF619BD7B0307398CC1",
+			want:          "F352B472FE6019E126F237A2A7AA0605145D8824F0BC026F3A225D44EE6AE1E6",
+		},
+		{
+			name:          "invalid issuance ID",
+			mptIssuanceID: "not-hex",
+			wantError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MPTokenIssuance(tt.mptIssuanceID)
+			if tt.wantError {
+				require.Error(t, err)
+				require.Empty(t, got)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMPToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		mptIssuanceID string
+		holder        string
+		want          string
+		wantError     bool
+	}{
+		{
+			name:          "calcMPTokenEntryHash",
+			mptIssuanceID: "000000018A5182C75B3FB0B57A011EF619BD7B0307398CC1",
+			holder:        "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+			want:          "0138CC42D68A9A0D7316EC3DE0D2D81F05BF9C037F6FCB534CE727C2F619CEB4",
+		},
+		{
+			name:          "invalid holder",
+			mptIssuanceID: "000000018A5182C75B3FB0B57A011EF619BD7B0307398CC1",
+			holder:        "invalid",
+			wantError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MPToken(tt.mptIssuanceID, tt.holder)
+			if tt.wantError {
+				require.Error(t, err)
+				require.Empty(t, got)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -12,7 +12,13 @@ import (
 // It takes a transaction blob and returns the hash of the signed transaction.
 // It returns an error if the transaction blob is invalid.
 func SignTxBlob(txBlob string) (string, error) {
-	tx, err := binarycodec.Decode(txBlob)
+	return SignTxBlobWithCodec(txBlob, binarycodec.DefaultCodec())
+}
+
+// SignTxBlobWithCodec hashes a signed transaction blob, decoding it with the given codec.
+// It returns an error if the transaction blob is invalid.
+func SignTxBlobWithCodec(txBlob string, codec *binarycodec.Codec) (string, error) {
+	tx, err := codec.Decode(txBlob)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,71 @@
+package autofill
+
+import (
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// SetValidAddresses converts the X-addresses of a transaction to classic addresses. The tags of
+// the Account and Destination X-addresses are moved to SourceTag and DestinationTag.
+func SetValidAddresses(tx *transaction.FlatTransaction) error {
+	if err := ValidateAddress(tx, "Account", "SourceTag"); err != nil {
+		return err
+	}
+
+	if _, ok := (*tx)["Destination"]; ok {
+		if err := ValidateAddress(tx, "Destination", "DestinationTag"); err != nil {
+			return err
+		}
+	}
+
+	// DepositPreauth
+	ConvertAddress(tx, "Authorize")
+	ConvertAddress(tx, "Unauthorize")
+	// EscrowCancel, EscrowFinish
+	ConvertAddress(tx, "Owner")
+	// SetRegularKey
+	ConvertAddress(tx, "RegularKey")
+
+	return nil
+}
+
+// ClassicAccountAndTag returns the classic address and tag of an X-address. Other addresses are
+// returned as is, with a zero tag.
+func ClassicAccountAndTag(address string) (string, uint32) {
+	if !addresscodec.IsValidXAddress(address) {
+		return address, 0
+	}
+	classicAddress, tag, _, _, err := addresscodec.XAddressToClassicAddress(address)
+	if err != nil {
+		return address, 0
+	}
+	return classicAddress, tag
+}
+
+// ConvertAddress converts the X-address of a field to a classic address, dropping its tag.
+func ConvertAddress(tx *transaction.FlatTransaction, fieldName string) {
+	if address, ok := (*tx)[fieldName].(string); ok {
+		classicAddress, _ := ClassicAccountAndTag(address)
+		(*tx)[fieldName] = classicAddress
+	}
+}
+
+// ValidateAddress converts the X-address of a field to a classic address and sets its tag in
+// the tag field, which must match the tag if it is already set.
+func ValidateAddress(tx *transaction.FlatTransaction, addressField, tagField string) error {
+	address, _ := (*tx)[addressField].(string)
+	classicAddress, tag := ClassicAccountAndTag(address)
+	(*tx)[addressField] = classicAddress
+
+	if tag != uint32(0) {
+		if txTag, ok := (*tx)[tagField].(uint32); ok && txTag != tag {
+			return ErrMismatchedTag{
+				Expected: addressField,
+				Actual:   tagField,
+			}
+		}
+		(*tx)[tagField] = tag
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package autofill
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetValidAddresses(t *testing.T) {
+	testcases := []struct {
+		name        string
+		tx          transaction.FlatTransaction
+		expected    transaction.FlatTransaction
+		expectedErr error
+	}{
+		{
+			name: "pass - classic addresses",
+			tx: transaction.FlatTransaction{
+				"Account":     "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			},
+			expected: transaction.FlatTransaction{
+				"Account":     "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+				"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			},
+		},
+		{
+			name: "pass - X-addresses with tags",
+			tx: transaction.FlatTransaction{
+				"Account":     "XVDRzcTbNA6v2WnbxjKp1CHmyyYrKJh23t4buUdRme6q4XY",
+				"Destination": "XV5kHfQmzDQjbFNv4jX3FX9Y7ig5QhfHfuo7rTzSFVRy6hS",
+			},
+			expected: transaction.FlatTransaction{
+				"Account":        "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+				"SourceTag":      uint32(12345),
+				"Destination":    "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"DestinationTag": uint32(7),
+			},
+		},
+		{
+			name: "pass - matching tag and other address fields",
+			tx: transaction.FlatTransaction{
+				"Account":    "XVDRzcTbNA6v2WnbxjKp1CHmyyYrKJh23t4buUdRme6q4XY",
+				"SourceTag":  uint32(12345),
+				"RegularKey": "XV5kHfQmzDQjbFNv4jX3FX9Y7ig5QhfHfuo7rTzSFVRy6hS",
+			},
+			expected: transaction.FlatTransaction{
+				"Account":    "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+				"SourceTag":  uint32(12345),
+				"RegularKey": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			},
+		},
+		{
+			name: "fail - mismatched destination tag",
+			tx: transaction.FlatTransaction{
+				"Account":        "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+				"Destination":    "XV5kHfQmzDQjbFNv4jX3FX9Y7ig5QhfHfuo7rTzSFVRy6hS",
+				"DestinationTag": uint32(8),
+			},
+			expectedErr: ErrMismatchedTag{Expected: "Destination", Actual: "DestinationTag"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := SetValidAddresses(&tc.tx)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tc.tx)
+		})
+	}
+}
+
+func TestSequence(t *testing.T) {
+	errAccountInfo := errors.New("account info")
+	accountSequence := func(account string) (uint32, error) {
+		if account != "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH" {
+			return 0, errAccountInfo
+		}
+		return 10, nil
+	}
+
+	tx := transaction.FlatTransaction{"Account": "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"}
+	require.NoError(t, Sequence(&tx, accountSequence))
+	require.Equal(t, uint32(10), tx["Sequence"])
+
+	tx = transaction.FlatTransaction{"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "TicketSequence": uint32(7)}
+	require.NoError(t, Sequence(&tx, accountSequence))
+	require.Equal(t, uint32(0), tx["Sequence"])
+
+	tx = transaction.FlatTransaction{"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"}
+	require.ErrorIs(t, Sequence(&tx, accountSequence), errAccountInfo)
+}
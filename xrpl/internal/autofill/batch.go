@@ -0,0 +1,133 @@
+package autofill
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// BatchSource provides the network values the inner transactions of a Batch depend on.
+type BatchSource struct {
+	// NetworkID is the network ID the inner transactions must match.
+	NetworkID uint32
+	// NeedsNetworkID reports whether the inner transactions require a NetworkID.
+	NeedsNetworkID func() (bool, error)
+	// AccountSequence returns the current sequence of the account.
+	AccountSequence func(account string) (uint32, error)
+}
+
+type validatedInnerTx struct {
+	rawTx   map[string]any
+	account string
+}
+
+// RawTransactions validates and fills in the missing fields of the inner transactions of a Batch.
+// Inner transactions get a zero Fee, an empty SigningPubKey, the NetworkID when required and the
+// next sequence of their account. Inner transactions of the outer account start after the outer
+// transaction sequence.
+func RawTransactions(tx *transaction.FlatTransaction, src BatchSource) error {
+	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
+	if !ok {
+		return ErrRawTransactionsFieldIsNotAnArray
+	}
+
+	var outerNetworkID *uint32
+	if outer := (*tx)["NetworkID"]; outer != nil {
+		outerNetworkIDUint, ok := outer.(uint32)
+		if !ok {
+			return ErrNetworkIDFieldIsNotAUint32
+		}
+		if outerNetworkIDUint != src.NetworkID {
+			return ErrNetworkIDFieldMismatch
+		}
+		outerNetworkID = &outerNetworkIDUint
+	}
+
+	inners := make([]validatedInnerTx, 0, len(rawTxs))
+	for _, rawTx := range rawTxs {
+		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
+		if !ok {
+			return ErrRawTransactionFieldIsNotAnObject
+		}
+
+		acc, ok := innerRawTx["Account"].(string)
+		if !ok {
+			return ErrAccountFieldIsNotAString
+		}
+
+		if fee := innerRawTx["Fee"]; fee != nil && fee != "0" {
+			return types.ErrBatchInnerTransactionInvalid
+		}
+
+		if signingPubKey := innerRawTx["SigningPubKey"]; signingPubKey != nil && signingPubKey != "" {
+			return ErrSigningPubKeyFieldMustBeEmpty
+		}
+
+		if innerRawTx["TxnSignature"] != nil {
+			return ErrTxnSignatureFieldMustBeEmpty
+		}
+		if innerRawTx["Signers"] != nil {
+			return ErrSignersFieldMustBeEmpty
+		}
+
+		if networkID := innerRawTx["NetworkID"]; networkID != nil {
+			innerNetworkID, ok := networkID.(uint32)
+			if !ok {
+				return ErrNetworkIDFieldIsNotAUint32
+			}
+			if innerNetworkID != src.NetworkID {
+				return ErrNetworkIDFieldMismatch
+			}
+			if outerNetworkID != nil && innerNetworkID != *outerNetworkID {
+				return ErrNetworkIDFieldMismatch
+			}
+		}
+
+		inners = append(inners, validatedInnerTx{rawTx: innerRawTx, account: acc})
+	}
+
+	needsNetworkID, err := src.NeedsNetworkID()
+	if err != nil {
+		return err
+	}
+
+	accountSeq := make(map[string]uint32, len(inners))
+
+	for _, inner := range inners {
+		innerRawTx := inner.rawTx
+		if innerRawTx["Fee"] == nil {
+			innerRawTx["Fee"] = "0"
+		}
+
+		if innerRawTx["SigningPubKey"] == nil {
+			innerRawTx["SigningPubKey"] = ""
+		}
+
+		if innerRawTx["NetworkID"] == nil && needsNetworkID {
+			innerRawTx["NetworkID"] = src.NetworkID
+		}
+
+		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
+			acc := inner.account
+
+			if accountSeq[acc] != 0 {
+				innerRawTx["Sequence"] = accountSeq[acc]
+				accountSeq[acc]++
+			} else {
+				accountSequence, err := src.AccountSequence(acc)
+				if err != nil {
+					return err
+				}
+				var seq uint32
+				if innerRawTx["Account"] == (*tx)["Account"] {
+					seq = accountSequence + 1
+				} else {
+					seq = accountSequence
+				}
+				accountSeq[acc] = seq + 1
+				innerRawTx["Sequence"] = seq
+			}
+		}
+	}
+
+	return nil
+}
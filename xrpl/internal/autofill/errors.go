@@ -0,0 +1,60 @@
+package autofill
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// fee
+
+	// ErrInvalidFulfillmentLength is returned when the fulfillment length is invalid.
+	ErrInvalidFulfillmentLength = errors.New("invalid fulfillment length")
+	// ErrRawTransactionsFieldMissing is returned when the RawTransactions field is missing from a Batch transaction.
+	ErrRawTransactionsFieldMissing = errors.New("RawTransactions field missing from Batch transaction")
+	// ErrRawTransactionFieldMissing is returned when the RawTransaction field is missing from a wrapper.
+	ErrRawTransactionFieldMissing = errors.New("RawTransaction field missing from wrapper")
+	// ErrFeeFieldMissing is returned when the fee field is missing after calculation.
+	ErrFeeFieldMissing = errors.New("fee field missing after calculation")
+
+	// batch
+
+	// ErrRawTransactionsFieldIsNotAnArray is returned when the RawTransactions field is not an array type.
+	ErrRawTransactionsFieldIsNotAnArray = errors.New("field RawTransactions must be an array")
+	// ErrRawTransactionFieldIsNotAnObject is returned when the RawTransaction field is not an object type.
+	ErrRawTransactionFieldIsNotAnObject = errors.New("field RawTransaction must be an object")
+	// ErrSigningPubKeyFieldMustBeEmpty is returned when the SigningPubKey field should be empty but isn't.
+	ErrSigningPubKeyFieldMustBeEmpty = errors.New("field SigningPubKey must be empty")
+	// ErrTxnSignatureFieldMustBeEmpty is returned when the TxnSignature field should be empty but isn't.
+	ErrTxnSignatureFieldMustBeEmpty = errors.New("field TxnSignature must be empty")
+	// ErrSignersFieldMustBeEmpty is returned when the Signers field should be empty but isn't.
+	ErrSignersFieldMustBeEmpty = errors.New("field Signers must be empty")
+	// ErrAccountFieldIsNotAString is returned when the Account field is not a string type.
+	ErrAccountFieldIsNotAString = errors.New("field Account must be a string")
+	// ErrNetworkIDFieldIsNotAUint32 is returned when the NetworkID field is set but not a uint32.
+	ErrNetworkIDFieldIsNotAUint32 = errors.New("field NetworkID must be a uint32")
+	// ErrNetworkIDFieldMismatch is returned when the NetworkID field does not match the expected NetworkID.
+	ErrNetworkIDFieldMismatch = errors.New("field NetworkID must match expected NetworkID")
+)
+
+// ErrFailedToParseFee is returned when fee parsing fails.
+type ErrFailedToParseFee struct {
+	Fee string
+	Err error
+}
+
+// Error implements the error interface for ErrFailedToParseFee
+func (e ErrFailedToParseFee) Error() string {
+	return fmt.Sprintf("failed to parse fee: %q: %v", e.Fee, e.Err)
+}
+
+// ErrMismatchedTag is returned when a transaction tag field does not match the expected value.
+type ErrMismatchedTag struct {
+	Expected string
+	Actual   string
+}
+
+// Error implements the error interface for ErrMismatchedTag
+func (e ErrMismatchedTag) Error() string {
+	return fmt.Sprintf("transaction tag mismatch: %q must equal %q", e.Actual, e.Expected)
+}
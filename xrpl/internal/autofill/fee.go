@@ -0,0 +1,179 @@
+package autofill
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// FeeSource provides the network values the transaction cost depends on.
+// The clients fetch them from the server, offline autofill reads them from a snapshot.
+type FeeSource struct {
+	// NetworkFee returns the network fee in drops, load factor and fee cushion included.
+	NetworkFee func() (uint64, error)
+	// OwnerReserve returns the owner reserve increment in drops.
+	OwnerReserve func() (uint64, error)
+	// CounterpartySignersCount returns the number of signers of the counterparty of a LoanSet transaction.
+	CounterpartySignersCount func(tx transaction.FlatTransaction) (uint64, error)
+}
+
+// NetworkFee calculates the network fee in drops from the base fee in XRP and the load factor
+// reported by the server. The fee is multiplied by the cushion and capped at maxFeeXRP.
+func NetworkFee(baseFeeXRP float32, loadFactor uint, cushion, maxFeeXRP float32) (uint64, error) {
+	if loadFactor == 0 {
+		loadFactor = 1
+	}
+
+	fee := baseFeeXRP * float32(loadFactor) * cushion
+
+	if fee > maxFeeXRP {
+		fee = maxFeeXRP
+	}
+
+	// Round fee to NUM_DECIMAL_PLACES
+	roundedFee := float32(math.Round(float64(fee)*math.Pow10(currency.MaxFractionLength))) / float32(math.Pow10(currency.MaxFractionLength))
+
+	// Convert the rounded fee back to a string with NUM_DECIMAL_PLACES
+	feeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.*f", currency.MaxFractionLength, roundedFee))
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(feeDrops, 10, 64)
+}
+
+// CalculateFee calculates the fee per transaction type and sets the Fee field of the transaction.
+//
+// Enhanced implementation that replicates xrpl.js calculateFeePerTransactionType logic,
+// including special cases for EscrowFinish, AccountDelete, AMMCreate, Batch, and multi-signing.
+func CalculateFee(tx *transaction.FlatTransaction, nSigners uint64, maxFeeXRP float32, src FeeSource) error {
+	// Get base network fee
+	baseFeeUint, err := src.NetworkFee()
+	if err != nil {
+		return err
+	}
+
+	baseFee := baseFeeUint
+
+	// Get transaction type
+	transactionType := ""
+	if txType, ok := (*tx)["TransactionType"]; ok {
+		if str, ok := txType.(string); ok {
+			transactionType = str
+		}
+	}
+
+	// Check if this is a special transaction cost type
+	isSpecialTxCost := transactionType == "AccountDelete" || transactionType == "AMMCreate"
+
+	switch transactionType {
+	case "EscrowFinish":
+		if fulfillment, ok := (*tx)["Fulfillment"]; ok && fulfillment != nil {
+			if fulfillmentStr, ok := fulfillment.(string); ok && fulfillmentStr != "" {
+				fulfillmentBytesSize := (len(fulfillmentStr) + 1) / 2 // Math.ceil(length / 2)
+				if fulfillmentBytesSize < 0 {
+					return ErrInvalidFulfillmentLength
+				}
+				// BaseFee × (33 + ceil(Fulfillment size in bytes / 16))
+				chunks := (uint64(fulfillmentBytesSize) + 15) / 16 // ceil division
+				baseFee = baseFeeUint * (33 + chunks)
+			}
+		}
+	case "AccountDelete", "AMMCreate":
+		reserveFee, err := src.OwnerReserve()
+		if err != nil {
+			return err
+		}
+		baseFee = reserveFee
+	case "Batch":
+		rawTxFees, err := calculateBatchFees(tx, maxFeeXRP, src)
+		if err != nil {
+			return err
+		}
+		baseFee = baseFeeUint*2 + rawTxFees
+	case "LoanSet":
+		// For LoanSet, account for counterparty signers
+		counterPartySignersCount, err := src.CounterpartySignersCount(*tx)
+		if err != nil {
+			return err
+		}
+		baseFee = baseFeeUint + (baseFeeUint * counterPartySignersCount)
+	}
+
+	// Multi-signed Transaction: BaseFee × (1 + Number of Signatures Provided)
+	if nSigners > 0 {
+		signersFee := baseFeeUint * nSigners
+		baseFee += signersFee
+	}
+
+	// Apply max fee limit (but not for special transaction cost types)
+	var totalFee uint64
+	if isSpecialTxCost {
+		totalFee = baseFee
+	} else {
+		maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", maxFeeXRP))
+		if err != nil {
+			return err
+		}
+		maxFeeUint, err := strconv.ParseUint(maxFeeDrops, 10, 64)
+		if err != nil {
+			return err
+		}
+		totalFee = min(baseFee, maxFeeUint)
+	}
+
+	(*tx)["Fee"] = strconv.FormatUint(totalFee, 10)
+	return nil
+}
+
+// calculateBatchFees calculates the total fees for all inner transactions in a Batch.
+// Replicates the JavaScript logic for Batch transaction fee calculation.
+func calculateBatchFees(tx *transaction.FlatTransaction, maxFeeXRP float32, src FeeSource) (uint64, error) {
+	var totalFees uint64
+
+	// Get RawTransactions from the batch transaction
+	rawTransactions, ok := (*tx)["RawTransactions"].([]map[string]any)
+	if !ok {
+		return 0, ErrRawTransactionsFieldMissing
+	}
+
+	// Iterate through each raw transaction
+	for _, rawTx := range rawTransactions {
+		// Extract the actual transaction from the wrapper
+		innerTx, ok := rawTx["RawTransaction"].(map[string]any)
+		if !ok {
+			return 0, ErrRawTransactionFieldMissing
+		}
+
+		// Calculate fee for this inner transaction (no multi-signing for inner transactions)
+		innerTxFlat := transaction.FlatTransaction(innerTx)
+		err := CalculateFee(&innerTxFlat, 0, maxFeeXRP, src)
+		if err != nil {
+			return 0, err
+		}
+
+		// Extract the calculated fee
+		feeStr, ok := innerTx["Fee"].(string)
+		if !ok {
+			return 0, ErrFeeFieldMissing
+		}
+
+		innerTx["Fee"] = "0"
+
+		// Convert fee string to uint64 and add to total
+		feeUint, err := strconv.ParseUint(feeStr, 10, 64)
+		if err != nil {
+			return 0, ErrFailedToParseFee{
+				Fee: feeStr,
+				Err: err,
+			}
+		}
+
+		totalFees += feeUint
+	}
+
+	return totalFees, nil
+}
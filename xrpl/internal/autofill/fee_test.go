@@ -0,0 +1,114 @@
+package autofill
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkFee(t *testing.T) {
+	testCases := []struct {
+		name       string
+		baseFeeXRP float32
+		loadFactor uint
+		cushion    float32
+		maxFeeXRP  float32
+		expected   uint64
+	}{
+		{
+			name:       "pass - cushion applied",
+			baseFeeXRP: 0.00001,
+			loadFactor: 1,
+			cushion:    1.2,
+			maxFeeXRP:  2,
+			expected:   12,
+		},
+		{
+			name:       "pass - zero load factor defaults to 1",
+			baseFeeXRP: 0.00001,
+			cushion:    1,
+			maxFeeXRP:  2,
+			expected:   10,
+		},
+		{
+			name:       "pass - capped at max fee",
+			baseFeeXRP: 0.00001,
+			loadFactor: 1000000,
+			cushion:    1,
+			maxFeeXRP:  2,
+			expected:   2000000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee, err := NetworkFee(tc.baseFeeXRP, tc.loadFactor, tc.cushion, tc.maxFeeXRP)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, fee)
+		})
+	}
+}
+
+func TestCalculateFee(t *testing.T) {
+	src := FeeSource{
+		NetworkFee: func() (uint64, error) { return 10, nil },
+		OwnerReserve: func() (uint64, error) {
+			return 2000000, nil
+		},
+		CounterpartySignersCount: func(transaction.FlatTransaction) (uint64, error) {
+			return 2, nil
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		tx       transaction.FlatTransaction
+		nSigners uint64
+		expected string
+	}{
+		{
+			name:     "pass - base fee",
+			tx:       transaction.FlatTransaction{"TransactionType": "AccountSet"},
+			expected: "10",
+		},
+		{
+			name:     "pass - multisigned",
+			tx:       transaction.FlatTransaction{"TransactionType": "AccountSet"},
+			nSigners: 3,
+			expected: "40",
+		},
+		{
+			name:     "pass - EscrowFinish with fulfillment",
+			tx:       transaction.FlatTransaction{"TransactionType": "EscrowFinish", "Fulfillment": "A0028000"},
+			expected: "340",
+		},
+		{
+			name:     "pass - AccountDelete uses owner reserve above max fee",
+			tx:       transaction.FlatTransaction{"TransactionType": "AccountDelete"},
+			expected: "2000000",
+		},
+		{
+			name:     "pass - LoanSet counterparty signers",
+			tx:       transaction.FlatTransaction{"TransactionType": "LoanSet"},
+			expected: "30",
+		},
+		{
+			name: "pass - Batch inner fees",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Batch",
+				"RawTransactions": []map[string]any{
+					{"RawTransaction": map[string]any{"TransactionType": "Payment"}},
+				},
+			},
+			expected: "30",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, CalculateFee(&tc.tx, tc.nSigners, 1, src))
+			require.Equal(t, tc.expected, tc.tx["Fee"])
+		})
+	}
+}
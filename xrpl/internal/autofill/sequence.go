@@ -0,0 +1,23 @@
+package autofill
+
+import "github.com/Peersyst/xrpl-go/xrpl/transaction"
+
+// Sequence sets the Sequence of a transaction to the next sequence of its account, or to 0 if
+// the transaction uses a TicketSequence.
+func Sequence(tx *transaction.FlatTransaction, accountSequence func(account string) (uint32, error)) error {
+	if ticket := (*tx)["TicketSequence"]; ticket != nil {
+		(*tx)["Sequence"] = uint32(0)
+		return nil
+	}
+
+	account, ok := (*tx)["Account"].(string)
+	if !ok {
+		return ErrAccountFieldIsNotAString
+	}
+	sequence, err := accountSequence(account)
+	if err != nil {
+		return err
+	}
+	(*tx)["Sequence"] = sequence
+	return nil
+}
@@ -0,0 +1,130 @@
+// Package autofill holds the transaction autofill rules shared by the rpc and
+// websocket clients and by offline autofill. Callers provide the network values
+// the rules depend on, so the same rules apply online and offline.
+package autofill
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// RestrictedNetworks is the threshold above which sidechains are expected to have network IDs.
+	// Networks with ID above this restricted number are expected specify an accurate NetworkID field
+	// in every transaction to that chain to prevent replay attacks.
+	// Mainnet and testnet are exceptions. More context: https://github.com/XRPLF/rippled/pull/4370
+	RestrictedNetworks = 1024
+	// RequiredNetworkIDVersion is the minimum rippled version that requires NetworkID validation.
+	RequiredNetworkIDVersion = "1.11.0"
+)
+
+// TxNeedsNetworkID reports whether transactions on the given network require a NetworkID.
+// A NetworkID is needed if the network ID is above RestrictedNetworks and the server build
+// version is at least RequiredNetworkIDVersion.
+func TxNeedsNetworkID(networkID uint32, buildVersion string) bool {
+	if networkID <= RestrictedNetworks || buildVersion == "" {
+		return false
+	}
+	return IsNotLaterRippledVersion(RequiredNetworkIDVersion, buildVersion)
+}
+
+// IsNotLaterRippledVersion determines whether the source rippled version is not later than the target rippled version.
+// Example usage: IsNotLaterRippledVersion("1.10.0", "1.11.0") returns true.
+//
+//	IsNotLaterRippledVersion("1.10.0", "1.10.0-b1") returns false.
+func IsNotLaterRippledVersion(source, target string) bool {
+	if source == target {
+		return true
+	}
+
+	sourceDecomp := strings.Split(source, ".")
+	targetDecomp := strings.Split(target, ".")
+
+	if len(sourceDecomp) < 3 || len(targetDecomp) < 3 {
+		return false
+	}
+
+	sourceMajor, err := strconv.Atoi(sourceDecomp[0])
+	if err != nil {
+		return false
+	}
+	sourceMinor, err := strconv.Atoi(sourceDecomp[1])
+	if err != nil {
+		return false
+	}
+	targetMajor, err := strconv.Atoi(targetDecomp[0])
+	if err != nil {
+		return false
+	}
+	targetMinor, err := strconv.Atoi(targetDecomp[1])
+	if err != nil {
+		return false
+	}
+
+	// Compare major version
+	if sourceMajor != targetMajor {
+		return sourceMajor < targetMajor
+	}
+
+	// Compare minor version
+	if sourceMinor != targetMinor {
+		return sourceMinor < targetMinor
+	}
+
+	sourcePatch := strings.Split(sourceDecomp[2], "-")
+	targetPatch := strings.Split(targetDecomp[2], "-")
+
+	sourcePatchVersion, err := strconv.Atoi(sourcePatch[0])
+	if err != nil {
+		return false
+	}
+	targetPatchVersion, err := strconv.Atoi(targetPatch[0])
+	if err != nil {
+		return false
+	}
+
+	// Compare patch version
+	if sourcePatchVersion != targetPatchVersion {
+		return sourcePatchVersion < targetPatchVersion
+	}
+
+	// Compare release version
+	if len(sourcePatch) != len(targetPatch) {
+		return len(sourcePatch) > len(targetPatch)
+	}
+
+	if len(sourcePatch) == 2 {
+		// Compare different release types
+		if !strings.HasPrefix(sourcePatch[1], string(targetPatch[1][0])) {
+			return sourcePatch[1] < targetPatch[1]
+		}
+
+		// Compare beta version
+		if strings.HasPrefix(sourcePatch[1], "b") {
+			sourceBeta, err := strconv.Atoi(sourcePatch[1][1:])
+			if err != nil {
+				return false
+			}
+			targetBeta, err := strconv.Atoi(targetPatch[1][1:])
+			if err != nil {
+				return false
+			}
+			return sourceBeta < targetBeta
+		}
+
+		// Compare rc version
+		if strings.HasPrefix(sourcePatch[1], "rc") {
+			sourceRC, err := strconv.Atoi(sourcePatch[1][2:])
+			if err != nil {
+				return false
+			}
+			targetRC, err := strconv.Atoi(targetPatch[1][2:])
+			if err != nil {
+				return false
+			}
+			return sourceRC < targetRC
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,37 @@
+package autofill
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNotLaterRippledVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   string
+		target   string
+		expected bool
+	}{
+		{name: "equal", source: "1.11.0", target: "1.11.0", expected: true},
+		{name: "earlier minor", source: "1.10.0", target: "1.11.0", expected: true},
+		{name: "later major", source: "2.0.0", target: "1.11.0", expected: false},
+		{name: "release after beta", source: "1.10.0", target: "1.10.0-b1", expected: false},
+		{name: "earlier beta", source: "1.10.0-b1", target: "1.10.0-b2", expected: true},
+		{name: "earlier rc", source: "1.10.0-rc1", target: "1.10.0-rc2", expected: true},
+		{name: "malformed", source: "1.10", target: "1.11.0", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, IsNotLaterRippledVersion(tc.source, tc.target))
+		})
+	}
+}
+
+func TestTxNeedsNetworkID(t *testing.T) {
+	require.False(t, TxNeedsNetworkID(1, "2.4.0"))
+	require.False(t, TxNeedsNetworkID(21338, ""))
+	require.False(t, TxNeedsNetworkID(21338, "1.10.0"))
+	require.True(t, TxNeedsNetworkID(21338, "2.4.0"))
+}
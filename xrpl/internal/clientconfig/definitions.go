@@ -0,0 +1,17 @@
+package clientconfig
+
+// WarnDefinitionsMismatch logs that the server's binary codec definitions hash differs
+// from the hash of the embedded definitions.
+func WarnDefinitionsMismatch(clientName, embeddedHash, serverHash string) {
+	l := logger.Load()
+	if l == nil {
+		return
+	}
+
+	l.Printf(
+		"xrpl-go: warning: %s client server definitions hash %q does not match the embedded definitions hash %q; encoding may fail or differ from the server",
+		clientName,
+		serverHash,
+		embeddedHash,
+	)
+}
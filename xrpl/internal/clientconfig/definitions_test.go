@@ -0,0 +1,18 @@
+package clientconfig_test
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/internal/clientconfig"
+	clientconfigtestutil "github.com/Peersyst/xrpl-go/xrpl/internal/clientconfig/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarnDefinitionsMismatch(t *testing.T) {
+	logs := clientconfigtestutil.CaptureLogOutput(t, func() {
+		clientconfig.WarnDefinitionsMismatch("test", "AA", "BB")
+	})
+
+	require.Contains(t, logs, "xrpl-go: warning: test client server definitions hash \"BB\"")
+	require.Contains(t, logs, "embedded definitions hash \"AA\"")
+}
@@ -0,0 +1,168 @@
+// Package codeccache caches binary codecs built from server definitions, keyed by definitions hash.
+package codeccache
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/internal/clientconfig"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+)
+
+// Fetcher fetches the server definitions for the given request.
+type Fetcher func(req *server.DefinitionsRequest) (*server.DefinitionsResponse, error)
+
+// Cache caches binary codecs built from server definitions, keyed by definitions hash.
+// The zero value is ready to use.
+type Cache struct {
+	mu     sync.Mutex
+	codecs map[string]*binarycodec.Codec
+	last   string
+	active *binarycodec.Codec
+
+	checkMu  sync.Mutex
+	checked  bool
+	checkErr error
+}
+
+// Get returns the codec cached for the given definitions hash.
+func (c *Cache) Get(hash string) (*binarycodec.Codec, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	codec, ok := c.codecs[hash]
+	return codec, ok
+}
+
+// Store caches the codec for the given definitions hash.
+func (c *Cache) Store(hash string, codec *binarycodec.Codec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.codecs == nil {
+		c.codecs = make(map[string]*binarycodec.Codec)
+	}
+	c.codecs[hash] = codec
+	c.last = hash
+}
+
+// Fetch returns the codec for the server's current definitions. It sends the most recently
+// stored hash, so the server only returns the full definitions when they have changed, and
+// builds and caches a new codec in that case.
+func (c *Cache) Fetch(fetch Fetcher) (*binarycodec.Codec, error) {
+	c.mu.Lock()
+	last := c.last
+	c.mu.Unlock()
+
+	res, err := fetch(&server.DefinitionsRequest{Hash: last})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.codecFor(fetch, res)
+}
+
+// codecFor returns the cached codec for the response hash, or builds and caches one from
+// the response, fetching the full definitions when the response only carries the hash.
+func (c *Cache) codecFor(fetch Fetcher, res *server.DefinitionsResponse) (*binarycodec.Codec, error) {
+	if codec, ok := c.Get(res.Hash); ok {
+		return codec, nil
+	}
+
+	if len(res.Fields) == 0 {
+		// The server only returned its hash, which is not cached: fetch the full definitions.
+		var err error
+		res, err = fetch(&server.DefinitionsRequest{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	codec, err := res.Codec()
+	if err != nil {
+		return nil, err
+	}
+	c.Store(res.Hash, codec)
+
+	return codec, nil
+}
+
+// Codec returns the codec the client encodes and decodes with: the server's codec when
+// it was loaded by Check, otherwise the default codec.
+func (c *Cache) Codec() *binarycodec.Codec {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.active != nil {
+		return c.active
+	}
+	return binarycodec.DefaultCodec()
+}
+
+// Check compares the server's definitions hash with the embedded definitions hash and
+// applies check on mismatch: it logs a warning, returns an error wrapping
+// ErrDefinitionsMismatch, or loads the server's definitions as the codec returned by Codec.
+func (c *Cache) Check(fetch Fetcher, check common.DefinitionsCheck, clientName string) error {
+	if check == common.DefinitionsCheckNone {
+		return nil
+	}
+
+	embedded := binarycodec.DefaultCodec().Definitions().Hash
+	res, err := fetch(&server.DefinitionsRequest{Hash: embedded})
+	if err != nil {
+		return err
+	}
+
+	if res.Hash == embedded {
+		c.setActive(nil)
+		return nil
+	}
+
+	switch check {
+	case common.DefinitionsCheckWarn:
+		clientconfig.WarnDefinitionsMismatch(clientName, embedded, res.Hash)
+		return nil
+	case common.DefinitionsCheckLoad:
+		codec, err := c.codecFor(fetch, res)
+		if err != nil {
+			return err
+		}
+		c.setActive(codec)
+		return nil
+	default:
+		return fmt.Errorf("%w: embedded %s, server %s", ErrDefinitionsMismatch, embedded, res.Hash)
+	}
+}
+
+// CheckOnce runs Check until it either succeeds or reports a definitions mismatch, and
+// returns that outcome on every later call. Fetch errors are returned without being
+// remembered, so the check is retried on the next call.
+func (c *Cache) CheckOnce(fetch Fetcher, check common.DefinitionsCheck, clientName string) error {
+	if check == common.DefinitionsCheckNone {
+		return nil
+	}
+
+	c.checkMu.Lock()
+	defer c.checkMu.Unlock()
+
+	if c.checked {
+		return c.checkErr
+	}
+
+	err := c.Check(fetch, check, clientName)
+	if err == nil || errors.Is(err, ErrDefinitionsMismatch) {
+		c.checked = true
+		c.checkErr = err
+	}
+	return err
+}
+
+func (c *Cache) setActive(codec *binarycodec.Codec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.active = codec
+}
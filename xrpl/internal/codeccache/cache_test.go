@@ -0,0 +1,243 @@
+package codeccache
+
+import (
+	"errors"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+	clientconfigtestutil "github.com/Peersyst/xrpl-go/xrpl/internal/clientconfig/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+func definitionsResponse(hash string) *server.DefinitionsResponse {
+	return &server.DefinitionsResponse{
+		Hash:  hash,
+		Types: map[string]int32{"UInt32": 2},
+		Fields: []types.DefinitionField{
+			{
+				Name: "Flags",
+				Info: types.DefinitionFieldInfo{
+					Nth:            2,
+					IsSerialized:   true,
+					IsSigningField: true,
+					Type:           "UInt32",
+				},
+			},
+		},
+		TransactionTypes: map[string]int32{"Payment": 0},
+	}
+}
+
+// fakeServer answers server_definitions requests like rippled: it only returns the hash
+// when the requested hash matches its own.
+type fakeServer struct {
+	hash     string
+	requests []string
+	err      error
+}
+
+func (s *fakeServer) fetch(req *server.DefinitionsRequest) (*server.DefinitionsResponse, error) {
+	s.requests = append(s.requests, req.Hash)
+	if s.err != nil {
+		return nil, s.err
+	}
+	if req.Hash == s.hash {
+		return &server.DefinitionsResponse{Hash: s.hash}, nil
+	}
+	return definitionsResponse(s.hash), nil
+}
+
+func TestCache_Fetch(t *testing.T) {
+	t.Run("builds and caches the codec", func(t *testing.T) {
+		var cache Cache
+		srv := &fakeServer{hash: "AA"}
+
+		first, err := cache.Fetch(srv.fetch)
+		require.NoError(t, err)
+		require.Equal(t, "AA", first.Definitions().Hash)
+
+		second, err := cache.Fetch(srv.fetch)
+		require.NoError(t, err)
+		require.Same(t, first, second)
+		require.Equal(t, []string{"", "AA"}, srv.requests)
+	})
+
+	t.Run("fetches new definitions when the hash changes", func(t *testing.T) {
+		var cache Cache
+		srv := &fakeServer{hash: "AA"}
+
+		first, err := cache.Fetch(srv.fetch)
+		require.NoError(t, err)
+
+		srv.hash = "BB"
+		second, err := cache.Fetch(srv.fetch)
+		require.NoError(t, err)
+		require.NotSame(t, first, second)
+		require.Equal(t, "BB", second.Definitions().Hash)
+
+		cached, ok := cache.Get("AA")
+		require.True(t, ok)
+		require.Same(t, first, cached)
+	})
+
+	t.Run("reuses a previously cached hash", func(t *testing.T) {
+		var cache Cache
+		srv := &fakeServer{hash: "AA"}
+
+		first, err := cache.Fetch(srv.fetch)
+		require.NoError(t, err)
+
+		srv.hash = "BB"
+		_, err = cache.Fetch(srv.fetch)
+		require.NoError(t, err)
+
+		srv.hash = "AA"
+		third, err := cache.Fetch(srv.fetch)
+		require.NoError(t, err)
+		require.Same(t, first, third)
+	})
+
+	t.Run("refetches the full definitions when only an unknown hash is returned", func(t *testing.T) {
+		var cache Cache
+		calls := 0
+		fetch := func(req *server.DefinitionsRequest) (*server.DefinitionsResponse, error) {
+			calls++
+			if calls == 1 {
+				require.Equal(t, "ZZ", req.Hash)
+				return &server.DefinitionsResponse{Hash: "AA"}, nil
+			}
+			require.Empty(t, req.Hash)
+			return definitionsResponse("AA"), nil
+		}
+		cache.Store("ZZ", nil)
+
+		codec, err := cache.Fetch(fetch)
+		require.NoError(t, err)
+		require.NotNil(t, codec)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("returns the fetch error", func(t *testing.T) {
+		var cache Cache
+		srv := &fakeServer{err: errors.New("connection refused")}
+
+		_, err := cache.Fetch(srv.fetch)
+		require.EqualError(t, err, "connection refused")
+	})
+}
+
+func TestCache_Check(t *testing.T) {
+	embedded := binarycodec.DefaultCodec().Definitions().Hash
+
+	tests := []struct {
+		name          string
+		serverHash    string
+		check         common.DefinitionsCheck
+		expectedErr   error
+		expectWarning bool
+		expectLoaded  bool
+		expectFetches int
+	}{
+		{
+			name:          "none skips the check",
+			serverHash:    "BB",
+			check:         common.DefinitionsCheckNone,
+			expectFetches: 0,
+		},
+		{
+			name:          "matching hash",
+			serverHash:    embedded,
+			check:         common.DefinitionsCheckFail,
+			expectFetches: 1,
+		},
+		{
+			name:          "warn on mismatch",
+			serverHash:    "BB",
+			check:         common.DefinitionsCheckWarn,
+			expectWarning: true,
+			expectFetches: 1,
+		},
+		{
+			name:          "fail on mismatch",
+			serverHash:    "BB",
+			check:         common.DefinitionsCheckFail,
+			expectedErr:   ErrDefinitionsMismatch,
+			expectFetches: 1,
+		},
+		{
+			name:          "load on mismatch",
+			serverHash:    "BB",
+			check:         common.DefinitionsCheckLoad,
+			expectLoaded:  true,
+			expectFetches: 1,
+		},
+		{
+			name:          "load keeps the default codec on match",
+			serverHash:    embedded,
+			check:         common.DefinitionsCheckLoad,
+			expectFetches: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cache Cache
+			srv := &fakeServer{hash: tt.serverHash}
+
+			var err error
+			logs := clientconfigtestutil.CaptureLogOutput(t, func() {
+				err = cache.Check(srv.fetch, tt.check, "test")
+			})
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.expectWarning {
+				require.Contains(t, logs, "does not match the embedded definitions hash")
+			} else {
+				require.Empty(t, logs)
+			}
+
+			if tt.expectLoaded {
+				require.Equal(t, tt.serverHash, cache.Codec().Definitions().Hash)
+			} else {
+				require.Same(t, binarycodec.DefaultCodec(), cache.Codec())
+			}
+
+			require.Len(t, srv.requests, tt.expectFetches)
+			if tt.expectFetches > 0 {
+				require.Equal(t, embedded, srv.requests[0])
+			}
+		})
+	}
+}
+
+func TestCache_CheckOnce(t *testing.T) {
+	t.Run("remembers a mismatch", func(t *testing.T) {
+		var cache Cache
+		srv := &fakeServer{hash: "BB"}
+
+		require.ErrorIs(t, cache.CheckOnce(srv.fetch, common.DefinitionsCheckFail, "test"), ErrDefinitionsMismatch)
+		require.ErrorIs(t, cache.CheckOnce(srv.fetch, common.DefinitionsCheckFail, "test"), ErrDefinitionsMismatch)
+		require.Len(t, srv.requests, 1)
+	})
+
+	t.Run("retries after a fetch error", func(t *testing.T) {
+		var cache Cache
+		srv := &fakeServer{hash: "BB", err: errors.New("connection refused")}
+
+		require.EqualError(t, cache.CheckOnce(srv.fetch, common.DefinitionsCheckLoad, "test"), "connection refused")
+
+		srv.err = nil
+		require.NoError(t, cache.CheckOnce(srv.fetch, common.DefinitionsCheckLoad, "test"))
+		require.NoError(t, cache.CheckOnce(srv.fetch, common.DefinitionsCheckLoad, "test"))
+		require.Len(t, srv.requests, 2)
+		require.Equal(t, "BB", cache.Codec().Definitions().Hash)
+	})
+}
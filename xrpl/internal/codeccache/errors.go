@@ -0,0 +1,9 @@
+package codeccache
+
+import "errors"
+
+var (
+	// ErrDefinitionsMismatch is returned when the server's definitions hash does not match
+	// the embedded definitions hash.
+	ErrDefinitionsMismatch = errors.New("server definitions do not match the embedded definitions")
+)
@@ -2,6 +2,7 @@
 package ledger
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -207,3 +208,121 @@ func (a *AccountRoot) SetLsfRequireAuth() {
 func (a *AccountRoot) SetLsfRequireDestTag() {
 	a.Flags |= LsfRequireDestTag
 }
+
+// EncodeBinary writes the AccountRoot to e, without going through JSON and the map-based codec.
+// Optional fields are written when they are set, as with JSON marshalling. Index is not part of
+// the serialized entry and is not written.
+func (a *AccountRoot) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(AccountRootEntry))
+	e.UInt32("Flags", a.Flags)
+	e.AccountID("Account", a.Account.String())
+	if a.AccountTxnID != "" {
+		e.Hash256("AccountTxnID", a.AccountTxnID.String())
+	}
+	if a.AMMID != "" {
+		e.Hash256("AMMID", a.AMMID.String())
+	}
+	if a.Balance != 0 {
+		e.XRPAmount("Balance", a.Balance.Uint64())
+	}
+	if a.BurnedNFTokens != 0 {
+		e.UInt32("BurnedNFTokens", a.BurnedNFTokens)
+	}
+	if a.Domain != "" {
+		e.Blob("Domain", a.Domain)
+	}
+	if a.EmailHash != "" {
+		e.Hash128("EmailHash", a.EmailHash.String())
+	}
+	if a.FirstNFTokenSequence != 0 {
+		e.UInt32("FirstNFTokenSequence", a.FirstNFTokenSequence)
+	}
+	if a.MessageKey != "" {
+		e.Blob("MessageKey", a.MessageKey)
+	}
+	if a.MintedNFTokens != 0 {
+		e.UInt32("MintedNFTokens", a.MintedNFTokens)
+	}
+	if a.NFTokenMinter != "" {
+		e.AccountID("NFTokenMinter", a.NFTokenMinter.String())
+	}
+	e.UInt32("OwnerCount", a.OwnerCount)
+	e.Hash256("PreviousTxnID", a.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", a.PreviousTxnLgrSeq)
+	if a.RegularKey != "" {
+		e.AccountID("RegularKey", a.RegularKey.String())
+	}
+	e.UInt32("Sequence", a.Sequence)
+	if a.TicketCount != 0 {
+		e.UInt32("TicketCount", a.TicketCount)
+	}
+	if a.TickSize != 0 {
+		e.UInt8("TickSize", a.TickSize)
+	}
+	if a.TransferRate != 0 {
+		e.UInt32("TransferRate", a.TransferRate)
+	}
+	if a.WalletLocator != "" {
+		e.Hash256("WalletLocator", a.WalletLocator.String())
+	}
+	if a.WalletSize != 0 {
+		e.UInt32("WalletSize", a.WalletSize)
+	}
+}
+
+// DecodeBinary reads the AccountRoot from d, skipping fields an AccountRoot does not have.
+func (a *AccountRoot) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			a.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			a.Flags = d.UInt32()
+		case "Account":
+			a.Account = types.Address(d.AccountID())
+		case "AccountTxnID":
+			a.AccountTxnID = types.Hash256(d.Hash())
+		case "AMMID":
+			a.AMMID = types.Hash256(d.Hash())
+		case "Balance":
+			a.Balance = types.XRPCurrencyAmount(d.Amount().Drops)
+		case "BurnedNFTokens":
+			a.BurnedNFTokens = d.UInt32()
+		case "Domain":
+			a.Domain = d.Blob()
+		case "EmailHash":
+			a.EmailHash = types.Hash128(d.Hash())
+		case "FirstNFTokenSequence":
+			a.FirstNFTokenSequence = d.UInt32()
+		case "MessageKey":
+			a.MessageKey = d.Blob()
+		case "MintedNFTokens":
+			a.MintedNFTokens = d.UInt32()
+		case "NFTokenMinter":
+			a.NFTokenMinter = types.Address(d.AccountID())
+		case "OwnerCount":
+			a.OwnerCount = d.UInt32()
+		case "PreviousTxnID":
+			a.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			a.PreviousTxnLgrSeq = d.UInt32()
+		case "RegularKey":
+			a.RegularKey = types.Address(d.AccountID())
+		case "Sequence":
+			a.Sequence = d.UInt32()
+		case "TicketCount":
+			a.TicketCount = d.UInt32()
+		case "TickSize":
+			a.TickSize = d.UInt8()
+		case "TransferRate":
+			a.TransferRate = d.UInt32()
+		case "WalletLocator":
+			a.WalletLocator = types.Hash256(d.Hash())
+		case "WalletSize":
+			a.WalletSize = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,8 +1,12 @@
 package ledger
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
 	"testing"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/stretchr/testify/require"
@@ -45,6 +49,42 @@ func TestAccountRoot(t *testing.T) {
 	}
 }
 
+func TestAccountRoot_Binary(t *testing.T) {
+	entry := &AccountRoot{
+		Account:           "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+		AccountTxnID:      "0D5FB50FA65C9FE1538FD7E398FFFE9D1908DFA4576D8D7A020040686F93C77D",
+		Balance:           types.XRPCurrencyAmount(148446663),
+		Domain:            "6D64756F31332E636F6D",
+		EmailHash:         "98B4375E1D753E5B91627516F6D70977",
+		Flags:             8388608,
+		LedgerEntryType:   AccountRootEntry,
+		MessageKey:        "0000000000000000000000070000000300",
+		OwnerCount:        3,
+		PreviousTxnID:     "0D5FB50FA65C9FE1538FD7E398FFFE9D1908DFA4576D8D7A020040686F93C77D",
+		PreviousTxnLgrSeq: 14091160,
+		Sequence:          336,
+		TickSize:          5,
+		TransferRate:      1004999999,
+	}
+
+	data, err := json.Marshal(entry)
+	require.NoError(t, err)
+	var flat map[string]any
+	require.NoError(t, json.Unmarshal(data, &flat))
+	expected, err := binarycodec.Encode(flat)
+	require.NoError(t, err)
+
+	e := binarycodec.NewEncoder()
+	entry.EncodeBinary(e)
+	b, err := e.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)))
+
+	var decoded AccountRoot
+	require.NoError(t, decoded.DecodeBinary(binarycodec.NewDecoder(b)))
+	require.Equal(t, *entry, decoded)
+}
+
 func TestAccountRoot_EntryType(t *testing.T) {
 	ar := &AccountRoot{}
 	require.Equal(t, AccountRootEntry, ar.EntryType())
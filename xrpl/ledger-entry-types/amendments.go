@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -74,3 +75,65 @@ type Majority struct {
 	// The close time of the ledger version that reached majority support for this amendment.
 	CloseTime uint32
 }
+
+// EncodeBinary writes the Amendments to e, without going through JSON and the map-based codec.
+func (a *Amendments) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(AmendmentsEntry))
+	e.UInt32("Flags", a.Flags)
+	if len(a.Amendments) > 0 {
+		encodeBinaryHashes(e, "Amendments", a.Amendments)
+	}
+	if len(a.Majorities) > 0 {
+		e.BeginArray("Majorities")
+		for _, majority := range a.Majorities {
+			e.BeginObject("Majority")
+			e.Hash256("Amendment", majority.Majority.Amendment.String())
+			e.UInt32("CloseTime", majority.Majority.CloseTime)
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+	if a.PreviousTxnID != "" {
+		e.Hash256("PreviousTxnID", a.PreviousTxnID.String())
+	}
+	if a.PreviousTxnLgrSeq != 0 {
+		e.UInt32("PreviousTxnLgrSeq", a.PreviousTxnLgrSeq)
+	}
+}
+
+// DecodeBinary reads the Amendments from d, skipping fields an Amendments object does not have.
+func (a *Amendments) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Flags":
+			a.Flags = d.UInt32()
+		case "LedgerEntryType":
+			a.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Amendments":
+			a.Amendments = decodeBinaryHashes(d)
+		case "Majorities":
+			a.Majorities = nil
+			for d.Next() {
+				var majority Majority
+				for d.Next() {
+					switch d.Field() {
+					case "Amendment":
+						majority.Amendment = types.Hash256(d.Hash())
+					case "CloseTime":
+						majority.CloseTime = d.UInt32()
+					default:
+						d.Skip()
+					}
+				}
+				a.Majorities = append(a.Majorities, MajorityEntry{Majority: majority})
+			}
+		case "PreviousTxnID":
+			a.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			a.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
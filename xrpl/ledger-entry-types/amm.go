@@ -1,6 +1,9 @@
 package ledger
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -87,6 +90,26 @@ type AMM struct {
 	PreviousTxnLgrSeq uint32 `json:",omitempty"`
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for AMM.
+func (a *AMM) UnmarshalJSON(data []byte) error {
+	type Alias AMM
+	aux := &struct {
+		LPTokenBalance json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if a.LPTokenBalance, err = types.UnmarshalCurrencyAmount(aux.LPTokenBalance); err != nil {
+		return err
+	}
+	return nil
+}
+
 // AuctionSlot represents the auction slot details for AMM fee discounts, including account, auth accounts, fee, price, and expiration.
 type AuctionSlot struct {
 	// The current owner of this auction slot.
@@ -101,6 +124,31 @@ type AuctionSlot struct {
 	Expiration uint32
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for AuctionSlot.
+func (a *AuctionSlot) UnmarshalJSON(data []byte) error {
+	type Alias AuctionSlot
+	aux := &struct {
+		Price json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if a.Price, err = types.UnmarshalCurrencyAmount(aux.Price); err != nil {
+		return err
+	}
+	return nil
+}
+
+// isZero reports whether the AuctionSlot is the zero value, which JSON marshalling omits.
+func (a *AuctionSlot) isZero() bool {
+	return a.Account == "" && a.AuthAccounts == nil && a.DiscountedFee == 0 && a.Price == nil && a.Expiration == 0
+}
+
 // ---------------------------------------------
 // AuthAccounts Object
 // ---------------------------------------------
@@ -154,3 +202,128 @@ type VoteEntry struct {
 func (*AMM) EntryType() EntryType {
 	return AMMEntry
 }
+
+// EncodeBinary writes the AMM to e, without going through JSON and the map-based codec.
+func (a *AMM) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(AMMEntry))
+	e.UInt32("Flags", a.Flags)
+	e.AccountID("Account", a.Account.String())
+	encodeBinaryAsset(e, "Asset", a.Asset)
+	encodeBinaryAsset(e, "Asset2", a.Asset2)
+	if !a.AuctionSlot.isZero() {
+		e.BeginObject("AuctionSlot")
+		e.AccountID("Account", a.AuctionSlot.Account.String())
+		if len(a.AuctionSlot.AuthAccounts) > 0 {
+			e.BeginArray("AuthAccounts")
+			for _, authAccount := range a.AuctionSlot.AuthAccounts {
+				e.BeginObject("AuthAccount")
+				e.AccountID("Account", authAccount.AuthAccount.Account.String())
+				e.EndObject()
+			}
+			e.EndArray()
+		}
+		e.UInt16("DiscountedFee", a.AuctionSlot.DiscountedFee)
+		if a.AuctionSlot.Price != nil {
+			encodeBinaryAmount(e, "Price", a.AuctionSlot.Price)
+		}
+		e.UInt32("Expiration", a.AuctionSlot.Expiration)
+		e.EndObject()
+	}
+	if a.LPTokenBalance != nil {
+		encodeBinaryAmount(e, "LPTokenBalance", a.LPTokenBalance)
+	}
+	e.UInt16("TradingFee", a.TradingFee)
+	if len(a.VoteSlots) > 0 {
+		e.BeginArray("VoteSlots")
+		for _, voteSlot := range a.VoteSlots {
+			e.BeginObject("VoteEntry")
+			e.AccountID("Account", voteSlot.VoteEntry.Account.String())
+			e.UInt16("TradingFee", voteSlot.VoteEntry.TradingFee)
+			e.UInt32("VoteWeight", voteSlot.VoteEntry.VoteWeight)
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+	if a.PreviousTxnID != "" {
+		e.Hash256("PreviousTxnID", a.PreviousTxnID.String())
+	}
+	if a.PreviousTxnLgrSeq != 0 {
+		e.UInt32("PreviousTxnLgrSeq", a.PreviousTxnLgrSeq)
+	}
+}
+
+// DecodeBinary reads the AMM from d, skipping fields an AMM does not have.
+func (a *AMM) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			a.LedgerEntryType = d.LedgerEntryType()
+		case "Flags":
+			a.Flags = d.UInt32()
+		case "Account":
+			a.Account = types.Address(d.AccountID())
+		case "Asset":
+			a.Asset = decodeBinaryAsset(d)
+		case "Asset2":
+			a.Asset2 = decodeBinaryAsset(d)
+		case "AuctionSlot":
+			a.AuctionSlot = AuctionSlot{}
+			for d.Next() {
+				switch d.Field() {
+				case "Account":
+					a.AuctionSlot.Account = types.Address(d.AccountID())
+				case "AuthAccounts":
+					a.AuctionSlot.AuthAccounts = nil
+					for d.Next() {
+						var authAccount AuthAccount
+						for d.Next() {
+							if d.Field() == "Account" {
+								authAccount.Account = types.Address(d.AccountID())
+							} else {
+								d.Skip()
+							}
+						}
+						a.AuctionSlot.AuthAccounts = append(a.AuctionSlot.AuthAccounts, AuthAccounts{AuthAccount: authAccount})
+					}
+				case "DiscountedFee":
+					a.AuctionSlot.DiscountedFee = d.UInt16()
+				case "Price":
+					a.AuctionSlot.Price = decodeBinaryAmount(d)
+				case "Expiration":
+					a.AuctionSlot.Expiration = d.UInt32()
+				default:
+					d.Skip()
+				}
+			}
+		case "LPTokenBalance":
+			a.LPTokenBalance = decodeBinaryAmount(d)
+		case "TradingFee":
+			a.TradingFee = d.UInt16()
+		case "VoteSlots":
+			a.VoteSlots = nil
+			for d.Next() {
+				var voteEntry VoteEntry
+				for d.Next() {
+					switch d.Field() {
+					case "Account":
+						voteEntry.Account = types.Address(d.AccountID())
+					case "TradingFee":
+						voteEntry.TradingFee = d.UInt16()
+					case "VoteWeight":
+						voteEntry.VoteWeight = d.UInt32()
+					default:
+						d.Skip()
+					}
+				}
+				a.VoteSlots = append(a.VoteSlots, VoteSlots{VoteEntry: voteEntry})
+			}
+		case "PreviousTxnID":
+			a.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			a.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
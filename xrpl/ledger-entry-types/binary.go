@@ -0,0 +1,125 @@
+package ledger
+
+import (
+	"fmt"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// BinaryEncoder is implemented by ledger objects that write themselves straight to a
+// binarycodec.Encoder, without going through JSON and the map-based codec.
+type BinaryEncoder interface {
+	Object
+	EncodeBinary(e *binarycodec.Encoder)
+}
+
+// BinaryDecoder is implemented by ledger objects that read themselves straight from a
+// binarycodec.Decoder.
+type BinaryDecoder interface {
+	Object
+	DecodeBinary(d *binarycodec.Decoder) error
+}
+
+// encodeBinaryAmount writes a currency amount to e.
+func encodeBinaryAmount(e *binarycodec.Encoder, field string, amount types.CurrencyAmount) {
+	switch a := amount.(type) {
+	case types.XRPCurrencyAmount:
+		e.XRPAmount(field, a.Uint64())
+	case types.IssuedCurrencyAmount:
+		e.IssuedAmount(field, a.Value, a.Currency, a.Issuer.String())
+	case types.MPTCurrencyAmount:
+		e.MPTAmount(field, a.Value, a.MPTIssuanceID)
+	default:
+		e.Value(field, amount.Flatten())
+	}
+}
+
+// decodeBinaryAmount reads the current Amount field of d as a currency amount.
+func decodeBinaryAmount(d *binarycodec.Decoder) types.CurrencyAmount {
+	a := d.Amount()
+	switch {
+	case a.XRP:
+		return types.XRPCurrencyAmount(a.Drops)
+	case a.MPTIssuanceID != "":
+		return types.MPTCurrencyAmount{MPTIssuanceID: a.MPTIssuanceID, Value: a.Value}
+	default:
+		return types.IssuedCurrencyAmount{Issuer: types.Address(a.Issuer), Currency: a.Currency, Value: a.Value}
+	}
+}
+
+// decodeBinaryIssuedAmount reads the current Amount field of d as an issued currency amount.
+func decodeBinaryIssuedAmount(d *binarycodec.Decoder) types.IssuedCurrencyAmount {
+	a := d.Amount()
+	return types.IssuedCurrencyAmount{Issuer: types.Address(a.Issuer), Currency: a.Currency, Value: a.Value}
+}
+
+// encodeBinaryAsset writes an Issue field from an asset.
+func encodeBinaryAsset(e *binarycodec.Encoder, field string, asset Asset) {
+	e.Issue(field, binarycodec.Issue{
+		Currency:      asset.Currency,
+		Issuer:        asset.Issuer.String(),
+		MPTIssuanceID: asset.MPTIssuanceID,
+	})
+}
+
+// decodeBinaryAsset reads the current Issue field of d as an asset.
+func decodeBinaryAsset(d *binarycodec.Decoder) Asset {
+	issue := d.Issue()
+	return Asset{
+		Currency:      issue.Currency,
+		Issuer:        types.Address(issue.Issuer),
+		MPTIssuanceID: issue.MPTIssuanceID,
+	}
+}
+
+// encodeBinaryBridge writes the XChainBridge field.
+func encodeBinaryBridge(e *binarycodec.Encoder, bridge types.XChainBridge) {
+	e.XChainBridge("XChainBridge", binarycodec.XChainBridge{
+		LockingChainDoor:  bridge.LockingChainDoor.String(),
+		LockingChainIssue: binarycodec.Issue{Currency: bridge.LockingChainIssue.Currency, Issuer: bridge.LockingChainIssue.Issuer.String()},
+		IssuingChainDoor:  bridge.IssuingChainDoor.String(),
+		IssuingChainIssue: binarycodec.Issue{Currency: bridge.IssuingChainIssue.Currency, Issuer: bridge.IssuingChainIssue.Issuer.String()},
+	})
+}
+
+// decodeBinaryBridge reads the current XChainBridge field of d.
+func decodeBinaryBridge(d *binarycodec.Decoder) types.XChainBridge {
+	bridge := d.XChainBridge()
+	return types.XChainBridge{
+		LockingChainDoor:  types.Address(bridge.LockingChainDoor),
+		LockingChainIssue: types.Issue{Currency: bridge.LockingChainIssue.Currency, Issuer: types.Address(bridge.LockingChainIssue.Issuer)},
+		IssuingChainDoor:  types.Address(bridge.IssuingChainDoor),
+		IssuingChainIssue: types.Issue{Currency: bridge.IssuingChainIssue.Currency, Issuer: types.Address(bridge.IssuingChainIssue.Issuer)},
+	}
+}
+
+// encodeBinaryUInt64 writes a UInt64 field given as a hex string, the way the JSON form of
+// ledger objects carries them.
+func encodeBinaryUInt64(e *binarycodec.Encoder, field, value string) {
+	e.Value(field, value)
+}
+
+// decodeBinaryUInt64 reads the current UInt64 field of d as a 16 character upper case hex string.
+func decodeBinaryUInt64(d *binarycodec.Decoder) string {
+	return fmt.Sprintf("%016X", d.UInt64())
+}
+
+// encodeBinaryHashes writes a Vector256 field.
+func encodeBinaryHashes(e *binarycodec.Encoder, field string, hashes []types.Hash256) {
+	values := make([]string, len(hashes))
+	for i, hash := range hashes {
+		values[i] = hash.String()
+	}
+	e.Vector256(field, values)
+}
+
+// decodeBinaryHashes reads the current Vector256 field of d.
+func decodeBinaryHashes(d *binarycodec.Decoder) []types.Hash256 {
+	values := d.Vector256()
+	hashes := make([]types.Hash256, len(values))
+	for i, value := range values {
+		hashes[i] = types.Hash256(value)
+	}
+	return hashes
+}
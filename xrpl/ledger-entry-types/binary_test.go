@@ -0,0 +1,177 @@
+package ledger
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil/binaryfill"
+	"github.com/stretchr/testify/require"
+)
+
+var binaryTestEntryTypes = []EntryType{
+	AccountRootEntry,
+	AmendmentsEntry,
+	AMMEntry,
+	BridgeEntry,
+	CheckEntry,
+	CredentialEntry,
+	DelegateEntry,
+	DepositPreauthObjEntry,
+	DIDEntry,
+	DirectoryNodeEntry,
+	EscrowEntry,
+	FeeSettingsEntry,
+	MPTokenEntry,
+	MPTokenIssuanceEntry,
+	LedgerHashesEntry,
+	LoanEntry,
+	LoanBrokerEntry,
+	NegativeUNLEntry,
+	NFTokenOfferEntry,
+	NFTokenPageEntry,
+	OfferEntry,
+	OracleEntry,
+	PayChannelEntry,
+	PermissionedDomainEntry,
+	RippleStateEntry,
+	SignerListEntry,
+	TicketEntry,
+	VaultEntry,
+	XChainOwnedClaimIDEntry,
+	XChainOwnedCreateAccountClaimIDEntry,
+}
+
+// binaryTestObjects returns one ledger object of every entry type, with all of its fields set.
+func binaryTestObjects(t testing.TB) []Object {
+	objects := make([]Object, 0, len(binaryTestEntryTypes))
+	for _, entryType := range binaryTestEntryTypes {
+		object, err := EmptyLedgerObject(string(entryType))
+		require.NoError(t, err)
+		binaryfill.Fill(object, func(name string, field reflect.Value) bool {
+			switch name {
+			case "Index":
+				// Index is the key of the object, not one of its fields.
+				return true
+			case "NFTokenURI":
+				// NFTokenURI is the URI field.
+				field.SetString("DEADBEEF")
+				return true
+			}
+			return false
+		})
+		if field := reflect.ValueOf(object).Elem().FieldByName("LedgerEntryType"); field.IsValid() {
+			field.Set(reflect.ValueOf(entryType).Convert(field.Type()))
+		}
+		objects = append(objects, object)
+	}
+	return objects
+}
+
+// binaryCodecForm turns the JSON form of a ledger object into the form binarycodec.Encode
+// takes: without index, with UInt64 fields as hex strings and UInt32 fields as numbers, and
+// with every NFToken wrapped in an NFToken object the way rippled returns them.
+func binaryCodecForm(t *testing.T, object Object) map[string]any {
+	data, err := json.Marshal(object)
+	require.NoError(t, err)
+	var flat map[string]any
+	require.NoError(t, json.Unmarshal(data, &flat))
+	delete(flat, "index")
+	flat["LedgerEntryType"] = string(object.EntryType())
+	normalizeBinaryCodecForm(t, flat)
+	return flat
+}
+
+func normalizeBinaryCodecForm(t *testing.T, fields map[string]any) {
+	for name, value := range fields {
+		typ, _ := definitions.Get().GetTypeNameByFieldName(name)
+		switch v := value.(type) {
+		case float64:
+			if typ == "UInt64" {
+				fields[name] = strconv.FormatUint(uint64(v), 16)
+			}
+		case string:
+			if n, err := strconv.ParseUint(v, 10, 32); err == nil && typ == "UInt32" {
+				fields[name] = n
+			}
+		case map[string]any:
+			normalizeBinaryCodecForm(t, v)
+		case []any:
+			for i, element := range v {
+				inner, ok := element.(map[string]any)
+				if !ok {
+					continue
+				}
+				if name == "NFTokens" {
+					inner = map[string]any{"NFToken": inner}
+					v[i] = inner
+				}
+				normalizeBinaryCodecForm(t, inner)
+			}
+		}
+	}
+}
+
+func TestEncodeBinary_AllEntryTypes(t *testing.T) {
+	for _, object := range binaryTestObjects(t) {
+		t.Run("pass - "+string(object.EntryType()), func(t *testing.T) {
+			require.Implements(t, (*BinaryEncoder)(nil), object)
+			require.Implements(t, (*BinaryDecoder)(nil), object)
+
+			expected, err := binarycodec.Encode(binaryCodecForm(t, object))
+			require.NoError(t, err)
+			e := binarycodec.NewEncoder()
+			object.(BinaryEncoder).EncodeBinary(e)
+			b, err := e.Bytes()
+			require.NoError(t, err)
+			require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)), "EncodeBinary must match binarycodec.Encode")
+
+			decoded, err := EmptyLedgerObject(string(object.EntryType()))
+			require.NoError(t, err)
+			require.NoError(t, decoded.(BinaryDecoder).DecodeBinary(binarycodec.NewDecoder(b)))
+			require.Equal(t, object, decoded)
+		})
+	}
+}
+
+func BenchmarkEncodeBinary(b *testing.B) {
+	for _, object := range binaryTestObjects(b) {
+		b.Run(string(object.EntryType()), func(b *testing.B) {
+			b.ReportAllocs()
+			e := binarycodec.NewEncoder()
+			for b.Loop() {
+				e.Reset()
+				object.(BinaryEncoder).EncodeBinary(e)
+				if _, err := e.Bytes(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeBinary(b *testing.B) {
+	for _, object := range binaryTestObjects(b) {
+		e := binarycodec.NewEncoder()
+		object.(BinaryEncoder).EncodeBinary(e)
+		data, err := e.Bytes()
+		require.NoError(b, err)
+
+		b.Run(string(object.EntryType()), func(b *testing.B) {
+			b.ReportAllocs()
+			d := binarycodec.NewDecoder(data)
+			for b.Loop() {
+				d.Reset(data)
+				decoded, _ := EmptyLedgerObject(string(object.EntryType()))
+				if err := decoded.(BinaryDecoder).DecodeBinary(d); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // Bridge ledger entry represents a single cross-chain bridge that connects the XRP Ledger with
 // another blockchain, such as its sidechain, and enables value in the form of XRP and other tokens (IOUs) to move efficiently between the two blockchains.
@@ -67,7 +72,77 @@ type Bridge struct {
 	XChainClaimID string
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for Bridge.
+func (b *Bridge) UnmarshalJSON(data []byte) error {
+	type Alias Bridge
+	aux := &struct {
+		MinAccountCreateAmount json.RawMessage
+		SignatureReward        json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(b),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if b.MinAccountCreateAmount, err = types.UnmarshalCurrencyAmount(aux.MinAccountCreateAmount); err != nil {
+		return err
+	}
+	if b.SignatureReward, err = types.UnmarshalCurrencyAmount(aux.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // EntryType returns the type of the ledger entry.
 func (*Bridge) EntryType() EntryType {
 	return BridgeEntry
 }
+
+// EncodeBinary writes the Bridge to e, without going through JSON and the map-based codec.
+func (b *Bridge) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(BridgeEntry))
+	e.UInt32("Flags", b.Flags)
+	e.AccountID("Account", b.Account.String())
+	if b.MinAccountCreateAmount != nil {
+		encodeBinaryAmount(e, "MinAccountCreateAmount", b.MinAccountCreateAmount)
+	}
+	if b.SignatureReward != nil {
+		encodeBinaryAmount(e, "SignatureReward", b.SignatureReward)
+	}
+	encodeBinaryUInt64(e, "XChainAccountClaimCount", b.XChainAccountClaimCount)
+	encodeBinaryUInt64(e, "XChainAccountCreateCount", b.XChainAccountCreateCount)
+	encodeBinaryBridge(e, b.XChainBridge)
+	encodeBinaryUInt64(e, "XChainClaimID", b.XChainClaimID)
+}
+
+// DecodeBinary reads the Bridge from d, skipping fields a Bridge does not have.
+func (b *Bridge) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			b.LedgerEntryType = d.LedgerEntryType()
+		case "Flags":
+			b.Flags = d.UInt32()
+		case "Account":
+			b.Account = types.Address(d.AccountID())
+		case "MinAccountCreateAmount":
+			b.MinAccountCreateAmount = decodeBinaryAmount(d)
+		case "SignatureReward":
+			b.SignatureReward = decodeBinaryAmount(d)
+		case "XChainAccountClaimCount":
+			b.XChainAccountClaimCount = decodeBinaryUInt64(d)
+		case "XChainAccountCreateCount":
+			b.XChainAccountCreateCount = decodeBinaryUInt64(d)
+		case "XChainBridge":
+			b.XChainBridge = decodeBinaryBridge(d)
+		case "XChainClaimID":
+			b.XChainClaimID = decodeBinaryUInt64(d)
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
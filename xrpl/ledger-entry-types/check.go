@@ -1,6 +1,11 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // Check represents a check ledger entry, similar to a paper personal check, which can be cashed by its destination to debit the sender's balance. (Added by the Checks amendment.)
 type Check struct {
@@ -43,7 +48,96 @@ type Check struct {
 	SourceTag uint32 `json:",omitempty"`
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for Check.
+func (c *Check) UnmarshalJSON(data []byte) error {
+	type Alias Check
+	aux := &struct {
+		SendMax json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if c.SendMax, err = types.UnmarshalCurrencyAmount(aux.SendMax); err != nil {
+		return err
+	}
+	return nil
+}
+
 // EntryType returns the ledger entry type for Check.
 func (*Check) EntryType() EntryType {
 	return CheckEntry
 }
+
+// EncodeBinary writes the Check to e, without going through JSON and the map-based codec.
+func (c *Check) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(CheckEntry))
+	e.UInt32("Flags", c.Flags)
+	e.AccountID("Account", c.Account.String())
+	e.AccountID("Destination", c.Destination.String())
+	if c.DestinationNode != "" {
+		encodeBinaryUInt64(e, "DestinationNode", c.DestinationNode)
+	}
+	if c.DestinationTag != 0 {
+		e.UInt32("DestinationTag", c.DestinationTag)
+	}
+	if c.Expiration != 0 {
+		e.UInt32("Expiration", c.Expiration)
+	}
+	if c.InvoiceID != "" {
+		e.Hash256("InvoiceID", c.InvoiceID.String())
+	}
+	encodeBinaryUInt64(e, "OwnerNode", c.OwnerNode)
+	e.Hash256("PreviousTxnID", c.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", c.PreviousTxnLgrSeq)
+	if c.SendMax != nil {
+		encodeBinaryAmount(e, "SendMax", c.SendMax)
+	}
+	e.UInt32("Sequence", c.Sequence)
+	if c.SourceTag != 0 {
+		e.UInt32("SourceTag", c.SourceTag)
+	}
+}
+
+// DecodeBinary reads the Check from d, skipping fields a Check does not have.
+func (c *Check) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			c.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			c.Flags = d.UInt32()
+		case "Account":
+			c.Account = types.Address(d.AccountID())
+		case "Destination":
+			c.Destination = types.Address(d.AccountID())
+		case "DestinationNode":
+			c.DestinationNode = decodeBinaryUInt64(d)
+		case "DestinationTag":
+			c.DestinationTag = d.UInt32()
+		case "Expiration":
+			c.Expiration = d.UInt32()
+		case "InvoiceID":
+			c.InvoiceID = types.Hash256(d.Hash())
+		case "OwnerNode":
+			c.OwnerNode = decodeBinaryUInt64(d)
+		case "PreviousTxnID":
+			c.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			c.PreviousTxnLgrSeq = d.UInt32()
+		case "SendMax":
+			c.SendMax = decodeBinaryAmount(d)
+		case "Sequence":
+			c.Sequence = d.UInt32()
+		case "SourceTag":
+			c.SourceTag = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
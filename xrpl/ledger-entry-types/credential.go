@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 const (
 	// LsfAccepted if enabled, the subject of the credential has accepted the credential.
@@ -49,3 +53,55 @@ func (*Credential) EntryType() EntryType {
 func (c *Credential) SetLsfAccepted() {
 	c.Flags |= LsfAccepted
 }
+
+// EncodeBinary writes the Credential to e, without going through JSON and the map-based codec.
+func (c *Credential) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(CredentialEntry))
+	e.UInt32("Flags", c.Flags)
+	e.Blob("CredentialType", c.CredentialType.String())
+	if c.Expiration != 0 {
+		e.UInt32("Expiration", c.Expiration)
+	}
+	e.AccountID("Issuer", c.Issuer.String())
+	encodeBinaryUInt64(e, "IssuerNode", c.IssuerNode)
+	e.Hash256("PreviousTxnID", c.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", c.PreviousTxnLgrSeq)
+	e.AccountID("Subject", c.Subject.String())
+	encodeBinaryUInt64(e, "SubjectNode", c.SubjectNode)
+	if c.URI != "" {
+		e.Blob("URI", c.URI)
+	}
+}
+
+// DecodeBinary reads the Credential from d, skipping fields a Credential does not have.
+func (c *Credential) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			c.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			c.Flags = d.UInt32()
+		case "CredentialType":
+			c.CredentialType = types.CredentialType(d.Blob())
+		case "Expiration":
+			c.Expiration = d.UInt32()
+		case "Issuer":
+			c.Issuer = types.Address(d.AccountID())
+		case "IssuerNode":
+			c.IssuerNode = decodeBinaryUInt64(d)
+		case "PreviousTxnID":
+			c.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			c.PreviousTxnLgrSeq = d.UInt32()
+		case "Subject":
+			c.Subject = types.Address(d.AccountID())
+		case "SubjectNode":
+			c.SubjectNode = decodeBinaryUInt64(d)
+		case "URI":
+			c.URI = d.Blob()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
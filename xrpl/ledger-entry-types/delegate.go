@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // Delegate entry type represents a set of permissions that an account has delegated to another account.
 // This allows one account to authorize another account to perform specific transactions on its behalf.
@@ -48,3 +52,61 @@ type Delegate struct {
 func (*Delegate) EntryType() EntryType {
 	return DelegateEntry
 }
+
+// EncodeBinary writes the Delegate to e, without going through JSON and the map-based codec.
+func (d *Delegate) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(DelegateEntry))
+	e.UInt32("Flags", d.Flags)
+	e.AccountID("Account", d.Account.String())
+	e.AccountID("Authorize", d.Authorize.String())
+	if d.Permissions != nil {
+		e.BeginArray("Permissions")
+		for _, permission := range d.Permissions {
+			e.BeginObject("Permission")
+			e.PermissionValue("PermissionValue", permission.Permission.PermissionValue)
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+	encodeBinaryUInt64(e, "OwnerNode", d.OwnerNode)
+	e.Hash256("PreviousTxnID", d.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", d.PreviousTxnLgrSeq)
+}
+
+// DecodeBinary reads the Delegate from dec, skipping fields a Delegate does not have.
+func (d *Delegate) DecodeBinary(dec *binarycodec.Decoder) error {
+	for dec.Next() {
+		switch dec.Field() {
+		case "LedgerEntryType":
+			d.LedgerEntryType = EntryType(dec.LedgerEntryType())
+		case "Flags":
+			d.Flags = dec.UInt32()
+		case "Account":
+			d.Account = types.Address(dec.AccountID())
+		case "Authorize":
+			d.Authorize = types.Address(dec.AccountID())
+		case "Permissions":
+			d.Permissions = []types.Permission{}
+			for dec.Next() {
+				var permission types.PermissionValue
+				for dec.Next() {
+					if dec.Field() == "PermissionValue" {
+						permission.PermissionValue = dec.PermissionValue()
+					} else {
+						dec.Skip()
+					}
+				}
+				d.Permissions = append(d.Permissions, types.Permission{Permission: permission})
+			}
+		case "OwnerNode":
+			d.OwnerNode = decodeBinaryUInt64(dec)
+		case "PreviousTxnID":
+			d.PreviousTxnID = types.Hash256(dec.Hash())
+		case "PreviousTxnLgrSeq":
+			d.PreviousTxnLgrSeq = dec.UInt32()
+		default:
+			dec.Skip()
+		}
+	}
+	return dec.Err()
+}
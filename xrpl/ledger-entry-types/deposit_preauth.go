@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -54,3 +55,39 @@ type DepositPreauthObj struct {
 func (*DepositPreauthObj) EntryType() EntryType {
 	return DepositPreauthObjEntry
 }
+
+// EncodeBinary writes the DepositPreauthObj to e, without going through JSON and the map-based codec.
+func (d *DepositPreauthObj) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(DepositPreauthObjEntry))
+	e.UInt32("Flags", d.Flags)
+	e.AccountID("Account", d.Account.String())
+	e.AccountID("Authorize", d.Authorize.String())
+	encodeBinaryUInt64(e, "OwnerNode", d.OwnerNode)
+	e.Hash256("PreviousTxnID", d.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", d.PreviousTxnLgrSeq)
+}
+
+// DecodeBinary reads the DepositPreauthObj from dec, skipping fields a DepositPreauthObj does not have.
+func (d *DepositPreauthObj) DecodeBinary(dec *binarycodec.Decoder) error {
+	for dec.Next() {
+		switch dec.Field() {
+		case "Flags":
+			d.Flags = dec.UInt32()
+		case "LedgerEntryType":
+			d.LedgerEntryType = EntryType(dec.LedgerEntryType())
+		case "Account":
+			d.Account = types.Address(dec.AccountID())
+		case "Authorize":
+			d.Authorize = types.Address(dec.AccountID())
+		case "OwnerNode":
+			d.OwnerNode = decodeBinaryUInt64(dec)
+		case "PreviousTxnID":
+			d.PreviousTxnID = types.Hash256(dec.Hash())
+		case "PreviousTxnLgrSeq":
+			d.PreviousTxnLgrSeq = dec.UInt32()
+		default:
+			dec.Skip()
+		}
+	}
+	return dec.Err()
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // DID ledger entry holds references to, or data associated with, a single DID.
 // Requires the "did" amendment to be enabled.
@@ -54,3 +58,51 @@ type DID struct {
 func (*DID) EntryType() EntryType {
 	return DIDEntry
 }
+
+// EncodeBinary writes the DID to e, without going through JSON and the map-based codec.
+func (d *DID) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(DIDEntry))
+	e.UInt32("Flags", d.Flags)
+	e.AccountID("Account", d.Account.String())
+	if d.DIDDocument != "" {
+		e.Blob("DIDDocument", d.DIDDocument)
+	}
+	if d.Data != "" {
+		e.Blob("Data", d.Data)
+	}
+	encodeBinaryUInt64(e, "OwnerNode", d.OwnerNode)
+	e.Hash256("PreviousTxnID", d.PreviousTxnID)
+	e.UInt32("PreviousTxnLgrSeq", d.PreviousTxnLgrSeq)
+	if d.URI != "" {
+		e.Blob("URI", d.URI)
+	}
+}
+
+// DecodeBinary reads the DID from dec, skipping fields a DID does not have.
+func (d *DID) DecodeBinary(dec *binarycodec.Decoder) error {
+	for dec.Next() {
+		switch dec.Field() {
+		case "LedgerEntryType":
+			d.LedgerEntryType = EntryType(dec.LedgerEntryType())
+		case "Flags":
+			d.Flags = dec.UInt32()
+		case "Account":
+			d.Account = types.Address(dec.AccountID())
+		case "DIDDocument":
+			d.DIDDocument = dec.Blob()
+		case "Data":
+			d.Data = dec.Blob()
+		case "OwnerNode":
+			d.OwnerNode = decodeBinaryUInt64(dec)
+		case "PreviousTxnID":
+			d.PreviousTxnID = dec.Hash()
+		case "PreviousTxnLgrSeq":
+			d.PreviousTxnLgrSeq = dec.UInt32()
+		case "URI":
+			d.URI = dec.Blob()
+		default:
+			dec.Skip()
+		}
+	}
+	return dec.Err()
+}
@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -100,3 +101,102 @@ func (d *DirectoryNode) SetNFTokenBuyOffers() {
 func (d *DirectoryNode) SetNFTokenSellOffers() {
 	d.Flags |= LsfNFTokenSellOffers
 }
+
+// EncodeBinary writes the DirectoryNode to e, without going through JSON and the map-based codec.
+func (d *DirectoryNode) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(DirectoryNodeEntry))
+	if d.ExchangeRate != "" {
+		encodeBinaryUInt64(e, "ExchangeRate", d.ExchangeRate)
+	}
+	e.UInt32("Flags", d.Flags)
+	if d.Indexes != nil {
+		encodeBinaryHashes(e, "Indexes", d.Indexes)
+	}
+	if d.IndexNext != "" {
+		encodeBinaryUInt64(e, "IndexNext", d.IndexNext)
+	}
+	if d.IndexPrevious != "" {
+		encodeBinaryUInt64(e, "IndexPrevious", d.IndexPrevious)
+	}
+	if d.NFTokenID != "" {
+		e.Hash256("NFTokenID", d.NFTokenID.String())
+	}
+	if d.Owner != "" {
+		e.AccountID("Owner", d.Owner.String())
+	}
+	if d.PreviousTxnID != "" {
+		e.Hash256("PreviousTxnID", d.PreviousTxnID.String())
+	}
+	if d.PreviousTxnLgrSeq != 0 {
+		e.UInt32("PreviousTxnLgrSeq", d.PreviousTxnLgrSeq)
+	}
+	e.Hash256("RootIndex", d.RootIndex.String())
+	if d.TakerGetsCurrency != "" {
+		e.Hash160("TakerGetsCurrency", d.TakerGetsCurrency)
+	}
+	if d.TakerGetsIssuer != "" {
+		e.Hash160("TakerGetsIssuer", d.TakerGetsIssuer)
+	}
+	if d.TakerGetsMPT != "" {
+		e.Hash192("TakerGetsMPT", d.TakerGetsMPT.String())
+	}
+	if d.TakerPaysCurrency != "" {
+		e.Hash160("TakerPaysCurrency", d.TakerPaysCurrency)
+	}
+	if d.TakerPaysIssuer != "" {
+		e.Hash160("TakerPaysIssuer", d.TakerPaysIssuer)
+	}
+	if d.TakerPaysMPT != "" {
+		e.Hash192("TakerPaysMPT", d.TakerPaysMPT.String())
+	}
+	if d.DomainID != "" {
+		e.Hash256("DomainID", d.DomainID)
+	}
+}
+
+// DecodeBinary reads the DirectoryNode from dec, skipping fields a DirectoryNode does not have.
+func (d *DirectoryNode) DecodeBinary(dec *binarycodec.Decoder) error {
+	for dec.Next() {
+		switch dec.Field() {
+		case "ExchangeRate":
+			d.ExchangeRate = decodeBinaryUInt64(dec)
+		case "Flags":
+			d.Flags = dec.UInt32()
+		case "Indexes":
+			d.Indexes = decodeBinaryHashes(dec)
+		case "IndexNext":
+			d.IndexNext = decodeBinaryUInt64(dec)
+		case "IndexPrevious":
+			d.IndexPrevious = decodeBinaryUInt64(dec)
+		case "LedgerEntryType":
+			d.LedgerEntryType = EntryType(dec.LedgerEntryType())
+		case "NFTokenID":
+			d.NFTokenID = types.Hash256(dec.Hash())
+		case "Owner":
+			d.Owner = types.Address(dec.AccountID())
+		case "PreviousTxnID":
+			d.PreviousTxnID = types.Hash256(dec.Hash())
+		case "PreviousTxnLgrSeq":
+			d.PreviousTxnLgrSeq = dec.UInt32()
+		case "RootIndex":
+			d.RootIndex = types.Hash256(dec.Hash())
+		case "TakerGetsCurrency":
+			d.TakerGetsCurrency = dec.Hash()
+		case "TakerGetsIssuer":
+			d.TakerGetsIssuer = dec.Hash()
+		case "TakerGetsMPT":
+			d.TakerGetsMPT = types.Hash192(dec.Hash())
+		case "TakerPaysCurrency":
+			d.TakerPaysCurrency = dec.Hash()
+		case "TakerPaysIssuer":
+			d.TakerPaysIssuer = dec.Hash()
+		case "TakerPaysMPT":
+			d.TakerPaysMPT = types.Hash192(dec.Hash())
+		case "DomainID":
+			d.DomainID = dec.Hash()
+		default:
+			dec.Skip()
+		}
+	}
+	return dec.Err()
+}
@@ -10,6 +10,8 @@ var (
 
 	// ErrUnsupportedLedgerObjectType is returned when an unsupported ledger object type is encountered.
 	ErrUnsupportedLedgerObjectType = errors.New("unsupported ledger object type")
+	// ErrMissingLedgerEntryType is returned when a ledger object has no LedgerEntryType field.
+	ErrMissingLedgerEntryType = errors.New("missing LedgerEntryType in ledger object")
 
 	// oracle
 
@@ -3,6 +3,7 @@ package ledger
 import (
 	"encoding/json"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -133,3 +134,84 @@ func (e *Escrow) UnmarshalJSON(data []byte) error {
 	e.Amount = amount
 	return nil
 }
+
+// EncodeBinary writes the Escrow to enc, without going through JSON and the map-based codec.
+func (e *Escrow) EncodeBinary(enc *binarycodec.Encoder) {
+	enc.LedgerEntryType(string(EscrowEntry))
+	enc.UInt32("Flags", e.Flags)
+	enc.AccountID("Account", e.Account.String())
+	if e.Amount != nil {
+		encodeBinaryAmount(enc, "Amount", e.Amount)
+	}
+	if e.CancelAfter != 0 {
+		enc.UInt32("CancelAfter", e.CancelAfter)
+	}
+	if e.Condition != "" {
+		enc.Blob("Condition", e.Condition)
+	}
+	enc.AccountID("Destination", e.Destination.String())
+	if e.DestinationNode != "" {
+		encodeBinaryUInt64(enc, "DestinationNode", e.DestinationNode)
+	}
+	if e.DestinationTag != 0 {
+		enc.UInt32("DestinationTag", e.DestinationTag)
+	}
+	if e.FinishAfter != 0 {
+		enc.UInt32("FinishAfter", e.FinishAfter)
+	}
+	encodeBinaryUInt64(enc, "OwnerNode", e.OwnerNode)
+	enc.Hash256("PreviousTxnID", e.PreviousTxnID.String())
+	enc.UInt32("PreviousTxnLgrSeq", e.PreviousTxnLgrSeq)
+	if e.SourceTag != 0 {
+		enc.UInt32("SourceTag", e.SourceTag)
+	}
+	if e.TransferRate != 0 {
+		enc.UInt32("TransferRate", e.TransferRate)
+	}
+	if e.IssuerNode != 0 {
+		enc.UInt64("IssuerNode", e.IssuerNode)
+	}
+}
+
+// DecodeBinary reads the Escrow from d, skipping fields an Escrow does not have.
+func (e *Escrow) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			e.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			e.Flags = d.UInt32()
+		case "Account":
+			e.Account = types.Address(d.AccountID())
+		case "Amount":
+			e.Amount = decodeBinaryAmount(d)
+		case "CancelAfter":
+			e.CancelAfter = d.UInt32()
+		case "Condition":
+			e.Condition = d.Blob()
+		case "Destination":
+			e.Destination = types.Address(d.AccountID())
+		case "DestinationNode":
+			e.DestinationNode = decodeBinaryUInt64(d)
+		case "DestinationTag":
+			e.DestinationTag = d.UInt32()
+		case "FinishAfter":
+			e.FinishAfter = d.UInt32()
+		case "OwnerNode":
+			e.OwnerNode = decodeBinaryUInt64(d)
+		case "PreviousTxnID":
+			e.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			e.PreviousTxnLgrSeq = d.UInt32()
+		case "SourceTag":
+			e.SourceTag = d.UInt32()
+		case "TransferRate":
+			e.TransferRate = d.UInt32()
+		case "IssuerNode":
+			e.IssuerNode = d.UInt64()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // FeeSettings entry contains the current base transaction cost and reserve amounts as determined by fee voting.
 // Each ledger version contains at most one FeeSettings entry.
@@ -47,3 +51,46 @@ type FeeSettings struct {
 func (*FeeSettings) EntryType() EntryType {
 	return FeeSettingsEntry
 }
+
+// EncodeBinary writes the FeeSettings to e, without going through JSON and the map-based codec.
+func (f *FeeSettings) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(FeeSettingsEntry))
+	e.UInt32("Flags", f.Flags)
+	encodeBinaryUInt64(e, "BaseFee", f.BaseFee)
+	e.UInt32("ReferenceFeeUnits", f.ReferenceFeeUnits)
+	e.UInt32("ReserveBase", f.ReserveBase)
+	e.UInt32("ReserveIncrement", f.ReserveIncrement)
+	if f.PreviousTxnID != "" {
+		e.Hash256("PreviousTxnID", f.PreviousTxnID.String())
+	}
+	if f.PreviousTxnLgrSeq != 0 {
+		e.UInt32("PreviousTxnLgrSeq", f.PreviousTxnLgrSeq)
+	}
+}
+
+// DecodeBinary reads the FeeSettings from d, skipping fields a FeeSettings does not have.
+func (f *FeeSettings) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Flags":
+			f.Flags = d.UInt32()
+		case "LedgerEntryType":
+			f.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "BaseFee":
+			f.BaseFee = decodeBinaryUInt64(d)
+		case "ReferenceFeeUnits":
+			f.ReferenceFeeUnits = d.UInt32()
+		case "ReserveBase":
+			f.ReserveBase = d.UInt32()
+		case "ReserveIncrement":
+			f.ReserveIncrement = d.UInt32()
+		case "PreviousTxnID":
+			f.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			f.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // Hashes represents a LedgerHashes object that contains a history of prior ledgers.
 // (Not to be confused with the "ledger hash" string data type, which uniquely identifies a ledger version.
@@ -65,3 +69,39 @@ type Hashes struct {
 func (*Hashes) EntryType() EntryType {
 	return LedgerHashesEntry
 }
+
+// EncodeBinary writes the Hashes to e, without going through JSON and the map-based codec.
+func (h *Hashes) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(LedgerHashesEntry))
+	e.UInt32("Flags", h.Flags)
+	if h.FirstLedgerSequence != 0 {
+		e.UInt32("FirstLedgerSequence", h.FirstLedgerSequence)
+	}
+	if h.Hashes != nil {
+		encodeBinaryHashes(e, "Hashes", h.Hashes)
+	}
+	if h.LastLedgerSequence != 0 {
+		e.UInt32("LastLedgerSequence", h.LastLedgerSequence)
+	}
+}
+
+// DecodeBinary reads the Hashes from d, skipping fields a Hashes object does not have.
+func (h *Hashes) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Flags":
+			h.Flags = d.UInt32()
+		case "LedgerEntryType":
+			h.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "FirstLedgerSequence":
+			h.FirstLedgerSequence = d.UInt32()
+		case "Hashes":
+			h.Hashes = decodeBinaryHashes(d)
+		case "LastLedgerSequence":
+			h.LastLedgerSequence = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,6 +1,9 @@
 package ledger
 
 import (
+	"strconv"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -127,3 +130,146 @@ func (l *Loan) SetLsfLoanImpaired() {
 func (l *Loan) SetLsfLoanOverpayment() {
 	l.Flags |= LsfLoanOverpayment
 }
+
+// EncodeBinary writes the Loan to e, without going through JSON and the map-based codec.
+func (l *Loan) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(LoanEntry))
+	e.UInt32("Flags", l.Flags)
+	e.UInt32("LoanSequence", l.LoanSequence)
+	encodeBinaryUInt64(e, "OwnerNode", l.OwnerNode)
+	encodeBinaryUInt64(e, "LoanBrokerNode", l.LoanBrokerNode)
+	e.Hash256("LoanBrokerID", l.LoanBrokerID.String())
+	e.AccountID("Borrower", l.Borrower.String())
+	e.Number("TotalValueOutstanding", l.TotalValueOutstanding.String())
+	e.Number("PrincipalOutstanding", l.PrincipalOutstanding.String())
+	if l.ManagementFeeOutstanding != nil {
+		e.Number("ManagementFeeOutstanding", l.ManagementFeeOutstanding.String())
+	}
+	e.Number("PeriodicPayment", l.PeriodicPayment.String())
+	if l.LoanScale != nil {
+		e.Int32("LoanScale", *l.LoanScale)
+	}
+	if l.LoanOriginationFee != nil {
+		e.Number("LoanOriginationFee", l.LoanOriginationFee.String())
+	}
+	if l.LoanServiceFee != nil {
+		e.Number("LoanServiceFee", l.LoanServiceFee.String())
+	}
+	if l.LatePaymentFee != nil {
+		e.Number("LatePaymentFee", l.LatePaymentFee.String())
+	}
+	if l.ClosePaymentFee != nil {
+		e.Number("ClosePaymentFee", l.ClosePaymentFee.String())
+	}
+	if l.OverpaymentFee != nil {
+		// OverpaymentFee is a UInt32 field on ledger, carried as a number string here.
+		if fee, err := strconv.ParseUint(l.OverpaymentFee.String(), 10, 32); err == nil {
+			e.UInt32("OverpaymentFee", uint32(fee))
+		} else {
+			e.Value("OverpaymentFee", l.OverpaymentFee.String())
+		}
+	}
+	if l.InterestRate != nil {
+		e.UInt32("InterestRate", uint32(*l.InterestRate))
+	}
+	if l.LateInterestRate != nil {
+		e.UInt32("LateInterestRate", uint32(*l.LateInterestRate))
+	}
+	if l.CloseInterestRate != nil {
+		e.UInt32("CloseInterestRate", uint32(*l.CloseInterestRate))
+	}
+	if l.OverpaymentInterestRate != nil {
+		e.UInt32("OverpaymentInterestRate", uint32(*l.OverpaymentInterestRate))
+	}
+	e.UInt32("StartDate", l.StartDate)
+	e.UInt32("PaymentInterval", l.PaymentInterval)
+	e.UInt32("GracePeriod", l.GracePeriod)
+	if l.PreviousPaymentDueDate != nil {
+		e.UInt32("PreviousPaymentDueDate", uint32(*l.PreviousPaymentDueDate))
+	}
+	e.UInt32("NextPaymentDueDate", l.NextPaymentDueDate)
+	e.UInt32("PaymentRemaining", l.PaymentRemaining)
+	e.Hash256("PreviousTxnID", l.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", l.PreviousTxnLgrSeq)
+}
+
+// DecodeBinary reads the Loan from d, skipping fields a Loan does not have.
+func (l *Loan) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			l.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			l.Flags = d.UInt32()
+		case "LoanSequence":
+			l.LoanSequence = d.UInt32()
+		case "OwnerNode":
+			l.OwnerNode = decodeBinaryUInt64(d)
+		case "LoanBrokerNode":
+			l.LoanBrokerNode = decodeBinaryUInt64(d)
+		case "LoanBrokerID":
+			l.LoanBrokerID = types.Hash256(d.Hash())
+		case "Borrower":
+			l.Borrower = types.Address(d.AccountID())
+		case "TotalValueOutstanding":
+			l.TotalValueOutstanding = types.XRPLNumber(d.Number())
+		case "PrincipalOutstanding":
+			l.PrincipalOutstanding = types.XRPLNumber(d.Number())
+		case "ManagementFeeOutstanding":
+			managementFeeOutstanding := types.XRPLNumber(d.Number())
+			l.ManagementFeeOutstanding = &managementFeeOutstanding
+		case "PeriodicPayment":
+			l.PeriodicPayment = types.XRPLNumber(d.Number())
+		case "LoanScale":
+			loanScale := d.Int32()
+			l.LoanScale = &loanScale
+		case "LoanOriginationFee":
+			loanOriginationFee := types.XRPLNumber(d.Number())
+			l.LoanOriginationFee = &loanOriginationFee
+		case "LoanServiceFee":
+			loanServiceFee := types.XRPLNumber(d.Number())
+			l.LoanServiceFee = &loanServiceFee
+		case "LatePaymentFee":
+			latePaymentFee := types.XRPLNumber(d.Number())
+			l.LatePaymentFee = &latePaymentFee
+		case "ClosePaymentFee":
+			closePaymentFee := types.XRPLNumber(d.Number())
+			l.ClosePaymentFee = &closePaymentFee
+		case "OverpaymentFee":
+			overpaymentFee := types.XRPLNumber(strconv.FormatUint(uint64(d.UInt32()), 10))
+			l.OverpaymentFee = &overpaymentFee
+		case "InterestRate":
+			interestRate := types.InterestRate(d.UInt32())
+			l.InterestRate = &interestRate
+		case "LateInterestRate":
+			lateInterestRate := types.InterestRate(d.UInt32())
+			l.LateInterestRate = &lateInterestRate
+		case "CloseInterestRate":
+			closeInterestRate := types.InterestRate(d.UInt32())
+			l.CloseInterestRate = &closeInterestRate
+		case "OverpaymentInterestRate":
+			overpaymentInterestRate := types.InterestRate(d.UInt32())
+			l.OverpaymentInterestRate = &overpaymentInterestRate
+		case "StartDate":
+			l.StartDate = d.UInt32()
+		case "PaymentInterval":
+			l.PaymentInterval = d.UInt32()
+		case "GracePeriod":
+			l.GracePeriod = d.UInt32()
+		case "PreviousPaymentDueDate":
+			previousPaymentDueDate := types.PreviousPaymentDueDate(d.UInt32())
+			l.PreviousPaymentDueDate = &previousPaymentDueDate
+		case "NextPaymentDueDate":
+			l.NextPaymentDueDate = d.UInt32()
+		case "PaymentRemaining":
+			l.PaymentRemaining = d.UInt32()
+		case "PreviousTxnID":
+			l.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			l.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
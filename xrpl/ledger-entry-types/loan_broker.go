@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -76,3 +77,95 @@ type LoanBroker struct {
 func (*LoanBroker) EntryType() EntryType {
 	return LoanBrokerEntry
 }
+
+// EncodeBinary writes the LoanBroker to e, without going through JSON and the map-based codec.
+func (l *LoanBroker) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(LoanBrokerEntry))
+	e.UInt32("Flags", l.Flags)
+	e.UInt32("Sequence", l.Sequence)
+	e.UInt32("LoanSequence", l.LoanSequence)
+	encodeBinaryUInt64(e, "OwnerNode", l.OwnerNode)
+	encodeBinaryUInt64(e, "VaultNode", l.VaultNode)
+	e.Hash256("VaultID", l.VaultID.String())
+	e.AccountID("Account", l.Account.String())
+	e.AccountID("Owner", l.Owner.String())
+	if l.OwnerCount != nil {
+		e.UInt32("OwnerCount", uint32(*l.OwnerCount))
+	}
+	if l.Data != "" {
+		e.Blob("Data", l.Data)
+	}
+	if l.ManagementFeeRate != nil {
+		e.UInt16("ManagementFeeRate", *l.ManagementFeeRate)
+	}
+	if l.DebtTotal != nil {
+		e.Number("DebtTotal", l.DebtTotal.String())
+	}
+	e.Number("DebtMaximum", l.DebtMaximum.String())
+	if l.CoverAvailable != nil {
+		e.Number("CoverAvailable", l.CoverAvailable.String())
+	}
+	if l.CoverRateMinimum != nil {
+		e.UInt32("CoverRateMinimum", uint32(*l.CoverRateMinimum))
+	}
+	if l.CoverRateLiquidation != nil {
+		e.UInt32("CoverRateLiquidation", uint32(*l.CoverRateLiquidation))
+	}
+	e.Hash256("PreviousTxnID", l.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", l.PreviousTxnLgrSeq)
+}
+
+// DecodeBinary reads the LoanBroker from d, skipping fields a LoanBroker does not have.
+func (l *LoanBroker) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			l.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			l.Flags = d.UInt32()
+		case "Sequence":
+			l.Sequence = d.UInt32()
+		case "LoanSequence":
+			l.LoanSequence = d.UInt32()
+		case "OwnerNode":
+			l.OwnerNode = decodeBinaryUInt64(d)
+		case "VaultNode":
+			l.VaultNode = decodeBinaryUInt64(d)
+		case "VaultID":
+			l.VaultID = types.Hash256(d.Hash())
+		case "Account":
+			l.Account = types.Address(d.AccountID())
+		case "Owner":
+			l.Owner = types.Address(d.AccountID())
+		case "OwnerCount":
+			ownerCount := types.OwnerCount(d.UInt32())
+			l.OwnerCount = &ownerCount
+		case "Data":
+			l.Data = d.Blob()
+		case "ManagementFeeRate":
+			managementFeeRate := d.UInt16()
+			l.ManagementFeeRate = &managementFeeRate
+		case "DebtTotal":
+			debtTotal := types.XRPLNumber(d.Number())
+			l.DebtTotal = &debtTotal
+		case "DebtMaximum":
+			l.DebtMaximum = types.XRPLNumber(d.Number())
+		case "CoverAvailable":
+			coverAvailable := types.XRPLNumber(d.Number())
+			l.CoverAvailable = &coverAvailable
+		case "CoverRateMinimum":
+			coverRateMinimum := types.CoverRate(d.UInt32())
+			l.CoverRateMinimum = &coverRateMinimum
+		case "CoverRateLiquidation":
+			coverRateLiquidation := types.CoverRate(d.UInt32())
+			l.CoverRateLiquidation = &coverRateLiquidation
+		case "PreviousTxnID":
+			l.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			l.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 const (
 	// LsfMPTLocked if enabled, indicates that the MPT owned by this account is currently locked and cannot be used in any XRP transactions other than sending value back to the issuer.
@@ -60,3 +64,47 @@ func (c *MPToken) SetLsfMPTAuthorized() {
 func (c *MPToken) SetLsfMPTAMM() {
 	c.Flags |= LsfMPTAMM
 }
+
+// EncodeBinary writes the MPToken to e, without going through JSON and the map-based codec.
+func (c *MPToken) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(MPTokenEntry))
+	e.UInt32("Flags", c.Flags)
+	e.AccountID("Account", c.Account.String())
+	e.Hash192("MPTokenIssuanceID", c.MPTokenIssuanceID.String())
+	e.UInt64("MPTAmount", c.MPTAmount)
+	if c.LockedAmount != 0 {
+		e.UInt64("LockedAmount", c.LockedAmount)
+	}
+	e.Hash256("PreviousTxnID", c.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", c.PreviousTxnLgrSeq)
+	e.UInt64("OwnerNode", c.OwnerNode)
+}
+
+// DecodeBinary reads the MPToken from d, skipping fields an MPToken does not have.
+func (c *MPToken) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			c.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			c.Flags = d.UInt32()
+		case "Account":
+			c.Account = types.Address(d.AccountID())
+		case "MPTokenIssuanceID":
+			c.MPTokenIssuanceID = types.Hash192(d.Hash())
+		case "MPTAmount":
+			c.MPTAmount = d.UInt64()
+		case "LockedAmount":
+			c.LockedAmount = d.UInt64()
+		case "PreviousTxnID":
+			c.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			c.PreviousTxnLgrSeq = d.UInt32()
+		case "OwnerNode":
+			c.OwnerNode = d.UInt64()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
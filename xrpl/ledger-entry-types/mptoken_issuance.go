@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -128,3 +129,74 @@ func (c *MPTokenIssuance) SetLsfMPTCanTransfer() {
 func (c *MPTokenIssuance) SetLsfMPTCanClawback() {
 	c.Flags |= LsfMPTCanClawback
 }
+
+// EncodeBinary writes the MPTokenIssuance to e, without going through JSON and the map-based codec.
+func (c *MPTokenIssuance) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(MPTokenIssuanceEntry))
+	e.UInt32("Flags", c.Flags)
+	e.AccountID("Issuer", c.Issuer.String())
+	e.UInt8("AssetScale", c.AssetScale)
+	e.UInt64("MaximumAmount", c.MaximumAmount)
+	e.UInt64("OutstandingAmount", c.OutstandingAmount)
+	e.UInt16("TransferFee", c.TransferFee)
+	e.Blob("MPTokenMetadata", c.MPTokenMetadata)
+	e.UInt64("OwnerNode", c.OwnerNode)
+	e.Hash256("PreviousTxnID", c.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", c.PreviousTxnLgrSeq)
+	e.UInt32("Sequence", c.Sequence)
+	if c.LockedAmount != 0 {
+		e.UInt64("LockedAmount", c.LockedAmount)
+	}
+	if c.DomainID != "" {
+		e.Hash256("DomainID", c.DomainID)
+	}
+	if c.MutableFlags != 0 {
+		e.UInt32("MutableFlags", c.MutableFlags)
+	}
+	if c.ReferenceHolding != "" {
+		e.Hash256("ReferenceHolding", c.ReferenceHolding.String())
+	}
+}
+
+// DecodeBinary reads the MPTokenIssuance from d, skipping fields an MPTokenIssuance does not have.
+func (c *MPTokenIssuance) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			c.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			c.Flags = d.UInt32()
+		case "Issuer":
+			c.Issuer = types.Address(d.AccountID())
+		case "AssetScale":
+			c.AssetScale = d.UInt8()
+		case "MaximumAmount":
+			c.MaximumAmount = d.UInt64()
+		case "OutstandingAmount":
+			c.OutstandingAmount = d.UInt64()
+		case "TransferFee":
+			c.TransferFee = d.UInt16()
+		case "MPTokenMetadata":
+			c.MPTokenMetadata = d.Blob()
+		case "OwnerNode":
+			c.OwnerNode = d.UInt64()
+		case "PreviousTxnID":
+			c.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			c.PreviousTxnLgrSeq = d.UInt32()
+		case "Sequence":
+			c.Sequence = d.UInt32()
+		case "LockedAmount":
+			c.LockedAmount = d.UInt64()
+		case "DomainID":
+			c.DomainID = d.Hash()
+		case "MutableFlags":
+			c.MutableFlags = d.UInt32()
+		case "ReferenceHolding":
+			c.ReferenceHolding = types.Hash256(d.Hash())
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // NegativeUNL represents a ledger entry containing the current status of the Negative UNL,
 // a list of trusted validators currently believed to be offline.
@@ -68,3 +72,70 @@ type DisabledValidator struct {
 	// The master public key of the validator, in hexadecimal.
 	PublicKey string
 }
+
+// EncodeBinary writes the NegativeUNL to e, without going through JSON and the map-based codec.
+func (n *NegativeUNL) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(NegativeUNLEntry))
+	e.UInt32("Flags", n.Flags)
+	if len(n.DisabledValidators) > 0 {
+		e.BeginArray("DisabledValidators")
+		for _, validator := range n.DisabledValidators {
+			e.BeginObject("DisabledValidator")
+			e.UInt32("FirstLedgerSequence", validator.DisabledValidator.FirstLedgerSequence)
+			e.Blob("PublicKey", validator.DisabledValidator.PublicKey)
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+	if n.PreviousTxnID != "" {
+		e.Hash256("PreviousTxnID", n.PreviousTxnID.String())
+	}
+	if n.PreviousTxnLgrSeq != 0 {
+		e.UInt32("PreviousTxnLgrSeq", n.PreviousTxnLgrSeq)
+	}
+	if n.ValidatorToDisable != "" {
+		e.Blob("ValidatorToDisable", n.ValidatorToDisable)
+	}
+	if n.ValidatorToReEnable != "" {
+		e.Blob("ValidatorToReEnable", n.ValidatorToReEnable)
+	}
+}
+
+// DecodeBinary reads the NegativeUNL from d, skipping fields a NegativeUNL does not have.
+func (n *NegativeUNL) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Flags":
+			n.Flags = d.UInt32()
+		case "LedgerEntryType":
+			n.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "DisabledValidators":
+			n.DisabledValidators = nil
+			for d.Next() {
+				var validator DisabledValidator
+				for d.Next() {
+					switch d.Field() {
+					case "FirstLedgerSequence":
+						validator.FirstLedgerSequence = d.UInt32()
+					case "PublicKey":
+						validator.PublicKey = d.Blob()
+					default:
+						d.Skip()
+					}
+				}
+				n.DisabledValidators = append(n.DisabledValidators, DisabledValidatorEntry{DisabledValidator: validator})
+			}
+		case "PreviousTxnID":
+			n.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			n.PreviousTxnLgrSeq = d.UInt32()
+		case "ValidatorToDisable":
+			n.ValidatorToDisable = d.Blob()
+		case "ValidatorToReEnable":
+			n.ValidatorToReEnable = d.Blob()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -3,6 +3,7 @@ package ledger
 import (
 	"encoding/json"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -106,3 +107,61 @@ func (n *NFTokenOffer) UnmarshalJSON(data []byte) error {
 	n.Amount = amnt
 	return nil
 }
+
+// EncodeBinary writes the NFTokenOffer to e, without going through JSON and the map-based codec.
+func (n *NFTokenOffer) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(NFTokenOfferEntry))
+	e.UInt32("Flags", n.Flags)
+	if n.Amount != nil {
+		encodeBinaryAmount(e, "Amount", n.Amount)
+	}
+	if n.Destination != "" {
+		e.AccountID("Destination", n.Destination.String())
+	}
+	if n.Expiration != 0 {
+		e.UInt32("Expiration", n.Expiration)
+	}
+	e.Hash256("NFTokenID", n.NFTokenID.String())
+	if n.NFTokenOfferNode != "" {
+		encodeBinaryUInt64(e, "NFTokenOfferNode", n.NFTokenOfferNode)
+	}
+	e.AccountID("Owner", n.Owner.String())
+	if n.OwnerNode != "" {
+		encodeBinaryUInt64(e, "OwnerNode", n.OwnerNode)
+	}
+	e.Hash256("PreviousTxnID", n.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", n.PreviousTxnLgrSeq)
+}
+
+// DecodeBinary reads the NFTokenOffer from d, skipping fields an NFTokenOffer does not have.
+func (n *NFTokenOffer) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Flags":
+			n.Flags = d.UInt32()
+		case "LedgerEntryType":
+			n.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Amount":
+			n.Amount = decodeBinaryAmount(d)
+		case "Destination":
+			n.Destination = types.Address(d.AccountID())
+		case "Expiration":
+			n.Expiration = d.UInt32()
+		case "NFTokenID":
+			n.NFTokenID = types.Hash256(d.Hash())
+		case "NFTokenOfferNode":
+			n.NFTokenOfferNode = decodeBinaryUInt64(d)
+		case "Owner":
+			n.Owner = types.Address(d.AccountID())
+		case "OwnerNode":
+			n.OwnerNode = decodeBinaryUInt64(d)
+		case "PreviousTxnID":
+			n.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			n.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // NFTokenPage object represents a collection of NFTs owned by the same account.
 // An account can have multiple NFTokenPage entries, which form a doubly linked list.
@@ -59,3 +63,70 @@ type NFTokenPage struct {
 func (*NFTokenPage) EntryType() EntryType {
 	return NFTokenPageEntry
 }
+
+// EncodeBinary writes the NFTokenPage to e, without going through JSON and the map-based codec.
+func (n *NFTokenPage) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(NFTokenPageEntry))
+	e.UInt32("Flags", n.Flags)
+	if n.NextPageMin != "" {
+		e.Hash256("NextPageMin", n.NextPageMin.String())
+	}
+	if n.PreviousPageMin != "" {
+		e.Hash256("PreviousPageMin", n.PreviousPageMin.String())
+	}
+	if n.PreviousTxnID != "" {
+		e.Hash256("PreviousTxnID", n.PreviousTxnID.String())
+	}
+	if n.PreviousTxnLgrSeq != 0 {
+		e.UInt32("PreviousTxnLgrSeq", n.PreviousTxnLgrSeq)
+	}
+	if n.NFTokens != nil {
+		e.BeginArray("NFTokens")
+		for _, token := range n.NFTokens {
+			e.BeginObject("NFToken")
+			e.Hash256("NFTokenID", token.NFTokenID.String())
+			e.Blob("URI", token.NFTokenURI.String())
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+}
+
+// DecodeBinary reads the NFTokenPage from d, skipping fields an NFTokenPage does not have.
+func (n *NFTokenPage) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			n.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			n.Flags = d.UInt32()
+		case "NextPageMin":
+			n.NextPageMin = types.Hash256(d.Hash())
+		case "PreviousPageMin":
+			n.PreviousPageMin = types.Hash256(d.Hash())
+		case "PreviousTxnID":
+			n.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			n.PreviousTxnLgrSeq = d.UInt32()
+		case "NFTokens":
+			n.NFTokens = []types.NFToken{}
+			for d.Next() {
+				var token types.NFToken
+				for d.Next() {
+					switch d.Field() {
+					case "NFTokenID":
+						token.NFTokenID = types.NFTokenID(d.Hash())
+					case "URI":
+						token.NFTokenURI = types.NFTokenURI(d.Blob())
+					default:
+						d.Skip()
+					}
+				}
+				n.NFTokens = append(n.NFTokens, token)
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -3,6 +3,7 @@ package ledger
 import (
 	"encoding/json"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -179,3 +180,92 @@ func (o *Offer) UnmarshalJSON(data []byte) error {
 	o.TakerGets = gets
 	return nil
 }
+
+// EncodeBinary writes the Offer to e, without going through JSON and the map-based codec.
+func (o *Offer) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(OfferEntry))
+	e.UInt32("Flags", o.Flags)
+	e.AccountID("Account", o.Account.String())
+	e.Hash256("BookDirectory", o.BookDirectory.String())
+	encodeBinaryUInt64(e, "BookNode", o.BookNode)
+	if o.Expiration != 0 {
+		e.UInt32("Expiration", o.Expiration)
+	}
+	encodeBinaryUInt64(e, "OwnerNode", o.OwnerNode)
+	e.Hash256("PreviousTxnID", o.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", o.PreviousTxnLgrSeq)
+	e.UInt32("Sequence", o.Sequence)
+	if o.TakerPays != nil {
+		encodeBinaryAmount(e, "TakerPays", o.TakerPays)
+	}
+	if o.TakerGets != nil {
+		encodeBinaryAmount(e, "TakerGets", o.TakerGets)
+	}
+	if o.DomainID != nil {
+		e.Hash256("DomainID", *o.DomainID)
+	}
+	if len(o.AdditionalBooks) > 0 {
+		e.BeginArray("AdditionalBooks")
+		for _, book := range o.AdditionalBooks {
+			e.BeginObject("Book")
+			e.Hash256("BookDirectory", book.Book.BookDirectory)
+			encodeBinaryUInt64(e, "BookNode", book.Book.BookNode)
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+}
+
+// DecodeBinary reads the Offer from d, skipping fields an Offer does not have.
+func (o *Offer) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Flags":
+			o.Flags = d.UInt32()
+		case "LedgerEntryType":
+			o.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Account":
+			o.Account = types.Address(d.AccountID())
+		case "BookDirectory":
+			o.BookDirectory = types.Hash256(d.Hash())
+		case "BookNode":
+			o.BookNode = decodeBinaryUInt64(d)
+		case "Expiration":
+			o.Expiration = d.UInt32()
+		case "OwnerNode":
+			o.OwnerNode = decodeBinaryUInt64(d)
+		case "PreviousTxnID":
+			o.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			o.PreviousTxnLgrSeq = d.UInt32()
+		case "Sequence":
+			o.Sequence = d.UInt32()
+		case "TakerPays":
+			o.TakerPays = decodeBinaryAmount(d)
+		case "TakerGets":
+			o.TakerGets = decodeBinaryAmount(d)
+		case "DomainID":
+			domainID := d.Hash()
+			o.DomainID = &domainID
+		case "AdditionalBooks":
+			o.AdditionalBooks = nil
+			for d.Next() {
+				var book Book
+				for d.Next() {
+					switch d.Field() {
+					case "BookDirectory":
+						book.Book.BookDirectory = d.Hash()
+					case "BookNode":
+						book.Book.BookNode = decodeBinaryUInt64(d)
+					default:
+						d.Skip()
+					}
+				}
+				o.AdditionalBooks = append(o.AdditionalBooks, book)
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,8 +1,11 @@
 package ledger
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -95,6 +98,38 @@ func (priceData *PriceData) Flatten() map[string]any {
 	return flattened
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for PriceData.
+// AssetPrice is accepted both as a number and as the hex string of the binary codec UInt64 type.
+func (priceData *PriceData) UnmarshalJSON(data []byte) error {
+	type Alias PriceData
+	aux := &struct {
+		AssetPrice json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(priceData),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	priceData.AssetPrice = 0
+	if len(aux.AssetPrice) == 0 {
+		return nil
+	}
+
+	var hexPrice string
+	if err := json.Unmarshal(aux.AssetPrice, &hexPrice); err == nil {
+		assetPrice, err := strconv.ParseUint(hexPrice, 16, 64)
+		if err != nil {
+			return err
+		}
+		priceData.AssetPrice = assetPrice
+		return nil
+	}
+
+	return json.Unmarshal(aux.AssetPrice, &priceData.AssetPrice)
+}
+
 // Oracle ledger entry holds data associated with a single price oracle object.
 // Requires PriceOracle amendment.
 // Example:
@@ -156,3 +191,81 @@ type Oracle struct {
 func (*Oracle) EntryType() EntryType {
 	return OracleEntry
 }
+
+// EncodeBinary writes the Oracle to e, without going through JSON and the map-based codec.
+func (o *Oracle) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(OracleEntry))
+	e.AccountID("Owner", o.Owner.String())
+	e.Blob("Provider", o.Provider)
+	if o.PriceDataSeries != nil {
+		e.BeginArray("PriceDataSeries")
+		for _, priceData := range o.PriceDataSeries {
+			e.BeginObject("PriceData")
+			e.Currency("BaseAsset", priceData.PriceData.BaseAsset)
+			e.Currency("QuoteAsset", priceData.PriceData.QuoteAsset)
+			if priceData.PriceData.AssetPrice != 0 {
+				e.UInt64("AssetPrice", priceData.PriceData.AssetPrice)
+			}
+			if priceData.PriceData.Scale != 0 {
+				e.UInt8("Scale", priceData.PriceData.Scale)
+			}
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+	e.UInt32("LastUpdateTime", o.LastUpdateTime)
+	if o.URI != "" {
+		e.Blob("URI", o.URI)
+	}
+	e.Blob("AssetClass", o.AssetClass)
+	e.UInt64("OwnerNode", o.OwnerNode)
+	e.Hash256("PreviousTxnID", o.PreviousTxnID)
+	e.UInt32("PreviousTxnLgrSeq", o.PreviousTxnLgrSeq)
+}
+
+// DecodeBinary reads the Oracle from d, skipping fields an Oracle does not have.
+func (o *Oracle) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Owner":
+			o.Owner = types.Address(d.AccountID())
+		case "Provider":
+			o.Provider = d.Blob()
+		case "PriceDataSeries":
+			o.PriceDataSeries = []PriceDataWrapper{}
+			for d.Next() {
+				var priceData PriceData
+				for d.Next() {
+					switch d.Field() {
+					case "BaseAsset":
+						priceData.BaseAsset = d.Currency()
+					case "QuoteAsset":
+						priceData.QuoteAsset = d.Currency()
+					case "AssetPrice":
+						priceData.AssetPrice = d.UInt64()
+					case "Scale":
+						priceData.Scale = d.UInt8()
+					default:
+						d.Skip()
+					}
+				}
+				o.PriceDataSeries = append(o.PriceDataSeries, PriceDataWrapper{PriceData: priceData})
+			}
+		case "LastUpdateTime":
+			o.LastUpdateTime = d.UInt32()
+		case "URI":
+			o.URI = d.Blob()
+		case "AssetClass":
+			o.AssetClass = d.Blob()
+		case "OwnerNode":
+			o.OwnerNode = d.UInt64()
+		case "PreviousTxnID":
+			o.PreviousTxnID = d.Hash()
+		case "PreviousTxnLgrSeq":
+			o.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -0,0 +1,50 @@
+package ledger
+
+import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
+
+// Parse decodes a flat ledger object into its concrete ledger object type, such as *AccountRoot
+// for an AccountRoot or *RippleState for a RippleState. The result can be inspected with a type
+// switch:
+//
+//	switch obj := obj.(type) {
+//	case *ledger.AccountRoot:
+//		...
+//	case *ledger.RippleState:
+//		...
+//	}
+func Parse(flat FlatLedgerObject) (Object, error) {
+	entryType, ok := flat["LedgerEntryType"].(string)
+	if !ok || entryType == "" {
+		return nil, ErrMissingLedgerEntryType
+	}
+
+	obj, err := EmptyLedgerObject(entryType)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(flat)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// DecodeBlob decodes a hex encoded ledger object, as returned by binary ledger_entry and
+// ledger_data requests, into its concrete ledger object type.
+func DecodeBlob(blob string) (Object, error) {
+	flat, err := binarycodec.Decode(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(flat)
+}
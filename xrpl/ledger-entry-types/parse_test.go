@@ -0,0 +1,107 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		flat     FlatLedgerObject
+		expected Object
+		err      error
+	}{
+		{
+			name: "pass - account root",
+			flat: FlatLedgerObject{
+				"LedgerEntryType":   "AccountRoot",
+				"Account":           "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+				"Balance":           "370000000",
+				"Flags":             0,
+				"OwnerCount":        0,
+				"PreviousTxnID":     "8D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B4",
+				"PreviousTxnLgrSeq": 8901,
+				"Sequence":          1,
+				"index":             "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+			},
+			expected: &AccountRoot{
+				Index:             "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+				LedgerEntryType:   AccountRootEntry,
+				Account:           "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+				Balance:           types.XRPCurrencyAmount(370000000),
+				PreviousTxnID:     "8D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B4",
+				PreviousTxnLgrSeq: 8901,
+				Sequence:          1,
+			},
+		},
+		{
+			name: "pass - check with an issued currency amount",
+			flat: FlatLedgerObject{
+				"LedgerEntryType":   "Check",
+				"Account":           "rUn84CUYbNjRoTQ6mSW7BVJPSVJNLb1QLo",
+				"Destination":       "rfkE1aSy9G8Upk4JssnwBxhEv5p4mn2KTy",
+				"OwnerNode":         "0000000000000000",
+				"PreviousTxnID":     "5463C6E08862A1FAE5EDAC12D70ADB16546A1F674930521295BC082494B62924",
+				"PreviousTxnLgrSeq": 6,
+				"SendMax": map[string]any{
+					"currency": "USD",
+					"issuer":   "rfkE1aSy9G8Upk4JssnwBxhEv5p4mn2KTy",
+					"value":    "100",
+				},
+				"Sequence": 2,
+			},
+			expected: &Check{
+				LedgerEntryType:   CheckEntry,
+				Account:           "rUn84CUYbNjRoTQ6mSW7BVJPSVJNLb1QLo",
+				Destination:       "rfkE1aSy9G8Upk4JssnwBxhEv5p4mn2KTy",
+				OwnerNode:         "0000000000000000",
+				PreviousTxnID:     "5463C6E08862A1FAE5EDAC12D70ADB16546A1F674930521295BC082494B62924",
+				PreviousTxnLgrSeq: 6,
+				SendMax: types.IssuedCurrencyAmount{
+					Currency: "USD",
+					Issuer:   "rfkE1aSy9G8Upk4JssnwBxhEv5p4mn2KTy",
+					Value:    "100",
+				},
+				Sequence: 2,
+			},
+		},
+		{
+			name: "fail - missing ledger entry type",
+			flat: FlatLedgerObject{"Account": "rUn84CUYbNjRoTQ6mSW7BVJPSVJNLb1QLo"},
+			err:  ErrMissingLedgerEntryType,
+		},
+		{
+			name: "fail - unrecognized ledger entry type",
+			flat: FlatLedgerObject{"LedgerEntryType": "Unknown"},
+			err:  ErrUnrecognizedLedgerObjectType{Type: "Unknown"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj, err := Parse(tt.flat)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, obj)
+		})
+	}
+}
+
+func TestDecodeBlob(t *testing.T) {
+	obj, err := DecodeBlob("1100612200000000240000000125000022C52D00000000558D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B46240000000160DC0808114712B799C79D1EEE3094B59EF9920C7FEB3CE4499")
+	require.NoError(t, err)
+	require.Equal(t, &AccountRoot{
+		LedgerEntryType:   AccountRootEntry,
+		Account:           "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+		Balance:           types.XRPCurrencyAmount(370000000),
+		PreviousTxnID:     "8D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B4",
+		PreviousTxnLgrSeq: 8901,
+		Sequence:          1,
+	}, obj)
+}
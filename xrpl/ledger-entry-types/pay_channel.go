@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // PayChannel represents a ledger entry for a payment channel (added by the PayChan amendment).
 type PayChannel struct {
@@ -70,3 +74,76 @@ type PayChannel struct {
 func (*PayChannel) EntryType() EntryType {
 	return PayChannelEntry
 }
+
+// EncodeBinary writes the PayChannel to e, without going through JSON and the map-based codec.
+func (p *PayChannel) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(PayChannelEntry))
+	e.AccountID("Account", p.Account.String())
+	e.XRPAmount("Amount", p.Amount.Uint64())
+	e.XRPAmount("Balance", p.Balance.Uint64())
+	if p.CancelAfter != 0 {
+		e.UInt32("CancelAfter", p.CancelAfter)
+	}
+	e.AccountID("Destination", p.Destination.String())
+	if p.DestinationTag != 0 {
+		e.UInt32("DestinationTag", p.DestinationTag)
+	}
+	if p.DestinationNode != "" {
+		encodeBinaryUInt64(e, "DestinationNode", p.DestinationNode)
+	}
+	if p.Expiration != 0 {
+		e.UInt32("Expiration", p.Expiration)
+	}
+	e.UInt32("Flags", p.Flags)
+	encodeBinaryUInt64(e, "OwnerNode", p.OwnerNode)
+	e.Hash256("PreviousTxnID", p.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", p.PreviousTxnLgrSeq)
+	e.Blob("PublicKey", p.PublicKey)
+	e.UInt32("SettleDelay", p.SettleDelay)
+	if p.SourceTag != 0 {
+		e.UInt32("SourceTag", p.SourceTag)
+	}
+}
+
+// DecodeBinary reads the PayChannel from d, skipping fields a PayChannel does not have.
+func (p *PayChannel) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Account":
+			p.Account = types.Address(d.AccountID())
+		case "Amount":
+			p.Amount = types.XRPCurrencyAmount(d.Amount().Drops)
+		case "Balance":
+			p.Balance = types.XRPCurrencyAmount(d.Amount().Drops)
+		case "CancelAfter":
+			p.CancelAfter = d.UInt32()
+		case "Destination":
+			p.Destination = types.Address(d.AccountID())
+		case "DestinationTag":
+			p.DestinationTag = d.UInt32()
+		case "DestinationNode":
+			p.DestinationNode = decodeBinaryUInt64(d)
+		case "Expiration":
+			p.Expiration = d.UInt32()
+		case "Flags":
+			p.Flags = d.UInt32()
+		case "LedgerEntryType":
+			p.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "OwnerNode":
+			p.OwnerNode = decodeBinaryUInt64(d)
+		case "PreviousTxnID":
+			p.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			p.PreviousTxnLgrSeq = d.UInt32()
+		case "PublicKey":
+			p.PublicKey = d.Blob()
+		case "SettleDelay":
+			p.SettleDelay = d.UInt32()
+		case "SourceTag":
+			p.SourceTag = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // PermissionedDomain represents a ledger entry that describes a single permissioned domain instance.
 // You can create a permissioned domain by sending a PermissionedDomainSet transaction.
@@ -80,3 +84,68 @@ func (p *PermissionedDomain) Flatten() FlatLedgerObject {
 
 	return flattened
 }
+
+// EncodeBinary writes the PermissionedDomain to e, without going through JSON and the map-based codec.
+func (p *PermissionedDomain) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(PermissionedDomainEntry))
+	e.XRPAmount("Fee", p.Fee.Uint64())
+	e.UInt32("Flags", p.Flags)
+	e.AccountID("Owner", p.Owner.String())
+	encodeBinaryUInt64(e, "OwnerNode", p.OwnerNode)
+	e.UInt32("Sequence", p.Sequence)
+	if p.AcceptedCredentials != nil {
+		e.BeginArray("AcceptedCredentials")
+		for _, credential := range p.AcceptedCredentials {
+			e.BeginObject("Credential")
+			e.AccountID("Issuer", credential.Credential.Issuer.String())
+			e.Blob("CredentialType", credential.Credential.CredentialType.String())
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+	e.Hash256("PreviousTxnID", p.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", p.PreviousTxnLgrSeq)
+}
+
+// DecodeBinary reads the PermissionedDomain from d, skipping fields a PermissionedDomain does not have.
+func (p *PermissionedDomain) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			p.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Fee":
+			p.Fee = types.XRPCurrencyAmount(d.Amount().Drops)
+		case "Flags":
+			p.Flags = d.UInt32()
+		case "Owner":
+			p.Owner = types.Address(d.AccountID())
+		case "OwnerNode":
+			p.OwnerNode = decodeBinaryUInt64(d)
+		case "Sequence":
+			p.Sequence = d.UInt32()
+		case "AcceptedCredentials":
+			p.AcceptedCredentials = types.AuthorizeCredentialList{}
+			for d.Next() {
+				var credential types.Credential
+				for d.Next() {
+					switch d.Field() {
+					case "Issuer":
+						credential.Issuer = types.Address(d.AccountID())
+					case "CredentialType":
+						credential.CredentialType = types.CredentialType(d.Blob())
+					default:
+						d.Skip()
+					}
+				}
+				p.AcceptedCredentials = append(p.AcceptedCredentials, types.AuthorizeCredential{Credential: credential})
+			}
+		case "PreviousTxnID":
+			p.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			p.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 const (
 	// LsfAMMNode this entry consumed AMM liquidity to complete a Payment transaction.
@@ -164,3 +168,65 @@ func (r *RippleState) SetLsfLowDeepFreeze() {
 func (r *RippleState) SetLsfHighDeepFreeze() {
 	r.Flags |= LsfHighDeepFreeze
 }
+
+// EncodeBinary writes the RippleState to e, without going through JSON and the map-based codec.
+func (r *RippleState) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(RippleStateEntry))
+	e.IssuedAmount("Balance", r.Balance.Value, r.Balance.Currency, r.Balance.Issuer.String())
+	e.UInt32("Flags", r.Flags)
+	e.IssuedAmount("HighLimit", r.HighLimit.Value, r.HighLimit.Currency, r.HighLimit.Issuer.String())
+	encodeBinaryUInt64(e, "HighNode", r.HighNode)
+	if r.HighQualityIn != 0 {
+		e.UInt32("HighQualityIn", r.HighQualityIn)
+	}
+	if r.HighQualityOut != 0 {
+		e.UInt32("HighQualityOut", r.HighQualityOut)
+	}
+	e.IssuedAmount("LowLimit", r.LowLimit.Value, r.LowLimit.Currency, r.LowLimit.Issuer.String())
+	encodeBinaryUInt64(e, "LowNode", r.LowNode)
+	if r.LowQualityIn != 0 {
+		e.UInt32("LowQualityIn", r.LowQualityIn)
+	}
+	if r.LowQualityOut != 0 {
+		e.UInt32("LowQualityOut", r.LowQualityOut)
+	}
+	e.Hash256("PreviousTxnID", r.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", r.PreviousTxnLgrSeq)
+}
+
+// DecodeBinary reads the RippleState from d, skipping fields a RippleState does not have.
+func (r *RippleState) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Balance":
+			r.Balance = decodeBinaryIssuedAmount(d)
+		case "Flags":
+			r.Flags = d.UInt32()
+		case "HighLimit":
+			r.HighLimit = decodeBinaryIssuedAmount(d)
+		case "HighNode":
+			r.HighNode = decodeBinaryUInt64(d)
+		case "HighQualityIn":
+			r.HighQualityIn = d.UInt32()
+		case "HighQualityOut":
+			r.HighQualityOut = d.UInt32()
+		case "LedgerEntryType":
+			r.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "LowLimit":
+			r.LowLimit = decodeBinaryIssuedAmount(d)
+		case "LowNode":
+			r.LowNode = decodeBinaryUInt64(d)
+		case "LowQualityIn":
+			r.LowQualityIn = d.UInt32()
+		case "LowQualityOut":
+			r.LowQualityOut = d.UInt32()
+		case "PreviousTxnID":
+			r.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			r.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
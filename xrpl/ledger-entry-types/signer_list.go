@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 const (
 	// LsfOneOwnerCount if this flag is enabled, this SignerList counts as one item for purposes of the owner reserve
@@ -120,3 +124,70 @@ func (*SignerList) EntryType() EntryType {
 func (s *SignerList) SetLsfOneOwnerCount() {
 	s.Flags |= LsfOneOwnerCount
 }
+
+// EncodeBinary writes the SignerList to e, without going through JSON and the map-based codec.
+func (s *SignerList) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(SignerListEntry))
+	e.Hash256("PreviousTxnID", s.PreviousTxnID)
+	e.UInt32("PreviousTxnLgrSeq", s.PreviousTxnLgrSeq)
+	encodeBinaryUInt64(e, "OwnerNode", s.OwnerNode)
+	if s.SignerEntries != nil {
+		e.BeginArray("SignerEntries")
+		for _, entry := range s.SignerEntries {
+			e.BeginObject("SignerEntry")
+			e.AccountID("Account", entry.SignerEntry.Account.String())
+			e.UInt16("SignerWeight", entry.SignerEntry.SignerWeight)
+			if entry.SignerEntry.WalletLocator != "" {
+				e.Hash256("WalletLocator", entry.SignerEntry.WalletLocator.String())
+			}
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+	e.UInt32("SignerListID", s.SignerListID)
+	e.UInt32("SignerQuorum", s.SignerQuorum)
+	e.UInt32("Flags", s.Flags)
+}
+
+// DecodeBinary reads the SignerList from d, skipping fields a SignerList does not have.
+func (s *SignerList) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			s.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "PreviousTxnID":
+			s.PreviousTxnID = d.Hash()
+		case "PreviousTxnLgrSeq":
+			s.PreviousTxnLgrSeq = d.UInt32()
+		case "OwnerNode":
+			s.OwnerNode = decodeBinaryUInt64(d)
+		case "SignerEntries":
+			s.SignerEntries = nil
+			for d.Next() {
+				var entry SignerEntry
+				for d.Next() {
+					switch d.Field() {
+					case "Account":
+						entry.Account = types.Address(d.AccountID())
+					case "SignerWeight":
+						entry.SignerWeight = d.UInt16()
+					case "WalletLocator":
+						entry.WalletLocator = types.Hash256(d.Hash())
+					default:
+						d.Skip()
+					}
+				}
+				s.SignerEntries = append(s.SignerEntries, SignerEntryWrapper{SignerEntry: entry})
+			}
+		case "SignerListID":
+			s.SignerListID = d.UInt32()
+		case "SignerQuorum":
+			s.SignerQuorum = d.UInt32()
+		case "Flags":
+			s.Flags = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
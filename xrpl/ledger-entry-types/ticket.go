@@ -1,6 +1,10 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // Ticket represents a ledger entry type that tracks an account sequence number reserved for future use.
 // You can create new tickets with a TicketCreate transaction.
@@ -44,3 +48,39 @@ type Ticket struct {
 func (*Ticket) EntryType() EntryType {
 	return TicketEntry
 }
+
+// EncodeBinary writes the Ticket to e, without going through JSON and the map-based codec.
+func (t *Ticket) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(TicketEntry))
+	e.AccountID("Account", t.Account.String())
+	e.UInt32("Flags", t.Flags)
+	encodeBinaryUInt64(e, "OwnerNode", t.OwnerNode)
+	e.Hash256("PreviousTxnID", t.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", t.PreviousTxnLgrSeq)
+	e.UInt32("TicketSequence", t.TicketSequence)
+}
+
+// DecodeBinary reads the Ticket from d, skipping fields a Ticket does not have.
+func (t *Ticket) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Account":
+			t.Account = types.Address(d.AccountID())
+		case "Flags":
+			t.Flags = d.UInt32()
+		case "LedgerEntryType":
+			t.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "OwnerNode":
+			t.OwnerNode = decodeBinaryUInt64(d)
+		case "PreviousTxnID":
+			t.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			t.PreviousTxnLgrSeq = d.UInt32()
+		case "TicketSequence":
+			t.TicketSequence = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
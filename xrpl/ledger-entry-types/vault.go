@@ -1,6 +1,7 @@
 package ledger
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -82,3 +83,86 @@ func (*Vault) EntryType() EntryType {
 func (v *Vault) SetLsfVaultPrivate() {
 	v.Flags |= LsfVaultPrivate
 }
+
+// EncodeBinary writes the Vault to e, without going through JSON and the map-based codec.
+func (v *Vault) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(VaultEntry))
+	e.UInt32("Flags", v.Flags)
+	e.UInt32("Sequence", v.Sequence)
+	encodeBinaryUInt64(e, "OwnerNode", v.OwnerNode)
+	e.AccountID("Owner", v.Owner.String())
+	e.AccountID("Account", v.Account.String())
+	encodeBinaryAsset(e, "Asset", v.Asset)
+	if v.AssetsTotal != nil {
+		e.Number("AssetsTotal", v.AssetsTotal.String())
+	}
+	if v.AssetsAvailable != nil {
+		e.Number("AssetsAvailable", v.AssetsAvailable.String())
+	}
+	if v.LossUnrealized != nil {
+		e.Number("LossUnrealized", v.LossUnrealized.String())
+	}
+	e.Hash192("ShareMPTID", v.ShareMPTID.String())
+	e.UInt8("WithdrawalPolicy", uint8(v.WithdrawalPolicy))
+	if v.AssetsMaximum != nil {
+		e.Number("AssetsMaximum", v.AssetsMaximum.String())
+	}
+	if v.Data != "" {
+		e.Blob("Data", v.Data)
+	}
+	if v.Scale != nil {
+		e.UInt8("Scale", *v.Scale)
+	}
+	e.Hash256("PreviousTxnID", v.PreviousTxnID.String())
+	e.UInt32("PreviousTxnLgrSeq", v.PreviousTxnLgrSeq)
+}
+
+// DecodeBinary reads the Vault from d, skipping fields a Vault does not have.
+func (v *Vault) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerEntryType":
+			v.LedgerEntryType = EntryType(d.LedgerEntryType())
+		case "Flags":
+			v.Flags = d.UInt32()
+		case "Sequence":
+			v.Sequence = d.UInt32()
+		case "OwnerNode":
+			v.OwnerNode = decodeBinaryUInt64(d)
+		case "Owner":
+			v.Owner = types.Address(d.AccountID())
+		case "Account":
+			v.Account = types.Address(d.AccountID())
+		case "Asset":
+			v.Asset = decodeBinaryAsset(d)
+		case "AssetsTotal":
+			assetsTotal := types.XRPLNumber(d.Number())
+			v.AssetsTotal = &assetsTotal
+		case "AssetsAvailable":
+			assetsAvailable := types.XRPLNumber(d.Number())
+			v.AssetsAvailable = &assetsAvailable
+		case "LossUnrealized":
+			lossUnrealized := types.XRPLNumber(d.Number())
+			v.LossUnrealized = &lossUnrealized
+		case "ShareMPTID":
+			v.ShareMPTID = types.Hash192(d.Hash())
+		case "WithdrawalPolicy":
+			v.WithdrawalPolicy = types.VaultWithdrawalPolicy(d.UInt8())
+		case "AssetsMaximum":
+			assetsMaximum := types.XRPLNumber(d.Number())
+			v.AssetsMaximum = &assetsMaximum
+		case "Data":
+			v.Data = d.Blob()
+		case "Scale":
+			scale := d.UInt8()
+			v.Scale = &scale
+		case "PreviousTxnID":
+			v.PreviousTxnID = types.Hash256(d.Hash())
+		case "PreviousTxnLgrSeq":
+			v.PreviousTxnLgrSeq = d.UInt32()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
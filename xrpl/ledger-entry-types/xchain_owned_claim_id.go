@@ -1,6 +1,11 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // XChainClaimProofSig holds the parameters of a proof signature for a cross-chain claim attestation.
 type XChainClaimProofSig struct {
@@ -18,6 +23,26 @@ type XChainClaimProofSig struct {
 	WasLockingChainSend uint8
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for XChainClaimProofSig.
+func (x *XChainClaimProofSig) UnmarshalJSON(data []byte) error {
+	type Alias XChainClaimProofSig
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // XChainClaimAttestation represents an attestation containing a proof signature from a witness server for a cross-chain claim.
 type XChainClaimAttestation struct {
 	// An attestation from one witness server.
@@ -101,7 +126,102 @@ type XChainOwnedClaimID struct {
 	XChainClaimID string
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for XChainOwnedClaimID.
+func (x *XChainOwnedClaimID) UnmarshalJSON(data []byte) error {
+	type Alias XChainOwnedClaimID
+	aux := &struct {
+		SignatureReward json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.SignatureReward, err = types.UnmarshalCurrencyAmount(aux.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // EntryType returns the type of the ledger entry.
 func (*XChainOwnedClaimID) EntryType() EntryType {
 	return XChainOwnedClaimIDEntry
 }
+
+// EncodeBinary writes the XChainOwnedClaimID to e, without going through JSON and the map-based codec.
+func (x *XChainOwnedClaimID) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(XChainOwnedClaimIDEntry))
+	e.AccountID("Account", x.Account.String())
+	e.AccountID("OtherChainSource", x.OtherChainSource.String())
+	if x.SignatureReward != nil {
+		encodeBinaryAmount(e, "SignatureReward", x.SignatureReward)
+	}
+	encodeBinaryBridge(e, x.XChainBridge)
+	if x.XChainClaimAttestations != nil {
+		e.BeginArray("XChainClaimAttestations")
+		for _, attestation := range x.XChainClaimAttestations {
+			e.BeginObject("XChainClaimProofSig")
+			if attestation.XChainClaimProofSig.Amount != nil {
+				encodeBinaryAmount(e, "Amount", attestation.XChainClaimProofSig.Amount)
+			}
+			e.AccountID("AttestationRewardAccount", attestation.XChainClaimProofSig.AttestationRewardAccount.String())
+			e.AccountID("AttestationSignerAccount", attestation.XChainClaimProofSig.AttestationSignerAccount.String())
+			if attestation.XChainClaimProofSig.Destination != "" {
+				e.AccountID("Destination", attestation.XChainClaimProofSig.Destination.String())
+			}
+			e.Blob("PublicKey", attestation.XChainClaimProofSig.PublicKey)
+			e.UInt8("WasLockingChainSend", attestation.XChainClaimProofSig.WasLockingChainSend)
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+	encodeBinaryUInt64(e, "XChainClaimID", x.XChainClaimID)
+}
+
+// DecodeBinary reads the XChainOwnedClaimID from d, skipping fields an XChainOwnedClaimID does not have.
+func (x *XChainOwnedClaimID) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Account":
+			x.Account = types.Address(d.AccountID())
+		case "OtherChainSource":
+			x.OtherChainSource = types.Address(d.AccountID())
+		case "SignatureReward":
+			x.SignatureReward = decodeBinaryAmount(d)
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		case "XChainClaimAttestations":
+			x.XChainClaimAttestations = nil
+			for d.Next() {
+				var proof XChainClaimProofSig
+				for d.Next() {
+					switch d.Field() {
+					case "Amount":
+						proof.Amount = decodeBinaryAmount(d)
+					case "AttestationRewardAccount":
+						proof.AttestationRewardAccount = types.Address(d.AccountID())
+					case "AttestationSignerAccount":
+						proof.AttestationSignerAccount = types.Address(d.AccountID())
+					case "Destination":
+						proof.Destination = types.Address(d.AccountID())
+					case "PublicKey":
+						proof.PublicKey = d.Blob()
+					case "WasLockingChainSend":
+						proof.WasLockingChainSend = d.UInt8()
+					default:
+						d.Skip()
+					}
+				}
+				x.XChainClaimAttestations = append(x.XChainClaimAttestations, XChainClaimAttestation{XChainClaimProofSig: proof})
+			}
+		case "XChainClaimID":
+			x.XChainClaimID = decodeBinaryUInt64(d)
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
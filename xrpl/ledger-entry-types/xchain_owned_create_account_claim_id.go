@@ -1,6 +1,11 @@
 package ledger
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // XChainCreateAccountProofSig holds the parameters of a proof signature used for cross-chain account creation attestations.
 type XChainCreateAccountProofSig struct {
@@ -18,6 +23,26 @@ type XChainCreateAccountProofSig struct {
 	WasLockingChainSend uint8
 }
 
+// UnmarshalJSON implements custom JSON unmarshalling for XChainCreateAccountProofSig.
+func (x *XChainCreateAccountProofSig) UnmarshalJSON(data []byte) error {
+	type Alias XChainCreateAccountProofSig
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // XChainCreateAccountAttestation represents an attestation containing a proof signature from a witness server for cross-chain account creation.
 type XChainCreateAccountAttestation struct {
 	// An attestation from one witness server.
@@ -86,3 +111,68 @@ type XChainOwnedCreateAccountClaimID struct {
 func (x *XChainOwnedCreateAccountClaimID) EntryType() EntryType {
 	return XChainOwnedCreateAccountClaimIDEntry
 }
+
+// EncodeBinary writes the XChainOwnedCreateAccountClaimID to e, without going through JSON and the map-based codec.
+func (x *XChainOwnedCreateAccountClaimID) EncodeBinary(e *binarycodec.Encoder) {
+	e.LedgerEntryType(string(XChainOwnedCreateAccountClaimIDEntry))
+	e.AccountID("Account", x.Account.String())
+	encodeBinaryUInt64(e, "XChainAccountCreateCount", x.XChainAccountCreateCount)
+	encodeBinaryBridge(e, x.XChainBridge)
+	if x.XChainCreateAccountAttestations != nil {
+		e.BeginArray("XChainCreateAccountAttestations")
+		for _, attestation := range x.XChainCreateAccountAttestations {
+			e.BeginObject("XChainCreateAccountProofSig")
+			if attestation.XChainCreateAccountProofSig.Amount != nil {
+				encodeBinaryAmount(e, "Amount", attestation.XChainCreateAccountProofSig.Amount)
+			}
+			e.AccountID("AttestationRewardAccount", attestation.XChainCreateAccountProofSig.AttestationRewardAccount.String())
+			e.AccountID("AttestationSignerAccount", attestation.XChainCreateAccountProofSig.AttestationSignerAccount.String())
+			e.AccountID("Destination", attestation.XChainCreateAccountProofSig.Destination.String())
+			e.Blob("PublicKey", attestation.XChainCreateAccountProofSig.PublicKey)
+			e.UInt8("WasLockingChainSend", attestation.XChainCreateAccountProofSig.WasLockingChainSend)
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+}
+
+// DecodeBinary reads the XChainOwnedCreateAccountClaimID from d, skipping fields an XChainOwnedCreateAccountClaimID does not have.
+func (x *XChainOwnedCreateAccountClaimID) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Account":
+			x.Account = types.Address(d.AccountID())
+		case "XChainAccountCreateCount":
+			x.XChainAccountCreateCount = decodeBinaryUInt64(d)
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		case "XChainCreateAccountAttestations":
+			x.XChainCreateAccountAttestations = nil
+			for d.Next() {
+				var proof XChainCreateAccountProofSig
+				for d.Next() {
+					switch d.Field() {
+					case "Amount":
+						proof.Amount = decodeBinaryAmount(d)
+					case "AttestationRewardAccount":
+						proof.AttestationRewardAccount = types.Address(d.AccountID())
+					case "AttestationSignerAccount":
+						proof.AttestationSignerAccount = types.Address(d.AccountID())
+					case "Destination":
+						proof.Destination = types.Address(d.AccountID())
+					case "PublicKey":
+						proof.PublicKey = d.Blob()
+					case "WasLockingChainSend":
+						proof.WasLockingChainSend = d.UInt8()
+					default:
+						d.Skip()
+					}
+				}
+				x.XChainCreateAccountAttestations = append(x.XChainCreateAccountAttestations, XChainCreateAccountAttestation{XChainCreateAccountProofSig: proof})
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -0,0 +1,25 @@
+package lightclient
+
+import "errors"
+
+var (
+	// ledger
+
+	// ErrLedgerNotValidated is returned when a ledger has not been validated by a trusted quorum.
+	ErrLedgerNotValidated = errors.New("ledger is not validated by a trusted quorum")
+	// ErrLedgerHashMismatch is returned when the node returns a ledger other than the one validated by the trusted quorum.
+	ErrLedgerHashMismatch = errors.New("ledger hash does not match the ledger validated by the trusted quorum")
+
+	// queries
+
+	// ErrMissingBinary is returned when a response does not include the binary data to verify.
+	ErrMissingBinary = errors.New("response does not include binary data")
+	// ErrTransactionNotValidated is returned when the node does not report a transaction as validated.
+	ErrTransactionNotValidated = errors.New("transaction is not validated")
+	// ErrTransactionMismatch is returned when a transaction response does not match the transaction in its validated ledger.
+	ErrTransactionMismatch = errors.New("transaction does not match its validated ledger")
+	// ErrLedgerEntryMismatch is returned when a ledger entry response does not match the entry in the validated ledger state.
+	ErrLedgerEntryMismatch = errors.New("ledger entry does not match the validated ledger state")
+	// ErrStateTooLarge is returned when the ledger state to download exceeds the bound set with WithMaxStateEntries.
+	ErrStateTooLarge = errors.New("ledger state exceeds the maximum number of entries")
+)
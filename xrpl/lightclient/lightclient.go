@@ -0,0 +1,338 @@
+// Package lightclient verifies the responses of an untrusted rippled server. Ledgers are only
+// accepted when a trusted validator quorum validated them, and transactions and ledger entries
+// are checked against the transaction_hash and account_hash of their validated ledger with
+// SHAMap proofs.
+package lightclient
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/shamap"
+)
+
+const (
+	// ledgerDataLimit is the number of state entries requested per ledger_data page.
+	ledgerDataLimit = 2048
+)
+
+// Node is the untrusted server the light client queries. The rpc and websocket clients
+// implement it.
+type Node interface {
+	GetLedger(req *ledger.Request) (*ledger.Response, error)
+	GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, error)
+	GetLedgerEntry(req *ledger.EntryRequest) (*ledger.EntryResponse, error)
+	GetTx(req *transactions.TxRequest) (*transactions.TxResponse, error)
+}
+
+// ValidatedLedgers reports the hashes of the ledgers validated by a trusted quorum.
+// validator.ValidationTracker implements it from the validations stream. It only keeps the last
+// validator.DefaultValidationHistory ledgers, so older ledgers cannot be verified with it.
+type ValidatedLedgers interface {
+	ValidatedLedgerHash(ledgerIndex uint32) (string, bool)
+}
+
+// LedgerResult is a ledger header returned by the node.
+type LedgerResult struct {
+	Ledger   ledgertypes.BaseLedger
+	Verified bool
+}
+
+// TxResult is a transaction returned by the node. When Verified is set, Proof proves that the
+// transaction and its metadata are part of the transaction_hash of the ledger LedgerHash.
+type TxResult struct {
+	Response   *transactions.TxResponse
+	LedgerHash string
+	Proof      *shamap.Proof
+	Verified   bool
+}
+
+// LedgerEntryResult is a ledger entry returned by the node. When Verified is set, Proof proves
+// that the entry is part of the account_hash of the ledger LedgerHash.
+type LedgerEntryResult struct {
+	Response   *ledger.EntryResponse
+	LedgerHash string
+	Proof      *shamap.Proof
+	Verified   bool
+}
+
+// Client queries an untrusted node and verifies its responses against the ledgers validated by
+// a trusted quorum. Its query methods return an error when the node cannot be queried, and a
+// result with Verified unset along with the verification error when a response cannot be
+// verified. It is safe for concurrent use.
+type Client struct {
+	node            Node
+	validated       ValidatedLedgers
+	maxStateEntries int
+
+	// The state tree of the last ledger an entry was verified in is kept, as building it
+	// downloads the whole ledger state.
+	mu        sync.Mutex
+	stateHash string
+	stateTree *shamap.SHAMap
+}
+
+// Option configures a Client.
+type Option func(c *Client)
+
+// WithMaxStateEntries bounds the number of state entries LedgerEntry downloads to build a proof.
+// When the ledger state holds more entries, LedgerEntry returns ErrStateTooLarge as soon as the
+// bound is exceeded instead of downloading the rest of the state. By default, the state is not
+// bounded.
+func WithMaxStateEntries(n int) Option {
+	return func(c *Client) {
+		c.maxStateEntries = n
+	}
+}
+
+// New returns a Client querying node and trusting the ledgers reported by validated.
+func New(node Node, validated ValidatedLedgers, opts ...Option) *Client {
+	c := &Client{node: node, validated: validated}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Ledger returns the header of a ledger, verified to hash to the ledger hash validated by the
+// trusted quorum.
+func (c *Client) Ledger(ledgerIndex uint32) (*LedgerResult, error) {
+	resp, err := c.node.GetLedger(&ledger.Request{LedgerIndex: common.LedgerIndex(ledgerIndex)})
+	if err != nil {
+		return nil, err
+	}
+	result := &LedgerResult{Ledger: resp.Ledger}
+	if _, err := c.verifyLedger(ledgerIndex, resp); err != nil {
+		return result, err
+	}
+	result.Verified = true
+	return result, nil
+}
+
+// verifyLedger checks a ledger response against the hash validated by the trusted quorum, and
+// returns that hash. The ledger contents included in the response are checked against its
+// header with Verify.
+func (c *Client) verifyLedger(ledgerIndex uint32, resp *ledger.Response) (string, error) {
+	validatedHash, ok := c.validated.ValidatedLedgerHash(ledgerIndex)
+	if !ok {
+		return "", fmt.Errorf("%w: %d", ErrLedgerNotValidated, ledgerIndex)
+	}
+	if uint32(resp.Ledger.LedgerIndex) != ledgerIndex {
+		return "", fmt.Errorf("%w: requested %d, got %d", ledger.ErrLedgerIndexMismatch, ledgerIndex, resp.Ledger.LedgerIndex)
+	}
+	if err := resp.Verify(); err != nil {
+		return "", err
+	}
+	reported := resp.LedgerHash
+	if reported == "" {
+		reported = resp.Ledger.LedgerHash
+	}
+	if !strings.EqualFold(reported, validatedHash) {
+		return "", fmt.Errorf("%w: got %s, validated %s", ErrLedgerHashMismatch, reported, validatedHash)
+	}
+	return validatedHash, nil
+}
+
+// Tx returns a transaction, verified to be part of a ledger validated by the trusted quorum
+// with the returned metadata. It downloads the transactions of that ledger to build the proof.
+func (c *Client) Tx(hash string) (*TxResult, error) {
+	resp, err := c.node.GetTx(&transactions.TxRequest{Transaction: hash, Binary: true})
+	if err != nil {
+		return nil, err
+	}
+	result := &TxResult{Response: resp}
+	if err := c.verifyTx(hash, result); err != nil {
+		return result, err
+	}
+	result.Verified = true
+	return result, nil
+}
+
+func (c *Client) verifyTx(hash string, result *TxResult) error {
+	resp := result.Response
+	if !resp.Validated {
+		return ErrTransactionNotValidated
+	}
+	if resp.TxBlob == "" || resp.MetaBlob == "" {
+		return fmt.Errorf("%w: tx_blob and meta_blob", ErrMissingBinary)
+	}
+	tx, err := hex.DecodeString(resp.TxBlob)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionMismatch, err)
+	}
+	meta, err := hex.DecodeString(resp.MetaBlob)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionMismatch, err)
+	}
+	key := shamap.TransactionID(tx)
+	if !strings.EqualFold(key.String(), hash) {
+		return fmt.Errorf("%w: requested %s, got %s", ErrTransactionMismatch, hash, key)
+	}
+	data, err := shamap.TransactionWithMetadata(tx, meta)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionMismatch, err)
+	}
+
+	ledgerIndex := uint32(resp.LedgerIndex)
+	ledgerResp, err := c.node.GetLedger(&ledger.Request{
+		LedgerIndex:  common.LedgerIndex(ledgerIndex),
+		Transactions: true,
+		Expand:       true,
+		Binary:       true,
+	})
+	if err != nil {
+		return err
+	}
+	ledgerHash, err := c.verifyLedger(ledgerIndex, ledgerResp)
+	if err != nil {
+		return err
+	}
+	tree, err := ledgerResp.Ledger.TransactionTree()
+	if err != nil {
+		return err
+	}
+	proof, err := tree.Proof(key)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionMismatch, err)
+	}
+	if !bytes.Equal(proof.Data, data) {
+		return fmt.Errorf("%w: transaction or metadata differs", ErrTransactionMismatch)
+	}
+	if err := verifyProof(ledgerResp.Ledger.TransactionHash, proof); err != nil {
+		return fmt.Errorf("%w: %w", ErrTransactionMismatch, err)
+	}
+
+	result.LedgerHash = ledgerHash
+	result.Proof = proof
+	return nil
+}
+
+// LedgerEntry returns a ledger entry of a ledger validated by the trusted quorum, verified to
+// be part of its state. Building the proof downloads the whole ledger state with ledger_data,
+// once per ledger: verifying entries of the same ledger reuses it. This is only practical for
+// small ledgers, such as the ones of test networks: the mainnet state holds tens of millions of
+// entries. Use WithMaxStateEntries to fail with ErrStateTooLarge instead of downloading it.
+func (c *Client) LedgerEntry(index string, ledgerIndex uint32) (*LedgerEntryResult, error) {
+	resp, err := c.node.GetLedgerEntry(&ledger.EntryRequest{
+		Index:       index,
+		LedgerIndex: common.LedgerIndex(ledgerIndex),
+		Binary:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &LedgerEntryResult{Response: resp}
+	if err := c.verifyLedgerEntry(index, ledgerIndex, result); err != nil {
+		return result, err
+	}
+	result.Verified = true
+	return result, nil
+}
+
+func (c *Client) verifyLedgerEntry(index string, ledgerIndex uint32, result *LedgerEntryResult) error {
+	resp := result.Response
+	if resp.NodeBinary == "" {
+		return fmt.Errorf("%w: node_binary", ErrMissingBinary)
+	}
+	if !strings.EqualFold(resp.Index, index) {
+		return fmt.Errorf("%w: requested %s, got %s", ErrLedgerEntryMismatch, index, resp.Index)
+	}
+	data, err := hex.DecodeString(resp.NodeBinary)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrLedgerEntryMismatch, err)
+	}
+	key, err := shamap.ParseHash(index)
+	if err != nil {
+		return err
+	}
+
+	ledgerResp, err := c.node.GetLedger(&ledger.Request{LedgerIndex: common.LedgerIndex(ledgerIndex)})
+	if err != nil {
+		return err
+	}
+	ledgerHash, err := c.verifyLedger(ledgerIndex, ledgerResp)
+	if err != nil {
+		return err
+	}
+	tree, err := c.stateTreeFor(ledgerHash, ledgerResp.Ledger)
+	if err != nil {
+		return err
+	}
+	proof, err := tree.Proof(key)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrLedgerEntryMismatch, err)
+	}
+	if !bytes.Equal(proof.Data, data) {
+		return fmt.Errorf("%w: entry differs", ErrLedgerEntryMismatch)
+	}
+	if err := verifyProof(ledgerResp.Ledger.AccountHash, proof); err != nil {
+		return fmt.Errorf("%w: %w", ErrLedgerEntryMismatch, err)
+	}
+
+	result.LedgerHash = ledgerHash
+	result.Proof = proof
+	return nil
+}
+
+// stateTreeFor returns the state tree of a verified ledger header, downloading its state when
+// it is not the kept one. The tree is checked against the account_hash of the header.
+func (c *Client) stateTreeFor(ledgerHash string, header ledgertypes.BaseLedger) (*shamap.SHAMap, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stateTree != nil && c.stateHash == ledgerHash {
+		return c.stateTree, nil
+	}
+
+	state := ledgertypes.BaseLedger{}
+	var marker any
+	for {
+		page, err := c.node.GetLedgerData(&ledger.DataRequest{
+			LedgerHash: common.LedgerHash(ledgerHash),
+			Binary:     true,
+			Limit:      ledgerDataLimit,
+			Marker:     marker,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if c.maxStateEntries > 0 && len(state.AccountState)+len(page.State) > c.maxStateEntries {
+			return nil, fmt.Errorf("%w: more than %d entries", ErrStateTooLarge, c.maxStateEntries)
+		}
+		for _, entry := range page.State {
+			if entry.Data == "" {
+				return nil, fmt.Errorf("%w: ledger_data entry %s", ErrMissingBinary, entry.Index)
+			}
+			state.AccountState = append(state.AccountState, map[string]any{"index": entry.Index, "data": entry.Data})
+		}
+		if page.Marker == nil || page.Marker == "" {
+			break
+		}
+		marker = page.Marker
+	}
+
+	tree, err := state.StateTree()
+	if err != nil {
+		return nil, err
+	}
+	if computed := tree.Hash().String(); !strings.EqualFold(computed, header.AccountHash) {
+		return nil, fmt.Errorf("%w: computed %s, reported %s", ledger.ErrAccountHashMismatch, computed, header.AccountHash)
+	}
+	c.stateHash, c.stateTree = ledgerHash, tree
+	return tree, nil
+}
+
+// verifyProof checks a proof against the root hash reported by a verified ledger header.
+func verifyProof(root string, proof *shamap.Proof) error {
+	rootHash, err := shamap.ParseHash(root)
+	if err != nil {
+		return err
+	}
+	return shamap.VerifyProof(rootHash, proof)
+}
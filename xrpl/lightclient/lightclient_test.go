@@ -0,0 +1,417 @@
+package lightclient
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/validator"
+	"github.com/Peersyst/xrpl-go/xrpl/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ledgerHash38129 = "E6DB7365949BF9814D76BCC730B01818EB9136A89DB224F3F9F5AAE4569D758E"
+	txHash          = "3B1A4E1C9BB6A7208EB146BCDB86ECEA6068ED01466D933528CA2B4C64F753EF"
+	entryIndex      = "02CE52E3E46AD340B1C7900F86AFB959AE0C246916E3463905EDD61DE26FFFDD"
+)
+
+var (
+	_ Node             = (*rpc.Client)(nil)
+	_ Node             = (*websocket.Client)(nil)
+	_ ValidatedLedgers = (*validator.ValidationTracker)(nil)
+)
+
+var errNode = errors.New("node unavailable")
+
+type validatedLedgers map[uint32]string
+
+func (v validatedLedgers) ValidatedLedgerHash(ledgerIndex uint32) (string, bool) {
+	h, ok := v[ledgerIndex]
+	return h, ok
+}
+
+// fakeNode serves ledger 38129 in binary form, as a rippled server would.
+type fakeNode struct {
+	header       ledgertypes.BaseLedger
+	transactions []any
+	txs          map[string]*transactions.TxResponse
+	state        []ledgertypes.State
+	pageSize     int
+	dataRequests int
+	err          error
+}
+
+func encodeHex(t *testing.T, obj map[string]any) string {
+	t.Helper()
+
+	fields := map[string]any{}
+	for k, v := range obj {
+		if k[0] >= 'A' && k[0] <= 'Z' {
+			fields[k] = v
+		}
+	}
+	blob, err := binarycodec.Encode(fields)
+	require.NoError(t, err)
+	return blob
+}
+
+// tamper flips the last bit of a hex blob.
+func tamper(blob string) string {
+	b, _ := hex.DecodeString(blob)
+	b[len(b)-1] ^= 1
+	return hex.EncodeToString(b)
+}
+
+func newFakeNode(t *testing.T) *fakeNode {
+	t.Helper()
+
+	data, err := os.ReadFile("../shamap/testdata/ledger-full-38129.json")
+	require.NoError(t, err)
+	var fixture struct {
+		AccountState []map[string]any `json:"accountState"`
+		Transactions []map[string]any `json:"transactions"`
+	}
+	require.NoError(t, json.Unmarshal(data, &fixture))
+
+	n := &fakeNode{
+		header: ledgertypes.BaseLedger{
+			AccountHash:         "2C23D15B6B549123FB351E4B5CDE81C564318EB845449CD43C3EA7953C4DB452",
+			CloseTime:           410424200,
+			CloseTimeResolution: 10,
+			Closed:              true,
+			LedgerHash:          ledgerHash38129,
+			LedgerIndex:         38129,
+			ParentCloseTime:     410424200,
+			ParentHash:          "3401E5B2E5D3A53EB0891088A5F2D9364BBB6CE5B37A337D2C0660DAF9C4175E",
+			TotalCoins:          99999999999996310,
+			TransactionHash:     "DB83BF807416C5B3499A73130F843CF615AB8E797D79FE7D330ADF1BFA93951A",
+		},
+		txs:      map[string]*transactions.TxResponse{},
+		pageSize: 100,
+	}
+	for _, tx := range fixture.Transactions {
+		txBlob := encodeHex(t, tx)
+		metaBlob := encodeHex(t, tx["metaData"].(map[string]any))
+		n.transactions = append(n.transactions, map[string]any{"tx_blob": txBlob, "meta": metaBlob})
+		n.txs[tx["hash"].(string)] = &transactions.TxResponse{
+			Hash:        types.Hash256(tx["hash"].(string)),
+			LedgerIndex: 38129,
+			Validated:   true,
+			TxBlob:      txBlob,
+			MetaBlob:    metaBlob,
+		}
+	}
+	for _, entry := range fixture.AccountState {
+		n.state = append(n.state, ledgertypes.State{Index: entry["index"].(string), Data: encodeHex(t, entry)})
+	}
+	return n
+}
+
+func (n *fakeNode) GetLedger(req *ledger.Request) (*ledger.Response, error) {
+	if n.err != nil {
+		return nil, n.err
+	}
+	resp := &ledger.Response{Ledger: n.header, LedgerHash: n.header.LedgerHash, LedgerIndex: n.header.LedgerIndex, Validated: true}
+	if req.Transactions {
+		resp.Ledger.Transactions = n.transactions
+	}
+	return resp, nil
+}
+
+func (n *fakeNode) GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, error) {
+	n.dataRequests++
+	start := 0
+	if req.Marker != nil {
+		start, _ = strconv.Atoi(req.Marker.(string))
+	}
+	end := min(start+n.pageSize, len(n.state))
+	resp := &ledger.DataResponse{LedgerHash: req.LedgerHash, State: n.state[start:end]}
+	if end < len(n.state) {
+		resp.Marker = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+func (n *fakeNode) GetLedgerEntry(req *ledger.EntryRequest) (*ledger.EntryResponse, error) {
+	if n.err != nil {
+		return nil, n.err
+	}
+	for _, entry := range n.state {
+		if entry.Index == req.Index {
+			return &ledger.EntryResponse{Index: entry.Index, LedgerIndex: n.header.LedgerIndex, NodeBinary: entry.Data, Validated: true}, nil
+		}
+	}
+	return nil, errors.New("entryNotFound")
+}
+
+func (n *fakeNode) GetTx(req *transactions.TxRequest) (*transactions.TxResponse, error) {
+	if n.err != nil {
+		return nil, n.err
+	}
+	tx, ok := n.txs[req.Transaction]
+	if !ok {
+		return nil, errors.New("txnNotFound")
+	}
+	resp := *tx
+	return &resp, nil
+}
+
+func TestClient_Ledger(t *testing.T) {
+	testcases := []struct {
+		name        string
+		modify      func(n *fakeNode, v validatedLedgers)
+		expectedErr error
+	}{
+		{
+			name:   "pass - ledger validated by quorum",
+			modify: func(_ *fakeNode, _ validatedLedgers) {},
+		},
+		{
+			name: "fail - ledger not validated",
+			modify: func(_ *fakeNode, v validatedLedgers) {
+				delete(v, 38129)
+			},
+			expectedErr: ErrLedgerNotValidated,
+		},
+		{
+			name: "fail - quorum validated another ledger",
+			modify: func(_ *fakeNode, v validatedLedgers) {
+				v[38129] = "3401E5B2E5D3A53EB0891088A5F2D9364BBB6CE5B37A337D2C0660DAF9C4175E"
+			},
+			expectedErr: ErrLedgerHashMismatch,
+		},
+		{
+			name: "fail - tampered header",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				n.header.AccountHash = "1B536BFBDFC92B9550F2F63D32F7269D451885FFB2CAB374332EBC2D663320E0"
+			},
+			expectedErr: ledger.ErrLedgerHashMismatch,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := newFakeNode(t)
+			v := validatedLedgers{38129: ledgerHash38129}
+			tc.modify(n, v)
+
+			result, err := New(n, v).Ledger(38129)
+			require.NotNil(t, result)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.False(t, result.Verified)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, result.Verified)
+			require.Equal(t, ledgerHash38129, result.Ledger.LedgerHash)
+		})
+	}
+
+	t.Run("fail - node error", func(t *testing.T) {
+		n := newFakeNode(t)
+		n.err = errNode
+		result, err := New(n, validatedLedgers{38129: ledgerHash38129}).Ledger(38129)
+		require.ErrorIs(t, err, errNode)
+		require.Nil(t, result)
+	})
+}
+
+func TestClient_Tx(t *testing.T) {
+	testcases := []struct {
+		name        string
+		modify      func(n *fakeNode, v validatedLedgers)
+		expectedErr error
+	}{
+		{
+			name:   "pass - transaction in validated ledger",
+			modify: func(_ *fakeNode, _ validatedLedgers) {},
+		},
+		{
+			name: "fail - transaction not validated",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				n.txs[txHash].Validated = false
+			},
+			expectedErr: ErrTransactionNotValidated,
+		},
+		{
+			name: "fail - missing metadata",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				n.txs[txHash].MetaBlob = ""
+			},
+			expectedErr: ErrMissingBinary,
+		},
+		{
+			name: "fail - tampered transaction",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				n.txs[txHash].TxBlob = tamper(n.txs[txHash].TxBlob)
+			},
+			expectedErr: ErrTransactionMismatch,
+		},
+		{
+			name: "fail - tampered metadata",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				n.txs[txHash].MetaBlob = tamper(n.txs[txHash].MetaBlob)
+			},
+			expectedErr: ErrTransactionMismatch,
+		},
+		{
+			name: "fail - ledger not validated",
+			modify: func(_ *fakeNode, v validatedLedgers) {
+				delete(v, 38129)
+			},
+			expectedErr: ErrLedgerNotValidated,
+		},
+		{
+			name: "fail - transaction missing from ledger",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				n.transactions = nil
+			},
+			expectedErr: ErrTransactionMismatch,
+		},
+		{
+			name: "fail - tampered ledger transactions",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				n.transactions = append(n.transactions, map[string]any{"tx_blob": tamper(n.txs[txHash].TxBlob), "meta": n.txs[txHash].MetaBlob})
+			},
+			expectedErr: ledger.ErrTransactionHashMismatch,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := newFakeNode(t)
+			v := validatedLedgers{38129: ledgerHash38129}
+			tc.modify(n, v)
+
+			result, err := New(n, v).Tx(txHash)
+			require.NotNil(t, result)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.False(t, result.Verified)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, result.Verified)
+			require.Equal(t, ledgerHash38129, result.LedgerHash)
+			require.Equal(t, txHash, result.Proof.Key.String())
+		})
+	}
+
+	t.Run("fail - node error", func(t *testing.T) {
+		n := newFakeNode(t)
+		n.err = errNode
+		result, err := New(n, validatedLedgers{38129: ledgerHash38129}).Tx(txHash)
+		require.ErrorIs(t, err, errNode)
+		require.Nil(t, result)
+	})
+}
+
+func TestClient_LedgerEntry(t *testing.T) {
+	testcases := []struct {
+		name        string
+		modify      func(n *fakeNode, v validatedLedgers)
+		expectedErr error
+	}{
+		{
+			name:   "pass - entry in validated ledger state",
+			modify: func(_ *fakeNode, _ validatedLedgers) {},
+		},
+		{
+			name: "pass - single ledger_data page",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				n.pageSize = len(n.state)
+			},
+		},
+		{
+			name: "fail - ledger not validated",
+			modify: func(_ *fakeNode, v validatedLedgers) {
+				delete(v, 38129)
+			},
+			expectedErr: ErrLedgerNotValidated,
+		},
+		{
+			name: "fail - tampered entry",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				for i := range n.state {
+					if n.state[i].Index == entryIndex {
+						n.state[i].Data = n.state[i+1].Data
+					}
+				}
+			},
+			expectedErr: ledger.ErrAccountHashMismatch,
+		},
+		{
+			name: "fail - missing binary state",
+			modify: func(n *fakeNode, _ validatedLedgers) {
+				n.state[len(n.state)-1].Data = ""
+			},
+			expectedErr: ErrMissingBinary,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := newFakeNode(t)
+			v := validatedLedgers{38129: ledgerHash38129}
+			tc.modify(n, v)
+
+			result, err := New(n, v).LedgerEntry(entryIndex, 38129)
+			require.NotNil(t, result)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.False(t, result.Verified)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, result.Verified)
+			require.Equal(t, ledgerHash38129, result.LedgerHash)
+			require.Equal(t, entryIndex, result.Proof.Key.String())
+		})
+	}
+
+	t.Run("fail - state larger than the bound", func(t *testing.T) {
+		n := newFakeNode(t)
+		c := New(n, validatedLedgers{38129: ledgerHash38129}, WithMaxStateEntries(len(n.state)-1))
+
+		result, err := c.LedgerEntry(entryIndex, 38129)
+		require.ErrorIs(t, err, ErrStateTooLarge)
+		require.False(t, result.Verified)
+		// The download stops at the page exceeding the bound.
+		require.Equal(t, (len(n.state)+n.pageSize-1)/n.pageSize, n.dataRequests)
+	})
+
+	t.Run("pass - state within the bound", func(t *testing.T) {
+		n := newFakeNode(t)
+		c := New(n, validatedLedgers{38129: ledgerHash38129}, WithMaxStateEntries(len(n.state)))
+
+		result, err := c.LedgerEntry(entryIndex, 38129)
+		require.NoError(t, err)
+		require.True(t, result.Verified)
+	})
+
+	t.Run("pass - state is downloaded once per ledger", func(t *testing.T) {
+		n := newFakeNode(t)
+		c := New(n, validatedLedgers{38129: ledgerHash38129})
+
+		_, err := c.LedgerEntry(entryIndex, 38129)
+		require.NoError(t, err)
+		requests := n.dataRequests
+		require.Greater(t, requests, 1)
+
+		result, err := c.LedgerEntry(n.state[len(n.state)-1].Index, 38129)
+		require.NoError(t, err)
+		require.True(t, result.Verified)
+		require.Equal(t, requests, n.dataRequests)
+	})
+}
@@ -23,6 +23,12 @@ import (
 // is returned.
 // If an error occurs, it will return an error.
 func Multisign(blobs ...string) (string, error) {
+	return MultisignWithCodec(binarycodec.DefaultCodec(), blobs...)
+}
+
+// MultisignWithCodec combines multisigned transaction blobs like Multisign, decoding and
+// encoding them with the given codec.
+func MultisignWithCodec(codec *binarycodec.Codec, blobs ...string) (string, error) {
 	if len(blobs) == 0 {
 		return "", ErrNoTxToMultisign
 	}
@@ -31,7 +37,7 @@ func Multisign(blobs ...string) (string, error) {
 	var referenceBlob string
 	signers := make([]any, 0)
 	for i, blob := range blobs {
-		tx, err := binarycodec.Decode(blob)
+		tx, err := codec.Decode(blob)
 		if err != nil {
 			return "", err
 		}
@@ -41,7 +47,7 @@ func Multisign(blobs ...string) (string, error) {
 
 		txWithoutSigners := shallowCopyWithoutSigners(tx)
 
-		encoded, err := binarycodec.Encode(txWithoutSigners)
+		encoded, err := codec.Encode(txWithoutSigners)
 		if err != nil {
 			return "", err
 		}
@@ -57,7 +63,7 @@ func Multisign(blobs ...string) (string, error) {
 		if err != nil {
 			return "", err
 		}
-		if err := validateSignerSignatures(txWithoutSigners, txSigners); err != nil {
+		if err := validateSignerSignatures(codec, txWithoutSigners, txSigners); err != nil {
 			return "", err
 		}
 		signers = append(signers, txSigners...)
@@ -68,7 +74,7 @@ func Multisign(blobs ...string) (string, error) {
 	}
 	firstTx["Signers"] = signers
 
-	blob, err := binarycodec.Encode(firstTx)
+	blob, err := codec.Encode(firstTx)
 	if err != nil {
 		return "", err
 	}
@@ -100,22 +106,22 @@ func signersFromTx(tx map[string]any) ([]any, error) {
 	return signers, nil
 }
 
-func validateSignerSignatures(txWithoutSigners map[string]any, signers []any) error {
+func validateSignerSignatures(codec *binarycodec.Codec, txWithoutSigners map[string]any, signers []any) error {
 	for _, signer := range signers {
-		if err := validateSignerSignature(txWithoutSigners, signer); err != nil {
+		if err := validateSignerSignature(codec, txWithoutSigners, signer); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func validateSignerSignature(txWithoutSigners map[string]any, signer any) error {
+func validateSignerSignature(codec *binarycodec.Codec, txWithoutSigners map[string]any, signer any) error {
 	account, signingPubKey, txnSignature, err := signerFields(signer)
 	if err != nil {
 		return err
 	}
 
-	payloadHex, err := binarycodec.EncodeForMultisigning(txWithoutSigners, account)
+	payloadHex, err := codec.EncodeForMultisigning(txWithoutSigners, account)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,222 @@
+package xrpl
+
+import (
+	"maps"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// MultisignSigner is an entry of an account SignerList, along with the keys that may sign for it.
+type MultisignSigner struct {
+	// Account is the signer account listed in the SignerList.
+	Account types.Address
+	// Weight is the weight of the signer in the SignerList.
+	Weight uint16
+	// RegularKey is the regular key of the signer account, if it has one.
+	RegularKey types.Address
+	// MasterDisabled reports whether the signer account has its master key disabled.
+	MasterDisabled bool
+}
+
+// MultisignCoordinator collects the signatures of a multisigned transaction and checks them
+// against the SignerList of the transaction account, so a multisignature below quorum is caught
+// before it is submitted.
+// Clients build one from the ledger with GetMultisignCoordinator.
+type MultisignCoordinator struct {
+	codec      *binarycodec.Codec
+	tx         map[string]any
+	encodedTx  string
+	quorum     uint32
+	signers    []MultisignSigner
+	signatures map[types.Address]any
+}
+
+// NewMultisignCoordinator returns a coordinator for the transaction, given the SignerQuorum and
+// the signers of the transaction account SignerList. Any Signers already set on the transaction
+// are ignored.
+func NewMultisignCoordinator(tx transaction.FlatTransaction, quorum uint32, signers []MultisignSigner) (*MultisignCoordinator, error) {
+	return NewMultisignCoordinatorWithCodec(tx, quorum, signers, binarycodec.DefaultCodec())
+}
+
+// NewMultisignCoordinatorWithCodec returns a coordinator like NewMultisignCoordinator, decoding and
+// encoding the transaction and its signatures with the given codec.
+func NewMultisignCoordinatorWithCodec(
+	tx transaction.FlatTransaction,
+	quorum uint32,
+	signers []MultisignSigner,
+	codec *binarycodec.Codec,
+) (*MultisignCoordinator, error) {
+	if account, ok := tx["Account"].(string); !ok || account == "" {
+		return nil, ErrMissingAccountInTransaction
+	}
+
+	unsignedTx := shallowCopyWithoutSigners(tx)
+	delete(unsignedTx, "TxnSignature")
+	unsignedTx["SigningPubKey"] = ""
+
+	encodedTx, err := codec.Encode(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultisignCoordinator{
+		codec:      codec,
+		tx:         unsignedTx,
+		encodedTx:  encodedTx,
+		quorum:     quorum,
+		signers:    signers,
+		signatures: make(map[types.Address]any),
+	}, nil
+}
+
+// MultisignSignersFromSignerList returns the signers of a SignerList ledger entry,
+// without any regular key information.
+func MultisignSignersFromSignerList(signerList ledger.SignerList) []MultisignSigner {
+	signers := make([]MultisignSigner, 0, len(signerList.SignerEntries))
+	for _, entry := range signerList.SignerEntries {
+		signers = append(signers, MultisignSigner{
+			Account: entry.SignerEntry.Account,
+			Weight:  entry.SignerEntry.SignerWeight,
+		})
+	}
+	return signers
+}
+
+// AddSignature adds the signatures of a multisigned transaction blob, as returned by
+// wallet.Multisign. Each signature must be valid, come from a listed signer and be made
+// with the master key or the regular key of that signer. Adding a signature again
+// replaces the previous one.
+func (c *MultisignCoordinator) AddSignature(blob string) error {
+	tx, err := c.codec.Decode(blob)
+	if err != nil {
+		return err
+	}
+	if pk, ok := tx["SigningPubKey"].(string); ok && pk != "" {
+		return ErrMultisignNonEmptySigningPubKey
+	}
+
+	txWithoutSigners := shallowCopyWithoutSigners(tx)
+	encoded, err := c.codec.Encode(txWithoutSigners)
+	if err != nil {
+		return err
+	}
+	if encoded != c.encodedTx {
+		return ErrMultisignTxNotEqual
+	}
+
+	txSigners, err := signersFromTx(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, txSigner := range txSigners {
+		if err := validateSignerSignature(c.codec, txWithoutSigners, txSigner); err != nil {
+			return err
+		}
+
+		account, signingPubKey, _, err := signerFields(txSigner)
+		if err != nil {
+			return err
+		}
+
+		signer, ok := c.signer(types.Address(account))
+		if !ok {
+			return ErrMultisignUnexpectedSigner{Account: account}
+		}
+
+		keyAddress, err := keypairs.DeriveClassicAddress(signingPubKey)
+		if err != nil {
+			return err
+		}
+		if !signer.authorizes(types.Address(keyAddress)) {
+			return ErrMultisignUnauthorizedKey{Account: account}
+		}
+	}
+
+	for _, txSigner := range txSigners {
+		account, _, _, _ := signerFields(txSigner)
+		c.signatures[types.Address(account)] = txSigner
+	}
+
+	return nil
+}
+
+// Quorum returns the SignerQuorum the signatures must reach.
+func (c *MultisignCoordinator) Quorum() uint32 {
+	return c.quorum
+}
+
+// Signers returns the signers of the SignerList the signatures are checked against.
+func (c *MultisignCoordinator) Signers() []MultisignSigner {
+	return c.signers
+}
+
+// Weight returns the sum of the weights of the signers that have signed.
+func (c *MultisignCoordinator) Weight() uint32 {
+	var weight uint32
+	for _, signer := range c.signers {
+		if _, ok := c.signatures[signer.Account]; ok {
+			weight += uint32(signer.Weight)
+		}
+	}
+	return weight
+}
+
+// QuorumMet reports whether the weight of the collected signatures reaches the quorum.
+func (c *MultisignCoordinator) QuorumMet() bool {
+	return c.Weight() >= c.quorum
+}
+
+// MissingSigners returns the listed signers that have not signed yet, in SignerList order.
+func (c *MultisignCoordinator) MissingSigners() []types.Address {
+	missing := make([]types.Address, 0)
+	for _, signer := range c.signers {
+		if _, ok := c.signatures[signer.Account]; !ok {
+			missing = append(missing, signer.Account)
+		}
+	}
+	return missing
+}
+
+// Finalize returns the encoded transaction with the collected signatures sorted as Signers.
+// It returns ErrMultisignQuorumNotMet if the signatures do not reach the quorum.
+func (c *MultisignCoordinator) Finalize() (string, error) {
+	if !c.QuorumMet() {
+		return "", ErrMultisignQuorumNotMet{Weight: c.Weight(), Quorum: c.quorum}
+	}
+
+	signers := make([]any, 0, len(c.signatures))
+	for _, signer := range c.signatures {
+		signers = append(signers, signer)
+	}
+	if err := SortSigners(signers); err != nil {
+		return "", err
+	}
+
+	tx := make(map[string]any, len(c.tx)+1)
+	maps.Copy(tx, c.tx)
+	tx["Signers"] = signers
+
+	return c.codec.Encode(tx)
+}
+
+func (c *MultisignCoordinator) signer(account types.Address) (MultisignSigner, bool) {
+	for _, signer := range c.signers {
+		if signer.Account == account {
+			return signer, true
+		}
+	}
+	return MultisignSigner{}, false
+}
+
+// authorizes reports whether a key with the given address may sign for the signer.
+func (s MultisignSigner) authorizes(keyAddress types.Address) bool {
+	if keyAddress == s.Account {
+		return !s.MasterDisabled
+	}
+	return s.RegularKey != "" && keyAddress == s.RegularKey
+}
@@ -0,0 +1,138 @@
+package xrpl_test
+
+import (
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultisignCoordinator(t *testing.T) {
+	a, b, c := bundleTestWallets(t)
+	tx := bundleTestTx(a.ClassicAddress.String())
+
+	newCoordinator := func(t *testing.T, signers ...xrpl.MultisignSigner) *xrpl.MultisignCoordinator {
+		t.Helper()
+		coordinator, err := xrpl.NewMultisignCoordinator(tx, 3, signers)
+		require.NoError(t, err)
+		return coordinator
+	}
+	multisign := func(t *testing.T, w wallet.Wallet) string {
+		t.Helper()
+		signed, _, err := w.Multisign(tx)
+		require.NoError(t, err)
+		return signed
+	}
+
+	t.Run("pass - quorum reached", func(t *testing.T) {
+		coordinator := newCoordinator(t,
+			xrpl.MultisignSigner{Account: b.ClassicAddress, Weight: 1},
+			xrpl.MultisignSigner{Account: c.ClassicAddress, Weight: 2},
+		)
+		signedB := multisign(t, b)
+		signedC := multisign(t, c)
+
+		require.NoError(t, coordinator.AddSignature(signedB))
+		require.Equal(t, uint32(1), coordinator.Weight())
+		require.False(t, coordinator.QuorumMet())
+		require.Equal(t, []types.Address{c.ClassicAddress}, coordinator.MissingSigners())
+
+		_, err := coordinator.Finalize()
+		require.ErrorIs(t, err, xrpl.ErrMultisignQuorumNotMet{Weight: 1, Quorum: 3})
+
+		require.NoError(t, coordinator.AddSignature(signedC))
+		require.Equal(t, uint32(3), coordinator.Weight())
+		require.True(t, coordinator.QuorumMet())
+		require.Empty(t, coordinator.MissingSigners())
+
+		blob, err := coordinator.Finalize()
+		require.NoError(t, err)
+
+		expected, err := xrpl.Multisign(signedB, signedC)
+		require.NoError(t, err)
+		require.Equal(t, expected, blob)
+	})
+
+	t.Run("pass - signed with the regular key of the signer", func(t *testing.T) {
+		coordinator := newCoordinator(t,
+			xrpl.MultisignSigner{Account: b.ClassicAddress, Weight: 3, RegularKey: c.ClassicAddress, MasterDisabled: true},
+		)
+		regularKeySigner := c
+		regularKeySigner.ClassicAddress = b.ClassicAddress
+
+		require.NoError(t, coordinator.AddSignature(multisign(t, regularKeySigner)))
+		require.True(t, coordinator.QuorumMet())
+	})
+
+	t.Run("pass - with the given codec", func(t *testing.T) {
+		codec := binarycodec.NewCodec(definitions.Get())
+		coordinator, err := xrpl.NewMultisignCoordinatorWithCodec(tx, 3, []xrpl.MultisignSigner{
+			{Account: b.ClassicAddress, Weight: 3},
+		}, codec)
+		require.NoError(t, err)
+
+		signed, _, err := b.MultisignWithCodec(tx, codec)
+		require.NoError(t, err)
+		require.NoError(t, coordinator.AddSignature(signed))
+
+		blob, err := coordinator.Finalize()
+		require.NoError(t, err)
+
+		expected, err := xrpl.MultisignWithCodec(codec, signed)
+		require.NoError(t, err)
+		require.Equal(t, expected, blob)
+	})
+
+	t.Run("fail - signer not in the signer list", func(t *testing.T) {
+		coordinator := newCoordinator(t, xrpl.MultisignSigner{Account: b.ClassicAddress, Weight: 3})
+
+		err := coordinator.AddSignature(multisign(t, c))
+		require.ErrorIs(t, err, xrpl.ErrMultisignUnexpectedSigner{Account: c.ClassicAddress.String()})
+	})
+
+	t.Run("fail - master key disabled", func(t *testing.T) {
+		coordinator := newCoordinator(t, xrpl.MultisignSigner{Account: b.ClassicAddress, Weight: 3, MasterDisabled: true})
+
+		err := coordinator.AddSignature(multisign(t, b))
+		require.ErrorIs(t, err, xrpl.ErrMultisignUnauthorizedKey{Account: b.ClassicAddress.String()})
+	})
+
+	t.Run("fail - key is not the regular key of the signer", func(t *testing.T) {
+		coordinator := newCoordinator(t, xrpl.MultisignSigner{Account: b.ClassicAddress, Weight: 3})
+		otherKeySigner := c
+		otherKeySigner.ClassicAddress = b.ClassicAddress
+
+		err := coordinator.AddSignature(multisign(t, otherKeySigner))
+		require.ErrorIs(t, err, xrpl.ErrMultisignUnauthorizedKey{Account: b.ClassicAddress.String()})
+	})
+
+	t.Run("fail - different transaction", func(t *testing.T) {
+		coordinator := newCoordinator(t, xrpl.MultisignSigner{Account: b.ClassicAddress, Weight: 3})
+		other := bundleTestTx(a.ClassicAddress.String())
+		other["Sequence"] = uint32(11)
+		signed, _, err := b.Multisign(other)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, coordinator.AddSignature(signed), xrpl.ErrMultisignTxNotEqual)
+	})
+}
+
+func TestMultisignSignersFromSignerList(t *testing.T) {
+	signers := xrpl.MultisignSignersFromSignerList(ledger.SignerList{
+		SignerQuorum: 2,
+		SignerEntries: []ledger.SignerEntryWrapper{
+			{SignerEntry: ledger.SignerEntry{Account: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", SignerWeight: 1}},
+			{SignerEntry: ledger.SignerEntry{Account: "rLHzPsX6oXkzU2qL12kHCH8G8cnZv1rBJh", SignerWeight: 2}},
+		},
+	})
+
+	require.Equal(t, []xrpl.MultisignSigner{
+		{Account: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", Weight: 1},
+		{Account: "rLHzPsX6oXkzU2qL12kHCH8G8cnZv1rBJh", Weight: 2},
+	}, signers)
+}
@@ -0,0 +1,379 @@
+package xrpl
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// PreclaimReason identifies the ledger state check a transaction failed in Preclaim.
+type PreclaimReason string
+
+const (
+	// PreclaimSourceNotFound means the sending account does not exist.
+	PreclaimSourceNotFound PreclaimReason = "source_not_found"
+	// PreclaimInsufficientFee means the sending account cannot pay the transaction fee.
+	PreclaimInsufficientFee PreclaimReason = "insufficient_fee"
+	// PreclaimNoDestination means the destination does not exist and the payment cannot create it.
+	PreclaimNoDestination PreclaimReason = "no_destination"
+	// PreclaimInsufficientXRPToCreate means the destination does not exist and the XRP sent
+	// is below the account reserve needed to create it.
+	PreclaimInsufficientXRPToCreate PreclaimReason = "insufficient_xrp_to_create"
+	// PreclaimDestinationTagNeeded means the destination requires a destination tag and none is set.
+	PreclaimDestinationTagNeeded PreclaimReason = "destination_tag_needed"
+	// PreclaimDepositNotAuthorized means the destination has DepositAuth enabled and has not
+	// preauthorized the sender.
+	PreclaimDepositNotAuthorized PreclaimReason = "deposit_not_authorized"
+	// PreclaimNoTrustLine means the sender or the destination has no trust line to the issuer.
+	PreclaimNoTrustLine PreclaimReason = "no_trust_line"
+	// PreclaimFrozen means a trust line involved in the payment is frozen or deep frozen,
+	// or the issuer has a global freeze.
+	PreclaimFrozen PreclaimReason = "frozen"
+	// PreclaimNoRipple means the issuer has NoRipple set on both trust lines, so the payment
+	// cannot ripple through it.
+	PreclaimNoRipple PreclaimReason = "no_ripple"
+	// PreclaimInsufficientBalance means the sender spendable balance, after reserves and fee,
+	// is below the amount.
+	PreclaimInsufficientBalance PreclaimReason = "insufficient_balance"
+	// PreclaimMPTIssuanceNotFound means the MPTokenIssuance of the amount does not exist.
+	PreclaimMPTIssuanceNotFound PreclaimReason = "mpt_issuance_not_found"
+	// PreclaimMPTNotAuthorized means the sender or the destination does not hold the MPT,
+	// or is not authorized by an issuer that requires authorization.
+	PreclaimMPTNotAuthorized PreclaimReason = "mpt_not_authorized"
+	// PreclaimMPTNotTransferable means the MPT cannot be transferred between holders.
+	PreclaimMPTNotTransferable PreclaimReason = "mpt_not_transferable"
+	// PreclaimMPTLocked means the MPT issuance or a holder balance is locked.
+	PreclaimMPTLocked PreclaimReason = "mpt_locked"
+)
+
+// PreclaimSource provides the ledger state read by Preclaim. Each lookup is only called
+// when a check needs it.
+type PreclaimSource struct {
+	// AccountRoot returns the AccountRoot of an account, or nil if the account does not exist.
+	AccountRoot func(address types.Address) (*ledger.AccountRoot, error)
+	// Reserves returns the account reserve and the owner reserve, in drops.
+	Reserves func() (base, inc uint64, err error)
+	// DepositAuthorized reports whether source can deliver funds to destination.
+	DepositAuthorized func(source, destination types.Address) (bool, error)
+	// TrustLine returns the trust line of account with issuer for currency, as seen by account,
+	// or nil if there is none.
+	TrustLine func(account, issuer types.Address, currency string) (*accounttypes.TrustLine, error)
+	// MPTokenIssuance returns the MPTokenIssuance ledger entry, or nil if it does not exist.
+	MPTokenIssuance func(mptIssuanceID string) (ledger.FlatLedgerObject, error)
+	// MPToken returns the MPToken ledger entry of holder, or nil if it does not exist.
+	MPToken func(mptIssuanceID string, holder types.Address) (ledger.FlatLedgerObject, error)
+}
+
+// Preclaim runs the common ledger state checks rippled applies before a transaction is
+// claimed, so a transaction bound to fail is rejected before any fee is burned.
+// The sending account must exist and be able to pay the fee. Payments are also checked for
+// destination creation, destination tags, DepositAuth, trust lines, freezes, NoRipple,
+// spendable balance and MPT authorization.
+//
+// Balance and trust line checks are skipped for partial and cross-currency payments, since
+// their delivered amount depends on paths only the server can evaluate.
+// A failed check is returned as ErrPreclaim.
+func Preclaim(tx transaction.FlatTransaction, src PreclaimSource) error {
+	account, ok := tx["Account"].(string)
+	if !ok || account == "" {
+		return ErrMissingAccountInTransaction
+	}
+	sender := types.Address(account)
+
+	senderRoot, err := src.AccountRoot(sender)
+	if err != nil {
+		return err
+	}
+	if senderRoot == nil {
+		return ErrPreclaim{Reason: PreclaimSourceNotFound, Result: transaction.TerNO_ACCOUNT, Account: sender}
+	}
+
+	fee, err := flatUint(tx["Fee"])
+	if err != nil {
+		return err
+	}
+	if uint64(senderRoot.Balance) < fee {
+		return ErrPreclaim{Reason: PreclaimInsufficientFee, Result: transaction.TerINSUF_FEE_B, Account: sender}
+	}
+
+	if tx.TxType() != transaction.PaymentTx {
+		return nil
+	}
+
+	p := &paymentPreclaim{tx: tx, src: src, sender: sender, senderRoot: senderRoot, fee: fee}
+	return p.check()
+}
+
+// paymentPreclaim holds the state of the Preclaim checks of a Payment.
+type paymentPreclaim struct {
+	tx         transaction.FlatTransaction
+	src        PreclaimSource
+	sender     types.Address
+	senderRoot *ledger.AccountRoot
+	fee        uint64
+}
+
+func (p *paymentPreclaim) check() error {
+	destination, _ := p.tx["Destination"].(string)
+	if destination == "" {
+		return nil
+	}
+	dest := types.Address(destination)
+
+	amount, ok := p.tx["Amount"]
+	if !ok {
+		amount = p.tx["DeliverMax"]
+	}
+
+	destRoot, err := p.src.AccountRoot(dest)
+	if err != nil {
+		return err
+	}
+
+	xrpAmount, isXRP := amount.(string)
+	var drops uint64
+	if isXRP {
+		if drops, err = strconv.ParseUint(xrpAmount, 10, 64); err != nil {
+			return err
+		}
+	}
+
+	if destRoot == nil {
+		if !isXRP {
+			return ErrPreclaim{Reason: PreclaimNoDestination, Result: transaction.TecNO_DST, Account: dest}
+		}
+		base, _, err := p.src.Reserves()
+		if err != nil {
+			return err
+		}
+		if drops < base {
+			return ErrPreclaim{Reason: PreclaimInsufficientXRPToCreate, Result: transaction.TecNO_DST_INSUF_XRP, Account: dest}
+		}
+	} else if err := p.checkDestination(dest, destRoot); err != nil {
+		return err
+	}
+
+	// The delivered amount of partial and cross-currency payments depends on paths.
+	flags, err := flatUint(p.tx["Flags"])
+	if err != nil {
+		return err
+	}
+	_, hasSendMax := p.tx["SendMax"]
+	_, hasPaths := p.tx["Paths"]
+	if uint32(flags)&transaction.TfPartialPayment != 0 || hasSendMax || hasPaths {
+		return nil
+	}
+
+	if isXRP {
+		return p.checkXRP(drops)
+	}
+
+	amountObj, ok := amount.(map[string]any)
+	if !ok {
+		return nil
+	}
+	if mptIssuanceID, ok := amountObj["mpt_issuance_id"].(string); ok {
+		return p.checkMPT(dest, mptIssuanceID, amountObj)
+	}
+	return p.checkIssued(dest, amountObj)
+}
+
+// checkDestination checks the destination tag and deposit authorization of an existing destination.
+func (p *paymentPreclaim) checkDestination(dest types.Address, destRoot *ledger.AccountRoot) error {
+	if destRoot.Flags&ledger.LsfRequireDestTag != 0 {
+		if _, ok := p.tx["DestinationTag"]; !ok {
+			return ErrPreclaim{Reason: PreclaimDestinationTagNeeded, Result: transaction.TecDST_TAG_NEEDED, Account: dest}
+		}
+	}
+
+	if destRoot.Flags&ledger.LsfDepositAuth != 0 && dest != p.sender {
+		authorized, err := p.src.DepositAuthorized(p.sender, dest)
+		if err != nil {
+			return err
+		}
+		if !authorized {
+			return ErrPreclaim{Reason: PreclaimDepositNotAuthorized, Result: transaction.TecNO_PERMISSION, Account: dest}
+		}
+	}
+
+	return nil
+}
+
+// checkXRP checks that the sender can send drops, as rippled does: the balance before the fee
+// must cover the drops plus the larger of the reserve and the fee.
+func (p *paymentPreclaim) checkXRP(drops uint64) error {
+	base, inc, err := p.src.Reserves()
+	if err != nil {
+		return err
+	}
+
+	reserve := base + uint64(p.senderRoot.OwnerCount)*inc
+	balance := uint64(p.senderRoot.Balance)
+	if balance < drops+max(reserve, p.fee) {
+		return ErrPreclaim{Reason: PreclaimInsufficientBalance, Result: transaction.TecUNFUNDED_PAYMENT, Account: p.sender}
+	}
+	return nil
+}
+
+// checkIssued checks the trust lines of a direct issued currency payment.
+func (p *paymentPreclaim) checkIssued(dest types.Address, amount map[string]any) error {
+	issuerAddress, _ := amount["issuer"].(string)
+	currency, _ := amount["currency"].(string)
+	value, _ := amount["value"].(string)
+	issuer := types.Address(issuerAddress)
+
+	issuerRoot, err := p.src.AccountRoot(issuer)
+	if err != nil {
+		return err
+	}
+	if issuerRoot == nil {
+		return ErrPreclaim{Reason: PreclaimNoTrustLine, Result: transaction.TecPATH_DRY, Account: issuer}
+	}
+	if issuerRoot.Flags&ledger.LsfGlobalFreeze != 0 && p.sender != issuer && dest != issuer {
+		return ErrPreclaim{Reason: PreclaimFrozen, Result: transaction.TecPATH_DRY, Account: issuer}
+	}
+
+	var senderLine, destLine *accounttypes.TrustLine
+	if p.sender != issuer {
+		if senderLine, err = p.src.TrustLine(p.sender, issuer, currency); err != nil {
+			return err
+		}
+		if senderLine == nil {
+			return ErrPreclaim{Reason: PreclaimNoTrustLine, Result: transaction.TecPATH_DRY, Account: p.sender}
+		}
+		// Frozen holders can only send back to the issuer.
+		if (senderLine.FreezePeer || senderLine.DeepFreezePeer) && dest != issuer {
+			return ErrPreclaim{Reason: PreclaimFrozen, Result: transaction.TecPATH_DRY, Account: p.sender}
+		}
+		if compareDecimal(senderLine.Balance, value) < 0 {
+			return ErrPreclaim{Reason: PreclaimInsufficientBalance, Result: transaction.TecPATH_PARTIAL, Account: p.sender}
+		}
+	}
+
+	if dest != issuer {
+		if destLine, err = p.src.TrustLine(dest, issuer, currency); err != nil {
+			return err
+		}
+		if destLine == nil {
+			return ErrPreclaim{Reason: PreclaimNoTrustLine, Result: transaction.TecPATH_DRY, Account: dest}
+		}
+		// Deep frozen holders cannot receive, even from the issuer.
+		if destLine.DeepFreezePeer {
+			return ErrPreclaim{Reason: PreclaimFrozen, Result: transaction.TecPATH_DRY, Account: dest}
+		}
+	}
+
+	// A holder to holder payment ripples through the issuer, which blocks it
+	// when it has NoRipple set on both trust lines.
+	if senderLine != nil && destLine != nil && senderLine.NoRipplePeer && destLine.NoRipplePeer {
+		return ErrPreclaim{Reason: PreclaimNoRipple, Result: transaction.TecPATH_DRY, Account: issuer}
+	}
+
+	return nil
+}
+
+// checkMPT checks the issuance and holder MPTokens of an MPT payment.
+func (p *paymentPreclaim) checkMPT(dest types.Address, mptIssuanceID string, amount map[string]any) error {
+	issuance, err := p.src.MPTokenIssuance(mptIssuanceID)
+	if err != nil {
+		return err
+	}
+	if issuance == nil {
+		return ErrPreclaim{Reason: PreclaimMPTIssuanceNotFound, Result: transaction.TecOBJECT_NOT_FOUND, Account: p.sender}
+	}
+
+	issuerAddress, _ := issuance["Issuer"].(string)
+	issuer := types.Address(issuerAddress)
+	issuanceFlags, err := flatUint(issuance["Flags"])
+	if err != nil {
+		return err
+	}
+
+	if p.sender != issuer && dest != issuer && uint32(issuanceFlags)&ledger.LsfMPTCanTransfer == 0 {
+		return ErrPreclaim{Reason: PreclaimMPTNotTransferable, Result: transaction.TecNO_AUTH, Account: issuer}
+	}
+
+	value, err := flatUint(amount["value"])
+	if err != nil {
+		return err
+	}
+
+	for _, holder := range []types.Address{p.sender, dest} {
+		if holder == issuer {
+			continue
+		}
+
+		token, err := p.src.MPToken(mptIssuanceID, holder)
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			return ErrPreclaim{Reason: PreclaimMPTNotAuthorized, Result: transaction.TecNO_AUTH, Account: holder}
+		}
+
+		tokenFlags, err := flatUint(token["Flags"])
+		if err != nil {
+			return err
+		}
+		if uint32(issuanceFlags)&ledger.LsfMPTRequireAuth != 0 && uint32(tokenFlags)&ledger.LsfMPTAuthorized == 0 {
+			return ErrPreclaim{Reason: PreclaimMPTNotAuthorized, Result: transaction.TecNO_AUTH, Account: holder}
+		}
+		if uint32(issuanceFlags)&ledger.LsfMPTLocked != 0 || uint32(tokenFlags)&ledger.LsfMPTLocked != 0 {
+			return ErrPreclaim{Reason: PreclaimMPTLocked, Result: transaction.TecLOCKED, Account: holder}
+		}
+
+		if holder == p.sender {
+			balance, err := flatUint(token["MPTAmount"])
+			if err != nil {
+				return err
+			}
+			if balance < value {
+				return ErrPreclaim{Reason: PreclaimInsufficientBalance, Result: transaction.TecPATH_PARTIAL, Account: holder}
+			}
+		}
+	}
+
+	return nil
+}
+
+// flatUint returns the unsigned integer value of a flat transaction or ledger object field,
+// which holds a decimal string or a JSON number depending on its source. Missing fields are 0.
+func flatUint(v any) (uint64, error) {
+	switch n := v.(type) {
+	case nil:
+		return 0, nil
+	case string:
+		return strconv.ParseUint(n, 10, 64)
+	case json.Number:
+		return strconv.ParseUint(n.String(), 10, 64)
+	case float64:
+		return uint64(n), nil
+	case uint64:
+		return n, nil
+	case uint32:
+		return uint64(n), nil
+	case int:
+		return uint64(n), nil
+	default:
+		return 0, ErrPreclaimInvalidNumber
+	}
+}
+
+// compareDecimal compares two decimal strings, as found in issued currency amounts.
+// Values that cannot be parsed compare as 0.
+func compareDecimal(a, b string) int {
+	x, _, err := big.ParseFloat(a, 10, 128, big.ToNearestEven)
+	if err != nil {
+		x = new(big.Float)
+	}
+	y, _, err := big.ParseFloat(b, 10, 128, big.ToNearestEven)
+	if err != nil {
+		y = new(big.Float)
+	}
+	return x.Cmp(y)
+}
@@ -0,0 +1,355 @@
+package xrpl
+
+import (
+	"testing"
+
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	preclaimSender = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+	preclaimDest   = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	preclaimIssuer = "rLHzPsX6oXkzU2qL12kHCH8G8cnZv1rBJh"
+	preclaimMPTID  = "000000018A5182C75B3FB0B57A011EF619BD7B0307398CC1"
+)
+
+// preclaimState is an in-memory ledger state for Preclaim tests.
+type preclaimState struct {
+	accounts      map[types.Address]*ledger.AccountRoot
+	lines         map[types.Address]*accounttypes.TrustLine
+	preauthorized bool
+	issuance      ledger.FlatLedgerObject
+	tokens        map[types.Address]ledger.FlatLedgerObject
+}
+
+func newPreclaimState() *preclaimState {
+	return &preclaimState{
+		accounts: map[types.Address]*ledger.AccountRoot{
+			preclaimSender: {Account: preclaimSender, Balance: 50000000, OwnerCount: 2},
+			preclaimDest:   {Account: preclaimDest, Balance: 20000000},
+			preclaimIssuer: {Account: preclaimIssuer, Balance: 20000000},
+		},
+		lines: map[types.Address]*accounttypes.TrustLine{
+			preclaimSender: {Account: preclaimIssuer, Currency: "USD", Balance: "100", Limit: "1000"},
+			preclaimDest:   {Account: preclaimIssuer, Currency: "USD", Balance: "0", Limit: "1000"},
+		},
+		issuance: ledger.FlatLedgerObject{
+			"Issuer": preclaimIssuer,
+			"Flags":  ledger.LsfMPTCanTransfer,
+		},
+		tokens: map[types.Address]ledger.FlatLedgerObject{
+			preclaimSender: {"Flags": uint32(0), "MPTAmount": "100"},
+			preclaimDest:   {"Flags": uint32(0)},
+		},
+	}
+}
+
+func (s *preclaimState) source() PreclaimSource {
+	return PreclaimSource{
+		AccountRoot: func(address types.Address) (*ledger.AccountRoot, error) {
+			return s.accounts[address], nil
+		},
+		Reserves: func() (uint64, uint64, error) {
+			return 1000000, 200000, nil
+		},
+		DepositAuthorized: func(_, _ types.Address) (bool, error) {
+			return s.preauthorized, nil
+		},
+		TrustLine: func(account, _ types.Address, currency string) (*accounttypes.TrustLine, error) {
+			line := s.lines[account]
+			if line == nil || line.Currency != currency {
+				return nil, nil
+			}
+			return line, nil
+		},
+		MPTokenIssuance: func(_ string) (ledger.FlatLedgerObject, error) {
+			return s.issuance, nil
+		},
+		MPToken: func(_ string, holder types.Address) (ledger.FlatLedgerObject, error) {
+			return s.tokens[holder], nil
+		},
+	}
+}
+
+func preclaimPayment(amount any) transaction.FlatTransaction {
+	return transaction.FlatTransaction{
+		"TransactionType": "Payment",
+		"Account":         preclaimSender,
+		"Destination":     preclaimDest,
+		"Amount":          amount,
+		"Fee":             "12",
+	}
+}
+
+func withFee(tx transaction.FlatTransaction, fee string) transaction.FlatTransaction {
+	tx["Fee"] = fee
+	return tx
+}
+
+func TestPreclaim(t *testing.T) {
+	usd := func(issuer, value string) map[string]any {
+		return map[string]any{"currency": "USD", "issuer": issuer, "value": value}
+	}
+	mpt := func(value string) map[string]any {
+		return map[string]any{"mpt_issuance_id": preclaimMPTID, "value": value}
+	}
+
+	testCases := []struct {
+		name   string
+		tx     transaction.FlatTransaction
+		update func(s *preclaimState)
+		err    error
+	}{
+		{
+			name: "pass - XRP payment",
+			tx:   preclaimPayment("1000000"),
+		},
+		{
+			name: "pass - XRP payment creating the destination",
+			tx:   preclaimPayment("1000000"),
+			update: func(s *preclaimState) {
+				delete(s.accounts, preclaimDest)
+			},
+		},
+		{
+			name: "pass - non payment transaction",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         preclaimSender,
+				"Fee":             "12",
+			},
+		},
+		{
+			name: "pass - issued currency payment",
+			tx:   preclaimPayment(usd(preclaimIssuer, "50")),
+		},
+		{
+			name: "pass - issued currency payment from the issuer",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Payment",
+				"Account":         preclaimIssuer,
+				"Destination":     preclaimDest,
+				"Amount":          usd(preclaimIssuer, "5000"),
+				"Fee":             "12",
+			},
+		},
+		{
+			name: "pass - frozen holder paying the issuer",
+			tx: transaction.FlatTransaction{
+				"TransactionType": "Payment",
+				"Account":         preclaimSender,
+				"Destination":     preclaimIssuer,
+				"Amount":          usd(preclaimIssuer, "50"),
+				"Fee":             "12",
+			},
+			update: func(s *preclaimState) {
+				s.lines[preclaimSender].FreezePeer = true
+			},
+		},
+		{
+			name: "pass - partial payment skips balance checks",
+			tx: func() transaction.FlatTransaction {
+				tx := preclaimPayment(usd(preclaimIssuer, "5000"))
+				tx["Flags"] = transaction.TfPartialPayment
+				return tx
+			}(),
+		},
+		{
+			name: "pass - MPT payment",
+			tx:   preclaimPayment(mpt("50")),
+		},
+		{
+			name: "pass - DepositAuth with preauthorization",
+			tx:   preclaimPayment("1000000"),
+			update: func(s *preclaimState) {
+				s.accounts[preclaimDest].Flags = ledger.LsfDepositAuth
+				s.preauthorized = true
+			},
+		},
+		{
+			name: "fail - source not found",
+			tx:   preclaimPayment("1000000"),
+			update: func(s *preclaimState) {
+				delete(s.accounts, preclaimSender)
+			},
+			err: ErrPreclaim{Reason: PreclaimSourceNotFound, Result: transaction.TerNO_ACCOUNT, Account: preclaimSender},
+		},
+		{
+			name: "fail - cannot pay the fee",
+			tx:   preclaimPayment("1000000"),
+			update: func(s *preclaimState) {
+				s.accounts[preclaimSender].Balance = 10
+			},
+			err: ErrPreclaim{Reason: PreclaimInsufficientFee, Result: transaction.TerINSUF_FEE_B, Account: preclaimSender},
+		},
+		{
+			name: "fail - XRP below the reserve to create the destination",
+			tx:   preclaimPayment("10"),
+			update: func(s *preclaimState) {
+				delete(s.accounts, preclaimDest)
+			},
+			err: ErrPreclaim{Reason: PreclaimInsufficientXRPToCreate, Result: transaction.TecNO_DST_INSUF_XRP, Account: preclaimDest},
+		},
+		{
+			name: "fail - issued currency to a missing destination",
+			tx:   preclaimPayment(usd(preclaimIssuer, "50")),
+			update: func(s *preclaimState) {
+				delete(s.accounts, preclaimDest)
+			},
+			err: ErrPreclaim{Reason: PreclaimNoDestination, Result: transaction.TecNO_DST, Account: preclaimDest},
+		},
+		{
+			name: "fail - destination tag needed",
+			tx:   preclaimPayment("1000000"),
+			update: func(s *preclaimState) {
+				s.accounts[preclaimDest].Flags = ledger.LsfRequireDestTag
+			},
+			err: ErrPreclaim{Reason: PreclaimDestinationTagNeeded, Result: transaction.TecDST_TAG_NEEDED, Account: preclaimDest},
+		},
+		{
+			name: "fail - DepositAuth without preauthorization",
+			tx:   preclaimPayment("1000000"),
+			update: func(s *preclaimState) {
+				s.accounts[preclaimDest].Flags = ledger.LsfDepositAuth
+			},
+			err: ErrPreclaim{Reason: PreclaimDepositNotAuthorized, Result: transaction.TecNO_PERMISSION, Account: preclaimDest},
+		},
+		{
+			name: "fail - XRP balance below reserve",
+			tx:   preclaimPayment("49500000"),
+			err:  ErrPreclaim{Reason: PreclaimInsufficientBalance, Result: transaction.TecUNFUNDED_PAYMENT, Account: preclaimSender},
+		},
+		{
+			// The reserve of 1.4 XRP is above the fee: the balance must cover the amount and the reserve.
+			name: "pass - XRP amount at the reserve boundary",
+			tx:   preclaimPayment("48600000"),
+		},
+		{
+			name: "fail - XRP amount one drop above the reserve boundary",
+			tx:   preclaimPayment("48600001"),
+			err:  ErrPreclaim{Reason: PreclaimInsufficientBalance, Result: transaction.TecUNFUNDED_PAYMENT, Account: preclaimSender},
+		},
+		{
+			// The fee of 2 XRP is above the reserve: the balance must cover the amount and the fee.
+			name: "pass - XRP amount at the fee boundary",
+			tx:   withFee(preclaimPayment("48000000"), "2000000"),
+		},
+		{
+			name: "fail - XRP amount one drop above the fee boundary",
+			tx:   withFee(preclaimPayment("48000001"), "2000000"),
+			err:  ErrPreclaim{Reason: PreclaimInsufficientBalance, Result: transaction.TecUNFUNDED_PAYMENT, Account: preclaimSender},
+		},
+		{
+			name: "fail - destination without trust line",
+			tx:   preclaimPayment(usd(preclaimIssuer, "50")),
+			update: func(s *preclaimState) {
+				delete(s.lines, preclaimDest)
+			},
+			err: ErrPreclaim{Reason: PreclaimNoTrustLine, Result: transaction.TecPATH_DRY, Account: preclaimDest},
+		},
+		{
+			name: "fail - frozen sender line",
+			tx:   preclaimPayment(usd(preclaimIssuer, "50")),
+			update: func(s *preclaimState) {
+				s.lines[preclaimSender].FreezePeer = true
+			},
+			err: ErrPreclaim{Reason: PreclaimFrozen, Result: transaction.TecPATH_DRY, Account: preclaimSender},
+		},
+		{
+			name: "fail - deep frozen destination line",
+			tx:   preclaimPayment(usd(preclaimIssuer, "50")),
+			update: func(s *preclaimState) {
+				s.lines[preclaimDest].DeepFreezePeer = true
+			},
+			err: ErrPreclaim{Reason: PreclaimFrozen, Result: transaction.TecPATH_DRY, Account: preclaimDest},
+		},
+		{
+			name: "fail - global freeze",
+			tx:   preclaimPayment(usd(preclaimIssuer, "50")),
+			update: func(s *preclaimState) {
+				s.accounts[preclaimIssuer].Flags = ledger.LsfGlobalFreeze
+			},
+			err: ErrPreclaim{Reason: PreclaimFrozen, Result: transaction.TecPATH_DRY, Account: preclaimIssuer},
+		},
+		{
+			name: "fail - issued currency balance too low",
+			tx:   preclaimPayment(usd(preclaimIssuer, "1e3")),
+			err:  ErrPreclaim{Reason: PreclaimInsufficientBalance, Result: transaction.TecPATH_PARTIAL, Account: preclaimSender},
+		},
+		{
+			name: "fail - NoRipple on both issuer lines",
+			tx:   preclaimPayment(usd(preclaimIssuer, "50")),
+			update: func(s *preclaimState) {
+				s.lines[preclaimSender].NoRipplePeer = true
+				s.lines[preclaimDest].NoRipplePeer = true
+			},
+			err: ErrPreclaim{Reason: PreclaimNoRipple, Result: transaction.TecPATH_DRY, Account: preclaimIssuer},
+		},
+		{
+			name: "fail - MPT issuance not found",
+			tx:   preclaimPayment(mpt("50")),
+			update: func(s *preclaimState) {
+				s.issuance = nil
+			},
+			err: ErrPreclaim{Reason: PreclaimMPTIssuanceNotFound, Result: transaction.TecOBJECT_NOT_FOUND, Account: preclaimSender},
+		},
+		{
+			name: "fail - MPT not transferable",
+			tx:   preclaimPayment(mpt("50")),
+			update: func(s *preclaimState) {
+				s.issuance["Flags"] = uint32(0)
+			},
+			err: ErrPreclaim{Reason: PreclaimMPTNotTransferable, Result: transaction.TecNO_AUTH, Account: preclaimIssuer},
+		},
+		{
+			name: "fail - destination does not hold the MPT",
+			tx:   preclaimPayment(mpt("50")),
+			update: func(s *preclaimState) {
+				delete(s.tokens, preclaimDest)
+			},
+			err: ErrPreclaim{Reason: PreclaimMPTNotAuthorized, Result: transaction.TecNO_AUTH, Account: preclaimDest},
+		},
+		{
+			name: "fail - MPT holder not authorized",
+			tx:   preclaimPayment(mpt("50")),
+			update: func(s *preclaimState) {
+				s.issuance["Flags"] = ledger.LsfMPTCanTransfer | ledger.LsfMPTRequireAuth
+				s.tokens[preclaimSender]["Flags"] = ledger.LsfMPTAuthorized
+			},
+			err: ErrPreclaim{Reason: PreclaimMPTNotAuthorized, Result: transaction.TecNO_AUTH, Account: preclaimDest},
+		},
+		{
+			name: "fail - MPT locked",
+			tx:   preclaimPayment(mpt("50")),
+			update: func(s *preclaimState) {
+				s.tokens[preclaimSender]["Flags"] = ledger.LsfMPTLocked
+			},
+			err: ErrPreclaim{Reason: PreclaimMPTLocked, Result: transaction.TecLOCKED, Account: preclaimSender},
+		},
+		{
+			name: "fail - MPT balance too low",
+			tx:   preclaimPayment(mpt("500")),
+			err:  ErrPreclaim{Reason: PreclaimInsufficientBalance, Result: transaction.TecPATH_PARTIAL, Account: preclaimSender},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := newPreclaimState()
+			if tc.update != nil {
+				tc.update(state)
+			}
+
+			err := Preclaim(tc.tx, state.source())
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
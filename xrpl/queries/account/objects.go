@@ -80,3 +80,16 @@ type ObjectsResponse struct {
 	Marker             any                       `json:"marker,omitempty"`
 	Validated          bool                      `json:"validated,omitempty"`
 }
+
+// Objects returns the account objects as their concrete ledger object types.
+func (r *ObjectsResponse) Objects() ([]ledger.Object, error) {
+	objects := make([]ledger.Object, 0, len(r.AccountObjects))
+	for _, flat := range r.AccountObjects {
+		obj, err := ledger.Parse(flat)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
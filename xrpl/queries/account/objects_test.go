@@ -3,8 +3,10 @@ package account
 import (
 	"testing"
 
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccountObjectsRequest(t *testing.T) {
@@ -23,3 +25,31 @@ func TestAccountObjectsRequest(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestAccountObjectsResponse_Objects(t *testing.T) {
+	res := ObjectsResponse{
+		Account: "rsuHaTvJh1bDmDoxX9QcKP7HEBSBt4XsHx",
+		AccountObjects: []ledger.FlatLedgerObject{
+			{
+				"LedgerEntryType": "Ticket",
+				"Account":         "rsuHaTvJh1bDmDoxX9QcKP7HEBSBt4XsHx",
+				"TicketSequence":  3,
+			},
+			{
+				"LedgerEntryType": "SignerList",
+				"SignerQuorum":    2,
+			},
+		},
+	}
+
+	objects, err := res.Objects()
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	require.IsType(t, &ledger.Ticket{}, objects[0])
+	require.Equal(t, uint32(3), objects[0].(*ledger.Ticket).TicketSequence)
+	require.IsType(t, &ledger.SignerList{}, objects[1])
+
+	res.AccountObjects = append(res.AccountObjects, ledger.FlatLedgerObject{"LedgerEntryType": "Unknown"})
+	_, err = res.Objects()
+	require.ErrorIs(t, err, ledger.ErrUnrecognizedLedgerObjectType{Type: "Unknown"})
+}
@@ -20,4 +20,6 @@ type TrustLine struct {
 	PeerAuthorized bool          `json:"peer_authorized,omitempty"`
 	Freeze         bool          `json:"freeze,omitempty"`
 	FreezePeer     bool          `json:"freeze_peer,omitempty"`
+	DeepFreeze     bool          `json:"deep_freeze,omitempty"`
+	DeepFreezePeer bool          `json:"deep_freeze_peer,omitempty"`
 }
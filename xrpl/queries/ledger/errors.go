@@ -0,0 +1,20 @@
+package ledger
+
+import "errors"
+
+var (
+	// ledger verification
+
+	// ErrMissingLedgerHash is returned when a ledger response has no ledger hash to verify.
+	ErrMissingLedgerHash = errors.New("ledger response has no ledger hash")
+	// ErrLedgerHashMismatch is returned when the hash of a ledger header does not match the reported ledger hash.
+	ErrLedgerHashMismatch = errors.New("ledger header does not match ledger hash")
+	// ErrLedgerIndexMismatch is returned when the ledger index of a response does not match the index of its ledger.
+	ErrLedgerIndexMismatch = errors.New("ledger index does not match")
+	// ErrTransactionHashMismatch is returned when the transactions of a ledger do not hash to its transaction hash.
+	ErrTransactionHashMismatch = errors.New("ledger transactions do not match transaction hash")
+	// ErrAccountHashMismatch is returned when the account state of a ledger does not hash to its account hash.
+	ErrAccountHashMismatch = errors.New("ledger account state does not match account hash")
+	// ErrParentHashMismatch is returned when the parent hash of a ledger does not match the hash of its parent ledger.
+	ErrParentHashMismatch = errors.New("parent hash does not match parent ledger hash")
+)
@@ -49,3 +49,16 @@ type DataResponse struct {
 	State       []ledgertypes.State `json:"state"`
 	Marker      any                 `json:"marker"`
 }
+
+// Objects returns the state objects as their concrete ledger object types.
+func (r *DataResponse) Objects() ([]ledger.Object, error) {
+	objects := make([]ledger.Object, 0, len(r.State))
+	for i := range r.State {
+		obj, err := r.State[i].Object()
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
@@ -1,10 +1,14 @@
 package ledger
 
 import (
+	"encoding/json"
 	"testing"
 
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLedgerDataRequest(t *testing.T) {
@@ -22,3 +26,58 @@ func TestLedgerDataRequest(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestDataResponse_Objects(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+	}{
+		{
+			name: "pass - json state",
+			response: `{
+	"ledger_index": "6",
+	"state": [
+		{
+			"Account": "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+			"Balance": "370000000",
+			"Flags": 0,
+			"LedgerEntryType": "AccountRoot",
+			"OwnerCount": 0,
+			"PreviousTxnID": "8D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B4",
+			"PreviousTxnLgrSeq": 8901,
+			"Sequence": 1,
+			"index": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8"
+		}
+	]
+}`,
+		},
+		{
+			name: "pass - binary state",
+			response: `{
+	"ledger_index": "6",
+	"state": [
+		{
+			"data": "1100612200000000240000000125000022C52D00000000558D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B46240000000160DC0808114712B799C79D1EEE3094B59EF9920C7FEB3CE4499",
+			"index": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8"
+		}
+	]
+}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res DataResponse
+			require.NoError(t, json.Unmarshal([]byte(tt.response), &res))
+
+			objects, err := res.Objects()
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			accountRoot, ok := objects[0].(*ledger.AccountRoot)
+			require.True(t, ok)
+			require.Equal(t, types.Address("rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7"), accountRoot.Account)
+			require.Equal(t, types.XRPCurrencyAmount(370000000), accountRoot.Balance)
+		})
+	}
+}
@@ -44,5 +44,15 @@ type EntryResponse struct {
 	LedgerIndex        common.LedgerIndex      `json:"ledger_index,omitempty"`
 	LedgerCurrentIndex common.LedgerIndex      `json:"ledger_current_index,omitempty"`
 	Node               ledger.FlatLedgerObject `json:"node"`
+	NodeBinary         string                  `json:"node_binary,omitempty"`
 	Validated          bool                    `json:"validated"`
 }
+
+// Object returns the ledger entry as its concrete ledger object type, decoding it from
+// NodeBinary when the entry was requested as binary.
+func (r *EntryResponse) Object() (ledger.Object, error) {
+	if r.NodeBinary != "" {
+		return ledger.DecodeBlob(r.NodeBinary)
+	}
+	return ledger.Parse(r.Node)
+}
@@ -0,0 +1,63 @@
+package ledger
+
+import (
+	"encoding/json"
+	"testing"
+
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryResponse_Object(t *testing.T) {
+	expected := &ledger.AccountRoot{
+		LedgerEntryType:   ledger.AccountRootEntry,
+		Account:           "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+		Balance:           types.XRPCurrencyAmount(370000000),
+		PreviousTxnID:     "8D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B4",
+		PreviousTxnLgrSeq: 8901,
+		Sequence:          1,
+	}
+
+	tests := []struct {
+		name     string
+		response string
+	}{
+		{
+			name: "pass - json node",
+			response: `{
+	"index": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+	"node": {
+		"Account": "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+		"Balance": "370000000",
+		"Flags": 0,
+		"LedgerEntryType": "AccountRoot",
+		"OwnerCount": 0,
+		"PreviousTxnID": "8D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B4",
+		"PreviousTxnLgrSeq": 8901,
+		"Sequence": 1
+	},
+	"validated": true
+}`,
+		},
+		{
+			name: "pass - binary node",
+			response: `{
+	"index": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+	"node_binary": "1100612200000000240000000125000022C52D00000000558D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B46240000000160DC0808114712B799C79D1EEE3094B59EF9920C7FEB3CE4499",
+	"validated": true
+}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res EntryResponse
+			require.NoError(t, json.Unmarshal([]byte(tt.response), &res))
+
+			obj, err := res.Object()
+			require.NoError(t, err)
+			require.Equal(t, expected, obj)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+//revive:disable:var-naming
+package types
+
+import "errors"
+
+var (
+	// ledger trees
+
+	// ErrTransactionNotExpanded is returned when a ledger transaction is listed by hash only, so
+	// the transaction tree cannot be built from it.
+	ErrTransactionNotExpanded = errors.New("ledger transaction is not expanded")
+	// ErrInvalidLedgerTransaction is returned when a ledger transaction is neither in JSON nor in binary form.
+	ErrInvalidLedgerTransaction = errors.New("invalid ledger transaction")
+	// ErrInvalidLedgerState is returned when a ledger state object has no index, or no data in binary form.
+	ErrInvalidLedgerState = errors.New("invalid ledger state object")
+)
@@ -4,6 +4,7 @@
 package types
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -28,6 +29,21 @@ type BaseLedger struct {
 	Transactions        []any                     `json:"transactions,omitempty"`
 }
 
+// Header returns the ledger header fields, which hash.Ledger hashes into the ledger hash.
+func (l BaseLedger) Header() binarycodec.LedgerData {
+	return binarycodec.LedgerData{
+		LedgerIndex:         l.LedgerIndex.Uint32(),
+		TotalCoins:          l.TotalCoins.String(),
+		ParentHash:          l.ParentHash,
+		TransactionHash:     l.TransactionHash,
+		AccountHash:         l.AccountHash,
+		ParentCloseTime:     uint32(l.ParentCloseTime),
+		CloseTime:           uint32(l.CloseTime),
+		CloseTimeResolution: uint8(l.CloseTimeResolution),
+		CloseFlags:          uint8(l.CloseFlags),
+	}
+}
+
 // QueueData represents queued transaction details in ledger queue information.
 type QueueData struct {
 	Account          types.Address               `json:"account"`
@@ -1,7 +1,11 @@
 //revive:disable:var-naming
 package types
 
-import "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+)
 
 // State represents a raw ledger state object with its data and index.
 type State struct {
@@ -10,3 +14,28 @@ type State struct {
 	LedgerObject    ledger.FlatLedgerObject `json:"-"`
 	Index           string                  `json:"index"`
 }
+
+// UnmarshalJSON implements custom JSON unmarshalling for State.
+// When the state object is not binary, its fields are kept in LedgerObject.
+func (s *State) UnmarshalJSON(data []byte) error {
+	type Alias State
+	aux := (*Alias)(s)
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	s.LedgerObject = nil
+	if s.Data != "" {
+		return nil
+	}
+	return json.Unmarshal(data, &s.LedgerObject)
+}
+
+// Object returns the state object as its concrete ledger object type, decoding it from
+// Data when the state object is binary.
+func (s *State) Object() (ledger.Object, error) {
+	if s.Data != "" {
+		return ledger.DecodeBlob(s.Data)
+	}
+	return ledger.Parse(s.LedgerObject)
+}
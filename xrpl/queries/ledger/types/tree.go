@@ -0,0 +1,137 @@
+//revive:disable:var-naming
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/shamap"
+)
+
+// TransactionTree builds the SHAMap of the ledger transactions, whose root hash is the ledger
+// transaction_hash. The transactions must be expanded, in JSON or in binary form.
+func (l BaseLedger) TransactionTree() (*shamap.SHAMap, error) {
+	m := shamap.New(shamap.TransactionWithMetadataLeaf)
+	for i, entry := range l.Transactions {
+		tx, meta, err := ledgerTransactionBlobs(entry)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		if _, err := m.PutTransaction(tx, meta); err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+	return m, nil
+}
+
+// StateTree builds the SHAMap of the ledger state, whose root hash is the ledger account_hash.
+// It needs the whole AccountState of the ledger, in JSON or in binary form.
+func (l BaseLedger) StateTree() (*shamap.SHAMap, error) {
+	m := shamap.New(shamap.AccountStateLeaf)
+	for i, entry := range l.AccountState {
+		index, _ := entry["index"].(string)
+		key, err := shamap.ParseHash(index)
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: %w", ErrInvalidLedgerState, i, err)
+		}
+
+		var data []byte
+		if blob, ok := entry["data"].(string); ok {
+			data, err = hex.DecodeString(blob)
+		} else {
+			data, err = encodeProtocolFields(entry)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: %w", ErrInvalidLedgerState, i, err)
+		}
+		m.Put(key, data)
+	}
+	return m, nil
+}
+
+// ledgerTransactionBlobs returns the binary transaction and metadata of an expanded ledger
+// transaction, which can be in binary form (tx_blob and meta), in API v2 JSON form (tx_json and
+// meta) or in API v1 JSON form (transaction fields and metaData).
+func ledgerTransactionBlobs(entry any) ([]byte, []byte, error) {
+	var tx, meta any
+	switch e := entry.(type) {
+	case string:
+		return nil, nil, ErrTransactionNotExpanded
+	case map[string]any:
+		switch {
+		case e["tx_blob"] != nil:
+			tx, meta = e["tx_blob"], e["meta"]
+		case e["tx_json"] != nil:
+			tx, meta = e["tx_json"], e["meta"]
+		default:
+			tx, meta = e, e["metaData"]
+		}
+	default:
+		return nil, nil, fmt.Errorf("%w: %T", ErrInvalidLedgerTransaction, entry)
+	}
+
+	if m, ok := tx.(map[string]any); ok {
+		tx = deliverMaxToAmount(m)
+	}
+
+	txBlob, err := toBlob(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	metaBlob, err := toBlob(meta)
+	if err != nil {
+		return nil, nil, err
+	}
+	return txBlob, metaBlob, nil
+}
+
+// deliverMaxToAmount returns tx with its DeliverMax field, which replaces Amount in API v2 and
+// duplicates it in API v1, moved back to the Amount field that is hashed.
+func deliverMaxToAmount(tx map[string]any) map[string]any {
+	deliverMax, ok := tx["DeliverMax"]
+	if !ok {
+		return tx
+	}
+
+	fields := make(map[string]any, len(tx))
+	for k, v := range tx {
+		if k != "DeliverMax" {
+			fields[k] = v
+		}
+	}
+	if _, ok := fields["Amount"]; !ok {
+		fields["Amount"] = deliverMax
+	}
+	return fields
+}
+
+// toBlob returns the binary form of a hex blob or of a JSON object.
+func toBlob(v any) ([]byte, error) {
+	switch v := v.(type) {
+	case string:
+		return hex.DecodeString(v)
+	case map[string]any:
+		return encodeProtocolFields(v)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrInvalidLedgerTransaction, v)
+	}
+}
+
+// encodeProtocolFields encodes the protocol fields of a JSON object. Protocol field names start
+// with an upper case letter; the other fields, such as hash, index, metaData or
+// delivered_amount, are added by the server and are not part of the hashed data.
+func encodeProtocolFields(obj map[string]any) ([]byte, error) {
+	fields := make(map[string]any, len(obj))
+	for k, v := range obj {
+		if k != "" && k[0] >= 'A' && k[0] <= 'Z' {
+			fields[k] = v
+		}
+	}
+
+	blob, err := binarycodec.Encode(fields)
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(blob)
+}
@@ -0,0 +1,95 @@
+package ledger
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+)
+
+// Verify checks that the ledger header of the response hashes to the reported ledger_hash. The
+// ledger hash commits to the parent_hash, transaction_hash and account_hash of the header, so a
+// response that passes Verify reports the hashes of the ledger it claims to be.
+//
+// When the response includes expanded transactions, Verify also checks that they hash to the
+// transaction_hash, and when it includes the account state, that it hashes to the account_hash.
+// Transactions listed by hash only are not checked.
+func (r *Response) Verify() error {
+	reported := r.LedgerHash
+	if reported == "" {
+		reported = r.Ledger.LedgerHash
+	}
+	if reported == "" {
+		return ErrMissingLedgerHash
+	}
+	if r.Ledger.LedgerHash != "" && !strings.EqualFold(r.Ledger.LedgerHash, reported) {
+		return fmt.Errorf("%w: response reports %s, ledger reports %s", ErrLedgerHashMismatch, reported, r.Ledger.LedgerHash)
+	}
+	if r.LedgerIndex != 0 && r.Ledger.LedgerIndex != r.LedgerIndex {
+		return fmt.Errorf("%w: response reports %d, ledger reports %d", ErrLedgerIndexMismatch, r.LedgerIndex, r.Ledger.LedgerIndex)
+	}
+
+	computed, err := hash.Ledger(r.Ledger.Header())
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(computed, reported) {
+		return fmt.Errorf("%w: computed %s, reported %s", ErrLedgerHashMismatch, computed, reported)
+	}
+
+	if len(r.Ledger.Transactions) > 0 && !transactionsByHash(r.Ledger.Transactions) {
+		tree, err := r.Ledger.TransactionTree()
+		if err != nil {
+			return err
+		}
+		if computed := tree.Hash().String(); !strings.EqualFold(computed, r.Ledger.TransactionHash) {
+			return fmt.Errorf("%w: computed %s, reported %s", ErrTransactionHashMismatch, computed, r.Ledger.TransactionHash)
+		}
+	}
+
+	if len(r.Ledger.AccountState) > 0 {
+		tree, err := r.Ledger.StateTree()
+		if err != nil {
+			return err
+		}
+		if computed := tree.Hash().String(); !strings.EqualFold(computed, r.Ledger.AccountHash) {
+			return fmt.Errorf("%w: computed %s, reported %s", ErrAccountHashMismatch, computed, r.Ledger.AccountHash)
+		}
+	}
+
+	return nil
+}
+
+// transactionsByHash reports whether the ledger transactions are listed by hash only.
+func transactionsByHash(transactions []any) bool {
+	for _, tx := range transactions {
+		if _, ok := tx.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyParent checks that parent is the ledger immediately preceding the ledger of the
+// response. Both responses are checked with Verify first.
+func (r *Response) VerifyParent(parent *Response) error {
+	if err := r.Verify(); err != nil {
+		return err
+	}
+	if err := parent.Verify(); err != nil {
+		return fmt.Errorf("parent ledger: %w", err)
+	}
+	if parent.Ledger.LedgerIndex+1 != r.Ledger.LedgerIndex {
+		return fmt.Errorf("%w: parent ledger %d does not precede ledger %d", ErrLedgerIndexMismatch, parent.Ledger.LedgerIndex, r.Ledger.LedgerIndex)
+	}
+
+	parentHash := parent.LedgerHash
+	if parentHash == "" {
+		parentHash = parent.Ledger.LedgerHash
+	}
+	if !strings.EqualFold(r.Ledger.ParentHash, parentHash) {
+		return fmt.Errorf("%w: ledger reports %s, parent ledger is %s", ErrParentHashMismatch, r.Ledger.ParentHash, parentHash)
+	}
+
+	return nil
+}
@@ -0,0 +1,301 @@
+package ledger
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	ledgerentries "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/stretchr/testify/require"
+)
+
+func ledger38129() *Response {
+	return &Response{
+		Ledger: ledgertypes.BaseLedger{
+			AccountHash:         "2C23D15B6B549123FB351E4B5CDE81C564318EB845449CD43C3EA7953C4DB452",
+			CloseTime:           410424200,
+			CloseTimeResolution: 10,
+			Closed:              true,
+			LedgerHash:          "E6DB7365949BF9814D76BCC730B01818EB9136A89DB224F3F9F5AAE4569D758E",
+			LedgerIndex:         38129,
+			ParentCloseTime:     410424200,
+			ParentHash:          "3401E5B2E5D3A53EB0891088A5F2D9364BBB6CE5B37A337D2C0660DAF9C4175E",
+			TotalCoins:          99999999999996310,
+			TransactionHash:     "DB83BF807416C5B3499A73130F843CF615AB8E797D79FE7D330ADF1BFA93951A",
+		},
+		LedgerHash:  "E6DB7365949BF9814D76BCC730B01818EB9136A89DB224F3F9F5AAE4569D758E",
+		LedgerIndex: 38129,
+		Validated:   true,
+	}
+}
+
+func TestResponse_Verify(t *testing.T) {
+	testcases := []struct {
+		name        string
+		modify      func(r *Response)
+		expectedErr error
+	}{
+		{
+			name:   "pass - valid ledger",
+			modify: func(_ *Response) {},
+		},
+		{
+			name: "pass - lower case ledger hash",
+			modify: func(r *Response) {
+				r.LedgerHash = "e6db7365949bf9814d76bcc730b01818eb9136a89db224f3f9f5aae4569d758e"
+				r.Ledger.LedgerHash = ""
+			},
+		},
+		{
+			name: "fail - missing ledger hash",
+			modify: func(r *Response) {
+				r.LedgerHash = ""
+				r.Ledger.LedgerHash = ""
+			},
+			expectedErr: ErrMissingLedgerHash,
+		},
+		{
+			name: "fail - tampered account hash",
+			modify: func(r *Response) {
+				r.Ledger.AccountHash = "1B536BFBDFC92B9550F2F63D32F7269D451885FFB2CAB374332EBC2D663320E0"
+			},
+			expectedErr: ErrLedgerHashMismatch,
+		},
+		{
+			name: "fail - tampered transaction hash",
+			modify: func(r *Response) {
+				r.Ledger.TransactionHash = "0000000000000000000000000000000000000000000000000000000000000000"
+			},
+			expectedErr: ErrLedgerHashMismatch,
+		},
+		{
+			name: "fail - tampered parent hash",
+			modify: func(r *Response) {
+				r.Ledger.ParentHash = "CDFD329A6E418591770695D0FB859113641AC20CB3A1F39AB3D721CEA2685EFE"
+			},
+			expectedErr: ErrLedgerHashMismatch,
+		},
+		{
+			name: "fail - response and ledger hashes differ",
+			modify: func(r *Response) {
+				r.Ledger.LedgerHash = "16BB8E41DD96D643BC72E1981865C5D76B990464E2EA151FEAC16CDF1AE29388"
+			},
+			expectedErr: ErrLedgerHashMismatch,
+		},
+		{
+			name: "fail - response and ledger indexes differ",
+			modify: func(r *Response) {
+				r.LedgerIndex = 38130
+			},
+			expectedErr: ErrLedgerIndexMismatch,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := ledger38129()
+			tc.modify(r)
+
+			err := r.Verify()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResponse_VerifyParent(t *testing.T) {
+	child := func(parent *Response) *Response {
+		r := ledger38129()
+		r.Ledger.LedgerIndex = parent.Ledger.LedgerIndex + 1
+		r.LedgerIndex = r.Ledger.LedgerIndex
+		r.Ledger.ParentHash = parent.LedgerHash
+		h, err := hash.Ledger(r.Ledger.Header())
+		require.NoError(t, err)
+		r.LedgerHash = h
+		r.Ledger.LedgerHash = h
+		return r
+	}
+
+	t.Run("pass - child of parent", func(t *testing.T) {
+		parent := ledger38129()
+		require.NoError(t, child(parent).VerifyParent(parent))
+	})
+
+	t.Run("fail - not the next ledger", func(t *testing.T) {
+		parent := ledger38129()
+		r := child(parent)
+		require.ErrorIs(t, r.VerifyParent(r), ErrLedgerIndexMismatch)
+	})
+
+	t.Run("fail - parent hash differs", func(t *testing.T) {
+		parent := ledger38129()
+		r := child(parent)
+		r.Ledger.ParentHash = "3401E5B2E5D3A53EB0891088A5F2D9364BBB6CE5B37A337D2C0660DAF9C4175E"
+		h, err := hash.Ledger(r.Ledger.Header())
+		require.NoError(t, err)
+		r.LedgerHash = h
+		r.Ledger.LedgerHash = h
+		require.ErrorIs(t, r.VerifyParent(parent), ErrParentHashMismatch)
+	})
+
+	t.Run("fail - tampered parent", func(t *testing.T) {
+		parent := ledger38129()
+		r := child(parent)
+		parent.Ledger.CloseTime++
+		require.ErrorIs(t, r.VerifyParent(parent), ErrLedgerHashMismatch)
+	})
+}
+
+// fullLedger38129 returns ledger 38129 with its transactions and account state, in API v1 JSON form.
+func fullLedger38129(t *testing.T) *Response {
+	t.Helper()
+
+	data, err := os.ReadFile("../../shamap/testdata/ledger-full-38129.json")
+	require.NoError(t, err)
+
+	var contents struct {
+		AccountState []ledgerentries.FlatLedgerObject `json:"accountState"`
+		Transactions []any                            `json:"transactions"`
+	}
+	require.NoError(t, json.Unmarshal(data, &contents))
+
+	r := ledger38129()
+	r.Ledger.AccountState = contents.AccountState
+	r.Ledger.Transactions = contents.Transactions
+	return r
+}
+
+func encodeHex(t *testing.T, v any) string {
+	t.Helper()
+
+	obj := map[string]any{}
+	for k, v := range v.(map[string]any) {
+		if k[0] >= 'A' && k[0] <= 'Z' {
+			obj[k] = v
+		}
+	}
+	blob, err := binarycodec.Encode(obj)
+	require.NoError(t, err)
+	return blob
+}
+
+func TestResponse_VerifyContents(t *testing.T) {
+	testcases := []struct {
+		name        string
+		modify      func(t *testing.T, r *Response)
+		expectedErr error
+	}{
+		{
+			name:   "pass - API v1 JSON transactions and state",
+			modify: func(_ *testing.T, _ *Response) {},
+		},
+		{
+			name: "pass - API v2 JSON transactions",
+			modify: func(t *testing.T, r *Response) {
+				payments := 0
+				for i, tx := range r.Ledger.Transactions {
+					entry := tx.(map[string]any)
+					hash, meta := entry["hash"], entry["metaData"]
+					delete(entry, "hash")
+					delete(entry, "metaData")
+					if entry["TransactionType"] == "Payment" {
+						entry["DeliverMax"] = entry["Amount"]
+						delete(entry, "Amount")
+						payments++
+					}
+					r.Ledger.Transactions[i] = map[string]any{"hash": hash, "tx_json": entry, "meta": meta}
+				}
+				require.NotZero(t, payments)
+			},
+		},
+		{
+			name: "pass - API v1 JSON transactions with DeliverMax",
+			modify: func(_ *testing.T, r *Response) {
+				for _, tx := range r.Ledger.Transactions {
+					entry := tx.(map[string]any)
+					if entry["TransactionType"] == "Payment" {
+						entry["DeliverMax"] = entry["Amount"]
+					}
+				}
+			},
+		},
+		{
+			name: "pass - binary transactions and state",
+			modify: func(t *testing.T, r *Response) {
+				for i, tx := range r.Ledger.Transactions {
+					entry := tx.(map[string]any)
+					r.Ledger.Transactions[i] = map[string]any{"tx_blob": encodeHex(t, entry), "meta": encodeHex(t, entry["metaData"])}
+				}
+				for i, entry := range r.Ledger.AccountState {
+					r.Ledger.AccountState[i] = ledgerentries.FlatLedgerObject{"index": entry["index"], "data": encodeHex(t, map[string]any(entry))}
+				}
+			},
+		},
+		{
+			name: "pass - transactions by hash are not checked",
+			modify: func(_ *testing.T, r *Response) {
+				r.Ledger.Transactions = []any{"3B1A4E1C9BB6A7208EB146BCDB86ECEA6068ED01466D933528CA2B4C64F753EF"}
+			},
+		},
+		{
+			name: "fail - extra transaction",
+			modify: func(_ *testing.T, r *Response) {
+				tx := map[string]any{}
+				for k, v := range r.Ledger.Transactions[0].(map[string]any) {
+					tx[k] = v
+				}
+				tx["Sequence"] = 63
+				r.Ledger.Transactions = append(r.Ledger.Transactions, tx)
+			},
+			expectedErr: ErrTransactionHashMismatch,
+		},
+		{
+			name: "fail - tampered account state",
+			modify: func(_ *testing.T, r *Response) {
+				r.Ledger.AccountState[0]["Balance"] = "370000001"
+			},
+			expectedErr: ErrAccountHashMismatch,
+		},
+		{
+			name: "fail - missing account state entry",
+			modify: func(_ *testing.T, r *Response) {
+				r.Ledger.AccountState = r.Ledger.AccountState[1:]
+			},
+			expectedErr: ErrAccountHashMismatch,
+		},
+		{
+			name: "fail - state entry without index",
+			modify: func(_ *testing.T, r *Response) {
+				delete(r.Ledger.AccountState[0], "index")
+			},
+			expectedErr: ledgertypes.ErrInvalidLedgerState,
+		},
+		{
+			name: "fail - mixed hash and expanded transactions",
+			modify: func(_ *testing.T, r *Response) {
+				r.Ledger.Transactions = append(r.Ledger.Transactions, "3B1A4E1C9BB6A7208EB146BCDB86ECEA6068ED01466D933528CA2B4C64F753EF")
+			},
+			expectedErr: ledgertypes.ErrTransactionNotExpanded,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := fullLedger38129(t)
+			tc.modify(t, r)
+
+			err := r.Verify()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// DefinitionsRequest is the request type for the server_definitions command.
+// It returns the binary serialization definitions the server was built with. When Hash
+// matches the server's definitions hash, only the hash is returned.
+type DefinitionsRequest struct {
+	common.BaseRequest
+	Hash string `json:"hash,omitempty"`
+}
+
+// Method returns the JSON-RPC method name for the DefinitionsRequest.
+func (*DefinitionsRequest) Method() string {
+	return "server_definitions"
+}
+
+// APIVersion returns the API version required by the DefinitionsRequest.
+func (*DefinitionsRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate verifies the DefinitionsRequest parameters.
+func (*DefinitionsRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// DefinitionsResponse is the response type returned by the server_definitions command.
+// All fields but Hash are empty when the requested hash matches the server's definitions.
+type DefinitionsResponse struct {
+	Hash               string                  `json:"hash"`
+	Types              map[string]int32        `json:"TYPES,omitempty"`
+	Fields             []types.DefinitionField `json:"FIELDS,omitempty"`
+	LedgerEntryTypes   map[string]int32        `json:"LEDGER_ENTRY_TYPES,omitempty"`
+	TransactionResults map[string]int32        `json:"TRANSACTION_RESULTS,omitempty"`
+	TransactionTypes   map[string]int32        `json:"TRANSACTION_TYPES,omitempty"`
+}
+
+// Codec builds a binary codec from the returned definitions.
+func (r *DefinitionsResponse) Codec() (*binarycodec.Codec, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return binarycodec.NewCodecFromJSON(data)
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefinitionsRequest(t *testing.T) {
+	s := DefinitionsRequest{
+		Hash: "C685734F5FEB756693B4BB978BBB3A158A65652E71EEB2977068B0D680689213",
+	}
+
+	j := `{
+	"hash": "C685734F5FEB756693B4BB978BBB3A158A65652E71EEB2977068B0D680689213"
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDefinitionsResponse(t *testing.T) {
+	s := DefinitionsResponse{
+		Hash:  "C685734F5FEB756693B4BB978BBB3A158A65652E71EEB2977068B0D680689213",
+		Types: map[string]int32{"UInt32": 2},
+		Fields: []types.DefinitionField{
+			{
+				Name: "Flags",
+				Info: types.DefinitionFieldInfo{
+					Nth:            2,
+					IsVLEncoded:    false,
+					IsSerialized:   true,
+					IsSigningField: true,
+					Type:           "UInt32",
+				},
+			},
+		},
+		TransactionTypes: map[string]int32{"Payment": 0},
+	}
+
+	j := `{
+	"hash": "C685734F5FEB756693B4BB978BBB3A158A65652E71EEB2977068B0D680689213",
+	"TYPES": {
+		"UInt32": 2
+	},
+	"FIELDS": [
+		[
+			"Flags",
+			{
+				"nth": 2,
+				"isVLEncoded": false,
+				"isSerialized": true,
+				"isSigningField": true,
+				"type": "UInt32"
+			}
+		]
+	],
+	"TRANSACTION_TYPES": {
+		"Payment": 0
+	}
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDefinitionsResponse_Codec(t *testing.T) {
+	t.Run("builds a codec from the definitions", func(t *testing.T) {
+		res := DefinitionsResponse{
+			Hash:  "AB",
+			Types: map[string]int32{"UInt32": 2},
+			Fields: []types.DefinitionField{
+				{
+					Name: "Flags",
+					Info: types.DefinitionFieldInfo{
+						Nth:            2,
+						IsSerialized:   true,
+						IsSigningField: true,
+						Type:           "UInt32",
+					},
+				},
+			},
+			TransactionTypes: map[string]int32{"Payment": 0},
+		}
+
+		codec, err := res.Codec()
+		require.NoError(t, err)
+		require.Equal(t, "AB", codec.Definitions().Hash)
+
+		encoded, err := codec.Encode(map[string]any{"Flags": uint32(1)})
+		require.NoError(t, err)
+		require.Equal(t, "2200000001", encoded)
+	})
+
+	t.Run("fails when only the hash was returned", func(t *testing.T) {
+		res := DefinitionsResponse{Hash: "AB"}
+
+		_, err := res.Codec()
+		require.Error(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/validator"
 )
 
 // ManifestDetails represents validator manifest information, including the domain,
@@ -52,3 +53,9 @@ type ManifestResponse struct {
 	Manifest  string          `json:"manifest,omitempty"`
 	Requested string          `json:"requested"`
 }
+
+// ParseManifest decodes the raw manifest of the response. The signatures of the returned
+// manifest are not verified, see validator.Manifest.Verify.
+func (r *ManifestResponse) ParseManifest() (*validator.Manifest, error) {
+	return validator.ParseManifest(r.Manifest)
+}
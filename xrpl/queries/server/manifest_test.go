@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
 )
 
 func TestManifestRequest(t *testing.T) {
@@ -47,3 +48,17 @@ func TestManifestResponse(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestManifestResponse_ParseManifest(t *testing.T) {
+	r := ManifestResponse{
+		Manifest: "JAAAAAFxIe3AkJgOyqs3y+UuiAI27Ff3Mrfbt8e7mjdo06bnGEp5XnMhAhRmvCZmWZXlwShVE9qXs2AVCvhVuA/WGYkTX/vVGBGwdkYwRAIgGnYpIGufURojN2cTXakAM7Vwa0GR7o3osdVlZShroXQCIH9R/Lx1v9rdb4YY2n5nrxdnhSSof3U6V/wIHJmeao5ucBJA9D1iAMo7YFCpb245N3Czc0L1R2Xac0YwQ6XdGT+cZ7yw2n8JbdC3hH8Xu9OUqc867Ee6JmlXtyDHzBdY/hdJCQ==",
+	}
+
+	m, err := r.ParseManifest()
+	require.NoError(t, err)
+	require.Equal(t, "nHUFE9prPXPrHcG3SkwP1UzAQbSphqyQkQK9ATXLZsfkezhhda3p", m.MasterKey)
+	require.Equal(t, "n9J67zk4B7GpbQV5jRQntbgdKf7TW6894QuG7qq1rE5gvjCu6snA", m.EphemeralKey)
+	require.Equal(t, uint32(1), m.Sequence)
+	require.Empty(t, m.Domain)
+	require.NoError(t, m.Verify())
+}
@@ -0,0 +1,46 @@
+//revive:disable:var-naming
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidDefinitionField is returned when a server_definitions field is not a [name, info] pair.
+var ErrInvalidDefinitionField = errors.New("definition field must be a [name, info] pair")
+
+// DefinitionFieldInfo describes how a field is serialized in the canonical binary format.
+type DefinitionFieldInfo struct {
+	Nth            int32  `json:"nth"`
+	IsVLEncoded    bool   `json:"isVLEncoded"`
+	IsSerialized   bool   `json:"isSerialized"`
+	IsSigningField bool   `json:"isSigningField"`
+	Type           string `json:"type"`
+}
+
+// DefinitionField is a field definition returned by the server_definitions command. It is
+// encoded in JSON as a [name, info] pair.
+type DefinitionField struct {
+	Name string
+	Info DefinitionFieldInfo
+}
+
+// MarshalJSON encodes the field as a [name, info] pair.
+func (f DefinitionField) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{f.Name, f.Info})
+}
+
+// UnmarshalJSON decodes the field from a [name, info] pair.
+func (f *DefinitionField) UnmarshalJSON(data []byte) error {
+	var pair []json.RawMessage
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return ErrInvalidDefinitionField
+	}
+	if err := json.Unmarshal(pair[0], &f.Name); err != nil {
+		return err
+	}
+	return json.Unmarshal(pair[1], &f.Info)
+}
@@ -21,6 +21,9 @@ type ValidationStream struct {
 	// usually indicates that multiple servers are incorrectly configured to use the same
 	// validation key pair.
 	Cookie any `json:"cookie,omitempty"`
+	// (May be omitted) The validation message in its canonical binary form, as a hex string,
+	// including the signature. It is the data the signature can be verified against.
+	Data string `json:"data,omitempty"`
 	// Bit-mask of flags added to this validation message. The flag 0x80000000 indicates
 	// that the validation signature is fully-canonical. The flag 0x00000001 indicates
 	// that this is a full validation; otherwise it's a partial validation. Partial
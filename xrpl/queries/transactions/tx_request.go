@@ -50,4 +50,6 @@ type TxResponse struct {
 	Meta        transaction.TxMetadataBuilder `json:"meta"`
 	Validated   bool                          `json:"validated"`
 	TxJSON      transaction.FlatTransaction   `json:"tx_json,omitempty"`
+	TxBlob      string                        `json:"tx_blob,omitempty"`
+	MetaBlob    string                        `json:"meta_blob,omitempty"`
 }
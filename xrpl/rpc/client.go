@@ -9,11 +9,14 @@ import (
 	"net/http"
 	"time"
 
-	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
 	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
 	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/Peersyst/xrpl-go/xrpl/internal/autofill"
+	"github.com/Peersyst/xrpl-go/xrpl/internal/codeccache"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	rpctypes "github.com/Peersyst/xrpl-go/xrpl/rpc/types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -35,7 +38,8 @@ const maxDrainBytes = 4 << 10 // 4 KiB
 
 // Client is an XRPL RPC client for sending requests and managing transactions.
 type Client struct {
-	cfg *Config
+	cfg    *Config
+	codecs codeccache.Cache
 
 	NetworkID uint32
 }
@@ -48,11 +52,18 @@ func NewClient(cfg *Config) *Client {
 }
 
 // Request sends a request to the XRPL server and returns the response and any error encountered.
+// When a definitions check is configured, it runs before the first request.
 func (c *Client) Request(reqParams XRPLRequest) (XRPLResponse, error) {
 	if err := reqParams.Validate(); err != nil {
 		return nil, err
 	}
 
+	if _, ok := reqParams.(*server.DefinitionsRequest); !ok {
+		if err := c.codecs.CheckOnce(c.GetServerDefinitions, c.cfg.definitionsCheck, "rpc"); err != nil {
+			return nil, err
+		}
+	}
+
 	body, err := createRequest(reqParams)
 	if err != nil {
 		return nil, err
@@ -141,11 +152,15 @@ func (c *Client) Request(reqParams XRPLRequest) (XRPLResponse, error) {
 // or a signing public key, and then submits it using a submission request.
 // The failHard flag determines how strictly errors are handled.
 func (c *Client) SubmitTxBlob(txBlob string, failHard bool) (*requests.SubmitResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
+	tx, err := c.codecs.Codec().Decode(txBlob)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := transaction.FlatTransaction(tx).RequireNotPseudo(); err != nil {
+		return nil, err
+	}
+
 	_, okTxSig := tx["TxSignature"].(string)
 	_, okPubKey := tx["SigningPubKey"].(string)
 
@@ -164,7 +179,7 @@ func (c *Client) SubmitTxBlob(txBlob string, failHard bool) (*requests.SubmitRes
 // and then waits until the transaction is confirmed in a ledger. It returns
 // the transaction response if the submission is successful.
 func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.TxResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
+	tx, err := c.codecs.Codec().Decode(txBlob)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +198,7 @@ func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.Tx
 		return nil, &ClientError{ErrorString: "transaction failed to submit with engine result: " + txResponse.EngineResult}
 	}
 
-	txHash, err := hash.SignTxBlob(txBlob)
+	txHash, err := hash.SignTxBlobWithCodec(txBlob, c.codecs.Codec())
 	if err != nil {
 		return nil, err
 	}
@@ -203,6 +218,12 @@ func (c *Client) SubmitTx(tx transaction.FlatTransaction, opts *rpctypes.SubmitO
 		return nil, err
 	}
 
+	if opts.Preclaim {
+		if err := c.Preclaim(tx); err != nil {
+			return nil, err
+		}
+	}
+
 	return c.submitRequest(&requests.SubmitRequest{
 		TxBlob:   txBlob,
 		FailHard: opts.FailHard,
@@ -223,6 +244,12 @@ func (c *Client) SubmitTxAndWait(tx transaction.FlatTransaction, opts *rpctypes.
 		return nil, err
 	}
 
+	if opts.Preclaim {
+		if err := c.Preclaim(tx); err != nil {
+			return nil, err
+		}
+	}
+
 	// Delegate to SubmitTxBlobAndWait to handle submission, engine result check,
 	// ledger sequence validation, and waiting for confirmation.
 	return c.SubmitTxBlobAndWait(txBlob, opts.FailHard)
@@ -230,10 +257,15 @@ func (c *Client) SubmitTxAndWait(tx transaction.FlatTransaction, opts *rpctypes.
 
 // SubmitMultisigned submits a multisigned transaction blob to the server and returns the response.
 func (c *Client) SubmitMultisigned(txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
+	tx, err := c.codecs.Codec().Decode(txBlob)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := transaction.FlatTransaction(tx).RequireNotPseudo(); err != nil {
+		return nil, err
+	}
+
 	signers, okSigners := tx["Signers"].([]any)
 
 	if okSigners && len(signers) > 0 {
@@ -252,6 +284,90 @@ func (c *Client) SubmitMultisigned(txBlob string, failHard bool) (*requests.Subm
 	})
 }
 
+// MultisignTx signs a multisigned transaction with one of its signers, encoding it with the
+// definitions loaded by the client. It returns the signed transaction blob and its hash, to be
+// combined with xrpl.MultisignWithCodec or added to a coordinator from GetMultisignCoordinator.
+func (c *Client) MultisignTx(signer wallet.Signer, tx transaction.FlatTransaction) (string, string, error) {
+	return wallet.MultisignWithCodec(signer, tx, c.codecs.Codec())
+}
+
+// NewBundle returns an empty bundle for the network of the client, encoding its transactions
+// with the definitions loaded by the client.
+func (c *Client) NewBundle(expiration time.Time) *xrpl.Bundle {
+	return xrpl.NewBundleWithCodec(c.NetworkID, expiration, c.codecs.Codec())
+}
+
+// ParseBundle parses a JSON encoded bundle like xrpl.ParseBundle, decoding its transactions
+// with the definitions loaded by the client.
+func (c *Client) ParseBundle(data []byte) (*xrpl.Bundle, error) {
+	return xrpl.ParseBundleWithCodec(data, c.codecs.Codec())
+}
+
+// ReplaceTx replaces a transaction that is still pending in the transaction queue or open ledger.
+// original is either the signed transaction blob or its hash. With ReplaceWithFeeBump the original
+// is re-signed with a higher fee, with ReplaceWithNoop a no-op AccountSet consuming the same
+// Sequence or TicketSequence is signed instead. The replacement fee follows the transaction queue's
+// fee-escalation rules: it exceeds the original fee by more than QueueRetryFeePercent and is never
+// below the current open ledger fee. The replacement is signed with opts.Wallet and submitted, and
+// both transactions are polled until one of them is validated.
+func (c *Client) ReplaceTx(original string, opts *rpctypes.ReplaceOptions) (*rpctypes.ReplaceResult, error) {
+	if opts == nil || wallet.IsNilSigner(opts.Wallet) {
+		return nil, ErrMissingWallet
+	}
+
+	originalBlob, err := c.getOriginalTxBlob(original)
+	if err != nil {
+		return nil, err
+	}
+
+	originalTx, err := c.codecs.Codec().Decode(originalBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	originalHash, err := hash.SignTxBlobWithCodec(originalBlob, c.codecs.Codec())
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := c.getReplacementFee(originalTx)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement, err := buildReplacementTx(originalTx, opts.Strategy, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := replacement["LastLedgerSequence"]; !ok {
+		if err := c.setLastLedgerSequence(&replacement); err != nil {
+			return nil, err
+		}
+	}
+
+	replacementBlob, replacementHash, err := wallet.SignWithCodec(opts.Wallet, replacement, c.codecs.Codec())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.submitRequest(&requests.SubmitRequest{
+		TxBlob:   replacementBlob,
+		FailHard: opts.FailHard,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.EngineResult != transaction.TesSUCCESS.String() && res.EngineResult != transaction.TerQUEUED.String() {
+		return nil, &ClientError{ErrorString: "replacement transaction failed to submit with engine result: " + res.EngineResult}
+	}
+
+	lastLedgerSequence, _ := replacement["LastLedgerSequence"].(uint32)
+
+	return c.waitForReplacement(originalHash, replacementHash, lastLedgerSequence)
+}
+
 // Autofill fills in the missing fields in a transaction.
 func (c *Client) Autofill(tx *transaction.FlatTransaction) error {
 	if err := c.setValidTransactionAddresses(tx); err != nil {
@@ -262,6 +378,10 @@ func (c *Client) Autofill(tx *transaction.FlatTransaction) error {
 		return err
 	}
 
+	if err := tx.RequireNotPseudo(); err != nil {
+		return err
+	}
+
 	if err := tx.NormalizeFlags(); err != nil {
 		return err
 	}
@@ -329,6 +449,89 @@ func (c *Client) AutofillMultisigned(tx *transaction.FlatTransaction, nSigners u
 	return nil
 }
 
+// GetNetworkSnapshot returns a snapshot of the network and of the given accounts, to
+// autofill their transactions offline with xrpl.AutofillOffline. The snapshot includes
+// the fee cushion and max fee of the client.
+func (c *Client) GetNetworkSnapshot(accounts ...types.Address) (*xrpl.NetworkSnapshot, error) {
+	info, err := c.GetServerInfo(&server.InfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := c.GetServerState(&server.StateRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerIndex, err := c.GetLedgerIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &xrpl.NetworkSnapshot{
+		NetworkID:     c.NetworkID,
+		ServerVersion: info.Info.BuildVersion,
+		LedgerIndex:   ledgerIndex.Uint32(),
+		BaseFeeXRP:    info.Info.ValidatedLedger.BaseFeeXRP,
+		LoadFactor:    info.Info.LoadFactor,
+		ReserveBase:   uint64(state.State.ValidatedLedger.ReserveBase),
+		ReserveInc:    uint64(state.State.ValidatedLedger.ReserveInc),
+		FeeCushion:    c.cfg.feeCushion,
+		MaxFeeXRP:     c.cfg.maxFeeXRP,
+		Accounts:      make(map[types.Address]*xrpl.AccountSnapshot, len(accounts)),
+	}
+
+	for _, address := range accounts {
+		accountSnapshot, err := c.getAccountSnapshot(address)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Accounts[address] = accountSnapshot
+	}
+
+	return snapshot, nil
+}
+
+// GetMultisignCoordinator returns a coordinator to collect the signatures of a multisigned
+// transaction, checked against the current SignerList of the transaction account and the
+// regular keys of its signers.
+// It returns xrpl.ErrNoSignerList if the account has no SignerList.
+func (c *Client) GetMultisignCoordinator(tx transaction.FlatTransaction) (*xrpl.MultisignCoordinator, error) {
+	address, ok := tx["Account"].(string)
+	if !ok || address == "" {
+		return nil, xrpl.ErrMissingAccountInTransaction
+	}
+
+	info, err := c.GetAccountInfo(&account.InfoRequest{
+		Account:     types.Address(address),
+		LedgerIndex: common.Validated,
+		SignerLists: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(info.SignerLists) == 0 {
+		return nil, xrpl.ErrNoSignerList
+	}
+
+	signerList := info.SignerLists[0]
+	signers := xrpl.MultisignSignersFromSignerList(signerList)
+	for i := range signers {
+		if err := c.setSignerKeys(&signers[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return xrpl.NewMultisignCoordinatorWithCodec(tx, signerList.SignerQuorum, signers, c.codecs.Codec())
+}
+
+// Preclaim runs xrpl.Preclaim against the current ledger state, so a transaction bound to
+// fail is rejected before it is submitted and any fee is burned.
+// A failed check is returned as xrpl.ErrPreclaim.
+func (c *Client) Preclaim(tx transaction.FlatTransaction) error {
+	return xrpl.Preclaim(tx, c.preclaimSource())
+}
+
 // FaucetProvider returns the faucet provider for the client.
 func (c *Client) FaucetProvider() commonconstants.FaucetProvider {
 	return c.cfg.faucetProvider
@@ -347,7 +550,7 @@ func (c *Client) FundWallet(wallet *wallet.Wallet) error {
 	// brand-new account) is treated as a zero balance so polling can still
 	// detect the faucet deposit.
 	startBalance, err := c.getXrpDropsBalance(wallet.ClassicAddress, common.Validated)
-	if err != nil && !isFundWalletActNotFound(err) {
+	if err != nil && !isActNotFound(err) {
 		return err
 	}
 
@@ -359,7 +562,7 @@ func (c *Client) FundWallet(wallet *wallet.Wallet) error {
 		time.Sleep(fundWalletPollInterval)
 		balance, err := c.getXrpDropsBalance(wallet.ClassicAddress, common.Validated)
 		if err != nil {
-			if isFundWalletActNotFound(err) {
+			if isActNotFound(err) {
 				continue
 			}
 			return err
@@ -372,122 +575,28 @@ func (c *Client) FundWallet(wallet *wallet.Wallet) error {
 	return ErrFundWalletBalanceNotUpdated
 }
 
-func isFundWalletActNotFound(err error) bool {
+func isActNotFound(err error) bool {
 	var clientErr *ClientError
 	return errors.As(err, &clientErr) && clientErr.ErrorString == actNotFound
 }
 
-type validatedInnerTx struct {
-	rawTx   map[string]any
-	account string
+func isEntryNotFound(err error) bool {
+	var clientErr *ClientError
+	return errors.As(err, &clientErr) && clientErr.ErrorString == entryNotFound
 }
 
 func (c *Client) autofillRawTransactions(tx *transaction.FlatTransaction) error {
-	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return ErrRawTransactionsFieldIsNotAnArray
-	}
-
-	var outerNetworkID *uint32
-	if outer := (*tx)["NetworkID"]; outer != nil {
-		outerNetworkIDUint, ok := outer.(uint32)
-		if !ok {
-			return ErrNetworkIDFieldIsNotAUint32
-		}
-		if outerNetworkIDUint != c.NetworkID {
-			return ErrNetworkIDFieldMismatch
-		}
-		outerNetworkID = &outerNetworkIDUint
-	}
-
-	inners := make([]validatedInnerTx, 0, len(rawTxs))
-	for _, rawTx := range rawTxs {
-		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return ErrRawTransactionFieldIsNotAnObject
-		}
-
-		acc, ok := innerRawTx["Account"].(string)
-		if !ok {
-			return ErrAccountFieldIsNotAString
-		}
-
-		if fee := innerRawTx["Fee"]; fee != nil && fee != "0" {
-			return types.ErrBatchInnerTransactionInvalid
-		}
-
-		if signingPubKey := innerRawTx["SigningPubKey"]; signingPubKey != nil && signingPubKey != "" {
-			return ErrSigningPubKeyFieldMustBeEmpty
-		}
-
-		if innerRawTx["TxnSignature"] != nil {
-			return ErrTxnSignatureFieldMustBeEmpty
-		}
-		if innerRawTx["Signers"] != nil {
-			return ErrSignersFieldMustBeEmpty
-		}
-
-		if networkID := innerRawTx["NetworkID"]; networkID != nil {
-			innerNetworkID, ok := networkID.(uint32)
-			if !ok {
-				return ErrNetworkIDFieldIsNotAUint32
-			}
-			if innerNetworkID != c.NetworkID {
-				return ErrNetworkIDFieldMismatch
-			}
-			if outerNetworkID != nil && innerNetworkID != *outerNetworkID {
-				return ErrNetworkIDFieldMismatch
-			}
-		}
-
-		inners = append(inners, validatedInnerTx{rawTx: innerRawTx, account: acc})
-	}
-
-	needsNetworkID, err := c.txNeedsNetworkID()
-	if err != nil {
-		return err
-	}
-
-	accountSeq := make(map[string]uint32, len(inners))
-
-	for _, inner := range inners {
-		innerRawTx := inner.rawTx
-		if innerRawTx["Fee"] == nil {
-			innerRawTx["Fee"] = "0"
-		}
-
-		if innerRawTx["SigningPubKey"] == nil {
-			innerRawTx["SigningPubKey"] = ""
-		}
-
-		if innerRawTx["NetworkID"] == nil && needsNetworkID {
-			innerRawTx["NetworkID"] = c.NetworkID
-		}
-
-		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
-			acc := inner.account
-
-			if accountSeq[acc] != 0 {
-				innerRawTx["Sequence"] = accountSeq[acc]
-				accountSeq[acc]++
-			} else {
-				accountInfo, err := c.GetAccountInfo(&account.InfoRequest{
-					Account: types.Address(acc),
-				})
-				if err != nil {
-					return err
-				}
-				var seq uint32
-				if innerRawTx["Account"] == (*tx)["Account"] {
-					seq = accountInfo.AccountData.Sequence + 1
-				} else {
-					seq = accountInfo.AccountData.Sequence
-				}
-				accountSeq[acc] = seq + 1
-				innerRawTx["Sequence"] = seq
+	return autofill.RawTransactions(tx, autofill.BatchSource{
+		NetworkID:      c.NetworkID,
+		NeedsNetworkID: c.txNeedsNetworkID,
+		AccountSequence: func(acc string) (uint32, error) {
+			accountInfo, err := c.GetAccountInfo(&account.InfoRequest{
+				Account: types.Address(acc),
+			})
+			if err != nil {
+				return 0, err
 			}
-		}
-	}
-
-	return nil
+			return accountInfo.AccountData.Sequence, nil
+		},
+	})
 }
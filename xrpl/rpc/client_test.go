@@ -11,8 +11,12 @@ import (
 	"testing"
 	"time"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
+	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
 	rpctypes "github.com/Peersyst/xrpl-go/xrpl/rpc/types"
@@ -410,6 +414,11 @@ func TestClient_SubmitTxBlob(t *testing.T) {
 			txBlob:      "1200002280000000240000000361D4838D7EA4C6800000000000000000000000000055534400000000004B4E9C06F24296074F7BC48F92A97916C6DC5EA968400000000000000A70",
 			expectError: errors.New("ReadField error: parser out of bounds"),
 		},
+		{
+			name:        "pseudo-transaction",
+			txBlob:      "1200642400000000260143E001501342426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE684000000000000000730081140000000000000000000000000000000000000000",
+			expectError: transaction.ErrPseudoTransaction,
+		},
 	}
 
 	for _, tt := range tests {
@@ -493,6 +502,18 @@ func TestClient_SubmitTx(t *testing.T) {
 			opts:        &rpctypes.SubmitOptions{},
 			expectError: ErrMissingWallet,
 		},
+		{
+			name: "fail - nil wallet pointer provided for unsigned tx",
+			tx: map[string]any{
+				"Account":         "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+				"Destination":     "rU6K7V3Po4snVhBBaU29sesqs2qTQJWDw1",
+				"Fee":             "10",
+				"TransactionType": "Payment",
+				"Sequence":        uint32(359),
+			},
+			opts:        &rpctypes.SubmitOptions{Wallet: (*wallet.Wallet)(nil)},
+			expectError: ErrMissingWallet,
+		},
 	}
 
 	for _, tt := range tests {
@@ -602,6 +623,14 @@ func TestClient_Autofill(t *testing.T) {
 			},
 			expectedErr: transaction.ErrTransactionTypeMissing,
 		},
+		{
+			name: "fail - pseudo-transaction",
+			tx: transaction.FlatTransaction{
+				"Account":         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+				"TransactionType": "SetFee",
+			},
+			expectedErr: transaction.ErrPseudoTransaction,
+		},
 		{
 			name: "fail - invalid Flags type",
 			tx: transaction.FlatTransaction{
@@ -1280,6 +1309,199 @@ func TestClient_autofillRawTransactions(t *testing.T) {
 }
 
 // Helper function to setup test RPC client for autofill tests
+func TestClient_GetNetworkSnapshot(t *testing.T) {
+	const testAddr = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+
+	tests := []struct {
+		name             string
+		mockResponses    []string
+		expectedSnapshot *xrpl.NetworkSnapshot
+		expectedErr      bool
+	}{
+		{
+			name: "pass - network and account state",
+			mockResponses: []string{
+				`{"result": {"info": {"build_version": "2.4.0", "load_factor": 1, "validated_ledger": {"base_fee_xrp": 0.00001}}}}`,
+				`{"result": {"state": {"validated_ledger": {"base_fee": 10, "reserve_base": 1000000, "reserve_inc": 200000}}}}`,
+				`{"result": {"ledger_index": 1000}}`,
+				`{"result": {"account_data": {"Account": "` + testAddr + `", "Sequence": 42}, "signer_lists": [{"SignerEntries": [{"SignerEntry": {"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "SignerWeight": 1}}, {"SignerEntry": {"Account": "rLHzPsX6oXkzU2qL12kHCH8G8cnZv1rBJh", "SignerWeight": 1}}]}]}}`,
+				`{"result": {"account_objects": [{"LedgerEntryType": "Ticket", "TicketSequence": 50}], "marker": "next"}}`,
+				`{"result": {"account_objects": [{"LedgerEntryType": "Ticket", "TicketSequence": 45}]}}`,
+			},
+			expectedSnapshot: &xrpl.NetworkSnapshot{
+				ServerVersion: "2.4.0",
+				LedgerIndex:   1000,
+				BaseFeeXRP:    0.00001,
+				LoadFactor:    1,
+				ReserveBase:   1000000,
+				ReserveInc:    200000,
+				FeeCushion:    commonconstants.DefaultFeeCushion,
+				MaxFeeXRP:     commonconstants.DefaultMaxFeeXRP,
+				Accounts: map[types.Address]*xrpl.AccountSnapshot{
+					testAddr: {
+						Sequence:       42,
+						Tickets:        []uint32{45, 50},
+						SignerListSize: 2,
+					},
+				},
+			},
+		},
+		{
+			name: "fail - account info error",
+			mockResponses: []string{
+				`{"result": {"info": {"build_version": "2.4.0", "load_factor": 1, "validated_ledger": {"base_fee_xrp": 0.00001}}}}`,
+				`{"result": {"state": {"validated_ledger": {"reserve_base": 1000000, "reserve_inc": 200000}}}}`,
+				`{"result": {"ledger_index": 1000}}`,
+				`{"result": {"error": "actNotFound"}}`,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := setupTestRPCClientForAutofill(t, tt.mockResponses)
+			snapshot, err := cl.GetNetworkSnapshot(testAddr)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSnapshot, snapshot)
+		})
+	}
+}
+
+func TestClient_GetMultisignCoordinator(t *testing.T) {
+	const (
+		testAddr    = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+		signerAddrA = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+		signerAddrB = "rLHzPsX6oXkzU2qL12kHCH8G8cnZv1rBJh"
+	)
+	tx := transaction.FlatTransaction{
+		"TransactionType": "AccountSet",
+		"Account":         testAddr,
+		"Fee":             "36",
+		"Sequence":        uint32(10),
+	}
+
+	tests := []struct {
+		name            string
+		mockResponses   []string
+		expectedQuorum  uint32
+		expectedSigners []xrpl.MultisignSigner
+		expectedErr     error
+	}{
+		{
+			name: "pass - signer list with regular keys",
+			mockResponses: []string{
+				`{"result": {"account_data": {"Account": "` + testAddr + `", "Sequence": 10}, "signer_lists": [{"SignerQuorum": 2, "SignerEntries": [{"SignerEntry": {"Account": "` + signerAddrA + `", "SignerWeight": 1}}, {"SignerEntry": {"Account": "` + signerAddrB + `", "SignerWeight": 2}}]}]}}`,
+				`{"result": {"account_data": {"Account": "` + signerAddrA + `", "Flags": 1048576, "RegularKey": "` + signerAddrB + `"}}}`,
+				`{"result": {"error": "actNotFound"}}`,
+			},
+			expectedQuorum: 2,
+			expectedSigners: []xrpl.MultisignSigner{
+				{Account: signerAddrA, Weight: 1, RegularKey: signerAddrB, MasterDisabled: true},
+				{Account: signerAddrB, Weight: 2},
+			},
+		},
+		{
+			name: "fail - no signer list",
+			mockResponses: []string{
+				`{"result": {"account_data": {"Account": "` + testAddr + `", "Sequence": 10}}}`,
+			},
+			expectedErr: xrpl.ErrNoSignerList,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := setupTestRPCClientForAutofill(t, tt.mockResponses)
+			coordinator, err := cl.GetMultisignCoordinator(tx)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedQuorum, coordinator.Quorum())
+			require.Equal(t, tt.expectedSigners, coordinator.Signers())
+			require.Equal(t, []types.Address{signerAddrA, signerAddrB}, coordinator.MissingSigners())
+		})
+	}
+}
+
+func TestClient_Preclaim(t *testing.T) {
+	const (
+		senderAddr = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+		destAddr   = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	)
+	payment := func(amount any) transaction.FlatTransaction {
+		return transaction.FlatTransaction{
+			"TransactionType": "Payment",
+			"Account":         senderAddr,
+			"Destination":     destAddr,
+			"Amount":          amount,
+			"Fee":             "12",
+		}
+	}
+
+	tests := []struct {
+		name          string
+		tx            transaction.FlatTransaction
+		mockResponses []string
+		expectedErr   error
+	}{
+		{
+			name: "pass - XRP payment",
+			tx:   payment("1000000"),
+			mockResponses: []string{
+				`{"result": {"account_data": {"Account": "` + senderAddr + `", "Balance": "50000000", "OwnerCount": 0}}}`,
+				`{"result": {"account_data": {"Account": "` + destAddr + `", "Balance": "20000000", "Flags": 0}}}`,
+				`{"result": {"state": {"validated_ledger": {"reserve_base": 1000000, "reserve_inc": 200000}}}}`,
+			},
+		},
+		{
+			name: "fail - source not found",
+			tx:   payment("1000000"),
+			mockResponses: []string{
+				`{"result": {"error": "actNotFound"}}`,
+			},
+			expectedErr: xrpl.ErrPreclaim{Reason: xrpl.PreclaimSourceNotFound, Result: transaction.TerNO_ACCOUNT, Account: senderAddr},
+		},
+		{
+			name: "fail - destination tag needed",
+			tx:   payment("1000000"),
+			mockResponses: []string{
+				`{"result": {"account_data": {"Account": "` + senderAddr + `", "Balance": "50000000"}}}`,
+				`{"result": {"account_data": {"Account": "` + destAddr + `", "Balance": "20000000", "Flags": 131072}}}`,
+			},
+			expectedErr: xrpl.ErrPreclaim{Reason: xrpl.PreclaimDestinationTagNeeded, Result: transaction.TecDST_TAG_NEEDED, Account: destAddr},
+		},
+		{
+			name: "fail - MPT issuance not found",
+			tx:   payment(map[string]any{"mpt_issuance_id": "000000018A5182C75B3FB0B57A011EF619BD7B0307398CC1", "value": "10"}),
+			mockResponses: []string{
+				`{"result": {"account_data": {"Account": "` + senderAddr + `", "Balance": "50000000"}}}`,
+				`{"result": {"account_data": {"Account": "` + destAddr + `", "Balance": "20000000"}}}`,
+				`{"result": {"error": "entryNotFound"}}`,
+			},
+			expectedErr: xrpl.ErrPreclaim{Reason: xrpl.PreclaimMPTIssuanceNotFound, Result: transaction.TecOBJECT_NOT_FOUND, Account: senderAddr},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := setupTestRPCClientForAutofill(t, tt.mockResponses)
+			err := cl.Preclaim(tt.tx)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func setupTestRPCClientForAutofill(t *testing.T, mockResponses []string) *Client {
 	mc := &testutil.JSONRPCMockClient{}
 	responseIndex := 0
@@ -1455,3 +1677,198 @@ func (c *countingReadCloser) Close() error {
 	c.closeFunc()
 	return nil
 }
+
+func TestClient_ReplaceTx(t *testing.T) {
+	w, err := wallet.FromSeed("sEdTCFHBquP36KursdZ17ZiuZenJZHg", "")
+	require.NoError(t, err)
+
+	originalBlob, originalHash, err := w.Sign(map[string]any{
+		"TransactionType":    "Payment",
+		"Account":            w.ClassicAddress.String(),
+		"Destination":        "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX",
+		"Amount":             "1000",
+		"Fee":                "10",
+		"Sequence":           uint32(3),
+		"LastLedgerSequence": uint32(100),
+	})
+	require.NoError(t, err)
+
+	feeResponse := `{"result": {"drops": {"base_fee": "10", "median_fee": "5000", "minimum_fee": "10", "open_ledger_fee": "10"}}}`
+	queuedResponse := `{"result": {"engine_result": "terQUEUED", "engine_result_code": -89}}`
+	notFoundResponse := `{"result": {"error": "txnNotFound"}}`
+
+	tests := []struct {
+		name             string
+		original         string
+		opts             *rpctypes.ReplaceOptions
+		mockResponses    func(replacementHash string) []string
+		expectedReplaced bool
+		expectedErr      error
+	}{
+		{
+			name:     "pass - replacement validated",
+			original: originalBlob,
+			opts: &rpctypes.ReplaceOptions{
+				Strategy: rpctypes.ReplaceWithNoop,
+				Wallet:   &w,
+			},
+			mockResponses: func(replacementHash string) []string {
+				return []string{
+					feeResponse,
+					queuedResponse,
+					`{"result": {"hash": "` + replacementHash + `", "ledger_index": 90, "validated": true}}`,
+				}
+			},
+			expectedReplaced: true,
+		},
+		{
+			name:     "pass - original validated",
+			original: originalBlob,
+			opts: &rpctypes.ReplaceOptions{
+				Strategy: rpctypes.ReplaceWithFeeBump,
+				Wallet:   &w,
+			},
+			mockResponses: func(_ string) []string {
+				return []string{
+					feeResponse,
+					queuedResponse,
+					notFoundResponse,
+					`{"result": {"hash": "` + originalHash + `", "ledger_index": 90, "validated": true}}`,
+				}
+			},
+			expectedReplaced: false,
+		},
+		{
+			name:     "fail - original already validated",
+			original: originalHash,
+			opts: &rpctypes.ReplaceOptions{
+				Wallet: &w,
+			},
+			mockResponses: func(_ string) []string {
+				return []string{
+					`{"result": {"hash": "` + originalHash + `", "tx_blob": "` + originalBlob + `", "validated": true}}`,
+				}
+			},
+			expectedErr: ErrOriginalTransactionAlreadyValidated,
+		},
+		{
+			name:     "fail - replacement rejected",
+			original: originalBlob,
+			opts: &rpctypes.ReplaceOptions{
+				Wallet: &w,
+			},
+			mockResponses: func(_ string) []string {
+				return []string{
+					feeResponse,
+					`{"result": {"engine_result": "telCAN_NOT_QUEUE_FEE", "engine_result_code": -364}}`,
+				}
+			},
+			expectedErr: &ClientError{ErrorString: "replacement transaction failed to submit with engine result: telCAN_NOT_QUEUE_FEE"},
+		},
+		{
+			name:        "fail - missing wallet",
+			original:    originalBlob,
+			opts:        &rpctypes.ReplaceOptions{},
+			expectedErr: ErrMissingWallet,
+		},
+		{
+			name:        "fail - nil wallet pointer",
+			original:    originalBlob,
+			opts:        &rpctypes.ReplaceOptions{Wallet: (*wallet.Wallet)(nil)},
+			expectedErr: ErrMissingWallet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mockResponses []string
+			if tt.mockResponses != nil {
+				// Both strategies produce a deterministic replacement, so the expected hash
+				// can be computed up front by building and signing it the same way.
+				originalTx, err := binarycodec.Decode(originalBlob)
+				require.NoError(t, err)
+				replacement, err := buildReplacementTx(originalTx, tt.opts.Strategy, 13)
+				require.NoError(t, err)
+				_, replacementHash, err := w.Sign(replacement)
+				require.NoError(t, err)
+				mockResponses = tt.mockResponses(replacementHash)
+			}
+
+			client := setupTestRPCClientForAutofill(t, mockResponses)
+			client.cfg.retryDelay = 0
+
+			res, err := client.ReplaceTx(tt.original, tt.opts)
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.expectedErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, originalHash, res.OriginalHash)
+			require.Equal(t, tt.expectedReplaced, res.Replaced)
+			require.True(t, res.TxResponse.Validated)
+		})
+	}
+}
+
+func TestClient_DefinitionsCheck(t *testing.T) {
+	t.Run("fails requests on mismatch", func(t *testing.T) {
+		mc := testutil.JSONRPCMockClient{}
+		respond := testutil.MockResponse(`{"result": {"hash": "AB"}}`, 200, &mc)
+		mc.DoFunc = func(req *http.Request) (*http.Response, error) {
+			mc.RequestCount++
+			return respond(req)
+		}
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc), WithDefinitionsCheck(commonconstants.DefinitionsCheckFail))
+		require.NoError(t, err)
+
+		client := NewClient(cfg)
+
+		_, err = client.GetServerInfo(&server.InfoRequest{})
+		require.ErrorIs(t, err, ErrDefinitionsMismatch)
+
+		_, err = client.GetServerInfo(&server.InfoRequest{})
+		require.ErrorIs(t, err, ErrDefinitionsMismatch)
+		require.Equal(t, 1, mc.RequestCount)
+	})
+
+	t.Run("loads the server definitions on mismatch", func(t *testing.T) {
+		mc := testutil.JSONRPCMockClient{}
+		mc.DoFunc = testutil.MockResponse(`{
+			"result": {
+				"hash": "AB",
+				"TYPES": {"UInt32": 2},
+				"FIELDS": [["Flags", {"nth": 2, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}]],
+				"TRANSACTION_TYPES": {"Payment": 0}
+			}
+		}`, 200, &mc)
+
+		cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc), WithDefinitionsCheck(commonconstants.DefinitionsCheckLoad))
+		require.NoError(t, err)
+
+		client := NewClient(cfg)
+
+		_, err = client.Request(&server.InfoRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "AB", client.codecs.Codec().Definitions().Hash)
+
+		signer, err := wallet.FromSeed("sEdTCFHBquP36KursdZ17ZiuZenJZHg", "")
+		require.NoError(t, err)
+		tx := transaction.FlatTransaction{
+			"TransactionType": "AccountSet",
+			"Account":         signer.ClassicAddress.String(),
+			"Flags":           uint32(0),
+		}
+
+		// Only Flags is known to the loaded definitions, so the signatures are dropped from the blob.
+		_, _, err = signer.Multisign(tx)
+		require.NoError(t, err)
+		_, _, err = client.MultisignTx(signer, tx)
+		require.Error(t, err)
+
+		bundle := client.NewBundle(time.Time{})
+		require.NoError(t, bundle.AddTransaction(tx, xrpl.BundleModeSingle, nil))
+		require.Equal(t, "2200000000", bundle.Transactions[0].TxBlob)
+	})
+}
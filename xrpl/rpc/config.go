@@ -12,8 +12,8 @@ import (
 
 const defaultMaxResponseSize int64 = 64 * 1024 * 1024
 
-// SetLogger overrides the *log.Logger used for SDK-emitted warnings (currently
-// just the insecure-scheme warning). Pass nil to silence the warnings entirely.
+// SetLogger overrides the *log.Logger used for SDK-emitted warnings (insecure-scheme
+// and definitions mismatch warnings). Pass nil to silence the warnings entirely.
 // The default logger writes to stdlib's log.Default(), preserving prior behavior.
 // The logger is shared across xrpl-go's client packages; calling SetLogger here
 // or in xrpl/websocket has the same effect.
@@ -46,6 +46,9 @@ type Config struct {
 	// Faucet config
 	faucetProvider common.FaucetProvider
 
+	// Definitions config
+	definitionsCheck common.DefinitionsCheck
+
 	timeout time.Duration
 }
 
@@ -107,6 +110,14 @@ func WithFaucetProvider(fp common.FaucetProvider) ConfigOpt {
 	}
 }
 
+// WithDefinitionsCheck returns a ConfigOpt that sets how the client reacts when the server's
+// binary codec definitions differ from the embedded ones. The check runs before the first request.
+func WithDefinitionsCheck(check common.DefinitionsCheck) ConfigOpt {
+	return func(c *Config) {
+		c.definitionsCheck = check
+	}
+}
+
 // WithTimeout returns a ConfigOpt that sets the request timeout for the HTTP client.
 func WithTimeout(timeout time.Duration) ConfigOpt {
 	return func(c *Config) {
@@ -220,6 +220,12 @@ func TestWithFaucetProvider(t *testing.T) {
 	require.Equal(t, fp, cfg.faucetProvider)
 }
 
+func TestWithDefinitionsCheck(t *testing.T) {
+	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithDefinitionsCheck(common.DefinitionsCheckLoad))
+
+	require.Equal(t, common.DefinitionsCheckLoad, cfg.definitionsCheck)
+}
+
 func TestTimeout(t *testing.T) {
 	t.Run("Default timeout applied to config and HTTP client", func(t *testing.T) {
 		cfg, _ := NewClientConfig("http://s1.ripple.com:51234")
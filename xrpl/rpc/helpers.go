@@ -5,19 +5,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"math"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
-	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/pkg/typecheck"
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/Peersyst/xrpl-go/xrpl/internal/autofill"
+	ledgerentries "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
 	server "github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	rpctypes "github.com/Peersyst/xrpl-go/xrpl/rpc/types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/Peersyst/xrpl-go/xrpl/wallet"
@@ -33,112 +42,11 @@ const (
 	// Networks with ID above this restricted number are expected specify an accurate NetworkID field
 	// in every transaction to that chain to prevent replay attacks.
 	// Mainnet and testnet are exceptions. More context: https://github.com/XRPLF/rippled/pull/4370
-	RestrictedNetworks = 1024
+	RestrictedNetworks = autofill.RestrictedNetworks
 	// RequiredNetworkIDVersion is the minimum rippled version that requires NetworkID validation.
-	RequiredNetworkIDVersion = "1.11.0"
+	RequiredNetworkIDVersion = autofill.RequiredNetworkIDVersion
 )
 
-// isNotLaterRippledVersion determines whether the source rippled version is not later than the target rippled version.
-// Example usage: isNotLaterRippledVersion("1.10.0", "1.11.0") returns true.
-//
-//	isNotLaterRippledVersion("1.10.0", "1.10.0-b1") returns false.
-func isNotLaterRippledVersion(source, target string) bool {
-	if source == target {
-		return true
-	}
-
-	sourceDecomp := strings.Split(source, ".")
-	targetDecomp := strings.Split(target, ".")
-
-	if len(sourceDecomp) < 3 || len(targetDecomp) < 3 {
-		return false
-	}
-
-	sourceMajor, err := strconv.Atoi(sourceDecomp[0])
-	if err != nil {
-		return false
-	}
-	sourceMinor, err := strconv.Atoi(sourceDecomp[1])
-	if err != nil {
-		return false
-	}
-	targetMajor, err := strconv.Atoi(targetDecomp[0])
-	if err != nil {
-		return false
-	}
-	targetMinor, err := strconv.Atoi(targetDecomp[1])
-	if err != nil {
-		return false
-	}
-
-	// Compare major version
-	if sourceMajor != targetMajor {
-		return sourceMajor < targetMajor
-	}
-
-	// Compare minor version
-	if sourceMinor != targetMinor {
-		return sourceMinor < targetMinor
-	}
-
-	sourcePatch := strings.Split(sourceDecomp[2], "-")
-	targetPatch := strings.Split(targetDecomp[2], "-")
-
-	sourcePatchVersion, err := strconv.Atoi(sourcePatch[0])
-	if err != nil {
-		return false
-	}
-	targetPatchVersion, err := strconv.Atoi(targetPatch[0])
-	if err != nil {
-		return false
-	}
-
-	// Compare patch version
-	if sourcePatchVersion != targetPatchVersion {
-		return sourcePatchVersion < targetPatchVersion
-	}
-
-	// Compare release version
-	if len(sourcePatch) != len(targetPatch) {
-		return len(sourcePatch) > len(targetPatch)
-	}
-
-	if len(sourcePatch) == 2 {
-		// Compare different release types
-		if !strings.HasPrefix(sourcePatch[1], string(targetPatch[1][0])) {
-			return sourcePatch[1] < targetPatch[1]
-		}
-
-		// Compare beta version
-		if strings.HasPrefix(sourcePatch[1], "b") {
-			sourceBeta, err := strconv.Atoi(sourcePatch[1][1:])
-			if err != nil {
-				return false
-			}
-			targetBeta, err := strconv.Atoi(targetPatch[1][1:])
-			if err != nil {
-				return false
-			}
-			return sourceBeta < targetBeta
-		}
-
-		// Compare rc version
-		if strings.HasPrefix(sourcePatch[1], "rc") {
-			sourceRC, err := strconv.Atoi(sourcePatch[1][2:])
-			if err != nil {
-				return false
-			}
-			targetRC, err := strconv.Atoi(targetPatch[1][2:])
-			if err != nil {
-				return false
-			}
-			return sourceRC < targetRC
-		}
-	}
-
-	return false
-}
-
 // txNeedsNetworkID determines if the transaction required a networkID to be valid.
 // Transaction needs networkID if later than restricted ID and build version is >= 1.11.0
 func (c *Client) txNeedsNetworkID() (bool, error) {
@@ -148,9 +56,7 @@ func (c *Client) txNeedsNetworkID() (bool, error) {
 			return false, err
 		}
 
-		if res.Info.BuildVersion != "" {
-			return isNotLaterRippledVersion(RequiredNetworkIDVersion, res.Info.BuildVersion), nil
-		}
+		return autofill.TxNeedsNetworkID(c.NetworkID, res.Info.BuildVersion), nil
 	}
 	return false, nil
 }
@@ -259,55 +165,15 @@ func readResponseBody(body io.Reader, maxResponseSize int64) ([]byte, error) {
 
 // Sets valid addresses for the transaction.
 func (c *Client) setValidTransactionAddresses(tx *transaction.FlatTransaction) error {
-	// Validate if "Account" address is an xAddress
-	if err := c.validateTransactionAddress(tx, "Account", "SourceTag"); err != nil {
-		return err
-	}
-
-	if _, ok := (*tx)["Destination"]; ok {
-		if err := c.validateTransactionAddress(tx, "Destination", "DestinationTag"); err != nil {
-			return err
-		}
-	}
-
-	// DepositPreuaht
-	c.convertTransactionAddressToClassicAddress(tx, "Authorize")
-	c.convertTransactionAddressToClassicAddress(tx, "Unauthorize")
-	// EscrowCancel, EscrowFinish
-	c.convertTransactionAddressToClassicAddress(tx, "Owner")
-	// SetRegularKey
-	c.convertTransactionAddressToClassicAddress(tx, "RegularKey")
-
-	return nil
-}
-
-// TODO: Implement this when IsValidXAddress is implemented
-func (c *Client) getClassicAccountAndTag(address string) (string, uint32) {
-	return address, 0
+	return autofill.SetValidAddresses(tx)
 }
 
 func (c *Client) convertTransactionAddressToClassicAddress(tx *transaction.FlatTransaction, fieldName string) {
-	if address, ok := (*tx)[fieldName].(string); ok {
-		classicAddress, _ := c.getClassicAccountAndTag(address)
-		(*tx)[fieldName] = classicAddress
-	}
+	autofill.ConvertAddress(tx, fieldName)
 }
 
 func (c *Client) validateTransactionAddress(tx *transaction.FlatTransaction, addressField, tagField string) error {
-	classicAddress, tag := c.getClassicAccountAndTag((*tx)[addressField].(string))
-	(*tx)[addressField] = classicAddress
-
-	if tag != uint32(0) {
-		if txTag, ok := (*tx)[tagField].(uint32); ok && txTag != tag {
-			return ErrMismatchedTag{
-				Expected: addressField,
-				Actual:   tagField,
-			}
-		}
-		(*tx)[tagField] = tag
-	}
-
-	return nil
+	return autofill.ValidateAddress(tx, addressField, tagField)
 }
 
 // Sets the next valid sequence number for a given transaction.
@@ -315,141 +181,41 @@ func (c *Client) setTransactionNextValidSequenceNumber(tx *transaction.FlatTrans
 	if _, ok := (*tx)["Account"].(string); !ok {
 		return ErrMissingAccountInTransaction
 	}
-	res, err := c.GetAccountInfo(&account.InfoRequest{
-		Account:     types.Address((*tx)["Account"].(string)),
-		LedgerIndex: common.LedgerTitle("current"),
+	return autofill.Sequence(tx, func(acc string) (uint32, error) {
+		res, err := c.GetAccountInfo(&account.InfoRequest{
+			Account:     types.Address(acc),
+			LedgerIndex: common.LedgerTitle("current"),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.AccountData.Sequence, nil
 	})
-	if err != nil {
-		return err
-	}
-
-	(*tx)["Sequence"] = uint32(res.AccountData.Sequence)
-	return nil
 }
 
-// Calculates the current transaction fee for the ledger.
-// Note: This is a public API that can be called directly.
-func (c *Client) getFeeXrp(cushion float32) (string, error) {
+// fetchNetworkFee fetches the network fee in drops from the server info,
+// applying the load factor, the fee cushion and the max fee of the client.
+func (c *Client) fetchNetworkFee() (uint64, error) {
 	res, err := c.GetServerInfo(&server.InfoRequest{})
 	if err != nil {
-		return "", err
+		return 0, err
 	}
 
 	if res.Info.ValidatedLedger.BaseFeeXRP == 0 {
-		return "", ErrCouldNotGetBaseFeeXrp
-	}
-
-	loadFactor := res.Info.LoadFactor
-	if res.Info.LoadFactor == 0 {
-		loadFactor = 1
-	}
-
-	fee := res.Info.ValidatedLedger.BaseFeeXRP * float32(loadFactor) * cushion
-
-	if fee > c.cfg.maxFeeXRP {
-		fee = c.cfg.maxFeeXRP
+		return 0, ErrCouldNotGetBaseFeeXrp
 	}
 
-	// Round fee to NUM_DECIMAL_PLACES
-	roundedFee := float32(math.Round(float64(fee)*math.Pow10(currency.MaxFractionLength))) / float32(math.Pow10(currency.MaxFractionLength))
-
-	// Convert the rounded fee back to a string with NUM_DECIMAL_PLACES
-	return fmt.Sprintf("%.*f", currency.MaxFractionLength, roundedFee), nil
+	return autofill.NetworkFee(res.Info.ValidatedLedger.BaseFeeXRP, res.Info.LoadFactor, c.cfg.feeCushion, c.cfg.maxFeeXRP)
 }
 
 // Calculates the fee per transaction type.
-//
-// Enhanced implementation that replicates xrpl.js calculateFeePerTransactionType logic,
-// including special cases for EscrowFinish, AccountDelete, AMMCreate, Batch, and multi-signing.
+// The fee rules are shared with offline autofill, see autofill.CalculateFee.
 func (c *Client) calculateFeePerTransactionType(tx *transaction.FlatTransaction, nSigners uint64) error {
-	// Get base network fee
-	netFeeXRP, err := c.getFeeXrp(c.cfg.feeCushion)
-	if err != nil {
-		return err
-	}
-
-	netFeeDrops, err := currency.XrpToDrops(netFeeXRP)
-	if err != nil {
-		return err
-	}
-
-	// Convert to uint64 for calculations
-	baseFeeUint, err := strconv.ParseUint(netFeeDrops, 10, 64)
-	if err != nil {
-		return err
-	}
-
-	baseFee := baseFeeUint
-
-	// Get transaction type
-	transactionType := ""
-	if txType, ok := (*tx)["TransactionType"]; ok {
-		if str, ok := txType.(string); ok {
-			transactionType = str
-		}
-	}
-
-	// Check if this is a special transaction cost type
-	isSpecialTxCost := transactionType == "AccountDelete" || transactionType == "AMMCreate"
-
-	switch transactionType {
-	case "EscrowFinish":
-		if fulfillment, ok := (*tx)["Fulfillment"]; ok && fulfillment != nil {
-			if fulfillmentStr, ok := fulfillment.(string); ok && fulfillmentStr != "" {
-				fulfillmentBytesSize := (len(fulfillmentStr) + 1) / 2 // Math.ceil(length / 2)
-				if fulfillmentBytesSize < 0 {
-					return ErrInvalidFulfillmentLength
-				}
-				// BaseFee × (33 + ceil(Fulfillment size in bytes / 16))
-				chunks := (uint64(fulfillmentBytesSize) + 15) / 16 // ceil division
-				baseFee = baseFeeUint * (33 + chunks)
-			}
-		}
-	case "AccountDelete", "AMMCreate":
-		reserveFee, err := c.fetchOwnerReserveFee()
-		if err != nil {
-			return err
-		}
-		baseFee = reserveFee
-	case "Batch":
-		rawTxFees, err := c.calculateBatchFees(tx)
-		if err != nil {
-			return err
-		}
-		baseFee = baseFeeUint*2 + rawTxFees
-	case "LoanSet":
-		// For LoanSet, account for counterparty signers
-		counterPartySignersCount, err := c.fetchCounterPartySignersCount(*tx)
-		if err != nil {
-			return err
-		}
-		baseFee = baseFeeUint + (baseFeeUint * counterPartySignersCount)
-	}
-
-	// Multi-signed Transaction: BaseFee × (1 + Number of Signatures Provided)
-	if nSigners > 0 {
-		signersFee := baseFeeUint * nSigners
-		baseFee += signersFee
-	}
-
-	// Apply max fee limit (but not for special transaction cost types)
-	var totalFee uint64
-	if isSpecialTxCost {
-		totalFee = baseFee
-	} else {
-		maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", c.cfg.maxFeeXRP))
-		if err != nil {
-			return err
-		}
-		maxFeeUint, err := strconv.ParseUint(maxFeeDrops, 10, 64)
-		if err != nil {
-			return err
-		}
-		totalFee = min(baseFee, maxFeeUint)
-	}
-
-	(*tx)["Fee"] = strconv.FormatUint(totalFee, 10)
-	return nil
+	return autofill.CalculateFee(tx, nSigners, c.cfg.maxFeeXRP, autofill.FeeSource{
+		NetworkFee:               c.fetchNetworkFee,
+		OwnerReserve:             c.fetchOwnerReserveFee,
+		CounterpartySignersCount: c.fetchCounterPartySignersCount,
+	})
 }
 
 // Sets the latest validated ledger sequence for the transaction.
@@ -572,21 +338,25 @@ func (c *Client) waitForTransaction(txHash string, lastLedgerSequence uint32) (*
 
 // getSignedTx ensures the transaction is fully signed and returns the transaction blob.
 // If the transaction is already signed, it encodes and returns it. Otherwise, it autofills (if enabled)
-// and signs the transaction using the provided wallet.
-func (c *Client) getSignedTx(tx transaction.FlatTransaction, autofill bool, wallet *wallet.Wallet) (string, error) {
+// and signs the transaction using the provided signer.
+func (c *Client) getSignedTx(tx transaction.FlatTransaction, autofill bool, signer wallet.Signer) (string, error) {
+	if err := tx.RequireNotPseudo(); err != nil {
+		return "", err
+	}
+
 	// Check if the transaction is already signed: both fields must be non-empty.
 	sig, sigOk := tx["TxnSignature"].(string)
 	pubKey, pubKeyOk := tx["SigningPubKey"].(string)
 	if sigOk && sig != "" && pubKeyOk && pubKey != "" {
-		blob, err := binarycodec.Encode(tx)
+		blob, err := c.codecs.Codec().Encode(tx)
 		if err != nil {
 			return "", err
 		}
 		return blob, nil
 	}
 
-	// If not signed, ensure a wallet is provided.
-	if wallet == nil {
+	// If not signed, ensure a signer is provided.
+	if wallet.IsNilSigner(signer) {
 		return "", ErrMissingWallet
 	}
 
@@ -598,7 +368,7 @@ func (c *Client) getSignedTx(tx transaction.FlatTransaction, autofill bool, wall
 	}
 
 	// Sign the transaction.
-	txBlob, _, err := wallet.Sign(tx)
+	txBlob, _, err := wallet.SignWithCodec(signer, tx, c.codecs.Codec())
 	if err != nil {
 		return "", err
 	}
@@ -681,51 +451,326 @@ func (c *Client) fetchCounterPartySignersCount(tx transaction.FlatTransaction) (
 	return 1, nil
 }
 
-// calculateBatchFees calculates the total fees for all inner transactions in a Batch.
-// Replicates the JavaScript logic for Batch transaction fee calculation.
-func (c *Client) calculateBatchFees(tx *transaction.FlatTransaction) (uint64, error) {
-	var totalFees uint64
+// getAccountSnapshot fetches the sequence, unused tickets and signer list size of an account.
+func (c *Client) getAccountSnapshot(address types.Address) (*xrpl.AccountSnapshot, error) {
+	info, err := c.GetAccountInfo(&account.InfoRequest{
+		Account:     address,
+		LedgerIndex: common.LedgerTitle("current"),
+		SignerLists: true,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	// Get RawTransactions from the batch transaction
-	rawTransactions, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return 0, ErrRawTransactionsFieldMissing
+	accountSnapshot := &xrpl.AccountSnapshot{
+		Sequence: info.AccountData.Sequence,
+	}
+	if len(info.SignerLists) > 0 {
+		accountSnapshot.SignerListSize = uint32(len(info.SignerLists[0].SignerEntries))
 	}
 
-	// Iterate through each raw transaction
-	for _, rawTx := range rawTransactions {
-		// Extract the actual transaction from the wrapper
-		innerTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return 0, ErrRawTransactionFieldMissing
+	var marker any
+	for {
+		objects, err := c.GetAccountObjects(&account.ObjectsRequest{
+			Account:     address,
+			Type:        account.TicketObject,
+			LedgerIndex: common.LedgerTitle("current"),
+			Marker:      marker,
+		})
+		if err != nil {
+			return nil, err
 		}
 
-		// Calculate fee for this inner transaction (no multi-signing for inner transactions)
-		innerTxFlat := transaction.FlatTransaction(innerTx)
-		err := c.calculateFeePerTransactionType(&innerTxFlat, 0)
-		if err != nil {
-			return 0, err
+		for _, object := range objects.AccountObjects {
+			switch ticketSequence := object["TicketSequence"].(type) {
+			case json.Number:
+				sequence, err := strconv.ParseUint(ticketSequence.String(), 10, 32)
+				if err != nil {
+					return nil, err
+				}
+				accountSnapshot.Tickets = append(accountSnapshot.Tickets, uint32(sequence))
+			case float64:
+				accountSnapshot.Tickets = append(accountSnapshot.Tickets, uint32(ticketSequence))
+			case uint32:
+				accountSnapshot.Tickets = append(accountSnapshot.Tickets, ticketSequence)
+			}
 		}
 
-		// Extract the calculated fee
-		feeStr, ok := innerTx["Fee"].(string)
-		if !ok {
-			return 0, ErrFeeFieldMissing
+		if objects.Marker == nil {
+			break
 		}
+		marker = objects.Marker
+	}
+	slices.Sort(accountSnapshot.Tickets)
 
-		innerTx["Fee"] = "0"
+	return accountSnapshot, nil
+}
 
-		// Convert fee string to uint64 and add to total
-		feeUint, err := strconv.ParseUint(feeStr, 10, 64)
-		if err != nil {
-			return 0, ErrFailedToParseFee{
-				Fee: feeStr,
-				Err: err,
+// setSignerKeys sets the regular key and master key status of a signer from its account root.
+// Signers without an account on the ledger can only sign with their master key.
+func (c *Client) setSignerKeys(signer *xrpl.MultisignSigner) error {
+	info, err := c.GetAccountInfo(&account.InfoRequest{
+		Account:     signer.Account,
+		LedgerIndex: common.Validated,
+	})
+	if err != nil {
+		if isActNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	signer.RegularKey = info.AccountData.RegularKey
+	signer.MasterDisabled = info.AccountData.Flags&ledgerentries.LsfDisableMaster != 0
+	return nil
+}
+
+// preclaimSource returns the ledger state lookups used by Preclaim.
+func (c *Client) preclaimSource() xrpl.PreclaimSource {
+	return xrpl.PreclaimSource{
+		AccountRoot: func(address types.Address) (*ledgerentries.AccountRoot, error) {
+			info, err := c.GetAccountInfo(&account.InfoRequest{
+				Account:     address,
+				LedgerIndex: common.Current,
+			})
+			if err != nil {
+				if isActNotFound(err) {
+					return nil, nil
+				}
+				return nil, err
+			}
+			return &info.AccountData, nil
+		},
+		Reserves: func() (uint64, uint64, error) {
+			state, err := c.GetServerState(&server.StateRequest{})
+			if err != nil {
+				return 0, 0, err
+			}
+			return uint64(state.State.ValidatedLedger.ReserveBase), uint64(state.State.ValidatedLedger.ReserveInc), nil
+		},
+		DepositAuthorized: func(source, destination types.Address) (bool, error) {
+			res, err := c.GetDepositAuthorized(&path.DepositAuthorizedRequest{
+				SourceAccount:      source,
+				DestinationAccount: destination,
+				LedgerIndex:        common.Current,
+			})
+			if err != nil {
+				return false, err
+			}
+			return res.DepositAuthorized, nil
+		},
+		TrustLine: func(address, issuer types.Address, currency string) (*accounttypes.TrustLine, error) {
+			lines, err := c.GetAccountLines(&account.LinesRequest{
+				Account:     address,
+				Peer:        issuer,
+				LedgerIndex: common.Current,
+			})
+			if err != nil {
+				if isActNotFound(err) {
+					return nil, nil
+				}
+				return nil, err
+			}
+			for _, line := range lines.Lines {
+				if line.Currency == currency {
+					return &line, nil
+				}
+			}
+			return nil, nil
+		},
+		MPTokenIssuance: func(mptIssuanceID string) (ledgerentries.FlatLedgerObject, error) {
+			index, err := hash.MPTokenIssuance(mptIssuanceID)
+			if err != nil {
+				return nil, err
+			}
+			return c.getLedgerEntryOrNil(index)
+		},
+		MPToken: func(mptIssuanceID string, holder types.Address) (ledgerentries.FlatLedgerObject, error) {
+			index, err := hash.MPToken(mptIssuanceID, holder.String())
+			if err != nil {
+				return nil, err
+			}
+			return c.getLedgerEntryOrNil(index)
+		},
+	}
+}
+
+// getLedgerEntryOrNil returns the ledger entry with the given index in the current ledger,
+// or nil if it does not exist.
+func (c *Client) getLedgerEntryOrNil(index string) (ledgerentries.FlatLedgerObject, error) {
+	res, err := c.GetLedgerEntry(&ledger.EntryRequest{
+		Index:       index,
+		LedgerIndex: common.Current,
+	})
+	if err != nil {
+		if isEntryNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res.Node, nil
+}
+
+// getOriginalTxBlob returns the signed blob of the transaction to replace.
+// original is returned as is unless it is a transaction hash, in which case the blob is
+// fetched from the server. It fails if the transaction has already been validated.
+func (c *Client) getOriginalTxBlob(original string) (string, error) {
+	if len(original) != 64 || !typecheck.IsHex(original) {
+		return original, nil
+	}
+
+	res, err := c.Request(&requests.TxRequest{
+		Transaction: original,
+		Binary:      true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var txResponse requests.TxResponse
+	if err := res.GetResult(&txResponse); err != nil {
+		return "", err
+	}
+
+	if txResponse.Validated {
+		return "", ErrOriginalTransactionAlreadyValidated
+	}
+	if txResponse.TxBlob == "" {
+		return "", ErrTransactionNotFound
+	}
+
+	return txResponse.TxBlob, nil
+}
+
+// getReplacementFee returns the fee, in drops, that a replacement of originalTx must pay
+// to be accepted by the transaction queue. It errors if that fee exceeds the client's max fee.
+func (c *Client) getReplacementFee(originalTx map[string]any) (uint64, error) {
+	feeStr, ok := originalTx["Fee"].(string)
+	if !ok {
+		return 0, ErrFeeFieldMissing
+	}
+	originalFee, err := strconv.ParseUint(feeStr, 10, 64)
+	if err != nil {
+		return 0, ErrFailedToParseFee{
+			Fee: feeStr,
+			Err: err,
+		}
+	}
+
+	res, err := c.GetFee(&server.FeeRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	fee := replacementFee(originalFee, res.Drops.OpenLedgerFee.Uint64())
+
+	maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", c.cfg.maxFeeXRP))
+	if err != nil {
+		return 0, err
+	}
+	maxFee, err := strconv.ParseUint(maxFeeDrops, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if fee > maxFee {
+		return 0, ErrReplacementFeeExceedsMaxFee
+	}
+
+	return fee, nil
+}
+
+// replacementFee applies the transaction queue's fee-escalation rules: a transaction replacing
+// a queued one with the same sequence must pay strictly more than QueueRetryFeePercent over the
+// original fee, and a transaction is only applied to the open ledger if it pays the open ledger fee.
+func replacementFee(originalFee, openLedgerFee uint64) uint64 {
+	fee := originalFee + originalFee*commonconstants.QueueRetryFeePercent/100 + 1
+	return max(fee, openLedgerFee)
+}
+
+// buildReplacementTx builds the unsigned replacement of originalTx for the given strategy and fee.
+// Both strategies keep the original's Sequence or TicketSequence, LastLedgerSequence and NetworkID,
+// so the replacement and the original can never both be validated.
+func buildReplacementTx(originalTx map[string]any, strategy rpctypes.ReplaceStrategy, fee uint64) (transaction.FlatTransaction, error) {
+	account, ok := originalTx["Account"].(string)
+	if !ok {
+		return nil, ErrMissingAccountInTransaction
+	}
+	sequence, _ := originalTx["Sequence"].(uint32)
+	ticketSequence, _ := originalTx["TicketSequence"].(uint32)
+	if sequence == 0 && ticketSequence == 0 {
+		return nil, ErrMissingSequenceInTransaction
+	}
+
+	switch strategy {
+	case rpctypes.ReplaceWithFeeBump:
+		if _, ok := originalTx["Signers"]; ok {
+			return nil, ErrCannotFeeBumpMultisignedTransaction
+		}
+		replacement := transaction.FlatTransaction(maps.Clone(originalTx))
+		delete(replacement, "TxnSignature")
+		delete(replacement, "SigningPubKey")
+		replacement["Fee"] = strconv.FormatUint(fee, 10)
+		return replacement, nil
+	case rpctypes.ReplaceWithNoop:
+		lastLedgerSequence, _ := originalTx["LastLedgerSequence"].(uint32)
+		networkID, _ := originalTx["NetworkID"].(uint32)
+		noop := transaction.AccountSet{
+			BaseTx: transaction.BaseTx{
+				Account:            types.Address(account),
+				TransactionType:    transaction.AccountSetTx,
+				Fee:                types.XRPCurrencyAmount(fee),
+				Sequence:           sequence,
+				TicketSequence:     ticketSequence,
+				LastLedgerSequence: lastLedgerSequence,
+				NetworkID:          networkID,
+			},
+		}
+		return noop.Flatten(), nil
+	default:
+		return nil, ErrInvalidReplaceStrategy
+	}
+}
+
+// waitForReplacement polls the original and replacement transactions until one of them is
+// validated, or until the ledger passes lastLedgerSequence.
+func (c *Client) waitForReplacement(originalHash, replacementHash string, lastLedgerSequence uint32) (*rpctypes.ReplaceResult, error) {
+	for range c.cfg.maxRetries {
+		for _, txHash := range []string{replacementHash, originalHash} {
+			res, err := c.Request(&requests.TxRequest{
+				Transaction: txHash,
+			})
+			if err != nil {
+				if strings.Contains(err.Error(), txnNotFound) {
+					continue
+				}
+				return nil, err
+			}
+
+			var txResponse requests.TxResponse
+			if err := res.GetResult(&txResponse); err != nil {
+				return nil, err
+			}
+
+			if txResponse.Validated {
+				return &rpctypes.ReplaceResult{
+					OriginalHash:    originalHash,
+					ReplacementHash: replacementHash,
+					Replaced:        txHash == replacementHash,
+					TxResponse:      &txResponse,
+				}, nil
 			}
 		}
 
-		totalFees += feeUint
+		currentLedger, err := c.GetLedgerIndex()
+		if err != nil {
+			return nil, err
+		}
+		if lastLedgerSequence != 0 && currentLedger.Uint32() > lastLedgerSequence {
+			break
+		}
+
+		time.Sleep(c.cfg.retryDelay)
 	}
 
-	return totalFees, nil
+	return nil, ErrTransactionNotFound
 }
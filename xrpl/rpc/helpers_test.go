@@ -9,6 +9,8 @@ import (
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+	rpctypes "github.com/Peersyst/xrpl-go/xrpl/rpc/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -181,3 +183,145 @@ func TestCheckForError(t *testing.T) {
 		})
 	}
 }
+
+func TestReplacementFee(t *testing.T) {
+	tests := []struct {
+		name          string
+		originalFee   uint64
+		openLedgerFee uint64
+		expected      uint64
+	}{
+		{
+			name:          "pass - bumps fee above retry percent",
+			originalFee:   10,
+			openLedgerFee: 10,
+			expected:      13,
+		},
+		{
+			name:          "pass - uses open ledger fee when higher",
+			originalFee:   10,
+			openLedgerFee: 5000,
+			expected:      5000,
+		},
+		{
+			name:          "pass - large fee",
+			originalFee:   1000,
+			openLedgerFee: 10,
+			expected:      1251,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, replacementFee(tt.originalFee, tt.openLedgerFee))
+		})
+	}
+}
+
+func TestBuildReplacementTx(t *testing.T) {
+	original := map[string]any{
+		"TransactionType":    "Payment",
+		"Account":            "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+		"Destination":        "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX",
+		"Amount":             "1000",
+		"Fee":                "10",
+		"Sequence":           uint32(3),
+		"LastLedgerSequence": uint32(100),
+		"SigningPubKey":      "03AB40A0490F9B7ED8DF29D246BF2D6269820A0EE7742ACDD457BEA7C7D0931EDB",
+		"TxnSignature":       "3045022100D184EB4AE5956FF600E7536EE459345C7BBCF097A84CC61A93B9AF7197EDB98702201CEA8009B7BEEBAA2AACC0359B41C427C1C5B550A4CA4B80CF2174AF2D6D5DCE",
+	}
+
+	tests := []struct {
+		name        string
+		original    map[string]any
+		strategy    rpctypes.ReplaceStrategy
+		expected    transaction.FlatTransaction
+		expectedErr error
+	}{
+		{
+			name:     "pass - fee bump",
+			original: original,
+			strategy: rpctypes.ReplaceWithFeeBump,
+			expected: transaction.FlatTransaction{
+				"TransactionType":    "Payment",
+				"Account":            "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+				"Destination":        "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX",
+				"Amount":             "1000",
+				"Fee":                "13",
+				"Sequence":           uint32(3),
+				"LastLedgerSequence": uint32(100),
+			},
+		},
+		{
+			name:     "pass - no-op",
+			original: original,
+			strategy: rpctypes.ReplaceWithNoop,
+			expected: transaction.FlatTransaction{
+				"TransactionType":    "AccountSet",
+				"Account":            "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+				"Fee":                "13",
+				"Sequence":           uint32(3),
+				"LastLedgerSequence": uint32(100),
+			},
+		},
+		{
+			name: "pass - no-op with ticket",
+			original: map[string]any{
+				"TransactionType": "Payment",
+				"Account":         "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+				"Fee":             "10",
+				"Sequence":        uint32(0),
+				"TicketSequence":  uint32(7),
+			},
+			strategy: rpctypes.ReplaceWithNoop,
+			expected: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+				"Fee":             "13",
+				"Sequence":        uint32(0),
+				"TicketSequence":  uint32(7),
+			},
+		},
+		{
+			name: "fail - missing sequence",
+			original: map[string]any{
+				"TransactionType": "Payment",
+				"Account":         "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+				"Fee":             "10",
+			},
+			strategy:    rpctypes.ReplaceWithNoop,
+			expectedErr: ErrMissingSequenceInTransaction,
+		},
+		{
+			name: "fail - fee bump multisigned",
+			original: map[string]any{
+				"TransactionType": "Payment",
+				"Account":         "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+				"Fee":             "10",
+				"Sequence":        uint32(3),
+				"Signers":         []any{},
+			},
+			strategy:    rpctypes.ReplaceWithFeeBump,
+			expectedErr: ErrCannotFeeBumpMultisignedTransaction,
+		},
+		{
+			name:        "fail - invalid strategy",
+			original:    original,
+			strategy:    rpctypes.ReplaceStrategy(99),
+			expectedErr: ErrInvalidReplaceStrategy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replacement, err := buildReplacementTx(tt.original, tt.strategy, 13)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, replacement)
+			require.Equal(t, "10", tt.original["Fee"])
+		})
+	}
+}
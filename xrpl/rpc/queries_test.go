@@ -18,6 +18,7 @@ import (
 	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
 	server "github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	servertypes "github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -1322,6 +1323,89 @@ func TestClient_GetLedgerData(t *testing.T) {
 	}
 }
 
+func TestClient_GetTypedLedgerData(t *testing.T) {
+	mc := testutil.JSONRPCMockClient{}
+	mc.DoFunc = testutil.MockResponse(`{
+		"result": {
+			"ledger_hash": "842B57C1CC0613299A686D3E9F310EC0422C84D3911E5056389AA7E5808A93C8",
+			"ledger_index": "6",
+			"marker": "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC322",
+			"state": [
+				{
+					"data": "1100612200000000240000000125000022C52D00000000558D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B46240000000160DC0808114712B799C79D1EEE3094B59EF9920C7FEB3CE4499",
+					"index": "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC321"
+				}
+			]
+		}
+	}`, 200, &mc)
+
+	cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+	require.NoError(t, err)
+	client := NewClient(cfg)
+
+	objects, marker, err := client.GetTypedLedgerData(&ledgerqueries.DataRequest{Binary: true})
+	require.NoError(t, err)
+	require.Equal(t, "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC322", marker)
+	require.Len(t, objects, 1)
+
+	accountRoot, ok := objects[0].(*ledger.AccountRoot)
+	require.True(t, ok)
+	require.Equal(t, types.Address("rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7"), accountRoot.Account)
+}
+
+func TestClient_GetTypedLedgerEntry(t *testing.T) {
+	mc := testutil.JSONRPCMockClient{}
+	mc.DoFunc = testutil.MockResponse(`{
+		"result": {
+			"index": "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC321",
+			"node_binary": "1100612200000000240000000125000022C52D00000000558D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B46240000000160DC0808114712B799C79D1EEE3094B59EF9920C7FEB3CE4499",
+			"validated": true
+		}
+	}`, 200, &mc)
+
+	cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+	require.NoError(t, err)
+	client := NewClient(cfg)
+
+	obj, err := client.GetTypedLedgerEntry(&ledgerqueries.EntryRequest{
+		Index:  "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC321",
+		Binary: true,
+	})
+	require.NoError(t, err)
+
+	accountRoot, ok := obj.(*ledger.AccountRoot)
+	require.True(t, ok)
+	require.Equal(t, types.XRPCurrencyAmount(370000000), accountRoot.Balance)
+}
+
+func TestClient_GetTx(t *testing.T) {
+	mc := testutil.JSONRPCMockClient{}
+	mc.DoFunc = testutil.MockResponse(`{
+		"result": {
+			"hash": "3B1A4E1C9BB6A7208EB146BCDB86ECEA6068ED01466D933528CA2B4C64F753EF",
+			"ledger_index": 38129,
+			"meta_blob": "201C00000000F8E3110061564C6ACBD635B0F07101F7FA25871B0925F8836155462152172755845CE691C49EE824000000016240000002540BE4008114D4CC8AB5B21D86A82C3E9E8D0ECF2404B77FECBAE1E1E51100612500007A55552485FDC606352F1B0785DA5DE96FB9DBAF43EB60ECBB01B7F6FA970F512CDA5F56B33FDD5CF3445E1A7F2BE9B06336BEBD73A5E3EE885D3EF93F7E3E2992E46F1AE6240000003E62400000E6D8EEB01EE1E72200000000240000003F2D0000000062400000E484E2CC148114550FC62003E785DC231A1058A05E56E3F09CF4E6E1E1F1031000",
+			"tx_blob": "1200002200000000240000003E6140000002540BE40068400000000000000A7321034AADB09CFF4A4804073701EC53C3510CDC95917C2BB0150FB742D0C66E6CEE9E74473045022022EB32AECEF7C644C891C19F87966DF9C62B1F34BABA6BE774325E4BB8E2DD62022100A51437898C28C2B297112DF8131F2BB39EA5FE613487DDD611525F17962646398114550FC62003E785DC231A1058A05E56E3F09CF4E68314D4CC8AB5B21D86A82C3E9E8D0ECF2404B77FECBA",
+			"validated": true
+		}
+	}`, 200, &mc)
+
+	cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+	require.NoError(t, err)
+	client := NewClient(cfg)
+
+	tx, err := client.GetTx(&transactions.TxRequest{
+		Transaction: "3B1A4E1C9BB6A7208EB146BCDB86ECEA6068ED01466D933528CA2B4C64F753EF",
+		Binary:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.Hash256("3B1A4E1C9BB6A7208EB146BCDB86ECEA6068ED01466D933528CA2B4C64F753EF"), tx.Hash)
+	require.Equal(t, common.LedgerIndex(38129), tx.LedgerIndex)
+	require.True(t, tx.Validated)
+	require.NotEmpty(t, tx.TxBlob)
+	require.NotEmpty(t, tx.MetaBlob)
+}
+
 func TestClient_GetLedger(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -2415,6 +2499,122 @@ func TestClient_GetAllFeatures(t *testing.T) {
 	}
 }
 
+func TestClient_GetServerDefinitions(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		mockStatus    int
+		request       *server.DefinitionsRequest
+		expected      *server.DefinitionsResponse
+		expectedError string
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"result": {
+					"hash": "AB",
+					"TYPES": {"UInt32": 2},
+					"FIELDS": [["Flags", {"nth": 2, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}]],
+					"TRANSACTION_TYPES": {"Payment": 0}
+				}
+			}`,
+			mockStatus: 200,
+			request:    &server.DefinitionsRequest{},
+			expected: &server.DefinitionsResponse{
+				Hash:  "AB",
+				Types: map[string]int32{"UInt32": 2},
+				Fields: []servertypes.DefinitionField{
+					{
+						Name: "Flags",
+						Info: servertypes.DefinitionFieldInfo{
+							Nth:            2,
+							IsSerialized:   true,
+							IsSigningField: true,
+							Type:           "UInt32",
+						},
+					},
+				},
+				TransactionTypes: map[string]int32{"Payment": 0},
+			},
+		},
+		{
+			name: "unchanged hash",
+			mockResponse: `{
+				"result": {
+					"hash": "AB"
+				}
+			}`,
+			mockStatus: 200,
+			request:    &server.DefinitionsRequest{Hash: "AB"},
+			expected:   &server.DefinitionsResponse{Hash: "AB"},
+		},
+		{
+			name: "error response",
+			mockResponse: `{
+				"result": {
+					"error": "unknownCmd",
+					"status": "error"
+				}
+			}`,
+			mockStatus:    200,
+			request:       &server.DefinitionsRequest{},
+			expectedError: "unknownCmd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.mockResponse, tt.mockStatus, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			client := NewClient(cfg)
+
+			resp, err := client.GetServerDefinitions(tt.request)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
+func TestClient_GetCodec(t *testing.T) {
+	mc := testutil.JSONRPCMockClient{}
+	mc.DoFunc = testutil.MockResponse(`{
+		"result": {
+			"hash": "AB",
+			"TYPES": {"UInt32": 2},
+			"FIELDS": [["Flags", {"nth": 2, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}]],
+			"TRANSACTION_TYPES": {"Payment": 0}
+		}
+	}`, 200, &mc)
+
+	cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+	require.NoError(t, err)
+
+	client := NewClient(cfg)
+
+	codec, err := client.GetCodec()
+	require.NoError(t, err)
+	require.Equal(t, "AB", codec.Definitions().Hash)
+
+	encoded, err := codec.Encode(map[string]any{"Flags": uint32(1)})
+	require.NoError(t, err)
+	require.Equal(t, "2200000001", encoded)
+
+	cached, err := client.GetCodec()
+	require.NoError(t, err)
+	require.Same(t, codec, cached)
+}
+
 func TestClient_GetFeature(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,22 @@
+package shamap
+
+import "errors"
+
+var (
+	// hash
+
+	// ErrInvalidHash is returned when a hash is not a 32 byte hex string.
+	ErrInvalidHash = errors.New("hash must be a 32 byte hex string")
+
+	// map
+
+	// ErrKeyNotFound is returned when a key is not in the map.
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrLeafTypeMismatch is returned when a leaf is added to a map holding leaves of another type.
+	ErrLeafTypeMismatch = errors.New("leaf type does not match map leaf type")
+
+	// proof
+
+	// ErrInvalidProof is returned when a proof does not lead from its leaf to the expected root hash.
+	ErrInvalidProof = errors.New("invalid proof")
+)
@@ -0,0 +1,57 @@
+package shamap
+
+import "fmt"
+
+// Proof is a Merkle proof that a leaf is part of a map with a given root hash. Path holds the
+// child hashes of the inner nodes on the way from the root, Path[0], down to the inner node
+// holding the leaf.
+type Proof struct {
+	LeafType LeafType
+	Key      Hash
+	Data     []byte
+	Path     [][BranchFactor]Hash
+}
+
+// Proof returns a proof that the leaf with the given key is part of the map.
+func (m *SHAMap) Proof(key Hash) (*Proof, error) {
+	leaf, path := m.find(key)
+	if leaf == nil {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+	}
+
+	proof := &Proof{
+		LeafType: m.leafType,
+		Key:      key,
+		Data:     leaf.data,
+		Path:     make([][BranchFactor]Hash, len(path)),
+	}
+	for i, n := range path {
+		proof.Path[i] = *n.childHashes()
+	}
+	return proof, nil
+}
+
+// VerifyProof checks that the proof leads from its leaf to the given root hash.
+func VerifyProof(root Hash, proof *Proof) error {
+	if len(proof.Path) == 0 || len(proof.Path) > maxDepth {
+		return fmt.Errorf("%w: path length %d", ErrInvalidProof, len(proof.Path))
+	}
+
+	// The hash of a transaction leaf does not cover its key, which must be the transaction ID.
+	if proof.LeafType == TransactionLeaf && TransactionID(proof.Data) != proof.Key {
+		return fmt.Errorf("%w: key is not the transaction ID", ErrInvalidProof)
+	}
+
+	h := LeafHash(proof.LeafType, proof.Key, proof.Data)
+	for depth := len(proof.Path) - 1; depth >= 0; depth-- {
+		children := proof.Path[depth]
+		if children[proof.Key.nibble(depth)] != h {
+			return fmt.Errorf("%w: hash mismatch at depth %d", ErrInvalidProof, depth)
+		}
+		h = innerHash(&children)
+	}
+	if h != root {
+		return fmt.Errorf("%w: root hash is %s, expected %s", ErrInvalidProof, h, root)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package shamap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSHAMap_Proof(t *testing.T) {
+	fixture := loadLedgerFixture(t)
+	m := stateMap(t, fixture)
+	root, err := ParseHash(fixture.AccountHash)
+	require.NoError(t, err)
+
+	for _, entry := range fixture.AccountState {
+		key, err := ParseHash(entry["index"].(string))
+		require.NoError(t, err)
+
+		proof, err := m.Proof(key)
+		require.NoError(t, err)
+		require.NoError(t, VerifyProof(root, proof))
+	}
+
+	key, err := ParseHash(fixture.AccountState[0]["index"].(string))
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name   string
+		modify func(p *Proof)
+	}{
+		{
+			name:   "tampered data",
+			modify: func(p *Proof) { p.Data = append([]byte{}, p.Data[:len(p.Data)-1]...) },
+		},
+		{
+			name:   "other key",
+			modify: func(p *Proof) { p.Key[HashLength-1] ^= 0xFF },
+		},
+		{
+			name:   "tampered sibling",
+			modify: func(p *Proof) { p.Path[0][(p.Key.nibble(0)+1)%BranchFactor][0] ^= 0xFF },
+		},
+		{
+			name:   "truncated path",
+			modify: func(p *Proof) { p.Path = p.Path[1:] },
+		},
+		{
+			name:   "empty path",
+			modify: func(p *Proof) { p.Path = nil },
+		},
+		{
+			name:   "other leaf type",
+			modify: func(p *Proof) { p.LeafType = TransactionWithMetadataLeaf },
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run("fail - "+tc.name, func(t *testing.T) {
+			proof, err := m.Proof(key)
+			require.NoError(t, err)
+			tc.modify(proof)
+			require.ErrorIs(t, VerifyProof(root, proof), ErrInvalidProof)
+		})
+	}
+
+	t.Run("fail - other root", func(t *testing.T) {
+		proof, err := m.Proof(key)
+		require.NoError(t, err)
+		require.ErrorIs(t, VerifyProof(Hash{}, proof), ErrInvalidProof)
+	})
+
+	t.Run("fail - missing key", func(t *testing.T) {
+		_, err := m.Proof(testKey(0))
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+func TestSHAMap_TransactionProof(t *testing.T) {
+	m := New(TransactionLeaf)
+	txs := [][]byte{[]byte("first"), []byte("second"), []byte("third")}
+	for _, tx := range txs {
+		m.Put(TransactionID(tx), tx)
+	}
+
+	proof, err := m.Proof(TransactionID(txs[1]))
+	require.NoError(t, err)
+	require.NoError(t, VerifyProof(m.Hash(), proof))
+
+	// A transaction leaf hash does not cover its key, so the key must be checked separately.
+	proof.Key = TransactionID(txs[0])
+	require.ErrorIs(t, VerifyProof(m.Hash(), proof), ErrInvalidProof)
+}
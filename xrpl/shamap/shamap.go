@@ -0,0 +1,348 @@
+// Package shamap implements the SHAMap, the Merkle radix tree the XRP Ledger uses to hash the
+// transactions and the account state of a ledger into its transaction_hash and account_hash.
+package shamap
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/hexutil"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+)
+
+const (
+	// BranchFactor is the number of children of an inner node, one per key nibble value.
+	BranchFactor = 16
+	// HashLength is the length in bytes of keys and node hashes.
+	HashLength = 32
+	// maxDepth is the number of nibbles in a key, and so the maximum depth of a leaf.
+	maxDepth = HashLength * 2
+)
+
+// Hash is a SHAMap key or node hash.
+type Hash [HashLength]byte
+
+// ParseHash parses a hex encoded hash.
+func ParseHash(s string) (Hash, error) {
+	var h Hash
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != HashLength {
+		return h, fmt.Errorf("%w: %q", ErrInvalidHash, s)
+	}
+	copy(h[:], b)
+	return h, nil
+}
+
+// String returns the hash as an upper case hex string.
+func (h Hash) String() string {
+	return hexutil.EncodeToUpperHex(h[:])
+}
+
+// IsZero reports whether the hash is all zeros, the hash of an empty map.
+func (h Hash) IsZero() bool {
+	return h == Hash{}
+}
+
+// nibble returns the nibble of the key at the given depth, which selects the branch taken by
+// the inner node at that depth.
+func (h Hash) nibble(depth int) int {
+	b := h[depth/2]
+	if depth%2 == 0 {
+		return int(b >> 4)
+	}
+	return int(b & 0x0F)
+}
+
+// LeafType is the type of the leaves of a map, which determines how they are hashed.
+type LeafType uint8
+
+const (
+	// TransactionLeaf is a transaction without metadata. Its hash is
+	// SHA-512Half(TransactionPrefix + transaction).
+	TransactionLeaf LeafType = iota
+	// TransactionWithMetadataLeaf is a transaction with its metadata, as found in a ledger
+	// transaction tree. Its data is the variable length encoded transaction followed by the
+	// variable length encoded metadata, and its hash is
+	// SHA-512Half(TransactionNodePrefix + data + key).
+	TransactionWithMetadataLeaf
+	// AccountStateLeaf is a ledger entry, as found in a ledger state tree. Its hash is
+	// SHA-512Half(LeafNodePrefix + data + key).
+	AccountStateLeaf
+)
+
+// String returns the name of the leaf type.
+func (t LeafType) String() string {
+	switch t {
+	case TransactionLeaf:
+		return "transaction"
+	case TransactionWithMetadataLeaf:
+		return "transaction with metadata"
+	case AccountStateLeaf:
+		return "account state"
+	default:
+		return fmt.Sprintf("LeafType(%d)", t)
+	}
+}
+
+// LeafHash returns the hash of a leaf of the given type.
+func LeafHash(t LeafType, key Hash, data []byte) Hash {
+	var prefix uint32
+	switch t {
+	case TransactionLeaf:
+		return sha512Half(binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(data)), hash.TransactionPrefix), data)
+	case TransactionWithMetadataLeaf:
+		prefix = hash.TransactionNodePrefix
+	default:
+		prefix = hash.LeafNodePrefix
+	}
+	payload := make([]byte, 0, 4+len(data)+HashLength)
+	payload = binary.BigEndian.AppendUint32(payload, prefix)
+	payload = append(payload, data...)
+	return sha512Half(payload, key[:])
+}
+
+// innerHash returns the hash of an inner node with the given child hashes. An inner node
+// without children hashes to zero.
+func innerHash(children *[BranchFactor]Hash) Hash {
+	empty := true
+	for _, c := range children {
+		if !c.IsZero() {
+			empty = false
+			break
+		}
+	}
+	if empty {
+		return Hash{}
+	}
+
+	payload := make([]byte, 0, 4+BranchFactor*HashLength)
+	payload = binary.BigEndian.AppendUint32(payload, hash.InnerNodePrefix)
+	for _, c := range children {
+		payload = append(payload, c[:]...)
+	}
+	return sha512Half(payload)
+}
+
+func sha512Half(parts ...[]byte) Hash {
+	var payload []byte
+	if len(parts) == 1 {
+		payload = parts[0]
+	} else {
+		for _, p := range parts {
+			payload = append(payload, p...)
+		}
+	}
+	var h Hash
+	copy(h[:], crypto.Sha512Half(payload))
+	return h
+}
+
+// TransactionID returns the ID of a transaction, the key of its leaf in a transaction tree.
+func TransactionID(tx []byte) Hash {
+	return LeafHash(TransactionLeaf, Hash{}, tx)
+}
+
+// TransactionWithMetadata returns the leaf data of a transaction with its metadata.
+func TransactionWithMetadata(tx, meta []byte) ([]byte, error) {
+	txLength, err := serdes.EncodeVariableLength(len(tx))
+	if err != nil {
+		return nil, err
+	}
+	metaLength, err := serdes.EncodeVariableLength(len(meta))
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, len(txLength)+len(tx)+len(metaLength)+len(meta))
+	data = append(data, txLength...)
+	data = append(data, tx...)
+	data = append(data, metaLength...)
+	data = append(data, meta...)
+	return data, nil
+}
+
+type node interface {
+	hash() Hash
+}
+
+type leafNode struct {
+	key  Hash
+	data []byte
+	h    Hash
+}
+
+func (n *leafNode) hash() Hash {
+	return n.h
+}
+
+type innerNode struct {
+	children [BranchFactor]node
+	h        Hash
+	dirty    bool
+}
+
+func (n *innerNode) hash() Hash {
+	if n.dirty {
+		n.h = innerHash(n.childHashes())
+		n.dirty = false
+	}
+	return n.h
+}
+
+func (n *innerNode) childHashes() *[BranchFactor]Hash {
+	var hashes [BranchFactor]Hash
+	for i, c := range n.children {
+		if c != nil {
+			hashes[i] = c.hash()
+		}
+	}
+	return &hashes
+}
+
+// SHAMap is an in-memory SHAMap. It holds leaves of a single LeafType, keyed by 32 byte keys,
+// in a radix tree with 16 branches per inner node. The zero value is not usable; create maps
+// with New.
+type SHAMap struct {
+	leafType LeafType
+	root     *innerNode
+	size     int
+}
+
+// New returns an empty map holding leaves of the given type.
+func New(leafType LeafType) *SHAMap {
+	return &SHAMap{leafType: leafType, root: &innerNode{}}
+}
+
+// LeafType returns the type of the leaves of the map.
+func (m *SHAMap) LeafType() LeafType {
+	return m.leafType
+}
+
+// Len returns the number of leaves in the map.
+func (m *SHAMap) Len() int {
+	return m.size
+}
+
+// Hash returns the root hash of the map. The root hash of an empty map is zero.
+func (m *SHAMap) Hash() Hash {
+	return m.root.hash()
+}
+
+// Put adds a leaf to the map, replacing the data of the leaf with the same key if there is one.
+func (m *SHAMap) Put(key Hash, data []byte) {
+	leaf := &leafNode{key: key, data: data, h: LeafHash(m.leafType, key, data)}
+
+	n := m.root
+	for depth := 0; ; depth++ {
+		n.dirty = true
+		i := key.nibble(depth)
+		switch child := n.children[i].(type) {
+		case nil:
+			n.children[i] = leaf
+			m.size++
+			return
+		case *leafNode:
+			if child.key == key {
+				n.children[i] = leaf
+				return
+			}
+			// Push the existing leaf down until the two keys take different branches.
+			inner := &innerNode{dirty: true}
+			n.children[i] = inner
+			inner.children[child.key.nibble(depth+1)] = child
+			n = inner
+		case *innerNode:
+			n = child
+		}
+	}
+}
+
+// PutTransaction adds a transaction with its metadata to a map of TransactionWithMetadataLeaf
+// leaves, keyed by its transaction ID, and returns the ID.
+func (m *SHAMap) PutTransaction(tx, meta []byte) (Hash, error) {
+	if m.leafType != TransactionWithMetadataLeaf {
+		return Hash{}, fmt.Errorf("%w: %s map", ErrLeafTypeMismatch, m.leafType)
+	}
+	data, err := TransactionWithMetadata(tx, meta)
+	if err != nil {
+		return Hash{}, err
+	}
+	id := TransactionID(tx)
+	m.Put(id, data)
+	return id, nil
+}
+
+// Get returns the data of the leaf with the given key.
+func (m *SHAMap) Get(key Hash) ([]byte, bool) {
+	leaf, _ := m.find(key)
+	if leaf == nil {
+		return nil, false
+	}
+	return leaf.data, true
+}
+
+// find returns the leaf with the given key and the inner nodes on the path to it.
+func (m *SHAMap) find(key Hash) (*leafNode, []*innerNode) {
+	var path []*innerNode
+	n := m.root
+	for depth := 0; depth < maxDepth; depth++ {
+		path = append(path, n)
+		switch child := n.children[key.nibble(depth)].(type) {
+		case *leafNode:
+			if child.key != key {
+				return nil, path
+			}
+			return child, path
+		case *innerNode:
+			n = child
+		default:
+			return nil, path
+		}
+	}
+	return nil, path
+}
+
+// Delete removes the leaf with the given key from the map and reports whether it was present.
+// Inner nodes left with a single leaf are collapsed, so that the map has the same shape, and the
+// same root hash, as if the leaf had never been added.
+func (m *SHAMap) Delete(key Hash) bool {
+	leaf, path := m.find(key)
+	if leaf == nil {
+		return false
+	}
+
+	depth := len(path) - 1
+	path[depth].children[key.nibble(depth)] = nil
+	for ; depth >= 0; depth-- {
+		n := path[depth]
+		n.dirty = true
+		if depth == 0 {
+			break
+		}
+		// Replace an inner node holding a single leaf with the leaf itself.
+		if only := n.onlyLeaf(); only != nil {
+			path[depth-1].children[key.nibble(depth-1)] = only
+		}
+	}
+	m.size--
+	return true
+}
+
+// onlyLeaf returns the only child of the node if it has a single child and that child is a leaf.
+func (n *innerNode) onlyLeaf() *leafNode {
+	var only node
+	for _, c := range n.children {
+		if c == nil {
+			continue
+		}
+		if only != nil {
+			return nil
+		}
+		only = c
+	}
+	leaf, _ := only.(*leafNode)
+	return leaf
+}
@@ -0,0 +1,181 @@
+package shamap
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/require"
+)
+
+type ledgerFixture struct {
+	AccountHash     string           `json:"account_hash"`
+	TransactionHash string           `json:"transaction_hash"`
+	AccountState    []map[string]any `json:"accountState"`
+	Transactions    []map[string]any `json:"transactions"`
+}
+
+func loadLedgerFixture(t testing.TB) ledgerFixture {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/ledger-full-38129.json")
+	require.NoError(t, err)
+
+	var fixture ledgerFixture
+	require.NoError(t, json.Unmarshal(data, &fixture))
+	return fixture
+}
+
+func encodeBlob(t testing.TB, obj map[string]any) []byte {
+	t.Helper()
+
+	blob, err := binarycodec.Encode(obj)
+	require.NoError(t, err)
+	b, err := hex.DecodeString(blob)
+	require.NoError(t, err)
+	return b
+}
+
+func stateMap(t testing.TB, fixture ledgerFixture) *SHAMap {
+	t.Helper()
+
+	m := New(AccountStateLeaf)
+	for _, entry := range fixture.AccountState {
+		key, err := ParseHash(entry["index"].(string))
+		require.NoError(t, err)
+
+		obj := make(map[string]any, len(entry))
+		for k, v := range entry {
+			if k != "index" {
+				obj[k] = v
+			}
+		}
+		m.Put(key, encodeBlob(t, obj))
+	}
+	return m
+}
+
+func testKey(i int) Hash {
+	return sha512Half([]byte(fmt.Sprintf("key %d", i)))
+}
+
+func TestParseHash(t *testing.T) {
+	h, err := ParseHash("2c23d15b6b549123fb351e4b5cde81c564318eb845449cd43c3ea7953c4db452")
+	require.NoError(t, err)
+	require.Equal(t, "2C23D15B6B549123FB351E4B5CDE81C564318EB845449CD43C3EA7953C4DB452", h.String())
+
+	_, err = ParseHash("2C23")
+	require.ErrorIs(t, err, ErrInvalidHash)
+
+	_, err = ParseHash("not hex")
+	require.ErrorIs(t, err, ErrInvalidHash)
+}
+
+func TestSHAMap_AccountHash(t *testing.T) {
+	fixture := loadLedgerFixture(t)
+
+	m := stateMap(t, fixture)
+	require.Equal(t, len(fixture.AccountState), m.Len())
+	require.Equal(t, fixture.AccountHash, m.Hash().String())
+}
+
+func TestSHAMap_TransactionHash(t *testing.T) {
+	fixture := loadLedgerFixture(t)
+
+	m := New(TransactionWithMetadataLeaf)
+	for _, entry := range fixture.Transactions {
+		tx := make(map[string]any, len(entry))
+		for k, v := range entry {
+			if k != "hash" && k != "metaData" {
+				tx[k] = v
+			}
+		}
+		id, err := m.PutTransaction(encodeBlob(t, tx), encodeBlob(t, entry["metaData"].(map[string]any)))
+		require.NoError(t, err)
+		require.Equal(t, entry["hash"], id.String())
+	}
+	require.Equal(t, fixture.TransactionHash, m.Hash().String())
+
+	_, err := New(AccountStateLeaf).PutTransaction(nil, nil)
+	require.ErrorIs(t, err, ErrLeafTypeMismatch)
+}
+
+func TestSHAMap_Empty(t *testing.T) {
+	m := New(AccountStateLeaf)
+	require.True(t, m.Hash().IsZero())
+	require.Equal(t, 0, m.Len())
+
+	_, ok := m.Get(testKey(0))
+	require.False(t, ok)
+	require.False(t, m.Delete(testKey(0)))
+}
+
+func TestSHAMap_PutGetDelete(t *testing.T) {
+	const n = 300
+
+	m := New(AccountStateLeaf)
+	hashes := make([]Hash, n+1)
+	hashes[0] = m.Hash()
+	for i := 0; i < n; i++ {
+		m.Put(testKey(i), []byte{byte(i)})
+		hashes[i+1] = m.Hash()
+	}
+	require.Equal(t, n, m.Len())
+
+	for i := 0; i < n; i++ {
+		data, ok := m.Get(testKey(i))
+		require.True(t, ok)
+		require.Equal(t, []byte{byte(i)}, data)
+	}
+
+	t.Run("replacing a leaf changes the root hash", func(t *testing.T) {
+		m.Put(testKey(7), []byte("replaced"))
+		require.Equal(t, n, m.Len())
+		require.NotEqual(t, hashes[n], m.Hash())
+
+		m.Put(testKey(7), []byte{7})
+		require.Equal(t, hashes[n], m.Hash())
+	})
+
+	t.Run("insertion order does not matter", func(t *testing.T) {
+		reversed := New(AccountStateLeaf)
+		for i := n - 1; i >= 0; i-- {
+			reversed.Put(testKey(i), []byte{byte(i)})
+		}
+		require.Equal(t, hashes[n], reversed.Hash())
+	})
+
+	t.Run("deleting restores previous root hashes", func(t *testing.T) {
+		for i := n - 1; i >= 0; i-- {
+			require.True(t, m.Delete(testKey(i)))
+			require.Equal(t, hashes[i], m.Hash(), "after deleting key %d", i)
+			require.False(t, m.Delete(testKey(i)))
+		}
+		require.Equal(t, 0, m.Len())
+	})
+}
+
+func TestSHAMap_DeleteFromLedger(t *testing.T) {
+	fixture := loadLedgerFixture(t)
+
+	full := stateMap(t, fixture)
+	last := fixture.AccountState[len(fixture.AccountState)-1]
+	fixture.AccountState = fixture.AccountState[:len(fixture.AccountState)-1]
+	expected := stateMap(t, fixture).Hash()
+
+	key, err := ParseHash(last["index"].(string))
+	require.NoError(t, err)
+	require.True(t, full.Delete(key))
+	require.Equal(t, expected, full.Hash())
+}
+
+func BenchmarkSHAMap_AccountHash(b *testing.B) {
+	fixture := loadLedgerFixture(b)
+	b.ReportAllocs()
+	for b.Loop() {
+		stateMap(b, fixture).Hash()
+	}
+}
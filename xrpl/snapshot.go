@@ -0,0 +1,77 @@
+package xrpl
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// NetworkSnapshot holds the network and account state needed to autofill
+// transactions without a connection to the network.
+// It is exported by an online client with GetNetworkSnapshot, saved with WriteFile
+// and loaded on the offline signer with ReadNetworkSnapshot.
+type NetworkSnapshot struct {
+	// NetworkID is the network ID of the server, 0 for networks that do not require it.
+	NetworkID uint32 `json:"network_id,omitempty"`
+	// ServerVersion is the build version of the server.
+	ServerVersion string `json:"server_version"`
+	// LedgerIndex is the most recently validated ledger index.
+	LedgerIndex uint32 `json:"ledger_index"`
+	// BaseFeeXRP is the base fee in XRP of the validated ledger.
+	BaseFeeXRP float32 `json:"base_fee_xrp"`
+	// LoadFactor is the load factor applied to the base fee.
+	LoadFactor uint `json:"load_factor"`
+	// ReserveBase is the account reserve in drops.
+	ReserveBase uint64 `json:"reserve_base"`
+	// ReserveInc is the owner reserve in drops.
+	ReserveInc uint64 `json:"reserve_inc"`
+	// FeeCushion is the multiplier applied to the network fee. Defaults to common.DefaultFeeCushion.
+	FeeCushion float32 `json:"fee_cushion,omitempty"`
+	// MaxFeeXRP is the maximum fee in XRP. Defaults to common.DefaultMaxFeeXRP.
+	MaxFeeXRP float32 `json:"max_fee_xrp,omitempty"`
+	// Accounts holds the state of the accounts transactions are prepared for.
+	Accounts map[types.Address]*AccountSnapshot `json:"accounts"`
+}
+
+// AccountSnapshot holds the state of an account needed to autofill its transactions offline.
+type AccountSnapshot struct {
+	// Sequence is the next sequence number of the account.
+	Sequence uint32 `json:"sequence"`
+	// Tickets lists the unused tickets of the account, in ascending order.
+	Tickets []uint32 `json:"tickets,omitempty"`
+	// SignerListSize is the number of entries of the account signer list, 0 if it has none.
+	SignerListSize uint32 `json:"signer_list_size,omitempty"`
+}
+
+// ReadNetworkSnapshot reads a network snapshot from a JSON file written by WriteFile.
+func ReadNetworkSnapshot(path string) (*NetworkSnapshot, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot NetworkSnapshot
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// WriteFile writes the network snapshot to a JSON file that can be carried to an offline signer.
+func (s *NetworkSnapshot) WriteFile(path string) error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o600)
+}
+
+// account returns the snapshot of the given account.
+func (s *NetworkSnapshot) account(address string) (*AccountSnapshot, error) {
+	acc, ok := s.Accounts[types.Address(address)]
+	if !ok || acc == nil {
+		return nil, ErrAccountNotInSnapshot{Account: address}
+	}
+	return acc, nil
+}
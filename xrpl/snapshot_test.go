@@ -0,0 +1,50 @@
+package xrpl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkSnapshot_WriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	snapshot := testNetworkSnapshot()
+	snapshot.NetworkID = 21338
+
+	require.NoError(t, snapshot.WriteFile(path))
+
+	read, err := ReadNetworkSnapshot(path)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, read)
+}
+
+func TestReadNetworkSnapshot(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		create  bool
+	}{
+		{
+			name: "fail - missing file",
+		},
+		{
+			name:    "fail - invalid JSON",
+			content: "{",
+			create:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "snapshot.json")
+			if tc.create {
+				require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+			}
+
+			_, err := ReadNetworkSnapshot(path)
+			require.Error(t, err)
+		})
+	}
+}
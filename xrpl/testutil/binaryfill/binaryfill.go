@@ -0,0 +1,123 @@
+// Package binaryfill fills transactions and ledger objects with values the binary codec accepts,
+// to test their binary encoding field by field.
+package binaryfill
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+const (
+	// Address is the address Fill writes to AccountID fields.
+	Address = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	// Currency is the currency code Fill writes to currency fields.
+	Currency = "USD"
+)
+
+// Fill sets every exported field of the struct v points to, recursively, to a value the binary
+// codec accepts for the field of the same name, so that encoding v exercises all of its fields.
+// Slices get a single element. fill is called first for every field, and reports whether it set
+// the field itself; it may be nil.
+//
+// Fields the binary codec does not know, and interface fields other than currency amounts and
+// any, are left unset unless fill sets them.
+func Fill(v any, fill func(name string, field reflect.Value) bool) {
+	fillValue("", reflect.ValueOf(v).Elem(), fill)
+}
+
+func fillValue(name string, v reflect.Value, fill func(string, reflect.Value) bool) {
+	if fill != nil && fill(name, v) {
+		return
+	}
+
+	typ, _ := definitions.Get().GetTypeNameByFieldName(name)
+	switch v.Kind() {
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillValue(name, v.Elem(), fill)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeFor[types.IssuedCurrencyAmount]() {
+			v.Set(reflect.ValueOf(testAmount(typ)))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() || field.Name == "TransactionType" || field.Name == "LedgerEntryType" {
+				continue
+			}
+			fillValue(field.Name, v.Field(i), fill)
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		if typ == "Vector256" {
+			v.Index(0).SetString(strings.Repeat("AB", 32))
+			return
+		}
+		fillValue(name, v.Index(0), fill)
+	case reflect.Interface:
+		switch {
+		case v.Type() == reflect.TypeFor[types.CurrencyAmount]():
+			v.Set(reflect.ValueOf(testAmount(typ)))
+		case v.NumMethod() == 0:
+			v.Set(reflect.ValueOf(uint32(3)))
+		}
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(3)
+	case reflect.Int, reflect.Int32:
+		v.SetInt(3)
+	case reflect.String:
+		v.SetString(testString(name, typ))
+	}
+}
+
+// testAmount returns the issued currency amount Fill writes to a currency
+// amount field of the given serialized type, without value for Issue fields.
+func testAmount(typ string) types.IssuedCurrencyAmount {
+	amount := types.IssuedCurrencyAmount{Currency: Currency, Issuer: Address}
+	if typ != "Issue" {
+		amount.Value = "1.5"
+	}
+	return amount
+}
+
+// testString returns the value Fill writes to a string field of the given
+// name and serialized type.
+func testString(name, typ string) string {
+	switch {
+	case name == "Currency":
+		return Currency
+	case name == "PermissionValue":
+		return "Payment"
+	}
+
+	switch typ {
+	case "AccountID":
+		return Address
+	case "Amount":
+		return "1000"
+	case "Blob":
+		return "DEADBEEF"
+	case "Currency":
+		return Currency
+	case "Hash128":
+		return strings.Repeat("AB", 16)
+	case "Hash160":
+		return strings.Repeat("AB", 20)
+	case "Hash192":
+		return strings.Repeat("AB", 24)
+	case "Hash256":
+		return strings.Repeat("AB", 32)
+	case "Number":
+		return "1.5"
+	case "UInt32":
+		return "3"
+	case "UInt64":
+		return "000000000000002A"
+	}
+	return ""
+}
@@ -3,6 +3,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -61,6 +62,41 @@ func (s *AccountDelete) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// EncodeBinary writes the AccountDelete to e. It writes the same fields as Flatten.
+func (s *AccountDelete) EncodeBinary(e *binarycodec.Encoder) {
+	s.BaseTx.encodeBinary(e)
+	e.TransactionType(s.TxType().String())
+
+	if len(s.CredentialIDs) > 0 {
+		e.Vector256("CredentialIDs", s.CredentialIDs)
+	}
+	if s.Destination != "" {
+		e.AccountID("Destination", s.Destination.String())
+	}
+	if s.DestinationTag != 0 {
+		e.UInt32("DestinationTag", s.DestinationTag)
+	}
+}
+
+// DecodeBinary reads the AccountDelete from d, skipping fields an AccountDelete does not have.
+func (s *AccountDelete) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "CredentialIDs":
+			s.CredentialIDs = d.Vector256()
+		case "Destination":
+			s.Destination = types.Address(d.AccountID())
+		case "DestinationTag":
+			s.DestinationTag = d.UInt32()
+		default:
+			if !s.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate implements the Validate method for the AccountDelete struct.
 func (s *AccountDelete) Validate() (bool, error) {
 	_, err := s.BaseTx.Validate()
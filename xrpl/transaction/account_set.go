@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -168,6 +169,84 @@ func (s *AccountSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the AccountSet to e. It writes the same fields as Flatten.
+func (s *AccountSet) EncodeBinary(e *binarycodec.Encoder) {
+	s.BaseTx.encodeBinary(e)
+	e.TransactionType(AccountSetTx.String())
+
+	if s.ClearFlag != 0 {
+		e.UInt32("ClearFlag", s.ClearFlag)
+	}
+	if s.Domain != nil {
+		e.Blob("Domain", *s.Domain)
+	}
+	if s.EmailHash != nil {
+		e.Hash128("EmailHash", s.EmailHash.String())
+	}
+	if s.MessageKey != nil {
+		e.Blob("MessageKey", *s.MessageKey)
+	}
+	if s.NFTokenMinter != nil {
+		e.AccountID("NFTokenMinter", *s.NFTokenMinter)
+	}
+	if s.SetFlag != 0 {
+		e.UInt32("SetFlag", s.SetFlag)
+	}
+	if s.TransferRate != nil {
+		e.UInt32("TransferRate", *s.TransferRate)
+	}
+	if s.TickSize != nil {
+		e.UInt8("TickSize", *s.TickSize)
+	}
+	if s.WalletLocator != nil {
+		e.Hash256("WalletLocator", s.WalletLocator.String())
+	}
+	if s.WalletSize != nil {
+		e.UInt32("WalletSize", *s.WalletSize)
+	}
+}
+
+// DecodeBinary reads the AccountSet from d, skipping fields an AccountSet does not have.
+func (s *AccountSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "ClearFlag":
+			s.ClearFlag = d.UInt32()
+		case "Domain":
+			domain := d.Blob()
+			s.Domain = &domain
+		case "EmailHash":
+			emailHash := types.Hash128(d.Hash())
+			s.EmailHash = &emailHash
+		case "MessageKey":
+			messageKey := d.Blob()
+			s.MessageKey = &messageKey
+		case "NFTokenMinter":
+			minter := d.AccountID()
+			s.NFTokenMinter = &minter
+		case "SetFlag":
+			s.SetFlag = d.UInt32()
+		case "TransferRate":
+			transferRate := d.UInt32()
+			s.TransferRate = &transferRate
+		case "TickSize":
+			tickSize := d.UInt8()
+			s.TickSize = &tickSize
+		case "WalletLocator":
+			walletLocator := types.Hash256(d.Hash())
+			s.WalletLocator = &walletLocator
+		case "WalletSize":
+			walletSize := d.UInt32()
+			s.WalletSize = &walletSize
+		default:
+			if !s.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // -----------------------------------
 // -------------- FLAGS --------------
 // -----------------------------------
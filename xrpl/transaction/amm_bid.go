@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -97,6 +100,88 @@ func (a *AMMBid) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the AMMBid to e. It writes the same fields as Flatten.
+func (a *AMMBid) EncodeBinary(e *binarycodec.Encoder) {
+	a.BaseTx.encodeBinary(e)
+	e.TransactionType(AMMBidTx.String())
+
+	encodeBinaryAsset(e, "Asset", a.Asset)
+	encodeBinaryAsset(e, "Asset2", a.Asset2)
+	if a.BidMin != nil {
+		encodeBinaryAmount(e, "BidMin", a.BidMin)
+	}
+	if a.BidMax != nil {
+		encodeBinaryAmount(e, "BidMax", a.BidMax)
+	}
+	if len(a.AuthAccounts) > 0 {
+		e.BeginArray("AuthAccounts")
+		for _, authAccount := range a.AuthAccounts {
+			e.BeginObject("AuthAccount")
+			e.AccountID("Account", authAccount.AuthAccount.Account.String())
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+}
+
+// DecodeBinary reads the AMMBid from d, skipping fields an AMMBid does not have.
+func (a *AMMBid) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Asset":
+			a.Asset = decodeBinaryAsset(d)
+		case "Asset2":
+			a.Asset2 = decodeBinaryAsset(d)
+		case "BidMin":
+			a.BidMin = decodeBinaryAmount(d)
+		case "BidMax":
+			a.BidMax = decodeBinaryAmount(d)
+		case "AuthAccounts":
+			a.AuthAccounts = nil
+			for d.Next() {
+				var authAccount ledger.AuthAccount
+				for d.Next() {
+					if d.Field() == "Account" {
+						authAccount.Account = types.Address(d.AccountID())
+					} else {
+						d.Skip()
+					}
+				}
+				a.AuthAccounts = append(a.AuthAccounts, ledger.AuthAccounts{AuthAccount: authAccount})
+			}
+		default:
+			if !a.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for AMMBid.
+func (a *AMMBid) UnmarshalJSON(data []byte) error {
+	type Alias AMMBid
+	aux := &struct {
+		BidMax json.RawMessage
+		BidMin json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if a.BidMax, err = types.UnmarshalCurrencyAmount(aux.BidMax); err != nil {
+		return err
+	}
+	if a.BidMin, err = types.UnmarshalCurrencyAmount(aux.BidMin); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate implements the Validate method for the AMMBid struct.
 func (a *AMMBid) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -85,6 +88,68 @@ func (a *AMMClawback) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the AMMClawback to e. It writes the same fields as Flatten.
+func (a *AMMClawback) EncodeBinary(e *binarycodec.Encoder) {
+	a.BaseTx.encodeBinary(e)
+	e.TransactionType(a.TxType().String())
+
+	if a.Holder != "" {
+		e.AccountID("Holder", a.Holder)
+	}
+	if a.Asset != (types.IssuedCurrency{}) {
+		e.Issue("Asset", binarycodec.Issue{Currency: a.Asset.Currency, Issuer: a.Asset.Issuer.String()})
+	}
+	if a.Asset2 != nil {
+		encodeBinaryAmountIssue(e, "Asset2", a.Asset2)
+	}
+	if a.Amount != (types.IssuedCurrencyAmount{}) {
+		encodeBinaryAmount(e, "Amount", a.Amount)
+	}
+}
+
+// DecodeBinary reads the AMMClawback from d, skipping fields an AMMClawback does not have.
+func (a *AMMClawback) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Holder":
+			a.Holder = d.AccountID()
+		case "Asset":
+			issue := d.Issue()
+			a.Asset = types.IssuedCurrency{Currency: issue.Currency, Issuer: types.Address(issue.Issuer)}
+		case "Asset2":
+			a.Asset2 = decodeBinaryAmountIssue(d)
+		case "Amount":
+			amount := d.Amount()
+			a.Amount = types.IssuedCurrencyAmount{Issuer: types.Address(amount.Issuer), Currency: amount.Currency, Value: amount.Value}
+		default:
+			if !a.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for AMMClawback.
+func (a *AMMClawback) UnmarshalJSON(data []byte) error {
+	type Alias AMMClawback
+	aux := &struct {
+		Asset2 json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if a.Asset2, err = types.UnmarshalCurrencyAmount(aux.Asset2); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates the AMMClawback transaction.
 func (a *AMMClawback) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
@@ -1,9 +1,24 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// AMMCreateMetadata represents the resulting metadata of a succeeded AMMCreate transaction.
+// It extends from TxObjMeta.
+type AMMCreateMetadata struct {
+	TxObjMeta
+
+	// AMMID is the ledger index of the AMM entry created by the transaction.
+	AMMID *types.Hash256 `json:"-"`
+
+	// AMMAccount is the special account that holds the assets of the AMM.
+	AMMAccount *types.Address `json:"-"`
+}
+
 // AMMCreate creates a new Automated Market Maker (AMM) instance for trading a pair of assets (fungible tokens or XRP).
 //
 // Creates both an AMM entry and a special AccountRoot entry to represent the AMM.
@@ -65,6 +80,63 @@ func (a *AMMCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the AMMCreate to e. It writes the same fields as Flatten.
+func (a *AMMCreate) EncodeBinary(e *binarycodec.Encoder) {
+	a.BaseTx.encodeBinary(e)
+	e.TransactionType(AMMCreateTx.String())
+
+	if a.Amount != nil {
+		encodeBinaryAmount(e, "Amount", a.Amount)
+	}
+	if a.Amount2 != nil {
+		encodeBinaryAmount(e, "Amount2", a.Amount2)
+	}
+	e.UInt16("TradingFee", a.TradingFee)
+}
+
+// DecodeBinary reads the AMMCreate from d, skipping fields an AMMCreate does not have.
+func (a *AMMCreate) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			a.Amount = decodeBinaryAmount(d)
+		case "Amount2":
+			a.Amount2 = decodeBinaryAmount(d)
+		case "TradingFee":
+			a.TradingFee = d.UInt16()
+		default:
+			if !a.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for AMMCreate.
+func (a *AMMCreate) UnmarshalJSON(data []byte) error {
+	type Alias AMMCreate
+	aux := &struct {
+		Amount  json.RawMessage
+		Amount2 json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if a.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	if a.Amount2, err = types.UnmarshalCurrencyAmount(aux.Amount2); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates the AMMCreate struct and ensures all fields are correct.
 func (a *AMMCreate) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 )
 
@@ -57,6 +58,32 @@ func (a *AMMDelete) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the AMMDelete to e. It writes the same fields as Flatten.
+func (a *AMMDelete) EncodeBinary(e *binarycodec.Encoder) {
+	a.BaseTx.encodeBinary(e)
+	e.TransactionType(AMMDeleteTx.String())
+
+	encodeBinaryAsset(e, "Asset", a.Asset)
+	encodeBinaryAsset(e, "Asset2", a.Asset2)
+}
+
+// DecodeBinary reads the AMMDelete from d, skipping fields an AMMDelete does not have.
+func (a *AMMDelete) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Asset":
+			a.Asset = decodeBinaryAsset(d)
+		case "Asset2":
+			a.Asset2 = decodeBinaryAsset(d)
+		default:
+			if !a.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks the AMMDelete transaction fields for correctness.
 func (a *AMMDelete) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
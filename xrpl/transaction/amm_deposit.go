@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -131,6 +134,89 @@ func (a *AMMDeposit) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the AMMDeposit to e. It writes the same fields as Flatten.
+func (a *AMMDeposit) EncodeBinary(e *binarycodec.Encoder) {
+	a.BaseTx.encodeBinary(e)
+	e.TransactionType(AMMDepositTx.String())
+
+	encodeBinaryAsset(e, "Asset", a.Asset)
+	encodeBinaryAsset(e, "Asset2", a.Asset2)
+	if a.Amount != nil {
+		encodeBinaryAmount(e, "Amount", a.Amount)
+	}
+	if a.Amount2 != nil {
+		encodeBinaryAmount(e, "Amount2", a.Amount2)
+	}
+	if a.EPrice != nil {
+		encodeBinaryAmount(e, "EPrice", a.EPrice)
+	}
+	if a.LPTokenOut != nil {
+		encodeBinaryAmount(e, "LPTokenOut", a.LPTokenOut)
+	}
+	if a.TradingFee != 0 {
+		e.UInt16("TradingFee", a.TradingFee)
+	}
+}
+
+// DecodeBinary reads the AMMDeposit from d, skipping fields an AMMDeposit does not have.
+func (a *AMMDeposit) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Asset":
+			a.Asset = decodeBinaryAsset(d)
+		case "Asset2":
+			a.Asset2 = decodeBinaryAsset(d)
+		case "Amount":
+			a.Amount = decodeBinaryAmount(d)
+		case "Amount2":
+			a.Amount2 = decodeBinaryAmount(d)
+		case "EPrice":
+			a.EPrice = decodeBinaryAmount(d)
+		case "LPTokenOut":
+			a.LPTokenOut = decodeBinaryAmount(d)
+		case "TradingFee":
+			a.TradingFee = d.UInt16()
+		default:
+			if !a.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for AMMDeposit.
+func (a *AMMDeposit) UnmarshalJSON(data []byte) error {
+	type Alias AMMDeposit
+	aux := &struct {
+		Amount     json.RawMessage
+		Amount2    json.RawMessage
+		EPrice     json.RawMessage
+		LPTokenOut json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if a.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	if a.Amount2, err = types.UnmarshalCurrencyAmount(aux.Amount2); err != nil {
+		return err
+	}
+	if a.EPrice, err = types.UnmarshalCurrencyAmount(aux.EPrice); err != nil {
+		return err
+	}
+	if a.LPTokenOut, err = types.UnmarshalCurrencyAmount(aux.LPTokenOut); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate implements the Validate method for the AMMDeposit struct.
 func (a *AMMDeposit) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
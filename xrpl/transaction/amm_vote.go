@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 )
 
@@ -57,6 +58,35 @@ func (a *AMMVote) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the AMMVote to e. It writes the same fields as Flatten.
+func (a *AMMVote) EncodeBinary(e *binarycodec.Encoder) {
+	a.BaseTx.encodeBinary(e)
+	e.TransactionType(AMMVoteTx.String())
+
+	encodeBinaryAsset(e, "Asset", a.Asset)
+	encodeBinaryAsset(e, "Asset2", a.Asset2)
+	e.UInt16("TradingFee", a.TradingFee)
+}
+
+// DecodeBinary reads the AMMVote from d, skipping fields an AMMVote does not have.
+func (a *AMMVote) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Asset":
+			a.Asset = decodeBinaryAsset(d)
+		case "Asset2":
+			a.Asset2 = decodeBinaryAsset(d)
+		case "TradingFee":
+			a.TradingFee = d.UInt16()
+		default:
+			if !a.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks the AMMVote transaction fields for correctness, returning false and an error if invalid.
 func (a *AMMVote) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -127,6 +130,81 @@ func (a *AMMWithdraw) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the AMMWithdraw to e. It writes the same fields as Flatten.
+func (a *AMMWithdraw) EncodeBinary(e *binarycodec.Encoder) {
+	a.BaseTx.encodeBinary(e)
+	e.TransactionType(AMMWithdrawTx.String())
+
+	encodeBinaryAsset(e, "Asset", a.Asset)
+	encodeBinaryAsset(e, "Asset2", a.Asset2)
+	if a.Amount != nil {
+		encodeBinaryAmount(e, "Amount", a.Amount)
+	}
+	if a.Amount2 != nil {
+		encodeBinaryAmount(e, "Amount2", a.Amount2)
+	}
+	if a.EPrice != nil {
+		encodeBinaryAmount(e, "EPrice", a.EPrice)
+	}
+	if !a.LPTokenIn.IsEmpty() {
+		encodeBinaryAmount(e, "LPTokenIn", a.LPTokenIn)
+	}
+}
+
+// DecodeBinary reads the AMMWithdraw from d, skipping fields an AMMWithdraw does not have.
+func (a *AMMWithdraw) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Asset":
+			a.Asset = decodeBinaryAsset(d)
+		case "Asset2":
+			a.Asset2 = decodeBinaryAsset(d)
+		case "Amount":
+			a.Amount = decodeBinaryAmount(d)
+		case "Amount2":
+			a.Amount2 = decodeBinaryAmount(d)
+		case "EPrice":
+			a.EPrice = decodeBinaryAmount(d)
+		case "LPTokenIn":
+			amount := d.Amount()
+			a.LPTokenIn = types.IssuedCurrencyAmount{Issuer: types.Address(amount.Issuer), Currency: amount.Currency, Value: amount.Value}
+		default:
+			if !a.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for AMMWithdraw.
+func (a *AMMWithdraw) UnmarshalJSON(data []byte) error {
+	type Alias AMMWithdraw
+	aux := &struct {
+		Amount  json.RawMessage
+		Amount2 json.RawMessage
+		EPrice  json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if a.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	if a.Amount2, err = types.UnmarshalCurrencyAmount(aux.Amount2); err != nil {
+		return err
+	}
+	if a.EPrice, err = types.UnmarshalCurrencyAmount(aux.EPrice); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates the AMMWithdraw struct and make sure all the fields are correct.
 func (a *AMMWithdraw) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
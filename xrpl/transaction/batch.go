@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -115,6 +118,120 @@ func (b *Batch) Flatten() FlatTransaction {
 	return flattenedTx
 }
 
+// EncodeBinary writes the Batch to e. It writes the same fields as Flatten.
+func (b *Batch) EncodeBinary(e *binarycodec.Encoder) {
+	b.BaseTx.encodeBinary(e)
+	e.TransactionType(b.TxType().String())
+
+	e.BeginArray("RawTransactions")
+	for _, rtw := range b.RawTransactions {
+		e.Value("RawTransaction", rtw.RawTransaction)
+	}
+	e.EndArray()
+	if len(b.BatchSigners) > 0 {
+		e.BeginArray("BatchSigners")
+		for _, bs := range b.BatchSigners {
+			e.BeginObject("BatchSigner")
+			e.AccountID("Account", bs.BatchSigner.Account.String())
+			if bs.BatchSigner.SigningPubKey != "" {
+				e.Blob("SigningPubKey", bs.BatchSigner.SigningPubKey)
+			}
+			if bs.BatchSigner.TxnSignature != "" {
+				e.Blob("TxnSignature", bs.BatchSigner.TxnSignature)
+			}
+			if len(bs.BatchSigner.Signers) > 0 {
+				encodeBinarySigners(e, bs.BatchSigner.Signers)
+			}
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+}
+
+// DecodeBinary reads the Batch from d, skipping fields a Batch does not have. The inner
+// transactions are read in their map form, as RawTransaction holds them.
+func (b *Batch) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "RawTransactions":
+			b.RawTransactions = []types.RawTransaction{}
+			for d.Next() {
+				raw, _ := d.Value().(map[string]any)
+				b.RawTransactions = append(b.RawTransactions, types.RawTransaction{RawTransaction: raw})
+			}
+		case "BatchSigners":
+			b.BatchSigners = nil
+			for d.Next() {
+				var signer types.BatchSignerData
+				for d.Next() {
+					switch d.Field() {
+					case "Account":
+						signer.Account = types.Address(d.AccountID())
+					case "SigningPubKey":
+						signer.SigningPubKey = d.Blob()
+					case "TxnSignature":
+						signer.TxnSignature = d.Blob()
+					case "Signers":
+						signer.Signers = decodeBinarySigners(d)
+					default:
+						d.Skip()
+					}
+				}
+				b.BatchSigners = append(b.BatchSigners, types.BatchSigner{BatchSigner: signer})
+			}
+		default:
+			if !b.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for Batch.
+// Inner transactions are normalized through the binary codec, so their fields
+// hold the same types as a decoded transaction blob.
+func (b *Batch) UnmarshalJSON(data []byte) error {
+	type Alias Batch
+	aux := &struct {
+		*Alias
+	}{
+		Alias: (*Alias)(b),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	for i, rtw := range b.RawTransactions {
+		if rtw.RawTransaction == nil {
+			continue
+		}
+		encoded, err := binarycodec.Encode(rtw.RawTransaction)
+		if err != nil {
+			return err
+		}
+		decoded, err := binarycodec.Decode(encoded)
+		if err != nil {
+			return err
+		}
+		b.RawTransactions[i].RawTransaction = decoded
+	}
+	return nil
+}
+
+// InnerTransactions returns the inner transactions of the batch as concrete transactions.
+func (b *Batch) InnerTransactions() ([]Tx, error) {
+	txs := make([]Tx, 0, len(b.RawTransactions))
+	for _, rtw := range b.RawTransactions {
+		tx, err := Parse(rtw.RawTransaction)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
 // Validate validates the Batch transaction.
 func (b *Batch) Validate() (bool, error) {
 	_, err := b.BaseTx.Validate()
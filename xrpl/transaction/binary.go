@@ -0,0 +1,482 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// BinaryEncoder is implemented by transactions that write themselves straight to a
+// binarycodec.Encoder, without going through Flatten and the map-based codec.
+type BinaryEncoder interface {
+	Tx
+	EncodeBinary(e *binarycodec.Encoder)
+}
+
+// BinaryDecoder is implemented by transactions that read themselves straight from a
+// binarycodec.Decoder.
+type BinaryDecoder interface {
+	Tx
+	DecodeBinary(d *binarycodec.Decoder) error
+}
+
+// EncodeBinary returns the canonical binary serialization of tx. It produces the same bytes as
+// encoding the flattened transaction with binarycodec.Encode. Transactions that do not implement
+// BinaryEncoder are written field by field from Flatten.
+func EncodeBinary(tx Tx) ([]byte, error) {
+	return EncodeBinaryWithCodec(tx, binarycodec.DefaultCodec())
+}
+
+// EncodeBinaryWithCodec returns the canonical binary serialization of tx like EncodeBinary,
+// using the given codec's definitions.
+func EncodeBinaryWithCodec(tx Tx, codec *binarycodec.Codec) ([]byte, error) {
+	e := codec.NewEncoder()
+	if err := writeBinary(e, tx); err != nil {
+		return nil, err
+	}
+	return e.Bytes()
+}
+
+// EncodeBinaryForSigning returns the binary serialization of tx used for single signing. It
+// produces the same bytes as encoding the flattened transaction with binarycodec.EncodeForSigning.
+func EncodeBinaryForSigning(tx Tx) ([]byte, error) {
+	return EncodeBinaryForSigningWithCodec(tx, binarycodec.DefaultCodec())
+}
+
+// EncodeBinaryForSigningWithCodec returns the binary serialization of tx used for single signing
+// like EncodeBinaryForSigning, using the given codec's definitions.
+func EncodeBinaryForSigningWithCodec(tx Tx, codec *binarycodec.Codec) ([]byte, error) {
+	e := codec.NewEncoder()
+	if err := writeBinary(e, tx); err != nil {
+		return nil, err
+	}
+	return e.SigningBytes()
+}
+
+// WithSignature returns a shallow copy of tx with SigningPubKey and TxnSignature replaced, leaving
+// tx unchanged. Empty values remove the fields. tx must be a pointer to a transaction struct that
+// embeds BaseTx, as all the transactions of this package are.
+func WithSignature(tx Tx, signingPubKey, txnSignature string) (Tx, error) {
+	v := reflect.ValueOf(tx)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %T", ErrSignatureUnsupported, tx)
+	}
+	signed := reflect.New(v.Elem().Type())
+	signed.Elem().Set(v.Elem())
+
+	field := signed.Elem().FieldByName("BaseTx")
+	if !field.IsValid() || field.Type() != reflect.TypeFor[BaseTx]() {
+		return nil, fmt.Errorf("%w: %T", ErrSignatureUnsupported, tx)
+	}
+	base := field.Addr().Interface().(*BaseTx)
+	base.SigningPubKey = signingPubKey
+	base.TxnSignature = txnSignature
+
+	signedTx, ok := signed.Interface().(Tx)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrSignatureUnsupported, tx)
+	}
+	return signedTx, nil
+}
+
+// DecodeBinary reads tx from its canonical binary serialization. Transactions that do not
+// implement BinaryDecoder are decoded with the map-based codec, as DecodeBlob does.
+func DecodeBinary(data []byte, tx Tx) error {
+	if bd, ok := tx.(BinaryDecoder); ok {
+		return bd.DecodeBinary(binarycodec.NewDecoder(data))
+	}
+
+	flat, err := binarycodec.Decode(hex.EncodeToString(data))
+	if err != nil {
+		return err
+	}
+	return parseInto(flat, tx)
+}
+
+// writeBinary writes tx to e, through its EncodeBinary method if it implements BinaryEncoder
+// and from Flatten otherwise.
+func writeBinary(e *binarycodec.Encoder, tx Tx) error {
+	if be, ok := tx.(BinaryEncoder); ok {
+		be.EncodeBinary(e)
+		return nil
+	}
+
+	f, ok := tx.(interface{ Flatten() FlatTransaction })
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrBinaryEncodingUnsupported, tx)
+	}
+	for field, value := range f.Flatten() {
+		e.Value(field, value)
+	}
+	return nil
+}
+
+// encodeBinary writes the BaseTx fields other than TransactionType to e, under the same
+// conditions as Flatten.
+func (tx *BaseTx) encodeBinary(e *binarycodec.Encoder) {
+	if tx.Account != "" {
+		e.AccountID("Account", tx.Account.String())
+	}
+	if tx.Fee != 0 {
+		e.XRPAmount("Fee", tx.Fee.Uint64())
+	}
+	// The XRPL protocol requires Sequence to be exactly 0 when using a ticket.
+	if tx.Sequence != 0 || tx.TicketSequence != 0 {
+		e.UInt32("Sequence", tx.Sequence)
+	}
+	if tx.AccountTxnID != "" {
+		e.Hash256("AccountTxnID", tx.AccountTxnID.String())
+	}
+	if tx.Flags != 0 || tx.explicitFlags {
+		e.UInt32("Flags", tx.Flags)
+	}
+	if tx.LastLedgerSequence != 0 {
+		e.UInt32("LastLedgerSequence", tx.LastLedgerSequence)
+	}
+	if len(tx.Memos) > 0 {
+		e.BeginArray("Memos")
+		for _, memo := range tx.Memos {
+			if memo.Memo == (types.Memo{}) {
+				continue
+			}
+			e.BeginObject("Memo")
+			if memo.Memo.MemoData != "" {
+				e.Blob("MemoData", memo.Memo.MemoData)
+			}
+			if memo.Memo.MemoFormat != "" {
+				e.Blob("MemoFormat", memo.Memo.MemoFormat)
+			}
+			if memo.Memo.MemoType != "" {
+				e.Blob("MemoType", memo.Memo.MemoType)
+			}
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+	if tx.NetworkID != 0 {
+		e.UInt32("NetworkID", tx.NetworkID)
+	}
+	if len(tx.Signers) > 0 {
+		encodeBinarySigners(e, tx.Signers)
+	}
+	if tx.SourceTag != 0 {
+		e.UInt32("SourceTag", tx.SourceTag)
+	}
+	if tx.SigningPubKey != "" {
+		e.Blob("SigningPubKey", tx.SigningPubKey)
+	}
+	if tx.TicketSequence != 0 {
+		e.UInt32("TicketSequence", tx.TicketSequence)
+	}
+	if tx.TxnSignature != "" {
+		e.Blob("TxnSignature", tx.TxnSignature)
+	}
+	if tx.Delegate != "" {
+		e.AccountID("Delegate", tx.Delegate.String())
+	}
+}
+
+// encodeBinaryPseudo writes the common fields of a pseudo-transaction to e, under the same
+// conditions as flattenPseudo.
+func (tx *BaseTx) encodeBinaryPseudo(e *binarycodec.Encoder) {
+	base := *tx
+	base.Fee, base.Sequence, base.TicketSequence, base.SigningPubKey = 0, 0, 0, ""
+	base.encodeBinary(e)
+
+	e.XRPAmount("Fee", tx.Fee.Uint64())
+	e.UInt32("Sequence", tx.Sequence)
+	e.Blob("SigningPubKey", tx.SigningPubKey)
+	if tx.TicketSequence != 0 {
+		e.UInt32("TicketSequence", tx.TicketSequence)
+	}
+}
+
+// decodeBinaryField reads the current field of d if it is a BaseTx field, and reports whether it was.
+func (tx *BaseTx) decodeBinaryField(d *binarycodec.Decoder) bool {
+	switch d.Field() {
+	case "TransactionType":
+		tx.TransactionType = TxType(d.TransactionType())
+	case "Account":
+		tx.Account = types.Address(d.AccountID())
+	case "Fee":
+		tx.Fee = types.XRPCurrencyAmount(d.Amount().Drops)
+	case "Sequence":
+		tx.Sequence = d.UInt32()
+	case "AccountTxnID":
+		tx.AccountTxnID = types.Hash256(d.Hash())
+	case "Flags":
+		tx.Flags = d.UInt32()
+		tx.keepFlags()
+	case "LastLedgerSequence":
+		tx.LastLedgerSequence = d.UInt32()
+	case "Memos":
+		tx.Memos = nil
+		for d.Next() {
+			var memo types.Memo
+			for d.Next() {
+				switch d.Field() {
+				case "MemoData":
+					memo.MemoData = d.Blob()
+				case "MemoFormat":
+					memo.MemoFormat = d.Blob()
+				case "MemoType":
+					memo.MemoType = d.Blob()
+				default:
+					d.Skip()
+				}
+			}
+			tx.Memos = append(tx.Memos, types.MemoWrapper{Memo: memo})
+		}
+	case "NetworkID":
+		tx.NetworkID = d.UInt32()
+	case "Signers":
+		tx.Signers = decodeBinarySigners(d)
+	case "SourceTag":
+		tx.SourceTag = d.UInt32()
+	case "SigningPubKey":
+		tx.SigningPubKey = d.Blob()
+	case "TicketSequence":
+		tx.TicketSequence = d.UInt32()
+	case "TxnSignature":
+		tx.TxnSignature = d.Blob()
+	case "Delegate":
+		tx.Delegate = types.Address(d.AccountID())
+	default:
+		return false
+	}
+	return true
+}
+
+// encodeBinaryAmount writes a currency amount to e.
+func encodeBinaryAmount(e *binarycodec.Encoder, field string, amount types.CurrencyAmount) {
+	switch a := amount.(type) {
+	case types.XRPCurrencyAmount:
+		e.XRPAmount(field, a.Uint64())
+	case types.IssuedCurrencyAmount:
+		e.IssuedAmount(field, a.Value, a.Currency, a.Issuer.String())
+	case types.MPTCurrencyAmount:
+		e.MPTAmount(field, a.Value, a.MPTIssuanceID)
+	default:
+		e.Value(field, amount.Flatten())
+	}
+}
+
+// decodeBinaryAmount reads the current Amount field of d as a currency amount.
+func decodeBinaryAmount(d *binarycodec.Decoder) types.CurrencyAmount {
+	a := d.Amount()
+	switch {
+	case a.XRP:
+		return types.XRPCurrencyAmount(a.Drops)
+	case a.MPTIssuanceID != "":
+		return types.MPTCurrencyAmount{MPTIssuanceID: a.MPTIssuanceID, Value: a.Value}
+	default:
+		return types.IssuedCurrencyAmount{Issuer: types.Address(a.Issuer), Currency: a.Currency, Value: a.Value}
+	}
+}
+
+// encodeBinaryAmountIssue writes an Issue field given as a currency amount without value, as
+// AMMClawback does for Asset2. Amounts that do not flatten into an Issue are written from
+// Flatten, so that they fail as they do with binarycodec.Encode.
+func encodeBinaryAmountIssue(e *binarycodec.Encoder, field string, amount types.CurrencyAmount) {
+	switch a := amount.(type) {
+	case types.IssuedCurrencyAmount:
+		if a.Value == "" {
+			e.Issue(field, binarycodec.Issue{Currency: a.Currency, Issuer: a.Issuer.String()})
+			return
+		}
+	case types.MPTCurrencyAmount:
+		if a.Value == "" {
+			e.Issue(field, binarycodec.Issue{MPTIssuanceID: a.MPTIssuanceID})
+			return
+		}
+	}
+	e.Value(field, amount.Flatten())
+}
+
+// decodeBinaryAmountIssue reads the current Issue field of d as a currency amount without value.
+func decodeBinaryAmountIssue(d *binarycodec.Decoder) types.CurrencyAmount {
+	issue := d.Issue()
+	if issue.MPTIssuanceID != "" {
+		return types.MPTCurrencyAmount{MPTIssuanceID: issue.MPTIssuanceID}
+	}
+	return types.IssuedCurrencyAmount{Currency: issue.Currency, Issuer: types.Address(issue.Issuer)}
+}
+
+// encodeBinaryAsset writes an Issue field from a ledger asset.
+func encodeBinaryAsset(e *binarycodec.Encoder, field string, asset ledger.Asset) {
+	e.Issue(field, binarycodec.Issue{
+		Currency:      asset.Currency,
+		Issuer:        asset.Issuer.String(),
+		MPTIssuanceID: asset.MPTIssuanceID,
+	})
+}
+
+// decodeBinaryAsset reads the current Issue field of d as a ledger asset.
+func decodeBinaryAsset(d *binarycodec.Decoder) ledger.Asset {
+	issue := d.Issue()
+	return ledger.Asset{
+		Currency:      issue.Currency,
+		Issuer:        types.Address(issue.Issuer),
+		MPTIssuanceID: issue.MPTIssuanceID,
+	}
+}
+
+// encodeBinaryBridge writes the XChainBridge field.
+func encodeBinaryBridge(e *binarycodec.Encoder, bridge types.XChainBridge) {
+	e.XChainBridge("XChainBridge", binarycodec.XChainBridge{
+		LockingChainDoor:  bridge.LockingChainDoor.String(),
+		LockingChainIssue: binarycodec.Issue{Currency: bridge.LockingChainIssue.Currency, Issuer: bridge.LockingChainIssue.Issuer.String()},
+		IssuingChainDoor:  bridge.IssuingChainDoor.String(),
+		IssuingChainIssue: binarycodec.Issue{Currency: bridge.IssuingChainIssue.Currency, Issuer: bridge.IssuingChainIssue.Issuer.String()},
+	})
+}
+
+// decodeBinaryBridge reads the current XChainBridge field of d.
+func decodeBinaryBridge(d *binarycodec.Decoder) types.XChainBridge {
+	bridge := d.XChainBridge()
+	return types.XChainBridge{
+		LockingChainDoor:  types.Address(bridge.LockingChainDoor),
+		LockingChainIssue: types.Issue{Currency: bridge.LockingChainIssue.Currency, Issuer: types.Address(bridge.LockingChainIssue.Issuer)},
+		IssuingChainDoor:  types.Address(bridge.IssuingChainDoor),
+		IssuingChainIssue: types.Issue{Currency: bridge.IssuingChainIssue.Currency, Issuer: types.Address(bridge.IssuingChainIssue.Issuer)},
+	}
+}
+
+// encodeBinaryUInt64 writes a UInt64 field given as a hex string, the way Flatten carries them.
+func encodeBinaryUInt64(e *binarycodec.Encoder, field, value string) {
+	e.Value(field, value)
+}
+
+// decodeBinaryUInt64 reads the current UInt64 field of d as a 16 character upper case hex string.
+func decodeBinaryUInt64(d *binarycodec.Decoder) string {
+	return fmt.Sprintf("%016X", d.UInt64())
+}
+
+// encodeBinarySigners writes the Signers array.
+func encodeBinarySigners(e *binarycodec.Encoder, signers []types.Signer) {
+	e.BeginArray("Signers")
+	for _, signer := range signers {
+		e.BeginObject("Signer")
+		if signer.SignerData.Account != "" {
+			e.AccountID("Account", signer.SignerData.Account.String())
+		}
+		if signer.SignerData.TxnSignature != "" {
+			e.Blob("TxnSignature", signer.SignerData.TxnSignature)
+		}
+		if signer.SignerData.SigningPubKey != "" {
+			e.Blob("SigningPubKey", signer.SignerData.SigningPubKey)
+		}
+		e.EndObject()
+	}
+	e.EndArray()
+}
+
+// decodeBinarySigners reads the current Signers array of d.
+func decodeBinarySigners(d *binarycodec.Decoder) []types.Signer {
+	var signers []types.Signer
+	for d.Next() {
+		var signer types.SignerData
+		for d.Next() {
+			switch d.Field() {
+			case "Account":
+				signer.Account = types.Address(d.AccountID())
+			case "TxnSignature":
+				signer.TxnSignature = d.Blob()
+			case "SigningPubKey":
+				signer.SigningPubKey = d.Blob()
+			default:
+				d.Skip()
+			}
+		}
+		signers = append(signers, types.Signer{SignerData: signer})
+	}
+	return signers
+}
+
+// encodeBinaryCredentials writes an array of Credential objects, as DepositPreauth carries them.
+func encodeBinaryCredentials(e *binarycodec.Encoder, field string, credentials []types.AuthorizeCredentialsWrapper) {
+	e.BeginArray(field)
+	for _, credential := range credentials {
+		e.BeginObject("Credential")
+		if credential.Credential.Issuer != "" {
+			e.AccountID("Issuer", credential.Credential.Issuer.String())
+		}
+		if credential.Credential.CredentialType != "" {
+			e.Blob("CredentialType", credential.Credential.CredentialType.String())
+		}
+		e.EndObject()
+	}
+	e.EndArray()
+}
+
+// decodeBinaryCredentials reads the current array of Credential objects of d.
+func decodeBinaryCredentials(d *binarycodec.Decoder) []types.AuthorizeCredentialsWrapper {
+	var credentials []types.AuthorizeCredentialsWrapper
+	for d.Next() {
+		var credential types.AuthorizeCredentials
+		for d.Next() {
+			switch d.Field() {
+			case "Issuer":
+				credential.Issuer = types.Address(d.AccountID())
+			case "CredentialType":
+				credential.CredentialType = types.CredentialType(d.Blob())
+			default:
+				d.Skip()
+			}
+		}
+		credentials = append(credentials, types.AuthorizeCredentialsWrapper{Credential: credential})
+	}
+	return credentials
+}
+
+// decodeBinaryNumber reads the current Number field of d.
+func decodeBinaryNumber(d *binarycodec.Decoder) *types.XRPLNumber {
+	number := types.XRPLNumber(d.Number())
+	return &number
+}
+
+// decodeBinaryInterestRate reads the current UInt32 field of d as an interest rate.
+func decodeBinaryInterestRate(d *binarycodec.Decoder) *types.InterestRate {
+	rate := types.InterestRate(d.UInt32())
+	return &rate
+}
+
+// encodeBinaryPriceData writes a PriceData object, under the same conditions as PriceData.Flatten.
+func encodeBinaryPriceData(e *binarycodec.Encoder, priceData ledger.PriceData) {
+	e.BeginObject("PriceData")
+	if priceData.AssetPrice != 0 {
+		e.UInt64("AssetPrice", priceData.AssetPrice)
+	}
+	if priceData.BaseAsset != "" {
+		e.Currency("BaseAsset", priceData.BaseAsset)
+	}
+	if priceData.QuoteAsset != "" {
+		e.Currency("QuoteAsset", priceData.QuoteAsset)
+	}
+	e.UInt8("Scale", priceData.Scale)
+	e.EndObject()
+}
+
+// decodeBinaryPriceData reads the current PriceData object of d.
+func decodeBinaryPriceData(d *binarycodec.Decoder) ledger.PriceData {
+	var priceData ledger.PriceData
+	for d.Next() {
+		switch d.Field() {
+		case "AssetPrice":
+			priceData.AssetPrice = d.UInt64()
+		case "BaseAsset":
+			priceData.BaseAsset = d.Currency()
+		case "QuoteAsset":
+			priceData.QuoteAsset = d.Currency()
+		case "Scale":
+			priceData.Scale = d.UInt8()
+		default:
+			d.Skip()
+		}
+	}
+	return priceData
+}
@@ -0,0 +1,399 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil/binaryfill"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func binaryTestPayments() []struct {
+	name string
+	tx   *Payment
+} {
+	destinationTag := uint32(42)
+	domainID := "6F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B"
+
+	return []struct {
+		name string
+		tx   *Payment
+	}{
+		{
+			name: "pass - XRP payment",
+			tx: &Payment{
+				BaseTx: BaseTx{
+					Account:            "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					Fee:                12,
+					Sequence:           5,
+					LastLedgerSequence: 100,
+					SigningPubKey:      "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+				},
+				Amount:         types.XRPCurrencyAmount(1000000),
+				Destination:    "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				DestinationTag: &destinationTag,
+			},
+		},
+		{
+			name: "pass - issued currency payment with paths",
+			tx: &Payment{
+				BaseTx: BaseTx{
+					Account:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					Fee:      12,
+					Sequence: 5,
+					Flags:    TfPartialPayment,
+					Memos: []types.MemoWrapper{
+						{Memo: types.Memo{MemoData: "72656E74", MemoType: "687474703A2F2F6578616D706C652E636F6D"}},
+					},
+					SigningPubKey: "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+					TxnSignature:  "DEADBEEF",
+				},
+				Amount: types.IssuedCurrencyAmount{
+					Issuer:   "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+					Currency: "USD",
+					Value:    "1.5",
+				},
+				DeliverMin:  types.IssuedCurrencyAmount{Issuer: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", Currency: "USD", Value: "1"},
+				SendMax:     types.XRPCurrencyAmount(2000000),
+				Destination: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				InvoiceID:   "6F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B",
+				Paths: [][]PathStep{
+					{{Currency: "XRP"}, {Account: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"}},
+					{{Currency: "USD", Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}},
+				},
+			},
+		},
+		{
+			name: "pass - multisigned MPT payment with ticket",
+			tx: &Payment{
+				BaseTx: BaseTx{
+					Account:        "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					Fee:            30,
+					TicketSequence: 10,
+					NetworkID:      21337,
+					Signers: []types.Signer{
+						{SignerData: types.SignerData{
+							Account:       "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+							TxnSignature:  "DEADBEEF",
+							SigningPubKey: "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+						}},
+					},
+				},
+				Amount:        types.MPTCurrencyAmount{MPTIssuanceID: "00000001A407AF5856CCF3C42619DAA925813FC955C72983", Value: "100"},
+				Destination:   "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				CredentialIDs: types.CredentialIDs{"6F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B"},
+				DomainID:      &domainID,
+			},
+		},
+	}
+}
+
+func TestPayment_EncodeBinary(t *testing.T) {
+	for _, tt := range binaryTestPayments() {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, err := binarycodec.Encode(tt.tx.Flatten())
+			require.NoError(t, err)
+
+			b, err := EncodeBinary(tt.tx)
+			require.NoError(t, err)
+			require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)), "EncodeBinary must match binarycodec.Encode")
+
+			expected, err = binarycodec.EncodeForSigning(tt.tx.Flatten())
+			require.NoError(t, err)
+
+			b, err = EncodeBinaryForSigning(tt.tx)
+			require.NoError(t, err)
+			require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)), "EncodeBinaryForSigning must match binarycodec.EncodeForSigning")
+		})
+	}
+}
+
+func TestPayment_DecodeBinary(t *testing.T) {
+	for _, tt := range binaryTestPayments() {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := EncodeBinary(tt.tx)
+			require.NoError(t, err)
+
+			var decoded Payment
+			require.NoError(t, DecodeBinary(b, &decoded))
+			require.Equal(t, tt.tx.Flatten(), decoded.Flatten())
+		})
+	}
+
+	t.Run("fail - truncated blob", func(t *testing.T) {
+		b, err := EncodeBinary(binaryTestPayments()[0].tx)
+		require.NoError(t, err)
+
+		var decoded Payment
+		require.Error(t, DecodeBinary(b[:len(b)-3], &decoded))
+	})
+}
+
+func BenchmarkPayment_Encode(b *testing.B) {
+	tx := binaryTestPayments()[0].tx
+
+	b.Run("flatten", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := binarycodec.Encode(tx.Flatten()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("binary", func(b *testing.B) {
+		b.ReportAllocs()
+		e := binarycodec.NewEncoder()
+		for b.Loop() {
+			e.Reset()
+			tx.EncodeBinary(e)
+			if _, err := e.Bytes(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// binaryParityFields holds the fields, beyond the common ones, that a transaction type needs to
+// flatten into an encodable transaction.
+func binaryParityFields() map[TxType]FlatTransaction {
+	const (
+		hash          = "6F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B"
+		mptIssuanceID = "00000001A407AF5856CCF3C42619DAA925813FC955C72983"
+		destination   = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	)
+	amm := FlatTransaction{
+		"Asset":  map[string]any{"currency": "XRP"},
+		"Asset2": map[string]any{"currency": "USD", "issuer": destination},
+	}
+
+	return map[TxType]FlatTransaction{
+		AMMBidTx:                   amm,
+		AMMDeleteTx:                amm,
+		AMMDepositTx:               amm,
+		AMMVoteTx:                  amm,
+		AMMWithdrawTx:              amm,
+		CheckCancelTx:              {"CheckID": hash},
+		CheckCashTx:                {"CheckID": hash},
+		CheckCreateTx:              {"Destination": destination},
+		EnableAmendmentTx:          {"Amendment": hash, "LedgerSequence": uint32(100)},
+		LoanBrokerCoverDepositTx:   {"LoanBrokerID": hash},
+		LoanBrokerCoverWithdrawTx:  {"LoanBrokerID": hash},
+		LoanBrokerDeleteTx:         {"LoanBrokerID": hash},
+		LoanBrokerSetTx:            {"VaultID": hash},
+		LoanDeleteTx:               {"LoanID": hash},
+		LoanManageTx:               {"LoanID": hash},
+		LoanPayTx:                  {"LoanID": hash},
+		LoanSetTx:                  {"LoanBrokerID": hash, "PrincipalRequested": "1000"},
+		MPTokenAuthorizeTx:         {"MPTokenIssuanceID": mptIssuanceID},
+		MPTokenIssuanceDestroyTx:   {"MPTokenIssuanceID": mptIssuanceID},
+		MPTokenIssuanceSetTx:       {"MPTokenIssuanceID": mptIssuanceID},
+		NFTokenCreateOfferTx:       {"NFTokenID": hash},
+		NFTokenModifyTx:            {"NFTokenID": hash},
+		PaymentChannelCreateTx:     {"Amount": "1000", "Destination": destination, "PublicKey": "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A", "SettleDelay": uint32(86400)},
+		PaymentChannelFundTx:       {"Amount": "1000", "Channel": hash},
+		PermissionedDomainDeleteTx: {"DomainID": hash},
+		VaultClawbackTx:            {"Holder": destination, "VaultID": hash},
+		VaultDeleteTx:              {"VaultID": hash},
+		VaultDepositTx:             {"VaultID": hash},
+		VaultSetTx:                 {"VaultID": hash},
+		VaultWithdrawTx:            {"VaultID": hash},
+	}
+}
+
+func TestEncodeBinary_Parity(t *testing.T) {
+	base := FlatTransaction{
+		"Account":            "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Fee":                "12",
+		"Flags":              uint32(0),
+		"Sequence":           uint32(5),
+		"LastLedgerSequence": uint32(100),
+		"SigningPubKey":      "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+		"Memos": []any{
+			map[string]any{"Memo": map[string]any{"MemoType": "687474703A2F2F6578616D706C652E636F6D", "MemoData": "72656E74"}},
+		},
+	}
+
+	var txs []struct {
+		name string
+		flat FlatTransaction
+	}
+	for txType := range definitions.Get().TransactionTypes {
+		if _, err := newTx(TxType(txType)); err != nil {
+			continue
+		}
+		flat := maps.Clone(base)
+		maps.Copy(flat, binaryParityFields()[TxType(txType)])
+		flat["TransactionType"] = txType
+		txs = append(txs, struct {
+			name string
+			flat FlatTransaction
+		}{name: "pass - base fields " + txType, flat: flat})
+	}
+	for i, fixture := range loadCodecFixtures(t) {
+		txs = append(txs, struct {
+			name string
+			flat FlatTransaction
+		}{name: fmt.Sprintf("pass - fixture %d %s", i, fixture.JSON.TxType()), flat: fixture.JSON})
+	}
+
+	for _, tt := range txs {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := Parse(tt.flat)
+			require.NoError(t, err)
+			requireBinaryParity(t, tx)
+		})
+	}
+}
+
+// binaryTestTxs returns a transaction of every type Parse supports, with every field set by
+// binaryfill.Fill, sorted by type.
+func binaryTestTxs(t testing.TB) []Tx {
+	t.Helper()
+
+	fill := func(name string, v reflect.Value) bool {
+		switch {
+		case name == "DeliverMax":
+			// DeliverMax is an API alias of the Payment Amount, and has no binary field.
+		case v.Type() == reflect.TypeFor[types.RawTransaction]():
+			v.Set(reflect.ValueOf(types.RawTransaction{RawTransaction: map[string]any{
+				"TransactionType": "Payment",
+				"Account":         binaryfill.Address,
+				"Destination":     "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Amount":          "1000",
+				"Fee":             "0",
+				"Flags":           types.TfInnerBatchTxn,
+				"Sequence":        uint32(5),
+				"SigningPubKey":   "",
+			}}))
+		default:
+			return false
+		}
+		return true
+	}
+
+	var txs []Tx
+	for _, txType := range slices.Sorted(maps.Keys(definitions.Get().TransactionTypes)) {
+		tx, err := newTx(TxType(txType))
+		if err != nil {
+			continue
+		}
+		binaryfill.Fill(tx, fill)
+		txs = append(txs, tx)
+	}
+	require.NotEmpty(t, txs)
+	return txs
+}
+
+func TestEncodeBinary_AllFields(t *testing.T) {
+	for _, tx := range binaryTestTxs(t) {
+		t.Run("pass - "+tx.TxType().String(), func(t *testing.T) {
+			requireBinaryParity(t, tx)
+		})
+	}
+}
+
+// requireBinaryParity checks that tx implements BinaryEncoder and BinaryDecoder, that its binary
+// serializations match binarycodec.Encode and binarycodec.EncodeForSigning of its flattened form,
+// and that decoding them flattens back to the same transaction.
+func requireBinaryParity(t *testing.T, tx Tx) {
+	t.Helper()
+
+	require.Implements(t, (*BinaryEncoder)(nil), tx)
+	require.Implements(t, (*BinaryDecoder)(nil), tx)
+	flat := tx.(flattener).Flatten()
+
+	expected, err := binarycodec.Encode(flat)
+	require.NoError(t, err)
+	b, err := EncodeBinary(tx)
+	require.NoError(t, err)
+	require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(b)), "EncodeBinary must match binarycodec.Encode")
+
+	expected, err = binarycodec.EncodeForSigning(flat)
+	require.NoError(t, err)
+	signing, err := EncodeBinaryForSigning(tx)
+	require.NoError(t, err)
+	require.Equal(t, expected, strings.ToUpper(hex.EncodeToString(signing)), "EncodeBinaryForSigning must match binarycodec.EncodeForSigning")
+
+	decoded, err := newTx(tx.TxType())
+	require.NoError(t, err)
+	require.NoError(t, DecodeBinary(b, decoded))
+	require.Equal(t, flat, decoded.(flattener).Flatten())
+}
+
+// unsignableTx is a Tx that does not embed BaseTx.
+type unsignableTx struct{}
+
+func (*unsignableTx) TxType() TxType { return PaymentTx }
+
+func TestWithSignature(t *testing.T) {
+	tx := &Payment{
+		BaseTx:      BaseTx{Account: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", SigningPubKey: "AB", TxnSignature: "CD"},
+		Amount:      types.XRPCurrencyAmount(15),
+		Destination: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+	}
+
+	signed, err := WithSignature(tx, "EF", "")
+	require.NoError(t, err)
+	require.IsType(t, &Payment{}, signed)
+	require.Equal(t, "EF", signed.(*Payment).SigningPubKey)
+	require.Empty(t, signed.(*Payment).TxnSignature)
+	require.Equal(t, tx.Destination, signed.(*Payment).Destination)
+	require.Equal(t, "AB", tx.SigningPubKey)
+	require.Equal(t, "CD", tx.TxnSignature)
+
+	_, err = WithSignature(&unsignableTx{}, "EF", "")
+	require.ErrorIs(t, err, ErrSignatureUnsupported)
+}
+
+func BenchmarkEncodeBinary(b *testing.B) {
+	for _, tx := range binaryTestTxs(b) {
+		b.Run(tx.TxType().String(), func(b *testing.B) {
+			b.ReportAllocs()
+			e := binarycodec.NewEncoder()
+			for b.Loop() {
+				e.Reset()
+				tx.(BinaryEncoder).EncodeBinary(e)
+				if _, err := e.Bytes(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeBinary(b *testing.B) {
+	for _, tx := range binaryTestTxs(b) {
+		data, err := EncodeBinary(tx)
+		require.NoError(b, err)
+
+		b.Run(tx.TxType().String(), func(b *testing.B) {
+			b.ReportAllocs()
+			d := binarycodec.NewDecoder(data)
+			for b.Loop() {
+				d.Reset(data)
+				decoded, _ := newTx(tx.TxType())
+				if err := decoded.(BinaryDecoder).DecodeBinary(d); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeBinary_Errors(t *testing.T) {
+	hash := TxHash("E08D6E9754025BA2534A78707605E0601F03ACE063687A0CA1BDDACFCD1698C7")
+	_, err := EncodeBinary(&hash)
+	require.ErrorIs(t, err, ErrBinaryEncodingUnsupported)
+
+	_, err = EncodeBinaryForSigning(&Binary{})
+	require.ErrorIs(t, err, ErrBinaryEncodingUnsupported)
+}
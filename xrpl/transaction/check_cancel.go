@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -42,6 +43,29 @@ func (c *CheckCancel) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the CheckCancel to e. It writes the same fields as Flatten.
+func (c *CheckCancel) EncodeBinary(e *binarycodec.Encoder) {
+	c.BaseTx.encodeBinary(e)
+	e.TransactionType(c.TxType().String())
+
+	e.Hash256("CheckID", c.CheckID.String())
+}
+
+// DecodeBinary reads the CheckCancel from d, skipping fields a CheckCancel does not have.
+func (c *CheckCancel) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "CheckID":
+			c.CheckID = types.Hash256(d.Hash())
+		default:
+			if !c.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks the validity of the CheckCancel transaction.
 func (c *CheckCancel) Validate() (bool, error) {
 	ok, err := c.BaseTx.Validate()
@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -60,6 +63,63 @@ func (c *CheckCash) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the CheckCash to e. It writes the same fields as Flatten.
+func (c *CheckCash) EncodeBinary(e *binarycodec.Encoder) {
+	c.BaseTx.encodeBinary(e)
+	e.TransactionType(c.TxType().String())
+
+	e.Hash256("CheckID", c.CheckID.String())
+	if c.Amount != nil {
+		encodeBinaryAmount(e, "Amount", c.Amount)
+	}
+	if c.DeliverMin != nil {
+		encodeBinaryAmount(e, "DeliverMin", c.DeliverMin)
+	}
+}
+
+// DecodeBinary reads the CheckCash from d, skipping fields a CheckCash does not have.
+func (c *CheckCash) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "CheckID":
+			c.CheckID = types.Hash256(d.Hash())
+		case "Amount":
+			c.Amount = decodeBinaryAmount(d)
+		case "DeliverMin":
+			c.DeliverMin = decodeBinaryAmount(d)
+		default:
+			if !c.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for CheckCash.
+func (c *CheckCash) UnmarshalJSON(data []byte) error {
+	type Alias CheckCash
+	aux := &struct {
+		Amount     json.RawMessage
+		DeliverMin json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if c.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	if c.DeliverMin, err = types.UnmarshalCurrencyAmount(aux.DeliverMin); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks all the fields of the transaction and returns an error if any of the fields are invalid.
 func (c *CheckCash) Validate() (bool, error) {
 	ok, err := c.BaseTx.Validate()
@@ -1,10 +1,22 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// CheckCreateMetadata represents the resulting metadata of a succeeded CheckCreate transaction.
+// It extends from TxObjMeta.
+type CheckCreateMetadata struct {
+	TxObjMeta
+
+	// CheckID is the ledger index of the Check entry created by the transaction.
+	CheckID *types.Hash256 `json:"-"`
+}
+
 // CheckCreate creates a Check object in the ledger, which is a deferred payment that can be cashed by its intended destination.
 // The sender of this transaction is the sender of the Check.
 //
@@ -67,6 +79,70 @@ func (c *CheckCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the CheckCreate to e. It writes the same fields as Flatten.
+func (c *CheckCreate) EncodeBinary(e *binarycodec.Encoder) {
+	c.BaseTx.encodeBinary(e)
+	e.TransactionType(c.TxType().String())
+
+	e.AccountID("Destination", c.Destination.String())
+	if c.SendMax != nil {
+		encodeBinaryAmount(e, "SendMax", c.SendMax)
+	}
+	if c.DestinationTag != nil {
+		e.UInt32("DestinationTag", *c.DestinationTag)
+	}
+	if c.Expiration != 0 {
+		e.UInt32("Expiration", c.Expiration)
+	}
+	if c.InvoiceID != "" {
+		e.Hash256("InvoiceID", c.InvoiceID.String())
+	}
+}
+
+// DecodeBinary reads the CheckCreate from d, skipping fields a CheckCreate does not have.
+func (c *CheckCreate) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Destination":
+			c.Destination = types.Address(d.AccountID())
+		case "SendMax":
+			c.SendMax = decodeBinaryAmount(d)
+		case "DestinationTag":
+			tag := d.UInt32()
+			c.DestinationTag = &tag
+		case "Expiration":
+			c.Expiration = d.UInt32()
+		case "InvoiceID":
+			c.InvoiceID = types.Hash256(d.Hash())
+		default:
+			if !c.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for CheckCreate.
+func (c *CheckCreate) UnmarshalJSON(data []byte) error {
+	type Alias CheckCreate
+	aux := &struct {
+		SendMax json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if c.SendMax, err = types.UnmarshalCurrencyAmount(aux.SendMax); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks all the fields of the transaction and returns an error if any of the fields are invalid.
 func (c *CheckCreate) Validate() (bool, error) {
 	ok, err := c.BaseTx.Validate()
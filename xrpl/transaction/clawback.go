@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -35,6 +38,51 @@ func (c *Clawback) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the Clawback to e. It writes the same fields as Flatten.
+func (c *Clawback) EncodeBinary(e *binarycodec.Encoder) {
+	c.BaseTx.encodeBinary(e)
+	e.TransactionType(ClawbackTx.String())
+
+	if c.Amount != nil {
+		encodeBinaryAmount(e, "Amount", c.Amount)
+	}
+}
+
+// DecodeBinary reads the Clawback from d, skipping fields a Clawback does not have.
+func (c *Clawback) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			c.Amount = decodeBinaryAmount(d)
+		default:
+			if !c.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for Clawback.
+func (c *Clawback) UnmarshalJSON(data []byte) error {
+	type Alias Clawback
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if c.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate implements the Validate method for the Clawback struct.
 func (c *Clawback) Validate() (bool, error) {
 	// validate the base transaction
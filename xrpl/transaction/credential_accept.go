@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -40,6 +41,36 @@ func (c *CredentialAccept) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the CredentialAccept to e. It writes the same fields as Flatten.
+func (c *CredentialAccept) EncodeBinary(e *binarycodec.Encoder) {
+	c.BaseTx.encodeBinary(e)
+	e.TransactionType(c.TxType().String())
+
+	if c.Issuer != "" {
+		e.AccountID("Issuer", c.Issuer.String())
+	}
+	if c.CredentialType != "" {
+		e.Blob("CredentialType", c.CredentialType.String())
+	}
+}
+
+// DecodeBinary reads the CredentialAccept from d, skipping fields a CredentialAccept does not have.
+func (c *CredentialAccept) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Issuer":
+			c.Issuer = types.Address(d.AccountID())
+		case "CredentialType":
+			c.CredentialType = types.CredentialType(d.Blob())
+		default:
+			if !c.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate implements the Validate method for the CredentialCreate struct.
 func (c *CredentialAccept) Validate() (bool, error) {
 	// validate the base transaction
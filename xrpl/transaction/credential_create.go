@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -13,6 +14,15 @@ const (
 	MaxURILength = 512
 )
 
+// CredentialCreateMetadata represents the resulting metadata of a succeeded CredentialCreate transaction.
+// It extends from TxObjMeta.
+type CredentialCreateMetadata struct {
+	TxObjMeta
+
+	// CredentialID is the ledger index of the Credential entry created by the transaction.
+	CredentialID *types.Hash256 `json:"-"`
+}
+
 // CredentialCreate transaction creates a credential in the ledger.
 // The issuer of the credential uses this transaction to provisionally issue a credential.
 // The credential is not valid until the subject of the credential accepts it with a CredentialAccept transaction.
@@ -65,6 +75,46 @@ func (c *CredentialCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the CredentialCreate to e. It writes the same fields as Flatten.
+func (c *CredentialCreate) EncodeBinary(e *binarycodec.Encoder) {
+	c.BaseTx.encodeBinary(e)
+	e.TransactionType(c.TxType().String())
+
+	if c.Subject != "" {
+		e.AccountID("Subject", c.Subject.String())
+	}
+	if c.CredentialType != "" {
+		e.Blob("CredentialType", c.CredentialType.String())
+	}
+	if c.Expiration != 0 {
+		e.UInt32("Expiration", c.Expiration)
+	}
+	if c.URI != "" {
+		e.Blob("URI", c.URI)
+	}
+}
+
+// DecodeBinary reads the CredentialCreate from d, skipping fields a CredentialCreate does not have.
+func (c *CredentialCreate) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Subject":
+			c.Subject = types.Address(d.AccountID())
+		case "CredentialType":
+			c.CredentialType = types.CredentialType(d.Blob())
+		case "Expiration":
+			c.Expiration = d.UInt32()
+		case "URI":
+			c.URI = d.Blob()
+		default:
+			if !c.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate implements the Validate method for the CredentialCreate struct.
 func (c *CredentialCreate) Validate() (bool, error) {
 	// validate the base transaction
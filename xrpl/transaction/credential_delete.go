@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -46,6 +47,41 @@ func (c *CredentialDelete) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the CredentialDelete to e. It writes the same fields as Flatten.
+func (c *CredentialDelete) EncodeBinary(e *binarycodec.Encoder) {
+	c.BaseTx.encodeBinary(e)
+	e.TransactionType(c.TxType().String())
+
+	if c.CredentialType != "" {
+		e.Blob("CredentialType", c.CredentialType.String())
+	}
+	if c.Subject != "" {
+		e.AccountID("Subject", c.Subject.String())
+	}
+	if c.Issuer != "" {
+		e.AccountID("Issuer", c.Issuer.String())
+	}
+}
+
+// DecodeBinary reads the CredentialDelete from d, skipping fields a CredentialDelete does not have.
+func (c *CredentialDelete) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "CredentialType":
+			c.CredentialType = types.CredentialType(d.Blob())
+		case "Subject":
+			c.Subject = types.Address(d.AccountID())
+		case "Issuer":
+			c.Issuer = types.Address(d.AccountID())
+		default:
+			if !c.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate validates the CredentialDelete transaction.
 func (c *CredentialDelete) Validate() (bool, error) {
 	// validate the base transaction
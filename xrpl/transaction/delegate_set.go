@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -23,6 +24,15 @@ var NonDelegatableTransactionsMap = map[string]uint8{
 	"UNLModify":       0,
 }
 
+// DelegateSetMetadata represents the resulting metadata of a succeeded DelegateSet transaction.
+// It extends from TxObjMeta.
+type DelegateSetMetadata struct {
+	TxObjMeta
+
+	// DelegateID is the ledger index of the Delegate entry created, updated or deleted by the transaction.
+	DelegateID *types.Hash256 `json:"-"`
+}
+
 // DelegateSet allows an account to delegate a set of permissions to another account.
 //
 // Example:
@@ -84,6 +94,53 @@ func (d *DelegateSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the DelegateSet to e. It writes the same fields as Flatten.
+func (d *DelegateSet) EncodeBinary(e *binarycodec.Encoder) {
+	d.BaseTx.encodeBinary(e)
+	e.TransactionType(DelegateSetTx.String())
+
+	if d.Authorize != "" {
+		e.AccountID("Authorize", d.Authorize.String())
+	}
+	if len(d.Permissions) > 0 {
+		e.BeginArray("Permissions")
+		for _, permission := range d.Permissions {
+			e.BeginObject("Permission")
+			e.PermissionValue("PermissionValue", permission.Permission.PermissionValue)
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+}
+
+// DecodeBinary reads the DelegateSet from dec, skipping fields a DelegateSet does not have.
+func (d *DelegateSet) DecodeBinary(dec *binarycodec.Decoder) error {
+	for dec.Next() {
+		switch dec.Field() {
+		case "Authorize":
+			d.Authorize = types.Address(dec.AccountID())
+		case "Permissions":
+			d.Permissions = nil
+			for dec.Next() {
+				var permission types.Permission
+				for dec.Next() {
+					if dec.Field() == "PermissionValue" {
+						permission.Permission.PermissionValue = dec.PermissionValue()
+					} else {
+						dec.Skip()
+					}
+				}
+				d.Permissions = append(d.Permissions, permission)
+			}
+		default:
+			if !d.BaseTx.decodeBinaryField(dec) {
+				dec.Skip()
+			}
+		}
+	}
+	return dec.Err()
+}
+
 // Validate validates the DelegateSet transaction and ensures all fields are correct.
 func (d *DelegateSet) Validate() (bool, error) {
 	_, err := d.BaseTx.Validate()
@@ -2,9 +2,19 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// DepositPreauthMetadata represents the resulting metadata of a succeeded DepositPreauth transaction.
+// It extends from TxObjMeta.
+type DepositPreauthMetadata struct {
+	TxObjMeta
+
+	// DepositPreauthID is the ledger index of the DepositPreauth entry created or deleted by the transaction.
+	DepositPreauthID *types.Hash256 `json:"-"`
+}
+
 // DepositPreauth gives pre-approval for another account to deliver payments to the sender. (Requires the DepositPreauth amendment)
 //
 // ```json
@@ -75,6 +85,46 @@ func (d *DepositPreauth) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the DepositPreauth to e. It writes the same fields as Flatten.
+func (d *DepositPreauth) EncodeBinary(e *binarycodec.Encoder) {
+	d.BaseTx.encodeBinary(e)
+	e.TransactionType(DepositPreauthTx.String())
+
+	if d.Authorize != "" {
+		e.AccountID("Authorize", d.Authorize.String())
+	}
+	if d.Unauthorize != "" {
+		e.AccountID("Unauthorize", d.Unauthorize.String())
+	}
+	if len(d.AuthorizeCredentials) > 0 {
+		encodeBinaryCredentials(e, "AuthorizeCredentials", d.AuthorizeCredentials)
+	}
+	if len(d.UnauthorizeCredentials) > 0 {
+		encodeBinaryCredentials(e, "UnauthorizeCredentials", d.UnauthorizeCredentials)
+	}
+}
+
+// DecodeBinary reads the DepositPreauth from dec, skipping fields a DepositPreauth does not have.
+func (d *DepositPreauth) DecodeBinary(dec *binarycodec.Decoder) error {
+	for dec.Next() {
+		switch dec.Field() {
+		case "Authorize":
+			d.Authorize = types.Address(dec.AccountID())
+		case "Unauthorize":
+			d.Unauthorize = types.Address(dec.AccountID())
+		case "AuthorizeCredentials":
+			d.AuthorizeCredentials = decodeBinaryCredentials(dec)
+		case "UnauthorizeCredentials":
+			d.UnauthorizeCredentials = decodeBinaryCredentials(dec)
+		default:
+			if !d.BaseTx.decodeBinaryField(dec) {
+				dec.Skip()
+			}
+		}
+	}
+	return dec.Err()
+}
+
 // Validate implements the Validate method for the DepositPreauth struct.
 func (d *DepositPreauth) Validate() (bool, error) {
 	_, err := d.BaseTx.Validate()
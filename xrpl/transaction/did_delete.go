@@ -1,5 +1,7 @@
 package transaction
 
+import binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+
 // DIDDelete deletes a DID ledger entry.
 //
 // Example:
@@ -31,6 +33,22 @@ func (tx *DIDDelete) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the DIDDelete to e. It writes the same fields as Flatten.
+func (tx *DIDDelete) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+}
+
+// DecodeBinary reads the DIDDelete from d, skipping fields a DIDDelete does not have.
+func (tx *DIDDelete) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		if !tx.BaseTx.decodeBinaryField(d) {
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
 // Validate validates the transaction.
 func (tx *DIDDelete) Validate() (bool, error) {
 	return tx.BaseTx.Validate()
@@ -1,5 +1,20 @@
 package transaction
 
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
+
+// DIDSetMetadata represents the resulting metadata of a succeeded DIDSet transaction.
+// It extends from TxObjMeta.
+type DIDSetMetadata struct {
+	TxObjMeta
+
+	// DIDID is the ledger index of the DID entry created or updated by the transaction.
+	DIDID *types.Hash256 `json:"-"`
+}
+
 // DIDSet creates or updates a DID ledger entry, setting Data, DIDDocument, or URI. (Requires the DID amendment)
 //
 // Example:
@@ -51,6 +66,41 @@ func (tx *DIDSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the DIDSet to e. It writes the same fields as Flatten.
+func (tx *DIDSet) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	if tx.Data != "" {
+		e.Blob("Data", tx.Data)
+	}
+	if tx.DIDDocument != "" {
+		e.Blob("DIDDocument", tx.DIDDocument)
+	}
+	if tx.URI != "" {
+		e.Blob("URI", tx.URI)
+	}
+}
+
+// DecodeBinary reads the DIDSet from d, skipping fields a DIDSet does not have.
+func (tx *DIDSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Data":
+			tx.Data = d.Blob()
+		case "DIDDocument":
+			tx.DIDDocument = d.Blob()
+		case "URI":
+			tx.URI = d.Blob()
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks DIDSet transaction fields and returns false with an error if invalid.
 func (tx *DIDSet) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
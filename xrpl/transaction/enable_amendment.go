@@ -0,0 +1,100 @@
+package transaction
+
+import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/flag"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+const (
+	// TfGotMajority indicates that support for the amendment increased to at least 80% of trusted validators.
+	TfGotMajority uint32 = 0x00010000
+	// TfLostMajority indicates that support for the amendment decreased to less than 80% of trusted validators.
+	TfLostMajority uint32 = 0x00020000
+)
+
+// EnableAmendment is a pseudo-transaction that marks a change in the status of an amendment
+// and the enabling of an amendment. It is created by the consensus process and is never signed
+// or submitted.
+//
+// Example:
+//
+// ```json
+//
+//	{
+//	    "Account": "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+//	    "Amendment": "42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE",
+//	    "Fee": "0",
+//	    "LedgerSequence": 21225473,
+//	    "Sequence": 0,
+//	    "SigningPubKey": "",
+//	    "TransactionType": "EnableAmendment"
+//	}
+//
+// ```
+type EnableAmendment struct {
+	BaseTx
+	// A unique identifier for the amendment.
+	Amendment types.Hash256
+	// The ledger index where this pseudo-transaction appears.
+	LedgerSequence uint32
+}
+
+// TxType returns the type of the transaction (EnableAmendment).
+func (*EnableAmendment) TxType() TxType {
+	return EnableAmendmentTx
+}
+
+// Flatten returns the flattened map of the EnableAmendment transaction.
+func (tx *EnableAmendment) Flatten() FlatTransaction {
+	flattened := tx.flattenPseudo()
+
+	flattened["TransactionType"] = tx.TxType().String()
+	flattened["Amendment"] = tx.Amendment.String()
+	flattened["LedgerSequence"] = tx.LedgerSequence
+
+	return flattened
+}
+
+// EncodeBinary writes the EnableAmendment to e. It writes the same fields as Flatten.
+func (tx *EnableAmendment) EncodeBinary(e *binarycodec.Encoder) {
+	tx.encodeBinaryPseudo(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("Amendment", tx.Amendment.String())
+	e.UInt32("LedgerSequence", tx.LedgerSequence)
+}
+
+// DecodeBinary reads the EnableAmendment from d, skipping fields an EnableAmendment does not have.
+func (tx *EnableAmendment) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amendment":
+			tx.Amendment = types.Hash256(d.Hash())
+		case "LedgerSequence":
+			tx.LedgerSequence = d.UInt32()
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// Validate checks that the EnableAmendment transaction is valid.
+func (tx *EnableAmendment) Validate() (bool, error) {
+	if ok, err := tx.BaseTx.Validate(); !ok {
+		return false, err
+	}
+
+	if !IsLedgerEntryID(tx.Amendment.String()) {
+		return false, ErrEnableAmendmentInvalidAmendment
+	}
+
+	if flag.Contains(tx.Flags, TfGotMajority) && flag.Contains(tx.Flags, TfLostMajority) {
+		return false, ErrEnableAmendmentConflictingFlags
+	}
+
+	return true, nil
+}
@@ -0,0 +1,96 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableAmendment_TxType(t *testing.T) {
+	tx := &EnableAmendment{}
+	require.Equal(t, EnableAmendmentTx, tx.TxType())
+}
+
+func TestEnableAmendment_Flatten(t *testing.T) {
+	tx := &EnableAmendment{
+		BaseTx: BaseTx{
+			Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+			TransactionType: EnableAmendmentTx,
+			Flags:           TfGotMajority,
+		},
+		Amendment:      "42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE",
+		LedgerSequence: 21225473,
+	}
+
+	expected := FlatTransaction{
+		"Account":         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		"TransactionType": "EnableAmendment",
+		"Fee":             "0",
+		"Sequence":        uint32(0),
+		"SigningPubKey":   "",
+		"Flags":           TfGotMajority,
+		"Amendment":       "42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE",
+		"LedgerSequence":  uint32(21225473),
+	}
+
+	require.Equal(t, expected, tx.Flatten())
+}
+
+func TestEnableAmendment_Validate(t *testing.T) {
+	baseTx := BaseTx{
+		Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		TransactionType: EnableAmendmentTx,
+		Fee:             types.XRPCurrencyAmount(0),
+	}
+
+	tests := []struct {
+		name string
+		tx   *EnableAmendment
+		err  error
+	}{
+		{
+			name: "pass - valid amendment",
+			tx: &EnableAmendment{
+				BaseTx:         baseTx,
+				Amendment:      "42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE",
+				LedgerSequence: 21225473,
+			},
+		},
+		{
+			name: "fail - invalid amendment",
+			tx: &EnableAmendment{
+				BaseTx:         baseTx,
+				Amendment:      "42426C4D",
+				LedgerSequence: 21225473,
+			},
+			err: ErrEnableAmendmentInvalidAmendment,
+		},
+		{
+			name: "fail - conflicting majority flags",
+			tx: &EnableAmendment{
+				BaseTx: BaseTx{
+					Account:         baseTx.Account,
+					TransactionType: EnableAmendmentTx,
+					Flags:           TfGotMajority | TfLostMajority,
+				},
+				Amendment:      "42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE",
+				LedgerSequence: 21225473,
+			},
+			err: ErrEnableAmendmentConflictingFlags,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, err := tt.tx.Validate()
+			if tt.err != nil {
+				require.False(t, valid)
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.True(t, valid)
+			require.NoError(t, err)
+		})
+	}
+}
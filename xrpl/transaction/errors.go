@@ -548,6 +548,32 @@ var (
 	ErrVaultClawbackHolderRequired = errors.New("vaultClawback: Holder is required")
 	// ErrVaultClawbackHolderInvalid is returned when Holder is not a valid XRPL address.
 	ErrVaultClawbackHolderInvalid = errors.New("vaultClawback: Holder must be a valid XRPL address")
+
+	// binary
+
+	// ErrBinaryEncodingUnsupported is returned when a transaction can neither encode itself to binary nor be flattened.
+	ErrBinaryEncodingUnsupported = errors.New("transaction does not support binary encoding")
+	// ErrSignatureUnsupported is returned when the signature fields of a transaction that does not embed BaseTx are set.
+	ErrSignatureUnsupported = errors.New("transaction does not embed BaseTx")
+
+	// pseudo-transaction
+
+	// ErrPseudoTransaction is returned when a pseudo-transaction is signed or submitted.
+	ErrPseudoTransaction = errors.New("pseudo-transactions cannot be signed or submitted")
+	// ErrEnableAmendmentInvalidAmendment is returned when Amendment is not a valid 64-character hexadecimal string.
+	ErrEnableAmendmentInvalidAmendment = errors.New("enableAmendment: Amendment must be a valid 64-character hexadecimal string")
+	// ErrEnableAmendmentConflictingFlags is returned when both TfGotMajority and TfLostMajority are set.
+	ErrEnableAmendmentConflictingFlags = errors.New("enableAmendment: tfGotMajority and tfLostMajority cannot both be set")
+	// ErrSetFeeMixedFields is returned when a SetFee transaction mixes the legacy and XRPFees field sets.
+	ErrSetFeeMixedFields = errors.New("setFee: legacy fee fields and XRPFees drop fields cannot be mixed")
+	// ErrSetFeeMissingFields is returned when a SetFee transaction does not carry a complete legacy or XRPFees field set.
+	ErrSetFeeMissingFields = errors.New("setFee: either all legacy fee fields or all XRPFees drop fields are required")
+	// ErrSetFeeInvalidBaseFee is returned when BaseFee is not a valid hexadecimal UInt64.
+	ErrSetFeeInvalidBaseFee = errors.New("setFee: BaseFee must be a hexadecimal string of at most 16 characters")
+	// ErrUNLModifyInvalidDisabling is returned when UNLModifyDisabling is neither 0 nor 1.
+	ErrUNLModifyInvalidDisabling = errors.New("unlModify: UNLModifyDisabling must be 0 or 1")
+	// ErrUNLModifyInvalidValidator is returned when UNLModifyValidator is not a valid hexadecimal public key.
+	ErrUNLModifyInvalidValidator = errors.New("unlModify: UNLModifyValidator must be a 33-byte hexadecimal public key")
 )
 
 // ErrAMMTradingFeeTooHigh is returned when the AMM trading fee exceeds the maximum allowed.
@@ -615,3 +641,13 @@ type ErrMissingField struct {
 func (e ErrMissingField) Error() string {
 	return fmt.Sprintf("missing field required: %s", e.Field)
 }
+
+// ErrUnsupportedTransactionType is returned when a transaction type has no concrete transaction struct.
+type ErrUnsupportedTransactionType struct {
+	Type string
+}
+
+// Error implements the error interface for ErrUnsupportedTransactionType
+func (e ErrUnsupportedTransactionType) Error() string {
+	return fmt.Sprintf("unsupported transaction type: %s", e.Type)
+}
@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -49,6 +50,36 @@ func (e *EscrowCancel) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the EscrowCancel to e. It writes the same fields as Flatten.
+func (e *EscrowCancel) EncodeBinary(enc *binarycodec.Encoder) {
+	e.BaseTx.encodeBinary(enc)
+	enc.TransactionType(EscrowCancelTx.String())
+
+	if e.Owner != "" {
+		enc.AccountID("Owner", e.Owner.String())
+	}
+	if e.OfferSequence != 0 {
+		enc.UInt32("OfferSequence", e.OfferSequence)
+	}
+}
+
+// DecodeBinary reads the EscrowCancel from d, skipping fields an EscrowCancel does not have.
+func (e *EscrowCancel) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Owner":
+			e.Owner = types.Address(d.AccountID())
+		case "OfferSequence":
+			e.OfferSequence = d.UInt32()
+		default:
+			if !e.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks if the EscrowCancel struct is valid.
 func (e *EscrowCancel) Validate() (bool, error) {
 	ok, err := e.BaseTx.Validate()
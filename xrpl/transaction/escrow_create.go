@@ -4,10 +4,20 @@ import (
 	"encoding/json"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// EscrowCreateMetadata represents the resulting metadata of a succeeded EscrowCreate transaction.
+// It extends from TxObjMeta.
+type EscrowCreateMetadata struct {
+	TxObjMeta
+
+	// EscrowID is the ledger index of the Escrow entry created by the transaction.
+	EscrowID *types.Hash256 `json:"-"`
+}
+
 // EscrowCreate sequesters XRP until the escrow process either finishes or is canceled.
 //
 // Example:
@@ -80,6 +90,57 @@ func (e *EscrowCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the EscrowCreate to e. It writes the same fields as Flatten.
+func (e *EscrowCreate) EncodeBinary(enc *binarycodec.Encoder) {
+	e.BaseTx.encodeBinary(enc)
+	enc.TransactionType(EscrowCreateTx.String())
+
+	if e.Amount != nil {
+		encodeBinaryAmount(enc, "Amount", e.Amount)
+	}
+	if e.Destination != "" {
+		enc.AccountID("Destination", e.Destination.String())
+	}
+	if e.CancelAfter != 0 {
+		enc.UInt32("CancelAfter", e.CancelAfter)
+	}
+	if e.FinishAfter != 0 {
+		enc.UInt32("FinishAfter", e.FinishAfter)
+	}
+	if e.Condition != "" {
+		enc.Blob("Condition", e.Condition)
+	}
+	if e.DestinationTag != nil {
+		enc.UInt32("DestinationTag", *e.DestinationTag)
+	}
+}
+
+// DecodeBinary reads the EscrowCreate from d, skipping fields an EscrowCreate does not have.
+func (e *EscrowCreate) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			e.Amount = decodeBinaryAmount(d)
+		case "Destination":
+			e.Destination = types.Address(d.AccountID())
+		case "CancelAfter":
+			e.CancelAfter = d.UInt32()
+		case "FinishAfter":
+			e.FinishAfter = d.UInt32()
+		case "Condition":
+			e.Condition = d.Blob()
+		case "DestinationTag":
+			tag := d.UInt32()
+			e.DestinationTag = &tag
+		default:
+			if !e.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // UnmarshalJSON implements custom JSON unmarshalling for EscrowCreate.
 func (e *EscrowCreate) UnmarshalJSON(data []byte) error {
 	type escrowCreateHelper struct {
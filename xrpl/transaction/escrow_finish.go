@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -72,6 +73,51 @@ func (e *EscrowFinish) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the EscrowFinish to e. It writes the same fields as Flatten.
+func (e *EscrowFinish) EncodeBinary(enc *binarycodec.Encoder) {
+	e.BaseTx.encodeBinary(enc)
+	enc.TransactionType(EscrowFinishTx.String())
+
+	if e.Owner != "" {
+		enc.AccountID("Owner", e.Owner.String())
+	}
+	if e.OfferSequence != 0 {
+		enc.UInt32("OfferSequence", e.OfferSequence)
+	}
+	if e.Condition != "" {
+		enc.Blob("Condition", e.Condition)
+	}
+	if e.Fulfillment != "" {
+		enc.Blob("Fulfillment", e.Fulfillment)
+	}
+	if len(e.CredentialIDs) > 0 {
+		enc.Vector256("CredentialIDs", e.CredentialIDs)
+	}
+}
+
+// DecodeBinary reads the EscrowFinish from d, skipping fields an EscrowFinish does not have.
+func (e *EscrowFinish) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Owner":
+			e.Owner = types.Address(d.AccountID())
+		case "OfferSequence":
+			e.OfferSequence = d.UInt32()
+		case "Condition":
+			e.Condition = d.Blob()
+		case "Fulfillment":
+			e.Fulfillment = d.Blob()
+		case "CredentialIDs":
+			e.CredentialIDs = d.Vector256()
+		default:
+			if !e.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks if the EscrowFinish struct is valid.
 func (e *EscrowFinish) Validate() (bool, error) {
 	ok, err := e.BaseTx.Validate()
@@ -47,6 +47,15 @@ func (f FlatTransaction) RequireTransactionType() error {
 	return nil
 }
 
+// RequireNotPseudo returns ErrPseudoTransaction when the flattened
+// transaction is a pseudo-transaction, which can never be signed or submitted.
+func (f FlatTransaction) RequireNotPseudo() error {
+	if f.TxType().IsPseudo() {
+		return ErrPseudoTransaction
+	}
+	return nil
+}
+
 // TxType returns the transaction type of the flattened transaction.
 func (f FlatTransaction) TxType() TxType {
 	txType, ok := f["TransactionType"].(string)
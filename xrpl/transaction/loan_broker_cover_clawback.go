@@ -1,8 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
 	"strconv"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -61,6 +63,57 @@ func (tx *LoanBrokerCoverClawback) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the LoanBrokerCoverClawback to e. It writes the same fields as Flatten.
+func (tx *LoanBrokerCoverClawback) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	if tx.LoanBrokerID != nil {
+		e.Hash256("LoanBrokerID", string(*tx.LoanBrokerID))
+	}
+	if tx.Amount != nil {
+		encodeBinaryAmount(e, "Amount", tx.Amount)
+	}
+}
+
+// DecodeBinary reads the LoanBrokerCoverClawback from d, skipping fields a LoanBrokerCoverClawback does not have.
+func (tx *LoanBrokerCoverClawback) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LoanBrokerID":
+			loanBrokerID := types.LoanBrokerID(d.Hash())
+			tx.LoanBrokerID = &loanBrokerID
+		case "Amount":
+			tx.Amount = decodeBinaryAmount(d)
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for LoanBrokerCoverClawback.
+func (tx *LoanBrokerCoverClawback) UnmarshalJSON(data []byte) error {
+	type Alias LoanBrokerCoverClawback
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(tx),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if tx.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks LoanBrokerCoverClawback transaction fields and returns false with an error if invalid.
 func (tx *LoanBrokerCoverClawback) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -48,6 +51,54 @@ func (tx *LoanBrokerCoverDeposit) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the LoanBrokerCoverDeposit to e. It writes the same fields as Flatten.
+func (tx *LoanBrokerCoverDeposit) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("LoanBrokerID", tx.LoanBrokerID)
+	if tx.Amount != nil {
+		encodeBinaryAmount(e, "Amount", tx.Amount)
+	}
+}
+
+// DecodeBinary reads the LoanBrokerCoverDeposit from d, skipping fields a LoanBrokerCoverDeposit does not have.
+func (tx *LoanBrokerCoverDeposit) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LoanBrokerID":
+			tx.LoanBrokerID = d.Hash()
+		case "Amount":
+			tx.Amount = decodeBinaryAmount(d)
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for LoanBrokerCoverDeposit.
+func (tx *LoanBrokerCoverDeposit) UnmarshalJSON(data []byte) error {
+	type Alias LoanBrokerCoverDeposit
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(tx),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if tx.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks LoanBrokerCoverDeposit transaction fields and returns false with an error if invalid.
 func (tx *LoanBrokerCoverDeposit) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
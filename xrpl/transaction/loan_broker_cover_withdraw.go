@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -62,6 +65,66 @@ func (tx *LoanBrokerCoverWithdraw) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the LoanBrokerCoverWithdraw to e. It writes the same fields as Flatten.
+func (tx *LoanBrokerCoverWithdraw) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("LoanBrokerID", tx.LoanBrokerID)
+	if tx.Amount != nil {
+		encodeBinaryAmount(e, "Amount", tx.Amount)
+	}
+	if tx.Destination != nil {
+		e.AccountID("Destination", tx.Destination.String())
+	}
+	if tx.DestinationTag != nil {
+		e.UInt32("DestinationTag", *tx.DestinationTag)
+	}
+}
+
+// DecodeBinary reads the LoanBrokerCoverWithdraw from d, skipping fields a LoanBrokerCoverWithdraw does not have.
+func (tx *LoanBrokerCoverWithdraw) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LoanBrokerID":
+			tx.LoanBrokerID = d.Hash()
+		case "Amount":
+			tx.Amount = decodeBinaryAmount(d)
+		case "Destination":
+			destination := types.Address(d.AccountID())
+			tx.Destination = &destination
+		case "DestinationTag":
+			tag := d.UInt32()
+			tx.DestinationTag = &tag
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for LoanBrokerCoverWithdraw.
+func (tx *LoanBrokerCoverWithdraw) UnmarshalJSON(data []byte) error {
+	type Alias LoanBrokerCoverWithdraw
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(tx),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if tx.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks LoanBrokerCoverWithdraw transaction fields and returns false with an error if invalid.
 func (tx *LoanBrokerCoverWithdraw) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
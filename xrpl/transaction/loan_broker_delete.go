@@ -1,5 +1,7 @@
 package transaction
 
+import binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+
 // LoanBrokerDelete deletes LoanBroker ledger object.
 //
 // ```json
@@ -37,6 +39,29 @@ func (tx *LoanBrokerDelete) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the LoanBrokerDelete to e. It writes the same fields as Flatten.
+func (tx *LoanBrokerDelete) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("LoanBrokerID", tx.LoanBrokerID)
+}
+
+// DecodeBinary reads the LoanBrokerDelete from d, skipping fields a LoanBrokerDelete does not have.
+func (tx *LoanBrokerDelete) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LoanBrokerID":
+			tx.LoanBrokerID = d.Hash()
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks LoanBrokerDelete transaction fields and returns false with an error if invalid.
 func (tx *LoanBrokerDelete) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
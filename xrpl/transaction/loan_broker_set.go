@@ -3,6 +3,7 @@ package transaction
 import (
 	"strconv"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -18,6 +19,16 @@ const (
 	LoanBrokerSetMaxCoverRateLiquidation = 100000
 )
 
+// LoanBrokerSetMetadata represents the resulting metadata of a succeeded LoanBrokerSet transaction.
+// It extends from TxObjMeta.
+type LoanBrokerSetMetadata struct {
+	TxObjMeta
+
+	// LoanBrokerID is the ledger index of the LoanBroker entry created by the transaction.
+	// It is nil when the transaction updated an existing LoanBroker.
+	LoanBrokerID *types.Hash256 `json:"-"`
+}
+
 // LoanBrokerSet creates a new LoanBroker object or updates an existing one.
 //
 // ```json
@@ -93,6 +104,62 @@ func (tx *LoanBrokerSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the LoanBrokerSet to e. It writes the same fields as Flatten.
+func (tx *LoanBrokerSet) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("VaultID", tx.VaultID)
+	if tx.LoanBrokerID != nil && *tx.LoanBrokerID != "" {
+		e.Hash256("LoanBrokerID", string(*tx.LoanBrokerID))
+	}
+	if tx.Data != nil && *tx.Data != "" {
+		e.Blob("Data", string(*tx.Data))
+	}
+	if tx.ManagementFeeRate != nil && *tx.ManagementFeeRate != 0 {
+		e.UInt16("ManagementFeeRate", uint16(*tx.ManagementFeeRate))
+	}
+	if tx.DebtMaximum != nil && *tx.DebtMaximum != "" {
+		e.Number("DebtMaximum", tx.DebtMaximum.String())
+	}
+	if tx.CoverRateMinimum != nil && *tx.CoverRateMinimum != 0 {
+		e.UInt32("CoverRateMinimum", uint32(*tx.CoverRateMinimum))
+	}
+	if tx.CoverRateLiquidation != nil && *tx.CoverRateLiquidation != 0 {
+		e.UInt32("CoverRateLiquidation", uint32(*tx.CoverRateLiquidation))
+	}
+}
+
+// DecodeBinary reads the LoanBrokerSet from d, skipping fields a LoanBrokerSet does not have.
+func (tx *LoanBrokerSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "VaultID":
+			tx.VaultID = d.Hash()
+		case "LoanBrokerID":
+			loanBrokerID := types.LoanBrokerID(d.Hash())
+			tx.LoanBrokerID = &loanBrokerID
+		case "Data":
+			data := types.Data(d.Blob())
+			tx.Data = &data
+		case "ManagementFeeRate":
+			rate := types.InterestRate(d.UInt16())
+			tx.ManagementFeeRate = &rate
+		case "DebtMaximum":
+			tx.DebtMaximum = decodeBinaryNumber(d)
+		case "CoverRateMinimum":
+			tx.CoverRateMinimum = decodeBinaryInterestRate(d)
+		case "CoverRateLiquidation":
+			tx.CoverRateLiquidation = decodeBinaryInterestRate(d)
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks LoanBrokerSet transaction fields and returns false with an error if invalid.
 func (tx *LoanBrokerSet) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
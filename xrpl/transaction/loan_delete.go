@@ -1,5 +1,7 @@
 package transaction
 
+import binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+
 // LoanDelete deletes an existing Loan object.
 //
 // ```json
@@ -37,6 +39,29 @@ func (tx *LoanDelete) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the LoanDelete to e. It writes the same fields as Flatten.
+func (tx *LoanDelete) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("LoanID", tx.LoanID)
+}
+
+// DecodeBinary reads the LoanDelete from d, skipping fields a LoanDelete does not have.
+func (tx *LoanDelete) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LoanID":
+			tx.LoanID = d.Hash()
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks LoanDelete transaction fields and returns false with an error if invalid.
 func (tx *LoanDelete) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
@@ -1,5 +1,7 @@
 package transaction
 
+import binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+
 // LoanManageFlags represents flags for LoanManage transactions.
 const (
 	// TfLoanDefault indicates that the Loan should be defaulted.
@@ -63,6 +65,29 @@ func (tx *LoanManage) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the LoanManage to e. It writes the same fields as Flatten.
+func (tx *LoanManage) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("LoanID", tx.LoanID)
+}
+
+// DecodeBinary reads the LoanManage from d, skipping fields a LoanManage does not have.
+func (tx *LoanManage) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LoanID":
+			tx.LoanID = d.Hash()
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks LoanManage transaction fields and returns false with an error if invalid.
 func (tx *LoanManage) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
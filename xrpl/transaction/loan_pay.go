@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	flag "github.com/Peersyst/xrpl-go/xrpl/flag"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -73,6 +76,54 @@ func (tx *LoanPay) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the LoanPay to e. It writes the same fields as Flatten.
+func (tx *LoanPay) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("LoanID", tx.LoanID)
+	if tx.Amount != nil {
+		encodeBinaryAmount(e, "Amount", tx.Amount)
+	}
+}
+
+// DecodeBinary reads the LoanPay from d, skipping fields a LoanPay does not have.
+func (tx *LoanPay) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LoanID":
+			tx.LoanID = d.Hash()
+		case "Amount":
+			tx.Amount = decodeBinaryAmount(d)
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for LoanPay.
+func (tx *LoanPay) UnmarshalJSON(data []byte) error {
+	type Alias LoanPay
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(tx),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if tx.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks LoanPay transaction fields and returns false with an error if invalid.
 func (tx *LoanPay) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
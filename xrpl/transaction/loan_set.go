@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -58,6 +59,15 @@ func (cs *CounterpartySignature) Flatten() map[string]any {
 	return flattened
 }
 
+// LoanSetMetadata represents the resulting metadata of a succeeded LoanSet transaction.
+// It extends from TxObjMeta.
+type LoanSetMetadata struct {
+	TxObjMeta
+
+	// LoanID is the ledger index of the Loan entry created by the transaction.
+	LoanID *types.Hash256 `json:"-"`
+}
+
 // LoanSet creates a new Loan object.
 //
 // ```json
@@ -196,6 +206,136 @@ func (tx *LoanSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the LoanSet to e. It writes the same fields as Flatten.
+func (tx *LoanSet) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("LoanBrokerID", tx.LoanBrokerID)
+	e.Number("PrincipalRequested", tx.PrincipalRequested.String())
+	if tx.CounterpartySignature != nil {
+		e.BeginObject("CounterpartySignature")
+		if tx.CounterpartySignature.SigningPubKey != "" {
+			e.Blob("SigningPubKey", tx.CounterpartySignature.SigningPubKey)
+		}
+		if tx.CounterpartySignature.TxnSignature != "" {
+			e.Blob("TxnSignature", tx.CounterpartySignature.TxnSignature)
+		}
+		if len(tx.CounterpartySignature.Signers) > 0 {
+			encodeBinarySigners(e, tx.CounterpartySignature.Signers)
+		}
+		e.EndObject()
+	}
+	if tx.Counterparty != nil {
+		e.AccountID("Counterparty", tx.Counterparty.String())
+	}
+	if tx.Data != nil && *tx.Data != "" {
+		e.Blob("Data", string(*tx.Data))
+	}
+	if tx.LoanOriginationFee != nil && *tx.LoanOriginationFee != "" {
+		e.Number("LoanOriginationFee", tx.LoanOriginationFee.String())
+	}
+	if tx.LoanServiceFee != nil && *tx.LoanServiceFee != "" {
+		e.Number("LoanServiceFee", tx.LoanServiceFee.String())
+	}
+	if tx.LatePaymentFee != nil && *tx.LatePaymentFee != "" {
+		e.Number("LatePaymentFee", tx.LatePaymentFee.String())
+	}
+	if tx.ClosePaymentFee != nil && *tx.ClosePaymentFee != "" {
+		e.Number("ClosePaymentFee", tx.ClosePaymentFee.String())
+	}
+	if tx.OverpaymentFee != nil && *tx.OverpaymentFee != 0 {
+		e.UInt32("OverpaymentFee", *tx.OverpaymentFee)
+	}
+	if tx.InterestRate != nil && *tx.InterestRate != 0 {
+		e.UInt32("InterestRate", uint32(*tx.InterestRate))
+	}
+	if tx.LateInterestRate != nil && *tx.LateInterestRate != 0 {
+		e.UInt32("LateInterestRate", uint32(*tx.LateInterestRate))
+	}
+	if tx.CloseInterestRate != nil && *tx.CloseInterestRate != 0 {
+		e.UInt32("CloseInterestRate", uint32(*tx.CloseInterestRate))
+	}
+	if tx.OverpaymentInterestRate != nil && *tx.OverpaymentInterestRate != 0 {
+		e.UInt32("OverpaymentInterestRate", uint32(*tx.OverpaymentInterestRate))
+	}
+	if tx.PaymentTotal != nil && *tx.PaymentTotal != 0 {
+		e.UInt32("PaymentTotal", uint32(*tx.PaymentTotal))
+	}
+	if tx.PaymentInterval != nil && *tx.PaymentInterval != 0 {
+		e.UInt32("PaymentInterval", uint32(*tx.PaymentInterval))
+	}
+	if tx.GracePeriod != nil && *tx.GracePeriod != 0 {
+		e.UInt32("GracePeriod", uint32(*tx.GracePeriod))
+	}
+}
+
+// DecodeBinary reads the LoanSet from d, skipping fields a LoanSet does not have.
+func (tx *LoanSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LoanBrokerID":
+			tx.LoanBrokerID = d.Hash()
+		case "PrincipalRequested":
+			tx.PrincipalRequested = types.XRPLNumber(d.Number())
+		case "CounterpartySignature":
+			signature := &CounterpartySignature{}
+			for d.Next() {
+				switch d.Field() {
+				case "SigningPubKey":
+					signature.SigningPubKey = d.Blob()
+				case "TxnSignature":
+					signature.TxnSignature = d.Blob()
+				case "Signers":
+					signature.Signers = decodeBinarySigners(d)
+				default:
+					d.Skip()
+				}
+			}
+			tx.CounterpartySignature = signature
+		case "Counterparty":
+			counterparty := types.Address(d.AccountID())
+			tx.Counterparty = &counterparty
+		case "Data":
+			data := types.Data(d.Blob())
+			tx.Data = &data
+		case "LoanOriginationFee":
+			tx.LoanOriginationFee = decodeBinaryNumber(d)
+		case "LoanServiceFee":
+			tx.LoanServiceFee = decodeBinaryNumber(d)
+		case "LatePaymentFee":
+			tx.LatePaymentFee = decodeBinaryNumber(d)
+		case "ClosePaymentFee":
+			tx.ClosePaymentFee = decodeBinaryNumber(d)
+		case "OverpaymentFee":
+			fee := d.UInt32()
+			tx.OverpaymentFee = &fee
+		case "InterestRate":
+			tx.InterestRate = decodeBinaryInterestRate(d)
+		case "LateInterestRate":
+			tx.LateInterestRate = decodeBinaryInterestRate(d)
+		case "CloseInterestRate":
+			tx.CloseInterestRate = decodeBinaryInterestRate(d)
+		case "OverpaymentInterestRate":
+			tx.OverpaymentInterestRate = decodeBinaryInterestRate(d)
+		case "PaymentTotal":
+			total := types.PaymentTotal(d.UInt32())
+			tx.PaymentTotal = &total
+		case "PaymentInterval":
+			interval := types.PaymentInterval(d.UInt32())
+			tx.PaymentInterval = &interval
+		case "GracePeriod":
+			period := types.GracePeriod(d.UInt32())
+			tx.GracePeriod = &period
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks LoanSet transaction fields and returns false with an error if invalid.
 func (tx *LoanSet) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
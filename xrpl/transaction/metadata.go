@@ -1,6 +1,8 @@
 package transaction
 
 import (
+	"maps"
+
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -59,3 +61,156 @@ type DeletedNode struct {
 	FinalFields     ledger.FlatLedgerObject `json:"FinalFields,omitempty"`
 	PreviousFields  ledger.FlatLedgerObject `json:"PreviousFields,omitempty"`
 }
+
+// FieldChange is the change of a ledger entry field made by a transaction.
+// Previous is nil for a field set by the transaction and Final is nil for a field it removed.
+type FieldChange struct {
+	Previous any
+	Final    any
+}
+
+// CreatedNodes returns the nodes of the given ledger entry type created by the transaction,
+// or all created nodes if the entry type is empty.
+func (m TxObjMeta) CreatedNodes(entryType ledger.EntryType) []CreatedNode {
+	nodes := make([]CreatedNode, 0)
+	for _, node := range m.AffectedNodes {
+		if node.CreatedNode == nil {
+			continue
+		}
+		if entryType == "" || node.CreatedNode.LedgerEntryType == entryType {
+			nodes = append(nodes, *node.CreatedNode)
+		}
+	}
+	return nodes
+}
+
+// CreatedObjects returns the ledger entries of the given type created by the transaction,
+// or all created ledger entries if the entry type is empty.
+func (m TxObjMeta) CreatedObjects(entryType ledger.EntryType) ([]ledger.Object, error) {
+	nodes := m.CreatedNodes(entryType)
+	objects := make([]ledger.Object, 0, len(nodes))
+	for _, node := range nodes {
+		obj, err := AffectedNode{CreatedNode: &node}.After()
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// createdIndex returns the ledger index of the first ledger entry of the given type created by
+// the transaction, or nil if there is none.
+func (m TxObjMeta) createdIndex(entryType ledger.EntryType) *types.Hash256 {
+	nodes := m.CreatedNodes(entryType)
+	if len(nodes) == 0 {
+		return nil
+	}
+	index := types.Hash256(nodes[0].LedgerIndex)
+	return &index
+}
+
+// affectedIndex returns the ledger index of the first ledger entry of the given type created,
+// modified or deleted by the transaction, or nil if there is none.
+func (m TxObjMeta) affectedIndex(entryType ledger.EntryType) *types.Hash256 {
+	for _, node := range m.AffectedNodes {
+		if node.EntryType() == entryType {
+			index := types.Hash256(node.LedgerIndex())
+			return &index
+		}
+	}
+	return nil
+}
+
+// createdField returns a string field of the first ledger entry of the given type created by
+// the transaction, or an empty string if there is none.
+func (m TxObjMeta) createdField(entryType ledger.EntryType, field string) string {
+	nodes := m.CreatedNodes(entryType)
+	if len(nodes) == 0 {
+		return ""
+	}
+	value, _ := nodes[0].NewFields[field].(string)
+	return value
+}
+
+// EntryType returns the ledger entry type of the affected node.
+func (n AffectedNode) EntryType() ledger.EntryType {
+	switch {
+	case n.CreatedNode != nil:
+		return n.CreatedNode.LedgerEntryType
+	case n.ModifiedNode != nil:
+		return n.ModifiedNode.LedgerEntryType
+	case n.DeletedNode != nil:
+		return n.DeletedNode.LedgerEntryType
+	}
+	return ""
+}
+
+// LedgerIndex returns the ledger index of the affected node.
+func (n AffectedNode) LedgerIndex() string {
+	switch {
+	case n.CreatedNode != nil:
+		return n.CreatedNode.LedgerIndex
+	case n.ModifiedNode != nil:
+		return n.ModifiedNode.LedgerIndex
+	case n.DeletedNode != nil:
+		return n.DeletedNode.LedgerIndex
+	}
+	return ""
+}
+
+// Before returns the ledger entry as it was before the transaction, or nil if the transaction
+// created it. Only the fields present in the metadata are set.
+func (n AffectedNode) Before() (ledger.Object, error) {
+	switch {
+	case n.ModifiedNode != nil:
+		return n.parse(n.ModifiedNode.FinalFields, n.ModifiedNode.PreviousFields)
+	case n.DeletedNode != nil:
+		return n.parse(n.DeletedNode.FinalFields, n.DeletedNode.PreviousFields)
+	}
+	return nil, nil
+}
+
+// After returns the ledger entry as it is after the transaction, or nil if the transaction
+// deleted it. Only the fields present in the metadata are set.
+func (n AffectedNode) After() (ledger.Object, error) {
+	switch {
+	case n.CreatedNode != nil:
+		return n.parse(n.CreatedNode.NewFields)
+	case n.ModifiedNode != nil:
+		return n.parse(n.ModifiedNode.FinalFields)
+	}
+	return nil, nil
+}
+
+// Diff returns the fields of the ledger entry changed by the transaction. A created node reports
+// all of its fields as set and a deleted node reports the fields changed before its deletion.
+func (n AffectedNode) Diff() map[string]FieldChange {
+	diff := make(map[string]FieldChange)
+	switch {
+	case n.CreatedNode != nil:
+		for field, value := range n.CreatedNode.NewFields {
+			diff[field] = FieldChange{Final: value}
+		}
+	case n.ModifiedNode != nil:
+		for field, value := range n.ModifiedNode.PreviousFields {
+			diff[field] = FieldChange{Previous: value, Final: n.ModifiedNode.FinalFields[field]}
+		}
+	case n.DeletedNode != nil:
+		for field, value := range n.DeletedNode.PreviousFields {
+			diff[field] = FieldChange{Previous: value, Final: n.DeletedNode.FinalFields[field]}
+		}
+	}
+	return diff
+}
+
+// parse decodes the given fields, applied in order, into the ledger entry type of the node.
+func (n AffectedNode) parse(fields ...ledger.FlatLedgerObject) (ledger.Object, error) {
+	flat := make(ledger.FlatLedgerObject)
+	for _, f := range fields {
+		maps.Copy(flat, f)
+	}
+	flat["LedgerEntryType"] = string(n.EntryType())
+	flat["index"] = n.LedgerIndex()
+	return ledger.Parse(flat)
+}
@@ -1,6 +1,9 @@
 package transaction
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // TxMetadataBuilder contains all `meta` transaction response fields and
 // enables specific transaction metadata building.
@@ -81,6 +84,293 @@ func (tmb TxMetadataBuilder) AsMPTokenIssuanceCreateMetadata() MPTokenIssuanceCr
 	}
 }
 
+// AsAMMCreateMetadata returns the AMMCreateMetadata.
+func (tmb TxMetadataBuilder) AsAMMCreateMetadata() AMMCreateMetadata {
+	meta := tmb.AsTxObjMeta()
+	result := AMMCreateMetadata{
+		TxObjMeta: meta,
+		AMMID:     meta.createdIndex(ledger.AMMEntry),
+	}
+	if account := meta.createdField(ledger.AMMEntry, "Account"); account != "" {
+		ammAccount := types.Address(account)
+		result.AMMAccount = &ammAccount
+	}
+	return result
+}
+
+// AsCheckCreateMetadata returns the CheckCreateMetadata.
+func (tmb TxMetadataBuilder) AsCheckCreateMetadata() CheckCreateMetadata {
+	meta := tmb.AsTxObjMeta()
+	return CheckCreateMetadata{
+		TxObjMeta: meta,
+		CheckID:   meta.createdIndex(ledger.CheckEntry),
+	}
+}
+
+// AsCredentialCreateMetadata returns the CredentialCreateMetadata.
+func (tmb TxMetadataBuilder) AsCredentialCreateMetadata() CredentialCreateMetadata {
+	meta := tmb.AsTxObjMeta()
+	return CredentialCreateMetadata{
+		TxObjMeta:    meta,
+		CredentialID: meta.createdIndex(ledger.CredentialEntry),
+	}
+}
+
+// AsDelegateSetMetadata returns the DelegateSetMetadata.
+func (tmb TxMetadataBuilder) AsDelegateSetMetadata() DelegateSetMetadata {
+	meta := tmb.AsTxObjMeta()
+	return DelegateSetMetadata{
+		TxObjMeta:  meta,
+		DelegateID: meta.affectedIndex(ledger.DelegateEntry),
+	}
+}
+
+// AsDepositPreauthMetadata returns the DepositPreauthMetadata.
+func (tmb TxMetadataBuilder) AsDepositPreauthMetadata() DepositPreauthMetadata {
+	meta := tmb.AsTxObjMeta()
+	return DepositPreauthMetadata{
+		TxObjMeta:        meta,
+		DepositPreauthID: meta.affectedIndex(ledger.DepositPreauthObjEntry),
+	}
+}
+
+// AsDIDSetMetadata returns the DIDSetMetadata.
+func (tmb TxMetadataBuilder) AsDIDSetMetadata() DIDSetMetadata {
+	meta := tmb.AsTxObjMeta()
+	return DIDSetMetadata{
+		TxObjMeta: meta,
+		DIDID:     meta.affectedIndex(ledger.DIDEntry),
+	}
+}
+
+// AsEscrowCreateMetadata returns the EscrowCreateMetadata.
+func (tmb TxMetadataBuilder) AsEscrowCreateMetadata() EscrowCreateMetadata {
+	meta := tmb.AsTxObjMeta()
+	return EscrowCreateMetadata{
+		TxObjMeta: meta,
+		EscrowID:  meta.createdIndex(ledger.EscrowEntry),
+	}
+}
+
+// AsLoanBrokerSetMetadata returns the LoanBrokerSetMetadata.
+func (tmb TxMetadataBuilder) AsLoanBrokerSetMetadata() LoanBrokerSetMetadata {
+	meta := tmb.AsTxObjMeta()
+	return LoanBrokerSetMetadata{
+		TxObjMeta:    meta,
+		LoanBrokerID: meta.createdIndex(ledger.LoanBrokerEntry),
+	}
+}
+
+// AsLoanSetMetadata returns the LoanSetMetadata.
+func (tmb TxMetadataBuilder) AsLoanSetMetadata() LoanSetMetadata {
+	meta := tmb.AsTxObjMeta()
+	return LoanSetMetadata{
+		TxObjMeta: meta,
+		LoanID:    meta.createdIndex(ledger.LoanEntry),
+	}
+}
+
+// AsMPTokenAuthorizeMetadata returns the MPTokenAuthorizeMetadata.
+func (tmb TxMetadataBuilder) AsMPTokenAuthorizeMetadata() MPTokenAuthorizeMetadata {
+	meta := tmb.AsTxObjMeta()
+	return MPTokenAuthorizeMetadata{
+		TxObjMeta: meta,
+		MPTokenID: meta.affectedIndex(ledger.MPTokenEntry),
+	}
+}
+
+// AsOfferCreateMetadata returns the OfferCreateMetadata.
+func (tmb TxMetadataBuilder) AsOfferCreateMetadata() OfferCreateMetadata {
+	meta := tmb.AsTxObjMeta()
+	return OfferCreateMetadata{
+		TxObjMeta: meta,
+		OfferID:   meta.createdIndex(ledger.OfferEntry),
+	}
+}
+
+// AsOracleSetMetadata returns the OracleSetMetadata.
+func (tmb TxMetadataBuilder) AsOracleSetMetadata() OracleSetMetadata {
+	meta := tmb.AsTxObjMeta()
+	return OracleSetMetadata{
+		TxObjMeta: meta,
+		OracleID:  meta.affectedIndex(ledger.OracleEntry),
+	}
+}
+
+// AsPaymentChannelCreateMetadata returns the PaymentChannelCreateMetadata.
+func (tmb TxMetadataBuilder) AsPaymentChannelCreateMetadata() PaymentChannelCreateMetadata {
+	meta := tmb.AsTxObjMeta()
+	return PaymentChannelCreateMetadata{
+		TxObjMeta: meta,
+		ChannelID: meta.createdIndex(ledger.PayChannelEntry),
+	}
+}
+
+// AsPermissionedDomainSetMetadata returns the PermissionedDomainSetMetadata.
+func (tmb TxMetadataBuilder) AsPermissionedDomainSetMetadata() PermissionedDomainSetMetadata {
+	meta := tmb.AsTxObjMeta()
+	return PermissionedDomainSetMetadata{
+		TxObjMeta: meta,
+		DomainID:  meta.createdIndex(ledger.PermissionedDomainEntry),
+	}
+}
+
+// AsSignerListSetMetadata returns the SignerListSetMetadata.
+func (tmb TxMetadataBuilder) AsSignerListSetMetadata() SignerListSetMetadata {
+	meta := tmb.AsTxObjMeta()
+	return SignerListSetMetadata{
+		TxObjMeta:    meta,
+		SignerListID: meta.affectedIndex(ledger.SignerListEntry),
+	}
+}
+
+// AsTicketCreateMetadata returns the TicketCreateMetadata.
+func (tmb TxMetadataBuilder) AsTicketCreateMetadata() TicketCreateMetadata {
+	meta := tmb.AsTxObjMeta()
+	result := TicketCreateMetadata{
+		TxObjMeta: meta,
+	}
+	objects, err := meta.CreatedObjects(ledger.TicketEntry)
+	if err != nil {
+		return result
+	}
+	for _, obj := range objects {
+		if ticket, ok := obj.(*ledger.Ticket); ok {
+			result.TicketSequences = append(result.TicketSequences, ticket.TicketSequence)
+		}
+	}
+	return result
+}
+
+// AsTrustSetMetadata returns the TrustSetMetadata.
+func (tmb TxMetadataBuilder) AsTrustSetMetadata() TrustSetMetadata {
+	meta := tmb.AsTxObjMeta()
+	return TrustSetMetadata{
+		TxObjMeta:   meta,
+		TrustLineID: meta.affectedIndex(ledger.RippleStateEntry),
+	}
+}
+
+// AsVaultCreateMetadata returns the VaultCreateMetadata.
+func (tmb TxMetadataBuilder) AsVaultCreateMetadata() VaultCreateMetadata {
+	meta := tmb.AsTxObjMeta()
+	result := VaultCreateMetadata{
+		TxObjMeta: meta,
+		VaultID:   meta.createdIndex(ledger.VaultEntry),
+	}
+	if shareMPTID := meta.createdField(ledger.VaultEntry, "ShareMPTID"); shareMPTID != "" {
+		id := types.MPTIssuanceID(shareMPTID)
+		result.ShareMPTID = &id
+	}
+	return result
+}
+
+// AsXChainAddAccountCreateAttestationMetadata returns the XChainAddAccountCreateAttestationMetadata.
+func (tmb TxMetadataBuilder) AsXChainAddAccountCreateAttestationMetadata() XChainAddAccountCreateAttestationMetadata {
+	meta := tmb.AsTxObjMeta()
+	return XChainAddAccountCreateAttestationMetadata{
+		TxObjMeta:                       meta,
+		XChainOwnedCreateAccountClaimID: meta.affectedIndex(ledger.XChainOwnedCreateAccountClaimIDEntry),
+	}
+}
+
+// AsXChainAddClaimAttestationMetadata returns the XChainAddClaimAttestationMetadata.
+func (tmb TxMetadataBuilder) AsXChainAddClaimAttestationMetadata() XChainAddClaimAttestationMetadata {
+	meta := tmb.AsTxObjMeta()
+	return XChainAddClaimAttestationMetadata{
+		TxObjMeta:          meta,
+		XChainOwnedClaimID: meta.affectedIndex(ledger.XChainOwnedClaimIDEntry),
+	}
+}
+
+// AsXChainCreateClaimIDMetadata returns the XChainCreateClaimIDMetadata.
+func (tmb TxMetadataBuilder) AsXChainCreateClaimIDMetadata() XChainCreateClaimIDMetadata {
+	meta := tmb.AsTxObjMeta()
+	return XChainCreateClaimIDMetadata{
+		TxObjMeta:     meta,
+		XChainClaimID: meta.createdField(ledger.XChainOwnedClaimIDEntry, "XChainClaimID"),
+	}
+}
+
+// AsXChainCreateBridgeMetadata returns the XChainCreateBridgeMetadata.
+func (tmb TxMetadataBuilder) AsXChainCreateBridgeMetadata() XChainCreateBridgeMetadata {
+	meta := tmb.AsTxObjMeta()
+	return XChainCreateBridgeMetadata{
+		TxObjMeta: meta,
+		BridgeID:  meta.createdIndex(ledger.BridgeEntry),
+	}
+}
+
+// Metadata returns the metadata of a transaction of the given type, as the typed metadata of
+// that transaction type when it has one, or as TxObjMeta otherwise.
+//
+// Every transaction that creates or updates a ledger entry it does not identify by its own fields
+// has typed metadata. The rest return TxObjMeta: transactions that only move funds or only modify
+// or delete entries they identify (for example CheckCash, EscrowFinish, NFTokenBurn or
+// VaultDeposit), pseudo-transactions, and the HASH and BINARY placeholders.
+func (tmb TxMetadataBuilder) Metadata(txType TxType) TxMeta {
+	switch txType {
+	case AMMCreateTx:
+		return tmb.AsAMMCreateMetadata()
+	case CheckCreateTx:
+		return tmb.AsCheckCreateMetadata()
+	case CredentialCreateTx:
+		return tmb.AsCredentialCreateMetadata()
+	case DelegateSetTx:
+		return tmb.AsDelegateSetMetadata()
+	case DepositPreauthTx:
+		return tmb.AsDepositPreauthMetadata()
+	case DIDSetTx:
+		return tmb.AsDIDSetMetadata()
+	case EscrowCreateTx:
+		return tmb.AsEscrowCreateMetadata()
+	case LoanBrokerSetTx:
+		return tmb.AsLoanBrokerSetMetadata()
+	case LoanSetTx:
+		return tmb.AsLoanSetMetadata()
+	case MPTokenAuthorizeTx:
+		return tmb.AsMPTokenAuthorizeMetadata()
+	case MPTokenIssuanceCreateTx:
+		return tmb.AsMPTokenIssuanceCreateMetadata()
+	case NFTokenAcceptOfferTx:
+		return tmb.AsNFTokenAcceptOfferMetadata()
+	case NFTokenCancelOfferTx:
+		return tmb.AsNFTokenCancelOfferMetadata()
+	case NFTokenCreateOfferTx:
+		return tmb.AsNFTokenCreateOfferMetadata()
+	case NFTokenMintTx:
+		return tmb.AsNFTokenMintMetadata()
+	case OfferCreateTx:
+		return tmb.AsOfferCreateMetadata()
+	case OracleSetTx:
+		return tmb.AsOracleSetMetadata()
+	case PaymentTx:
+		return tmb.AsPaymentMetadata()
+	case PaymentChannelCreateTx:
+		return tmb.AsPaymentChannelCreateMetadata()
+	case PermissionedDomainSetTx:
+		return tmb.AsPermissionedDomainSetMetadata()
+	case SignerListSetTx:
+		return tmb.AsSignerListSetMetadata()
+	case TicketCreateTx:
+		return tmb.AsTicketCreateMetadata()
+	case TrustSetTx:
+		return tmb.AsTrustSetMetadata()
+	case VaultCreateTx:
+		return tmb.AsVaultCreateMetadata()
+	case XChainAddAccountCreateAttestationTx:
+		return tmb.AsXChainAddAccountCreateAttestationMetadata()
+	case XChainAddClaimAttestationTx:
+		return tmb.AsXChainAddClaimAttestationMetadata()
+	case XChainCreateBridgeTx:
+		return tmb.AsXChainCreateBridgeMetadata()
+	case XChainCreateClaimIDTx:
+		return tmb.AsXChainCreateClaimIDMetadata()
+	default:
+		return tmb.AsTxObjMeta()
+	}
+}
+
 // AsTxObjMeta returns the base TxObjMeta metadata.
 func (tmb TxMetadataBuilder) AsTxObjMeta() TxObjMeta {
 	return TxObjMeta{
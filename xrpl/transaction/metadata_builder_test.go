@@ -1,8 +1,13 @@
 package transaction
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
 	"testing"
 
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/stretchr/testify/require"
 )
@@ -585,3 +590,309 @@ func TestTxMetadataBuilder_AsMPTokenIssuanceCreateMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestTxMetadataBuilder_AsAMMCreateMetadata(t *testing.T) {
+	builder := TxMetadataBuilder{
+		AffectedNodes: []AffectedNode{
+			{
+				CreatedNode: &CreatedNode{
+					LedgerEntryType: ledger.AMMEntry,
+					LedgerIndex:     "8A0E80B5BE3FB3E3C0CB5C2C6C9B5BA1C8A1FFB1A2EB2C4B1C7B0E2E9B8C2C3D",
+					NewFields: ledger.FlatLedgerObject{
+						"Account": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+					},
+				},
+			},
+		},
+		TransactionResult: "tesSUCCESS",
+	}
+
+	ammID := types.Hash256("8A0E80B5BE3FB3E3C0CB5C2C6C9B5BA1C8A1FFB1A2EB2C4B1C7B0E2E9B8C2C3D")
+	ammAccount := types.Address("rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM")
+	require.Equal(t, AMMCreateMetadata{
+		TxObjMeta:  builder.AsTxObjMeta(),
+		AMMID:      &ammID,
+		AMMAccount: &ammAccount,
+	}, builder.AsAMMCreateMetadata())
+}
+
+func TestTxMetadataBuilder_AsOfferCreateMetadata(t *testing.T) {
+	offerID := types.Hash256("C7C5E4A2A7B9C8E5E8D6F6A3D1A0C1B2E3F4A5B6C7D8E9F0A1B2C3D4E5F6A7B8")
+
+	tests := []struct {
+		name     string
+		builder  TxMetadataBuilder
+		expected *types.Hash256
+	}{
+		{
+			name: "pass - offer placed",
+			builder: TxMetadataBuilder{
+				AffectedNodes: []AffectedNode{
+					{CreatedNode: &CreatedNode{LedgerEntryType: ledger.DirectoryNodeEntry, LedgerIndex: "AB"}},
+					{CreatedNode: &CreatedNode{LedgerEntryType: ledger.OfferEntry, LedgerIndex: string(offerID)}},
+				},
+			},
+			expected: &offerID,
+		},
+		{
+			name: "pass - offer fully filled",
+			builder: TxMetadataBuilder{
+				AffectedNodes: []AffectedNode{
+					{ModifiedNode: &ModifiedNode{LedgerEntryType: ledger.AccountRootEntry, LedgerIndex: "AB"}},
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.builder.AsOfferCreateMetadata().OfferID)
+		})
+	}
+}
+
+func TestTxMetadataBuilder_AsTicketCreateMetadata(t *testing.T) {
+	builder := TxMetadataBuilder{
+		AffectedNodes: []AffectedNode{
+			{CreatedNode: &CreatedNode{LedgerEntryType: ledger.TicketEntry, LedgerIndex: "A1", NewFields: ledger.FlatLedgerObject{"TicketSequence": float64(4)}}},
+			{CreatedNode: &CreatedNode{LedgerEntryType: ledger.TicketEntry, LedgerIndex: "A2", NewFields: ledger.FlatLedgerObject{"TicketSequence": float64(5)}}},
+		},
+	}
+
+	require.Equal(t, []uint32{4, 5}, builder.AsTicketCreateMetadata().TicketSequences)
+}
+
+func TestTxMetadataBuilder_AsVaultCreateMetadata(t *testing.T) {
+	builder := TxMetadataBuilder{
+		AffectedNodes: []AffectedNode{
+			{
+				CreatedNode: &CreatedNode{
+					LedgerEntryType: ledger.VaultEntry,
+					LedgerIndex:     "9C8F7F6B2C6B5D1E7E4A2B3C1D0E9F8A7B6C5D4E3F2A1B0C9D8E7F6A5B4C3D2E",
+					NewFields: ledger.FlatLedgerObject{
+						"ShareMPTID": "0000000169F415C9F1AB6796AB9224CE635818AFD74F8175",
+					},
+				},
+			},
+		},
+	}
+
+	meta := builder.AsVaultCreateMetadata()
+	require.Equal(t, types.Hash256("9C8F7F6B2C6B5D1E7E4A2B3C1D0E9F8A7B6C5D4E3F2A1B0C9D8E7F6A5B4C3D2E"), *meta.VaultID)
+	require.Equal(t, types.MPTIssuanceID("0000000169F415C9F1AB6796AB9224CE635818AFD74F8175"), *meta.ShareMPTID)
+}
+
+func TestTxMetadataBuilder_AffectedEntryMetadata(t *testing.T) {
+	const index = "5A8E8D6F5C7B2E1D0C9B8A7F6E5D4C3B2A1F0E9D8C7B6A5F4E3D2C1B0A9F8E7D"
+	id := types.Hash256(index)
+
+	tests := []struct {
+		name     string
+		builder  TxMetadataBuilder
+		metadata func(tmb TxMetadataBuilder) *types.Hash256
+	}{
+		{
+			name: "pass - DIDSet creating the DID",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{ModifiedNode: &ModifiedNode{LedgerEntryType: ledger.AccountRootEntry, LedgerIndex: "AB"}},
+				{CreatedNode: &CreatedNode{LedgerEntryType: ledger.DIDEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 { return tmb.AsDIDSetMetadata().DIDID },
+		},
+		{
+			name: "pass - OracleSet updating the oracle",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{ModifiedNode: &ModifiedNode{LedgerEntryType: ledger.OracleEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 { return tmb.AsOracleSetMetadata().OracleID },
+		},
+		{
+			name: "pass - MPTokenAuthorize deleting the MPToken",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{DeletedNode: &DeletedNode{LedgerEntryType: ledger.MPTokenEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 { return tmb.AsMPTokenAuthorizeMetadata().MPTokenID },
+		},
+		{
+			name: "pass - DepositPreauth",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{CreatedNode: &CreatedNode{LedgerEntryType: ledger.DepositPreauthObjEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 { return tmb.AsDepositPreauthMetadata().DepositPreauthID },
+		},
+		{
+			name: "pass - SignerListSet",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{CreatedNode: &CreatedNode{LedgerEntryType: ledger.SignerListEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 { return tmb.AsSignerListSetMetadata().SignerListID },
+		},
+		{
+			name: "pass - DelegateSet",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{CreatedNode: &CreatedNode{LedgerEntryType: ledger.DelegateEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 { return tmb.AsDelegateSetMetadata().DelegateID },
+		},
+		{
+			name: "pass - TrustSet",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{ModifiedNode: &ModifiedNode{LedgerEntryType: ledger.RippleStateEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 { return tmb.AsTrustSetMetadata().TrustLineID },
+		},
+		{
+			name: "pass - XChainCreateBridge",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{CreatedNode: &CreatedNode{LedgerEntryType: ledger.BridgeEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 { return tmb.AsXChainCreateBridgeMetadata().BridgeID },
+		},
+		{
+			name: "pass - XChainAddClaimAttestation completing the claim",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{DeletedNode: &DeletedNode{LedgerEntryType: ledger.XChainOwnedClaimIDEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 {
+				return tmb.AsXChainAddClaimAttestationMetadata().XChainOwnedClaimID
+			},
+		},
+		{
+			name: "pass - XChainAddAccountCreateAttestation",
+			builder: TxMetadataBuilder{AffectedNodes: []AffectedNode{
+				{CreatedNode: &CreatedNode{LedgerEntryType: ledger.XChainOwnedCreateAccountClaimIDEntry, LedgerIndex: index}},
+			}},
+			metadata: func(tmb TxMetadataBuilder) *types.Hash256 {
+				return tmb.AsXChainAddAccountCreateAttestationMetadata().XChainOwnedCreateAccountClaimID
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, &id, tt.metadata(tt.builder))
+		})
+	}
+
+	t.Run("pass - no affected entry", func(t *testing.T) {
+		require.Nil(t, TxMetadataBuilder{}.AsDIDSetMetadata().DIDID)
+	})
+}
+
+func TestTxMetadataBuilder_Metadata(t *testing.T) {
+	builder := TxMetadataBuilder{
+		AffectedNodes:     []AffectedNode{},
+		TransactionResult: "tesSUCCESS",
+	}
+
+	tests := []struct {
+		txType   TxType
+		expected TxMeta
+	}{
+		{txType: PaymentTx, expected: PaymentMetadata{}},
+		{txType: EscrowCreateTx, expected: EscrowCreateMetadata{}},
+		{txType: LoanSetTx, expected: LoanSetMetadata{}},
+		{txType: CheckCreateTx, expected: CheckCreateMetadata{}},
+		{txType: AccountSetTx, expected: TxObjMeta{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.txType.String(), func(t *testing.T) {
+			require.IsType(t, tt.expected, builder.Metadata(tt.txType))
+		})
+	}
+}
+
+// untypedMetadata lists the transaction types whose metadata is TxObjMeta. A transaction type
+// that is neither here nor mapped to typed metadata by Metadata fails TestTxMetadataBuilder_MetadataCoverage.
+var untypedMetadata = map[TxType]bool{
+	AccountSetTx:                true,
+	AccountDeleteTx:             true,
+	AMMBidTx:                    true,
+	AMMClawbackTx:               true,
+	AMMDeleteTx:                 true,
+	AMMDepositTx:                true,
+	AMMVoteTx:                   true,
+	AMMWithdrawTx:               true,
+	BatchTx:                     true,
+	CheckCancelTx:               true,
+	CheckCashTx:                 true,
+	ClawbackTx:                  true,
+	CredentialAcceptTx:          true,
+	CredentialDeleteTx:          true,
+	DIDDeleteTx:                 true,
+	EscrowCancelTx:              true,
+	EscrowFinishTx:              true,
+	MPTokenIssuanceDestroyTx:    true,
+	MPTokenIssuanceSetTx:        true,
+	NFTokenBurnTx:               true,
+	NFTokenModifyTx:             true,
+	OfferCancelTx:               true,
+	OracleDeleteTx:              true,
+	PaymentChannelClaimTx:       true,
+	PaymentChannelFundTx:        true,
+	PermissionedDomainDeleteTx:  true,
+	SetRegularKeyTx:             true,
+	HashedTx:                    true,
+	BinaryTx:                    true,
+	XChainAccountCreateCommitTx: true,
+	XChainClaimTx:               true,
+	XChainCommitTx:              true,
+	XChainModifyBridgeTx:        true,
+	LoanDeleteTx:                true,
+	LoanManageTx:                true,
+	LoanPayTx:                   true,
+	LoanBrokerDeleteTx:          true,
+	LoanBrokerCoverDepositTx:    true,
+	LoanBrokerCoverWithdrawTx:   true,
+	LoanBrokerCoverClawbackTx:   true,
+	VaultSetTx:                  true,
+	VaultDeleteTx:               true,
+	VaultDepositTx:              true,
+	VaultWithdrawTx:             true,
+	VaultClawbackTx:             true,
+	EnableAmendmentTx:           true,
+	SetFeeTx:                    true,
+	UNLModifyTx:                 true,
+}
+
+// declaredTxTypes returns the TxType constants declared in tx_type.go.
+func declaredTxTypes(t *testing.T) []TxType {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "tx_type.go", nil, 0)
+	require.NoError(t, err)
+
+	var txTypes []TxType
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if ident, ok := value.Type.(*ast.Ident); !ok || ident.Name != "TxType" {
+				continue
+			}
+			for _, v := range value.Values {
+				name, err := strconv.Unquote(v.(*ast.BasicLit).Value)
+				require.NoError(t, err)
+				txTypes = append(txTypes, TxType(name))
+			}
+		}
+	}
+	return txTypes
+}
+
+func TestTxMetadataBuilder_MetadataCoverage(t *testing.T) {
+	txTypes := declaredTxTypes(t)
+	require.NotEmpty(t, txTypes)
+
+	for _, txType := range txTypes {
+		t.Run(txType.String(), func(t *testing.T) {
+			_, untyped := TxMetadataBuilder{}.Metadata(txType).(TxObjMeta)
+			require.Equal(t, untypedMetadata[txType], untyped,
+				"transaction type must have typed metadata in Metadata or be listed in untypedMetadata")
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package transaction
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAffectedNode_BeforeAfter(t *testing.T) {
+	modified := AffectedNode{
+		ModifiedNode: &ModifiedNode{
+			LedgerEntryType: ledger.AccountRootEntry,
+			LedgerIndex:     "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+			FinalFields: ledger.FlatLedgerObject{
+				"Account":    "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+				"Balance":    "369999990",
+				"OwnerCount": json.Number("1"),
+				"Sequence":   json.Number("2"),
+			},
+			PreviousFields: ledger.FlatLedgerObject{
+				"Balance":  "370000000",
+				"Sequence": json.Number("1"),
+			},
+		},
+	}
+
+	before, err := modified.Before()
+	require.NoError(t, err)
+	require.Equal(t, &ledger.AccountRoot{
+		Index:           "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+		LedgerEntryType: ledger.AccountRootEntry,
+		Account:         "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+		Balance:         types.XRPCurrencyAmount(370000000),
+		OwnerCount:      1,
+		Sequence:        1,
+	}, before)
+
+	after, err := modified.After()
+	require.NoError(t, err)
+	require.Equal(t, &ledger.AccountRoot{
+		Index:           "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+		LedgerEntryType: ledger.AccountRootEntry,
+		Account:         "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+		Balance:         types.XRPCurrencyAmount(369999990),
+		OwnerCount:      1,
+		Sequence:        2,
+	}, after)
+
+	require.Equal(t, map[string]FieldChange{
+		"Balance":  {Previous: "370000000", Final: "369999990"},
+		"Sequence": {Previous: json.Number("1"), Final: json.Number("2")},
+	}, modified.Diff())
+
+	created := AffectedNode{
+		CreatedNode: &CreatedNode{
+			LedgerEntryType: ledger.TicketEntry,
+			LedgerIndex:     "7458B6FD22827B3C141CDC88F1F0C72658C9B5D2E40961E45AF6CD31DECC0C29",
+			NewFields: ledger.FlatLedgerObject{
+				"Account":        "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+				"TicketSequence": float64(3),
+			},
+		},
+	}
+	before, err = created.Before()
+	require.NoError(t, err)
+	require.Nil(t, before)
+	require.Equal(t, map[string]FieldChange{
+		"Account":        {Final: "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7"},
+		"TicketSequence": {Final: float64(3)},
+	}, created.Diff())
+
+	deleted := AffectedNode{
+		DeletedNode: &DeletedNode{
+			LedgerEntryType: ledger.TicketEntry,
+			LedgerIndex:     "7458B6FD22827B3C141CDC88F1F0C72658C9B5D2E40961E45AF6CD31DECC0C29",
+			FinalFields: ledger.FlatLedgerObject{
+				"Account":        "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+				"TicketSequence": float64(3),
+			},
+		},
+	}
+	after, err = deleted.After()
+	require.NoError(t, err)
+	require.Nil(t, after)
+	before, err = deleted.Before()
+	require.NoError(t, err)
+	require.Equal(t, ledger.TicketEntry, before.EntryType())
+	require.Empty(t, deleted.Diff())
+}
+
+func TestTxObjMeta_CreatedObjects(t *testing.T) {
+	meta := TxObjMeta{
+		AffectedNodes: []AffectedNode{
+			{
+				ModifiedNode: &ModifiedNode{
+					LedgerEntryType: ledger.AccountRootEntry,
+					LedgerIndex:     "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+				},
+			},
+			{
+				CreatedNode: &CreatedNode{
+					LedgerEntryType: ledger.TicketEntry,
+					LedgerIndex:     "7458B6FD22827B3C141CDC88F1F0C72658C9B5D2E40961E45AF6CD31DECC0C29",
+					NewFields:       ledger.FlatLedgerObject{"TicketSequence": float64(3)},
+				},
+			},
+			{
+				CreatedNode: &CreatedNode{
+					LedgerEntryType: ledger.DirectoryNodeEntry,
+					LedgerIndex:     "2B6AC232AA4C4BE41BF49D2459FA4A0347E1B543A4C92FCEE0821C0201E2E9A8",
+				},
+			},
+		},
+	}
+
+	require.Len(t, meta.CreatedNodes(""), 2)
+	require.Len(t, meta.CreatedNodes(ledger.TicketEntry), 1)
+	require.Empty(t, meta.CreatedNodes(ledger.OfferEntry))
+
+	objects, err := meta.CreatedObjects(ledger.TicketEntry)
+	require.NoError(t, err)
+	require.Equal(t, []ledger.Object{
+		&ledger.Ticket{
+			Index:           "7458B6FD22827B3C141CDC88F1F0C72658C9B5D2E40961E45AF6CD31DECC0C29",
+			LedgerEntryType: ledger.TicketEntry,
+			TicketSequence:  3,
+		},
+	}, objects)
+}
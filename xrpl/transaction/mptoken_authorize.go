@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -16,6 +17,15 @@ const (
 	TfMPTUnauthorize uint32 = 1
 )
 
+// MPTokenAuthorizeMetadata represents the resulting metadata of a succeeded MPTokenAuthorize transaction.
+// It extends from TxObjMeta.
+type MPTokenAuthorizeMetadata struct {
+	TxObjMeta
+
+	// MPTokenID is the ledger index of the MPToken entry created, updated or deleted by the transaction.
+	MPTokenID *types.Hash256 `json:"-"`
+}
+
 // MPTokenAuthorize transaction is used to globally lock/unlock a MPTokenIssuance,
 // or lock/unlock an individual's MPToken.
 type MPTokenAuthorize struct {
@@ -55,6 +65,35 @@ func (m *MPTokenAuthorize) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the MPTokenAuthorize to e. It writes the same fields as Flatten.
+func (m *MPTokenAuthorize) EncodeBinary(e *binarycodec.Encoder) {
+	m.BaseTx.encodeBinary(e)
+	e.TransactionType(m.TxType().String())
+
+	e.Hash192("MPTokenIssuanceID", m.MPTokenIssuanceID)
+	if m.Holder != nil {
+		e.AccountID("Holder", m.Holder.String())
+	}
+}
+
+// DecodeBinary reads the MPTokenAuthorize from d, skipping fields an MPTokenAuthorize does not have.
+func (m *MPTokenAuthorize) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "MPTokenIssuanceID":
+			m.MPTokenIssuanceID = d.Hash()
+		case "Holder":
+			holder := types.Address(d.AccountID())
+			m.Holder = &holder
+		default:
+			if !m.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate validates the MPTokenAuthorize transaction ensuring all fields are correct.
 func (m *MPTokenAuthorize) Validate() (bool, error) {
 	ok, err := m.BaseTx.Validate()
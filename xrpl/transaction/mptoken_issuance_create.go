@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"strconv"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/flag"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -148,6 +151,68 @@ func (m *MPTokenIssuanceCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the MPTokenIssuanceCreate to e. It writes the same fields as Flatten.
+func (m *MPTokenIssuanceCreate) EncodeBinary(e *binarycodec.Encoder) {
+	m.BaseTx.encodeBinary(e)
+	e.TransactionType(m.TxType().String())
+
+	if m.AssetScale != nil {
+		e.UInt8("AssetScale", *m.AssetScale)
+	}
+	if m.TransferFee != nil {
+		e.UInt16("TransferFee", *m.TransferFee)
+	}
+	if m.MaximumAmount != nil {
+		encodeBinaryUInt64(e, "MaximumAmount", m.MaximumAmount.String())
+	}
+	if m.MPTokenMetadata != nil {
+		e.Blob("MPTokenMetadata", *m.MPTokenMetadata)
+	}
+	if m.DomainID != nil {
+		e.Hash256("DomainID", *m.DomainID)
+	}
+	if m.MutableFlags != nil {
+		e.UInt32("MutableFlags", *m.MutableFlags)
+	}
+}
+
+// DecodeBinary reads the MPTokenIssuanceCreate from d, skipping fields an MPTokenIssuanceCreate does not have.
+func (m *MPTokenIssuanceCreate) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "AssetScale":
+			scale := d.UInt8()
+			m.AssetScale = &scale
+		case "TransferFee":
+			fee := d.UInt16()
+			m.TransferFee = &fee
+		case "MaximumAmount":
+			// Flatten carries MaximumAmount as the decimal drops string, which the codec reads as
+			// hex, so the hex digits of the field are the decimal digits of the amount.
+			maximum, err := strconv.ParseUint(decodeBinaryUInt64(d), 10, 64)
+			if err != nil {
+				return err
+			}
+			amount := types.XRPCurrencyAmount(maximum)
+			m.MaximumAmount = &amount
+		case "MPTokenMetadata":
+			metadata := d.Blob()
+			m.MPTokenMetadata = &metadata
+		case "DomainID":
+			domainID := d.Hash()
+			m.DomainID = &domainID
+		case "MutableFlags":
+			flags := d.UInt32()
+			m.MutableFlags = &flags
+		default:
+			if !m.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // SetMPTCanLockFlag sets the TfMPTCanLock flag to allow the MPT to be locked both individually and globally.
 func (m *MPTokenIssuanceCreate) SetMPTCanLockFlag() {
 	m.Flags |= TfMPTCanLock
@@ -1,6 +1,10 @@
 package transaction
 
-import "github.com/Peersyst/xrpl-go/pkg/typecheck"
+import (
+	"github.com/Peersyst/xrpl-go/pkg/typecheck"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // MPTokenIssuanceDestroy transaction is used to remove an MPTokenIssuance object from the directory node
 // in which it is being held, effectively removing the token from the ledger ("destroying" it).
@@ -39,6 +43,29 @@ func (m *MPTokenIssuanceDestroy) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the MPTokenIssuanceDestroy to e. It writes the same fields as Flatten.
+func (m *MPTokenIssuanceDestroy) EncodeBinary(e *binarycodec.Encoder) {
+	m.BaseTx.encodeBinary(e)
+	e.TransactionType(m.TxType().String())
+
+	e.Hash192("MPTokenIssuanceID", m.MPTokenIssuanceID)
+}
+
+// DecodeBinary reads the MPTokenIssuanceDestroy from d, skipping fields an MPTokenIssuanceDestroy does not have.
+func (m *MPTokenIssuanceDestroy) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "MPTokenIssuanceID":
+			m.MPTokenIssuanceID = d.Hash()
+		default:
+			if !m.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate validates the MPTokenIssuanceDestroy transaction ensuring all fields are correct.
 func (m *MPTokenIssuanceDestroy) Validate() (bool, error) {
 	ok, err := m.BaseTx.Validate()
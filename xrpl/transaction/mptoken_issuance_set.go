@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/flag"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -107,6 +108,59 @@ func (m *MPTokenIssuanceSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the MPTokenIssuanceSet to e. It writes the same fields as Flatten.
+func (m *MPTokenIssuanceSet) EncodeBinary(e *binarycodec.Encoder) {
+	m.BaseTx.encodeBinary(e)
+	e.TransactionType(m.TxType().String())
+
+	e.Hash192("MPTokenIssuanceID", m.MPTokenIssuanceID)
+	if m.Holder != nil {
+		e.AccountID("Holder", m.Holder.String())
+	}
+	if m.DomainID != nil {
+		e.Hash256("DomainID", *m.DomainID)
+	}
+	if m.MPTokenMetadata != nil {
+		e.Blob("MPTokenMetadata", *m.MPTokenMetadata)
+	}
+	if m.TransferFee != nil {
+		e.UInt16("TransferFee", *m.TransferFee)
+	}
+	if m.MutableFlags != nil {
+		e.UInt32("MutableFlags", *m.MutableFlags)
+	}
+}
+
+// DecodeBinary reads the MPTokenIssuanceSet from d, skipping fields an MPTokenIssuanceSet does not have.
+func (m *MPTokenIssuanceSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "MPTokenIssuanceID":
+			m.MPTokenIssuanceID = d.Hash()
+		case "Holder":
+			holder := types.Address(d.AccountID())
+			m.Holder = &holder
+		case "DomainID":
+			domainID := d.Hash()
+			m.DomainID = &domainID
+		case "MPTokenMetadata":
+			metadata := d.Blob()
+			m.MPTokenMetadata = &metadata
+		case "TransferFee":
+			fee := d.UInt16()
+			m.TransferFee = &fee
+		case "MutableFlags":
+			flags := d.UInt32()
+			m.MutableFlags = &flags
+		default:
+			if !m.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // SetMPTLockFlag sets the TfMPTLock flag on the transaction.
 // Indicates that all MPT balances for this asset should be locked.
 func (m *MPTokenIssuanceSet) SetMPTLockFlag() {
@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -77,6 +80,61 @@ func (n *NFTokenAcceptOffer) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the NFTokenAcceptOffer to e. It writes the same fields as Flatten.
+func (n *NFTokenAcceptOffer) EncodeBinary(e *binarycodec.Encoder) {
+	n.BaseTx.encodeBinary(e)
+	e.TransactionType(n.TxType().String())
+
+	if n.NFTokenSellOffer != "" {
+		e.Hash256("NFTokenSellOffer", n.NFTokenSellOffer.String())
+	}
+	if n.NFTokenBuyOffer != "" {
+		e.Hash256("NFTokenBuyOffer", n.NFTokenBuyOffer.String())
+	}
+	if n.NFTokenBrokerFee != nil {
+		encodeBinaryAmount(e, "NFTokenBrokerFee", n.NFTokenBrokerFee)
+	}
+}
+
+// DecodeBinary reads the NFTokenAcceptOffer from d, skipping fields an NFTokenAcceptOffer does not have.
+func (n *NFTokenAcceptOffer) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "NFTokenSellOffer":
+			n.NFTokenSellOffer = types.Hash256(d.Hash())
+		case "NFTokenBuyOffer":
+			n.NFTokenBuyOffer = types.Hash256(d.Hash())
+		case "NFTokenBrokerFee":
+			n.NFTokenBrokerFee = decodeBinaryAmount(d)
+		default:
+			if !n.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for NFTokenAcceptOffer.
+func (n *NFTokenAcceptOffer) UnmarshalJSON(data []byte) error {
+	type Alias NFTokenAcceptOffer
+	aux := &struct {
+		NFTokenBrokerFee json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(n),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if n.NFTokenBrokerFee, err = types.UnmarshalCurrencyAmount(aux.NFTokenBrokerFee); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks the validity of the NFTokenAcceptOffer fields.
 func (n *NFTokenAcceptOffer) Validate() (bool, error) {
 	ok, err := n.BaseTx.Validate()
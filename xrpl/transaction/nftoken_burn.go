@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -56,6 +57,36 @@ func (n *NFTokenBurn) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the NFTokenBurn to e. It writes the same fields as Flatten.
+func (n *NFTokenBurn) EncodeBinary(e *binarycodec.Encoder) {
+	n.BaseTx.encodeBinary(e)
+	e.TransactionType(n.TxType().String())
+
+	if n.Owner != "" {
+		e.AccountID("Owner", n.Owner.String())
+	}
+	if n.NFTokenID != "" {
+		e.Hash256("NFTokenID", n.NFTokenID.String())
+	}
+}
+
+// DecodeBinary reads the NFTokenBurn from d, skipping fields an NFTokenBurn does not have.
+func (n *NFTokenBurn) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Owner":
+			n.Owner = types.Address(d.AccountID())
+		case "NFTokenID":
+			n.NFTokenID = types.NFTokenID(d.Hash())
+		default:
+			if !n.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks the validity of the NFTokenBurn fields.
 func (n *NFTokenBurn) Validate() (bool, error) {
 	ok, err := n.BaseTx.Validate()
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -57,6 +58,39 @@ func (n *NFTokenCancelOffer) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the NFTokenCancelOffer to e. It writes the same fields as Flatten.
+func (n *NFTokenCancelOffer) EncodeBinary(e *binarycodec.Encoder) {
+	n.BaseTx.encodeBinary(e)
+	e.TransactionType(n.TxType().String())
+
+	if len(n.NFTokenOffers) > 0 {
+		offers := make([]string, len(n.NFTokenOffers))
+		for i, offer := range n.NFTokenOffers {
+			offers[i] = offer.String()
+		}
+		e.Vector256("NFTokenOffers", offers)
+	}
+}
+
+// DecodeBinary reads the NFTokenCancelOffer from d, skipping fields an NFTokenCancelOffer does not have.
+func (n *NFTokenCancelOffer) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "NFTokenOffers":
+			offers := d.Vector256()
+			n.NFTokenOffers = make([]types.NFTokenID, len(offers))
+			for i, offer := range offers {
+				n.NFTokenOffers[i] = types.NFTokenID(offer)
+			}
+		default:
+			if !n.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks the validity of the NFTokenCancelOffer fields.
 func (n *NFTokenCancelOffer) Validate() (bool, error) {
 	ok, err := n.BaseTx.Validate()
@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/flag"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -96,6 +99,69 @@ func (n *NFTokenCreateOffer) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the NFTokenCreateOffer to e. It writes the same fields as Flatten.
+func (n *NFTokenCreateOffer) EncodeBinary(e *binarycodec.Encoder) {
+	n.BaseTx.encodeBinary(e)
+	e.TransactionType(n.TxType().String())
+
+	if n.Owner != "" {
+		e.AccountID("Owner", n.Owner.String())
+	}
+	e.Hash256("NFTokenID", n.NFTokenID.String())
+	if n.Amount != nil {
+		encodeBinaryAmount(e, "Amount", n.Amount)
+	}
+	if n.Expiration != 0 {
+		e.UInt32("Expiration", n.Expiration)
+	}
+	if n.Destination != "" {
+		e.AccountID("Destination", n.Destination.String())
+	}
+}
+
+// DecodeBinary reads the NFTokenCreateOffer from d, skipping fields an NFTokenCreateOffer does not have.
+func (n *NFTokenCreateOffer) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Owner":
+			n.Owner = types.Address(d.AccountID())
+		case "NFTokenID":
+			n.NFTokenID = types.NFTokenID(d.Hash())
+		case "Amount":
+			n.Amount = decodeBinaryAmount(d)
+		case "Expiration":
+			n.Expiration = d.UInt32()
+		case "Destination":
+			n.Destination = types.Address(d.AccountID())
+		default:
+			if !n.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for NFTokenCreateOffer.
+func (n *NFTokenCreateOffer) UnmarshalJSON(data []byte) error {
+	type Alias NFTokenCreateOffer
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(n),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if n.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks the validity of the NFTokenCreateOffer fields.
 func (n *NFTokenCreateOffer) Validate() (bool, error) {
 	ok, err := n.BaseTx.Validate()
@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/flag"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -158,6 +161,81 @@ func (n *NFTokenMint) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the NFTokenMint to e. It writes the same fields as Flatten.
+func (n *NFTokenMint) EncodeBinary(e *binarycodec.Encoder) {
+	n.BaseTx.encodeBinary(e)
+	e.TransactionType(n.TxType().String())
+
+	e.UInt32("NFTokenTaxon", n.NFTokenTaxon)
+	if n.Issuer != "" {
+		e.AccountID("Issuer", n.Issuer.String())
+	}
+	if n.TransferFee != nil {
+		e.UInt16("TransferFee", *n.TransferFee)
+	}
+	if n.URI != "" {
+		e.Blob("URI", n.URI.String())
+	}
+	if n.Amount != nil {
+		encodeBinaryAmount(e, "Amount", n.Amount)
+	}
+	if n.Expiration != nil {
+		e.UInt32("Expiration", *n.Expiration)
+	}
+	if n.Destination != "" {
+		e.AccountID("Destination", n.Destination.String())
+	}
+}
+
+// DecodeBinary reads the NFTokenMint from d, skipping fields an NFTokenMint does not have.
+func (n *NFTokenMint) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "NFTokenTaxon":
+			n.NFTokenTaxon = d.UInt32()
+		case "Issuer":
+			n.Issuer = types.Address(d.AccountID())
+		case "TransferFee":
+			fee := d.UInt16()
+			n.TransferFee = &fee
+		case "URI":
+			n.URI = types.NFTokenURI(d.Blob())
+		case "Amount":
+			n.Amount = decodeBinaryAmount(d)
+		case "Expiration":
+			expiration := d.UInt32()
+			n.Expiration = &expiration
+		case "Destination":
+			n.Destination = types.Address(d.AccountID())
+		default:
+			if !n.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for NFTokenMint.
+func (n *NFTokenMint) UnmarshalJSON(data []byte) error {
+	type Alias NFTokenMint
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(n),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if n.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 const (
 	// MaxTransferFee allows a transfer fee of up to 50%.
 	MaxTransferFee = 50000
@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -62,6 +63,39 @@ func (n *NFTokenModify) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the NFTokenModify to e. It writes the same fields as Flatten.
+func (n *NFTokenModify) EncodeBinary(e *binarycodec.Encoder) {
+	n.BaseTx.encodeBinary(e)
+	e.TransactionType(n.TxType().String())
+
+	if n.Owner != "" {
+		e.AccountID("Owner", n.Owner.String())
+	}
+	e.Hash256("NFTokenID", n.NFTokenID.String())
+	if n.URI != "" {
+		e.Blob("URI", n.URI.String())
+	}
+}
+
+// DecodeBinary reads the NFTokenModify from d, skipping fields an NFTokenModify does not have.
+func (n *NFTokenModify) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Owner":
+			n.Owner = types.Address(d.AccountID())
+		case "NFTokenID":
+			n.NFTokenID = types.NFTokenID(d.Hash())
+		case "URI":
+			n.URI = types.NFTokenURI(d.Blob())
+		default:
+			if !n.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks the NFTokenModify fields for correctness and returns an error if invalid.
 func (n *NFTokenModify) Validate() (bool, error) {
 	// Validate the base transaction
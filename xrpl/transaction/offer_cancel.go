@@ -1,5 +1,7 @@
 package transaction
 
+import binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+
 // OfferCancel transaction removes an Offer object from the XRP Ledger.
 //
 // Example:
@@ -39,6 +41,29 @@ func (o *OfferCancel) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the OfferCancel to e. It writes the same fields as Flatten.
+func (o *OfferCancel) EncodeBinary(e *binarycodec.Encoder) {
+	o.BaseTx.encodeBinary(e)
+	e.TransactionType(o.TxType().String())
+
+	e.UInt32("OfferSequence", o.OfferSequence)
+}
+
+// DecodeBinary reads the OfferCancel from d, skipping fields an OfferCancel does not have.
+func (o *OfferCancel) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "OfferSequence":
+			o.OfferSequence = d.UInt32()
+		default:
+			if !o.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks the OfferCancel transaction for correctness.
 func (o *OfferCancel) Validate() (bool, error) {
 	return o.BaseTx.Validate()
@@ -1,10 +1,23 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/flag"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// OfferCreateMetadata represents the resulting metadata of a succeeded OfferCreate transaction.
+// It extends from TxObjMeta.
+type OfferCreateMetadata struct {
+	TxObjMeta
+
+	// OfferID is the ledger index of the Offer entry placed by the transaction.
+	// It is nil when the offer was fully filled or not placed.
+	OfferID *types.Hash256 `json:"-"`
+}
+
 // OfferCreate transaction places an Offer in the decentralized exchange.
 //
 // Example:
@@ -119,6 +132,76 @@ func (o *OfferCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the OfferCreate to e. It writes the same fields as Flatten.
+func (o *OfferCreate) EncodeBinary(e *binarycodec.Encoder) {
+	o.BaseTx.encodeBinary(e)
+	e.TransactionType(o.TxType().String())
+
+	if o.Expiration != 0 {
+		e.UInt32("Expiration", o.Expiration)
+	}
+	if o.OfferSequence != 0 {
+		e.UInt32("OfferSequence", o.OfferSequence)
+	}
+	if o.TakerGets != nil {
+		encodeBinaryAmount(e, "TakerGets", o.TakerGets)
+	}
+	if o.TakerPays != nil {
+		encodeBinaryAmount(e, "TakerPays", o.TakerPays)
+	}
+	if o.DomainID != nil {
+		e.Hash256("DomainID", *o.DomainID)
+	}
+}
+
+// DecodeBinary reads the OfferCreate from d, skipping fields an OfferCreate does not have.
+func (o *OfferCreate) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Expiration":
+			o.Expiration = d.UInt32()
+		case "OfferSequence":
+			o.OfferSequence = d.UInt32()
+		case "TakerGets":
+			o.TakerGets = decodeBinaryAmount(d)
+		case "TakerPays":
+			o.TakerPays = decodeBinaryAmount(d)
+		case "DomainID":
+			domainID := d.Hash()
+			o.DomainID = &domainID
+		default:
+			if !o.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for OfferCreate.
+func (o *OfferCreate) UnmarshalJSON(data []byte) error {
+	type Alias OfferCreate
+	aux := &struct {
+		TakerGets json.RawMessage
+		TakerPays json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(o),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if o.TakerGets, err = types.UnmarshalCurrencyAmount(aux.TakerGets); err != nil {
+		return err
+	}
+	if o.TakerPays, err = types.UnmarshalCurrencyAmount(aux.TakerPays); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates the OfferCreate transaction.
 func (o *OfferCreate) Validate() (bool, error) {
 	_, err := o.BaseTx.Validate()
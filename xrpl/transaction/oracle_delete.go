@@ -1,5 +1,7 @@
 package transaction
 
+import binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+
 // OracleDelete deletes a price oracle for the Account.
 // ```json
 //
@@ -32,6 +34,29 @@ func (tx *OracleDelete) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the OracleDelete to e. It writes the same fields as Flatten.
+func (tx *OracleDelete) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.UInt32("OracleDocumentID", tx.OracleDocumentID)
+}
+
+// DecodeBinary reads the OracleDelete from d, skipping fields an OracleDelete does not have.
+func (tx *OracleDelete) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "OracleDocumentID":
+			tx.OracleDocumentID = d.UInt32()
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks that the OracleDelete transaction is valid.
 func (tx *OracleDelete) Validate() (bool, error) {
 	return tx.BaseTx.Validate()
@@ -1,7 +1,9 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
 const (
@@ -11,6 +13,15 @@ const (
 	OracleSetProviderMaxLength int = 256
 )
 
+// OracleSetMetadata represents the resulting metadata of a succeeded OracleSet transaction.
+// It extends from TxObjMeta.
+type OracleSetMetadata struct {
+	TxObjMeta
+
+	// OracleID is the ledger index of the Oracle entry created or updated by the transaction.
+	OracleID *types.Hash256 `json:"-"`
+}
+
 // OracleSet creates a new Oracle ledger entry or updates the fields of an existing one using the Oracle ID.
 //
 // The oracle provider must complete these steps before submitting this transaction:
@@ -100,6 +111,59 @@ func (tx *OracleSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the OracleSet to e. It writes the same fields as Flatten.
+func (tx *OracleSet) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.UInt32("OracleDocumentID", tx.OracleDocumentID)
+	if tx.Provider != "" {
+		e.Blob("Provider", tx.Provider)
+	}
+	if tx.URI != "" {
+		e.Blob("URI", tx.URI)
+	}
+	e.UInt32("LastUpdateTime", tx.LastUpdateTime)
+	if tx.AssetClass != "" {
+		e.Blob("AssetClass", tx.AssetClass)
+	}
+	if len(tx.PriceDataSeries) > 0 {
+		e.BeginArray("PriceDataSeries")
+		for _, priceData := range tx.PriceDataSeries {
+			encodeBinaryPriceData(e, priceData.PriceData)
+		}
+		e.EndArray()
+	}
+}
+
+// DecodeBinary reads the OracleSet from d, skipping fields an OracleSet does not have.
+func (tx *OracleSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "OracleDocumentID":
+			tx.OracleDocumentID = d.UInt32()
+		case "Provider":
+			tx.Provider = d.Blob()
+		case "URI":
+			tx.URI = d.Blob()
+		case "LastUpdateTime":
+			tx.LastUpdateTime = d.UInt32()
+		case "AssetClass":
+			tx.AssetClass = d.Blob()
+		case "PriceDataSeries":
+			tx.PriceDataSeries = nil
+			for d.Next() {
+				tx.PriceDataSeries = append(tx.PriceDataSeries, ledger.PriceDataWrapper{PriceData: decodeBinaryPriceData(d)})
+			}
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks OracleSet transaction fields and returns false with an error if invalid.
 func (tx *OracleSet) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
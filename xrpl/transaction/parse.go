@@ -0,0 +1,212 @@
+package transaction
+
+import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
+
+// Parse decodes a flat transaction into its concrete transaction type, such as *Payment for a
+// Payment or *Batch for a Batch. Flattening the returned transaction gives back the same
+// transaction, including an explicit zero Flags field.
+func Parse(flat FlatTransaction) (Tx, error) {
+	if err := flat.RequireTransactionType(); err != nil {
+		return nil, err
+	}
+
+	tx, err := newTx(flat.TxType())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := parseInto(flat, tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// parseInto decodes a flat transaction into tx, keeping an explicit zero Flags field.
+func parseInto(flat FlatTransaction, tx Tx) error {
+	data, err := json.Marshal(flat)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, tx); err != nil {
+		return err
+	}
+
+	if _, ok := flat["Flags"]; ok {
+		if f, ok := tx.(interface{ keepFlags() }); ok {
+			f.keepFlags()
+		}
+	}
+
+	return nil
+}
+
+// DecodeBlob decodes a hex encoded transaction blob into its concrete transaction type.
+func DecodeBlob(blob string) (Tx, error) {
+	flat, err := binarycodec.Decode(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(flat)
+}
+
+// newTx returns an empty transaction of the given type.
+func newTx(txType TxType) (Tx, error) {
+	switch txType {
+	case AccountSetTx:
+		return &AccountSet{}, nil
+	case AccountDeleteTx:
+		return &AccountDelete{}, nil
+	case AMMBidTx:
+		return &AMMBid{}, nil
+	case AMMClawbackTx:
+		return &AMMClawback{}, nil
+	case AMMCreateTx:
+		return &AMMCreate{}, nil
+	case AMMDeleteTx:
+		return &AMMDelete{}, nil
+	case AMMDepositTx:
+		return &AMMDeposit{}, nil
+	case AMMVoteTx:
+		return &AMMVote{}, nil
+	case AMMWithdrawTx:
+		return &AMMWithdraw{}, nil
+	case BatchTx:
+		return &Batch{}, nil
+	case CheckCancelTx:
+		return &CheckCancel{}, nil
+	case CheckCashTx:
+		return &CheckCash{}, nil
+	case CheckCreateTx:
+		return &CheckCreate{}, nil
+	case ClawbackTx:
+		return &Clawback{}, nil
+	case CredentialAcceptTx:
+		return &CredentialAccept{}, nil
+	case CredentialCreateTx:
+		return &CredentialCreate{}, nil
+	case CredentialDeleteTx:
+		return &CredentialDelete{}, nil
+	case DelegateSetTx:
+		return &DelegateSet{}, nil
+	case DepositPreauthTx:
+		return &DepositPreauth{}, nil
+	case DIDDeleteTx:
+		return &DIDDelete{}, nil
+	case DIDSetTx:
+		return &DIDSet{}, nil
+	case EscrowCancelTx:
+		return &EscrowCancel{}, nil
+	case EscrowCreateTx:
+		return &EscrowCreate{}, nil
+	case EscrowFinishTx:
+		return &EscrowFinish{}, nil
+	case MPTokenAuthorizeTx:
+		return &MPTokenAuthorize{}, nil
+	case MPTokenIssuanceCreateTx:
+		return &MPTokenIssuanceCreate{}, nil
+	case MPTokenIssuanceDestroyTx:
+		return &MPTokenIssuanceDestroy{}, nil
+	case MPTokenIssuanceSetTx:
+		return &MPTokenIssuanceSet{}, nil
+	case NFTokenAcceptOfferTx:
+		return &NFTokenAcceptOffer{}, nil
+	case NFTokenBurnTx:
+		return &NFTokenBurn{}, nil
+	case NFTokenCancelOfferTx:
+		return &NFTokenCancelOffer{}, nil
+	case NFTokenCreateOfferTx:
+		return &NFTokenCreateOffer{}, nil
+	case NFTokenMintTx:
+		return &NFTokenMint{}, nil
+	case NFTokenModifyTx:
+		return &NFTokenModify{}, nil
+	case OfferCreateTx:
+		return &OfferCreate{}, nil
+	case OfferCancelTx:
+		return &OfferCancel{}, nil
+	case OracleDeleteTx:
+		return &OracleDelete{}, nil
+	case OracleSetTx:
+		return &OracleSet{}, nil
+	case PaymentTx:
+		return &Payment{}, nil
+	case PaymentChannelClaimTx:
+		return &PaymentChannelClaim{}, nil
+	case PaymentChannelCreateTx:
+		return &PaymentChannelCreate{}, nil
+	case PaymentChannelFundTx:
+		return &PaymentChannelFund{}, nil
+	case PermissionedDomainDeleteTx:
+		return &PermissionedDomainDelete{}, nil
+	case PermissionedDomainSetTx:
+		return &PermissionedDomainSet{}, nil
+	case SetRegularKeyTx:
+		return &SetRegularKey{}, nil
+	case SignerListSetTx:
+		return &SignerListSet{}, nil
+	case TrustSetTx:
+		return &TrustSet{}, nil
+	case TicketCreateTx:
+		return &TicketCreate{}, nil
+	case XChainAccountCreateCommitTx:
+		return &XChainAccountCreateCommit{}, nil
+	case XChainAddAccountCreateAttestationTx:
+		return &XChainAddAccountCreateAttestation{}, nil
+	case XChainAddClaimAttestationTx:
+		return &XChainAddClaimAttestation{}, nil
+	case XChainCreateBridgeTx:
+		return &XChainCreateBridge{}, nil
+	case XChainCreateClaimIDTx:
+		return &XChainCreateClaimID{}, nil
+	case XChainClaimTx:
+		return &XChainClaim{}, nil
+	case XChainCommitTx:
+		return &XChainCommit{}, nil
+	case XChainModifyBridgeTx:
+		return &XChainModifyBridge{}, nil
+	case LoanSetTx:
+		return &LoanSet{}, nil
+	case LoanDeleteTx:
+		return &LoanDelete{}, nil
+	case LoanManageTx:
+		return &LoanManage{}, nil
+	case LoanPayTx:
+		return &LoanPay{}, nil
+	case LoanBrokerSetTx:
+		return &LoanBrokerSet{}, nil
+	case LoanBrokerDeleteTx:
+		return &LoanBrokerDelete{}, nil
+	case LoanBrokerCoverDepositTx:
+		return &LoanBrokerCoverDeposit{}, nil
+	case LoanBrokerCoverWithdrawTx:
+		return &LoanBrokerCoverWithdraw{}, nil
+	case LoanBrokerCoverClawbackTx:
+		return &LoanBrokerCoverClawback{}, nil
+	case VaultCreateTx:
+		return &VaultCreate{}, nil
+	case VaultSetTx:
+		return &VaultSet{}, nil
+	case VaultDeleteTx:
+		return &VaultDelete{}, nil
+	case VaultDepositTx:
+		return &VaultDeposit{}, nil
+	case VaultWithdrawTx:
+		return &VaultWithdraw{}, nil
+	case VaultClawbackTx:
+		return &VaultClawback{}, nil
+	case EnableAmendmentTx:
+		return &EnableAmendment{}, nil
+	case SetFeeTx:
+		return &SetFee{}, nil
+	case UNLModifyTx:
+		return &UNLModify{}, nil
+	default:
+		return nil, ErrUnsupportedTransactionType{Type: txType.String()}
+	}
+}
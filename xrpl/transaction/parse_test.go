@@ -0,0 +1,160 @@
+package transaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+type flattener interface {
+	Flatten() FlatTransaction
+}
+
+type codecFixture struct {
+	JSON   FlatTransaction `json:"json"`
+	Binary string          `json:"binary"`
+}
+
+// loadCodecFixtures loads the transactions of the binary codec fixtures, followed by the
+// standalone <name>-tx.json fixtures and their binary counterparts.
+func loadCodecFixtures(t *testing.T) []codecFixture {
+	t.Helper()
+
+	dir := filepath.Join("..", "..", "binary-codec", "testdata", "fixtures")
+	data, err := os.ReadFile(filepath.Join(dir, "codec-fixtures.json"))
+	require.NoError(t, err)
+
+	var fixtures struct {
+		Transactions []codecFixture `json:"transactions"`
+	}
+	require.NoError(t, json.Unmarshal(data, &fixtures))
+	require.NotEmpty(t, fixtures.Transactions)
+
+	txFiles, err := filepath.Glob(filepath.Join(dir, "*-tx.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, txFiles)
+	for _, txFile := range txFiles {
+		var fixture codecFixture
+
+		data, err := os.ReadFile(txFile)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &fixture.JSON))
+
+		binaryFile := strings.TrimSuffix(txFile, ".json") + "-binary.json"
+		if _, err := os.Stat(binaryFile); err != nil {
+			binaryFile = strings.TrimSuffix(txFile, "-tx.json") + "-binary.json"
+		}
+		data, err = os.ReadFile(binaryFile)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &fixture.Binary))
+
+		fixtures.Transactions = append(fixtures.Transactions, fixture)
+	}
+	return fixtures.Transactions
+}
+
+func TestParse(t *testing.T) {
+	for i, fixture := range loadCodecFixtures(t) {
+		t.Run(fmt.Sprintf("pass - %d %s", i, fixture.JSON["TransactionType"]), func(t *testing.T) {
+			tx, err := Parse(fixture.JSON)
+			require.NoError(t, err)
+			require.Equal(t, fixture.JSON.TxType(), tx.TxType())
+
+			encoded, err := binarycodec.Encode(tx.(flattener).Flatten())
+			require.NoError(t, err)
+			require.Equal(t, fixture.Binary, encoded)
+		})
+	}
+}
+
+func TestParse_Batch(t *testing.T) {
+	inner := &Payment{
+		BaseTx: BaseTx{
+			Account:         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			TransactionType: PaymentTx,
+			Fee:             types.XRPCurrencyAmount(0),
+			Sequence:        2,
+			Flags:           types.TfInnerBatchTxn,
+		},
+		Amount:      types.XRPCurrencyAmount(1000),
+		Destination: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+	}
+	batch := &Batch{
+		BaseTx: BaseTx{
+			Account:         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			TransactionType: BatchTx,
+			Fee:             types.XRPCurrencyAmount(40),
+			Sequence:        1,
+			Flags:           TfAllOrNothing,
+		},
+		RawTransactions: []types.RawTransaction{
+			{RawTransaction: inner.Flatten()},
+		},
+	}
+
+	parsed, err := Parse(batch.Flatten())
+	require.NoError(t, err)
+	require.IsType(t, &Batch{}, parsed)
+
+	parsedBatch := parsed.(*Batch)
+	valid, err := parsedBatch.Validate()
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	innerTxs, err := parsedBatch.InnerTransactions()
+	require.NoError(t, err)
+	require.Equal(t, []Tx{inner}, innerTxs)
+
+	expected, err := binarycodec.Encode(batch.Flatten())
+	require.NoError(t, err)
+	actual, err := binarycodec.Encode(parsedBatch.Flatten())
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		flat FlatTransaction
+		err  error
+	}{
+		{
+			name: "fail - missing transaction type",
+			flat: FlatTransaction{"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+			err:  ErrTransactionTypeMissing,
+		},
+		{
+			name: "fail - unsupported transaction type",
+			flat: FlatTransaction{"TransactionType": "Unknown"},
+			err:  ErrUnsupportedTransactionType{Type: "Unknown"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.flat)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestDecodeBlob(t *testing.T) {
+	for i, fixture := range loadCodecFixtures(t) {
+		t.Run(fmt.Sprintf("pass - %d %s", i, fixture.JSON["TransactionType"]), func(t *testing.T) {
+			tx, err := DecodeBlob(fixture.Binary)
+			require.NoError(t, err)
+			require.Equal(t, fixture.JSON.TxType(), tx.TxType())
+
+			encoded, err := binarycodec.Encode(tx.(flattener).Flatten())
+			require.NoError(t, err)
+			require.Equal(t, fixture.Binary, encoded)
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/flag"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -126,7 +129,7 @@ func (p *Payment) Flatten() FlatTransaction {
 	}
 
 	if len(p.Paths) > 0 {
-		flattenedPaths := make([][]any, len(p.Paths))
+		flattenedPaths := make([]any, len(p.Paths))
 		for i, path := range p.Paths {
 			flattenedPath := make([]any, len(path))
 			for j, step := range path {
@@ -151,6 +154,132 @@ func (p *Payment) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the Payment to e. It writes the same fields as Flatten.
+func (p *Payment) EncodeBinary(e *binarycodec.Encoder) {
+	p.BaseTx.encodeBinary(e)
+	e.TransactionType(PaymentTx.String())
+
+	if p.Amount != nil {
+		encodeBinaryAmount(e, "Amount", p.Amount)
+	}
+	if len(p.CredentialIDs) > 0 {
+		e.Vector256("CredentialIDs", p.CredentialIDs)
+	}
+	if p.DeliverMax != nil {
+		encodeBinaryAmount(e, "DeliverMax", p.DeliverMax)
+	}
+	if p.DeliverMin != nil {
+		encodeBinaryAmount(e, "DeliverMin", p.DeliverMin)
+	}
+	if p.Destination != "" {
+		e.AccountID("Destination", p.Destination.String())
+	}
+	if p.DestinationTag != nil {
+		e.UInt32("DestinationTag", *p.DestinationTag)
+	}
+	if p.InvoiceID != "" {
+		e.Hash256("InvoiceID", p.InvoiceID.String())
+	}
+	if len(p.Paths) > 0 {
+		paths := make([][]binarycodec.PathStep, len(p.Paths))
+		for i, path := range p.Paths {
+			paths[i] = make([]binarycodec.PathStep, len(path))
+			for j, step := range path {
+				paths[i][j] = binarycodec.PathStep{
+					Account:  step.Account.String(),
+					Currency: step.Currency,
+					Issuer:   step.Issuer.String(),
+				}
+			}
+		}
+		e.PathSet("Paths", paths)
+	}
+	if p.SendMax != nil {
+		encodeBinaryAmount(e, "SendMax", p.SendMax)
+	}
+	if p.DomainID != nil {
+		e.Hash256("DomainID", *p.DomainID)
+	}
+}
+
+// DecodeBinary reads the Payment from d, skipping fields a Payment does not have.
+func (p *Payment) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			p.Amount = decodeBinaryAmount(d)
+		case "CredentialIDs":
+			p.CredentialIDs = d.Vector256()
+		case "DeliverMax":
+			p.DeliverMax = decodeBinaryAmount(d)
+		case "DeliverMin":
+			p.DeliverMin = decodeBinaryAmount(d)
+		case "Destination":
+			p.Destination = types.Address(d.AccountID())
+		case "DestinationTag":
+			tag := d.UInt32()
+			p.DestinationTag = &tag
+		case "InvoiceID":
+			p.InvoiceID = types.Hash256(d.Hash())
+		case "Paths":
+			p.Paths = nil
+			for _, path := range d.PathSet() {
+				steps := make([]PathStep, len(path))
+				for i, step := range path {
+					steps[i] = PathStep{
+						Account:  types.Address(step.Account),
+						Currency: step.Currency,
+						Issuer:   types.Address(step.Issuer),
+					}
+				}
+				p.Paths = append(p.Paths, steps)
+			}
+		case "SendMax":
+			p.SendMax = decodeBinaryAmount(d)
+		case "DomainID":
+			domainID := d.Hash()
+			p.DomainID = &domainID
+		default:
+			if !p.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for Payment.
+func (p *Payment) UnmarshalJSON(data []byte) error {
+	type Alias Payment
+	aux := &struct {
+		Amount     json.RawMessage
+		DeliverMax json.RawMessage
+		DeliverMin json.RawMessage
+		SendMax    json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if p.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	if p.DeliverMax, err = types.UnmarshalCurrencyAmount(aux.DeliverMax); err != nil {
+		return err
+	}
+	if p.DeliverMin, err = types.UnmarshalCurrencyAmount(aux.DeliverMin); err != nil {
+		return err
+	}
+	if p.SendMax, err = types.UnmarshalCurrencyAmount(aux.SendMax); err != nil {
+		return err
+	}
+	return nil
+}
+
 // SetRippleNotDirectFlag sets the RippleNotDirect flag.
 //
 // RippleNotDirect: Do not use the default path; only use paths included in the Paths field.
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -111,6 +112,56 @@ func (p *PaymentChannelClaim) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the PaymentChannelClaim to e. It writes the same fields as Flatten.
+func (p *PaymentChannelClaim) EncodeBinary(e *binarycodec.Encoder) {
+	p.BaseTx.encodeBinary(e)
+	e.TransactionType(p.TxType().String())
+
+	if p.Channel != "" {
+		e.Hash256("Channel", p.Channel.String())
+	}
+	if p.Balance != 0 {
+		e.XRPAmount("Balance", p.Balance.Uint64())
+	}
+	if p.Amount != 0 {
+		e.XRPAmount("Amount", p.Amount.Uint64())
+	}
+	if p.Signature != "" {
+		e.Blob("Signature", p.Signature)
+	}
+	if p.PublicKey != "" {
+		e.Blob("PublicKey", p.PublicKey)
+	}
+	if len(p.CredentialIDs) > 0 {
+		e.Vector256("CredentialIDs", p.CredentialIDs)
+	}
+}
+
+// DecodeBinary reads the PaymentChannelClaim from d, skipping fields a PaymentChannelClaim does not have.
+func (p *PaymentChannelClaim) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Channel":
+			p.Channel = types.Hash256(d.Hash())
+		case "Balance":
+			p.Balance = types.XRPCurrencyAmount(d.Amount().Drops)
+		case "Amount":
+			p.Amount = types.XRPCurrencyAmount(d.Amount().Drops)
+		case "Signature":
+			p.Signature = d.Blob()
+		case "PublicKey":
+			p.PublicKey = d.Blob()
+		case "CredentialIDs":
+			p.CredentialIDs = d.Vector256()
+		default:
+			if !p.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // SetRenewFlag sets the Renew flag.
 //
 // Renew: Clear the channel's Expiration time. (Expiration is different from the
@@ -2,10 +2,20 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// PaymentChannelCreateMetadata represents the resulting metadata of a succeeded PaymentChannelCreate transaction.
+// It extends from TxObjMeta.
+type PaymentChannelCreateMetadata struct {
+	TxObjMeta
+
+	// ChannelID is the ledger index of the PayChannel entry created by the transaction.
+	ChannelID *types.Hash256 `json:"-"`
+}
+
 // PaymentChannelCreate creates a payment channel and funds it with XRP. The sender becomes the "source address" of the channel.
 //
 // Example:
@@ -71,6 +81,49 @@ func (p *PaymentChannelCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the PaymentChannelCreate to e. It writes the same fields as Flatten.
+func (p *PaymentChannelCreate) EncodeBinary(e *binarycodec.Encoder) {
+	p.BaseTx.encodeBinary(e)
+	e.TransactionType(p.TxType().String())
+
+	e.XRPAmount("Amount", p.Amount.Uint64())
+	e.AccountID("Destination", p.Destination.String())
+	e.UInt32("SettleDelay", p.SettleDelay)
+	e.Blob("PublicKey", p.PublicKey)
+	if p.CancelAfter != 0 {
+		e.UInt32("CancelAfter", p.CancelAfter)
+	}
+	if p.DestinationTag != nil {
+		e.UInt32("DestinationTag", *p.DestinationTag)
+	}
+}
+
+// DecodeBinary reads the PaymentChannelCreate from d, skipping fields a PaymentChannelCreate does not have.
+func (p *PaymentChannelCreate) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			p.Amount = types.XRPCurrencyAmount(d.Amount().Drops)
+		case "Destination":
+			p.Destination = types.Address(d.AccountID())
+		case "SettleDelay":
+			p.SettleDelay = d.UInt32()
+		case "PublicKey":
+			p.PublicKey = d.Blob()
+		case "CancelAfter":
+			p.CancelAfter = d.UInt32()
+		case "DestinationTag":
+			tag := d.UInt32()
+			p.DestinationTag = &tag
+		default:
+			if !p.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate validates the PaymentChannelCreate fields.
 func (p *PaymentChannelCreate) Validate() (bool, error) {
 	ok, err := p.BaseTx.Validate()
@@ -3,6 +3,7 @@ package transaction
 import (
 	"time"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	rippletime "github.com/Peersyst/xrpl-go/xrpl/time"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -54,6 +55,37 @@ func (p *PaymentChannelFund) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the PaymentChannelFund to e. It writes the same fields as Flatten.
+func (p *PaymentChannelFund) EncodeBinary(e *binarycodec.Encoder) {
+	p.BaseTx.encodeBinary(e)
+	e.TransactionType(p.TxType().String())
+
+	e.Hash256("Channel", p.Channel.String())
+	e.XRPAmount("Amount", p.Amount.Uint64())
+	if p.Expiration != 0 {
+		e.UInt32("Expiration", p.Expiration)
+	}
+}
+
+// DecodeBinary reads the PaymentChannelFund from d, skipping fields a PaymentChannelFund does not have.
+func (p *PaymentChannelFund) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Channel":
+			p.Channel = types.Hash256(d.Hash())
+		case "Amount":
+			p.Amount = types.XRPCurrencyAmount(d.Amount().Drops)
+		case "Expiration":
+			p.Expiration = d.UInt32()
+		default:
+			if !p.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate validates the PaymentChannelFund fields.
 func (p *PaymentChannelFund) Validate() (bool, error) {
 	ok, err := p.BaseTx.Validate()
@@ -565,8 +565,8 @@ func TestPayment_Flatten(t *testing.T) {
 				"Destination":    "r3dFAtNXwRFCyBGz5BcWhMj9a4cm7qkzzn",
 				"DestinationTag": uint32(12345),
 				"InvoiceID":      "ABC123",
-				"Paths": [][]any{
-					{
+				"Paths": []any{
+					[]any{
 						map[string]any{
 							"account":  "r3dFAtNXwRFCyBGz5BcWhMj9a4cm7qkzzn",
 							"currency": "USD",
@@ -1,5 +1,7 @@
 package transaction
 
+import binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+
 // PermissionedDomainDelete deletes a permissioned domain that you own.
 // (Requires the PermissionedDomains amendment)
 //
@@ -33,6 +35,29 @@ func (p *PermissionedDomainDelete) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the PermissionedDomainDelete to e. It writes the same fields as Flatten.
+func (p *PermissionedDomainDelete) EncodeBinary(e *binarycodec.Encoder) {
+	p.BaseTx.encodeBinary(e)
+	e.TransactionType(p.TxType().String())
+
+	e.Hash256("DomainID", p.DomainID)
+}
+
+// DecodeBinary reads the PermissionedDomainDelete from d, skipping fields a PermissionedDomainDelete does not have.
+func (p *PermissionedDomainDelete) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "DomainID":
+			p.DomainID = d.Hash()
+		default:
+			if !p.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate validates the PermissionedDomainDelete transaction.
 // It ensures that the base transaction is valid and that the required DomainID field is present.
 func (p *PermissionedDomainDelete) Validate() (bool, error) {
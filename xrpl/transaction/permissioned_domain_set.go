@@ -1,9 +1,20 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// PermissionedDomainSetMetadata represents the resulting metadata of a succeeded PermissionedDomainSet transaction.
+// It extends from TxObjMeta.
+type PermissionedDomainSetMetadata struct {
+	TxObjMeta
+
+	// DomainID is the ledger index of the PermissionedDomain entry created by the transaction.
+	// It is nil when the transaction updated an existing domain.
+	DomainID *types.Hash256 `json:"-"`
+}
+
 // PermissionedDomainSet creates a permissioned domain, or modifies one that you own.
 // (Requires the PermissionedDomains amendment)
 //
@@ -54,6 +65,49 @@ func (p *PermissionedDomainSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the PermissionedDomainSet to e. It writes the same fields as Flatten.
+func (p *PermissionedDomainSet) EncodeBinary(e *binarycodec.Encoder) {
+	p.BaseTx.encodeBinary(e)
+	e.TransactionType(p.TxType().String())
+
+	if p.DomainID != "" {
+		e.Hash256("DomainID", p.DomainID)
+	}
+	e.BeginArray("AcceptedCredentials")
+	for _, credential := range p.AcceptedCredentials {
+		e.BeginObject("Credential")
+		e.AccountID("Issuer", credential.Credential.Issuer.String())
+		e.Blob("CredentialType", credential.Credential.CredentialType.String())
+		e.EndObject()
+	}
+	e.EndArray()
+}
+
+// DecodeBinary reads the PermissionedDomainSet from d, skipping fields a PermissionedDomainSet does not have.
+func (p *PermissionedDomainSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "DomainID":
+			p.DomainID = d.Hash()
+		case "AcceptedCredentials":
+			p.AcceptedCredentials = types.AuthorizeCredentialList{}
+			for _, credential := range decodeBinaryCredentials(d) {
+				p.AcceptedCredentials = append(p.AcceptedCredentials, types.AuthorizeCredential{
+					Credential: types.Credential{
+						Issuer:         credential.Credential.Issuer,
+						CredentialType: credential.Credential.CredentialType,
+					},
+				})
+			}
+		default:
+			if !p.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate validates the PermissionedDomainSet transaction.
 func (p *PermissionedDomainSet) Validate() (bool, error) {
 	if ok, err := p.BaseTx.Validate(); !ok {
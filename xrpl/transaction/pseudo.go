@@ -0,0 +1,13 @@
+package transaction
+
+// flattenPseudo flattens the common fields of a pseudo-transaction, which always carries a
+// Fee, a Sequence and an empty SigningPubKey, even when they are zero.
+func (tx *BaseTx) flattenPseudo() FlatTransaction {
+	flattened := tx.Flatten()
+
+	flattened["Fee"] = tx.Fee.String()
+	flattened["Sequence"] = tx.Sequence
+	flattened["SigningPubKey"] = tx.SigningPubKey
+
+	return flattened
+}
@@ -0,0 +1,103 @@
+package transaction
+
+import (
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxType_IsPseudo(t *testing.T) {
+	require.True(t, EnableAmendmentTx.IsPseudo())
+	require.True(t, SetFeeTx.IsPseudo())
+	require.True(t, UNLModifyTx.IsPseudo())
+	require.False(t, PaymentTx.IsPseudo())
+}
+
+func TestFlatTransaction_RequireNotPseudo(t *testing.T) {
+	require.ErrorIs(t, FlatTransaction{"TransactionType": "SetFee"}.RequireNotPseudo(), ErrPseudoTransaction)
+	require.NoError(t, FlatTransaction{"TransactionType": "Payment"}.RequireNotPseudo())
+}
+
+func TestPseudoTransaction_DecodeBlob(t *testing.T) {
+	ledgerSequence := uint32(66462465)
+	referenceFeeUnits := uint32(10)
+	reserveBase := uint32(20000000)
+	reserveIncrement := uint32(5000000)
+	baseFeeDrops := types.XRPCurrencyAmount(10)
+	reserveBaseDrops := types.XRPCurrencyAmount(1000000)
+	reserveIncrementDrops := types.XRPCurrencyAmount(200000)
+
+	tests := []struct {
+		name string
+		tx   Tx
+	}{
+		{
+			name: "pass - enable amendment",
+			tx: &EnableAmendment{
+				BaseTx: BaseTx{
+					Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+					TransactionType: EnableAmendmentTx,
+					Flags:           TfLostMajority,
+				},
+				Amendment:      "42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE",
+				LedgerSequence: 21225473,
+			},
+		},
+		{
+			name: "pass - legacy set fee",
+			tx: &SetFee{
+				BaseTx: BaseTx{
+					Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+					TransactionType: SetFeeTx,
+				},
+				LedgerSequence:    &ledgerSequence,
+				BaseFee:           "000000000000000A",
+				ReferenceFeeUnits: &referenceFeeUnits,
+				ReserveBase:       &reserveBase,
+				ReserveIncrement:  &reserveIncrement,
+			},
+		},
+		{
+			name: "pass - XRPFees set fee",
+			tx: &SetFee{
+				BaseTx: BaseTx{
+					Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+					TransactionType: SetFeeTx,
+				},
+				LedgerSequence:        &ledgerSequence,
+				BaseFeeDrops:          &baseFeeDrops,
+				ReserveBaseDrops:      &reserveBaseDrops,
+				ReserveIncrementDrops: &reserveIncrementDrops,
+			},
+		},
+		{
+			name: "pass - unl modify",
+			tx: &UNLModify{
+				BaseTx: BaseTx{
+					Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+					TransactionType: UNLModifyTx,
+				},
+				LedgerSequence:     1600000,
+				UNLModifyDisabling: 1,
+				UNLModifyValidator: "ED6629D456285AE3613B285F65BBFF168D695BA3921F309949AFCD2CA7AFEC16FE",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := binarycodec.Encode(tt.tx.(flattener).Flatten())
+			require.NoError(t, err)
+
+			decoded, err := DecodeBlob(blob)
+			require.NoError(t, err)
+			require.Equal(t, tt.tx, decoded)
+
+			valid, err := decoded.(interface{ Validate() (bool, error) }).Validate()
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
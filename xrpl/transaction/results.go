@@ -1,8 +1,30 @@
 package transaction
 
+import "github.com/Peersyst/xrpl-go/binary-codec/definitions"
+
 // TxResult represents the result code of a transaction
 type TxResult string
 
+// TxResultCategory represents the category of a transaction result, given by the prefix of its code.
+type TxResultCategory string
+
+const (
+	// TxResultCategoryUnknown is the category of a result code without a known prefix.
+	TxResultCategoryUnknown TxResultCategory = ""
+	// TxResultCategoryTes is the category of the tesSUCCESS result.
+	TxResultCategoryTes TxResultCategory = "tes"
+	// TxResultCategoryTec is the category of results that claimed a fee without applying the transaction.
+	TxResultCategoryTec TxResultCategory = "tec"
+	// TxResultCategoryTef is the category of results that failed without being applied to a ledger.
+	TxResultCategoryTef TxResultCategory = "tef"
+	// TxResultCategoryTel is the category of local errors of the server processing the transaction.
+	TxResultCategoryTel TxResultCategory = "tel"
+	// TxResultCategoryTem is the category of malformed transaction results.
+	TxResultCategoryTem TxResultCategory = "tem"
+	// TxResultCategoryTer is the category of results that could succeed if retried.
+	TxResultCategoryTer TxResultCategory = "ter"
+)
+
 //revive:disable:var-naming,exported
 // #nosec G101
 
@@ -95,6 +117,9 @@ const (
 	// The OfferCreate transaction specified TfFillOrKill and could not be filled.
 	TecKILLED TxResult = "tecKILLED"
 
+	// The transaction failed because the asset is locked, for example a locked MPT balance or issuance.
+	TecLOCKED TxResult = "tecLOCKED"
+
 	// A sequence number field is already at its maximum. (Added by NonFungibleTokensV1_1 amendment)
 	TecMAX_SEQUENCE_REACHED TxResult = "tecMAX_SEQUENCE_REACHED"
 
@@ -176,6 +201,90 @@ const (
 	// Offer creation failed due to lack of the TakerGets currency.
 	TecUNFUNDED_OFFER TxResult = "tecUNFUNDED_OFFER"
 
+	// Insufficient balance to fund AMM.
+	TecUNFUNDED_AMM TxResult = "tecUNFUNDED_AMM"
+
+	// Some work was completed, but more submissions required to finish.
+	TecINCOMPLETE TxResult = "tecINCOMPLETE"
+
+	// Bad xchain transfer issue.
+	TecXCHAIN_BAD_TRANSFER_ISSUE TxResult = "tecXCHAIN_BAD_TRANSFER_ISSUE"
+
+	// No such xchain claim id.
+	TecXCHAIN_NO_CLAIM_ID TxResult = "tecXCHAIN_NO_CLAIM_ID"
+
+	// Bad xchain claim id.
+	TecXCHAIN_BAD_CLAIM_ID TxResult = "tecXCHAIN_BAD_CLAIM_ID"
+
+	// Quorum was not reached on the xchain claim.
+	TecXCHAIN_CLAIM_NO_QUORUM TxResult = "tecXCHAIN_CLAIM_NO_QUORUM"
+
+	// Unknown key for the xchain proof.
+	TecXCHAIN_PROOF_UNKNOWN_KEY TxResult = "tecXCHAIN_PROOF_UNKNOWN_KEY"
+
+	// Only XRP may be used for xchain create account.
+	TecXCHAIN_CREATE_ACCOUNT_NONXRP_ISSUE TxResult = "tecXCHAIN_CREATE_ACCOUNT_NONXRP_ISSUE"
+
+	// XChain Transaction was submitted to the wrong chain.
+	TecXCHAIN_WRONG_CHAIN TxResult = "tecXCHAIN_WRONG_CHAIN"
+
+	// The reward amount must match the reward specified in the xchain bridge.
+	TecXCHAIN_REWARD_MISMATCH TxResult = "tecXCHAIN_REWARD_MISMATCH"
+
+	// The account did not have a signers list.
+	TecXCHAIN_NO_SIGNERS_LIST TxResult = "tecXCHAIN_NO_SIGNERS_LIST"
+
+	// The sending account did not match the expected sending account.
+	TecXCHAIN_SENDING_ACCOUNT_MISMATCH TxResult = "tecXCHAIN_SENDING_ACCOUNT_MISMATCH"
+
+	// Insufficient amount to create an account.
+	TecXCHAIN_INSUFF_CREATE_AMOUNT TxResult = "tecXCHAIN_INSUFF_CREATE_AMOUNT"
+
+	// The account create count has already passed.
+	TecXCHAIN_ACCOUNT_CREATE_PAST TxResult = "tecXCHAIN_ACCOUNT_CREATE_PAST"
+
+	// There are too many pending account create transactions to submit a new one.
+	TecXCHAIN_ACCOUNT_CREATE_TOO_MANY TxResult = "tecXCHAIN_ACCOUNT_CREATE_TOO_MANY"
+
+	// Failed to transfer funds in a xchain transaction.
+	TecXCHAIN_PAYMENT_FAILED TxResult = "tecXCHAIN_PAYMENT_FAILED"
+
+	// Account cannot commit funds to itself.
+	TecXCHAIN_SELF_COMMIT TxResult = "tecXCHAIN_SELF_COMMIT"
+
+	// Bad public key account pair in an xchain transaction.
+	TecXCHAIN_BAD_PUBLIC_KEY_ACCOUNT_PAIR TxResult = "tecXCHAIN_BAD_PUBLIC_KEY_ACCOUNT_PAIR"
+
+	// This bridge does not support account creation.
+	TecXCHAIN_CREATE_ACCOUNT_DISABLED TxResult = "tecXCHAIN_CREATE_ACCOUNT_DISABLED"
+
+	// The Oracle object has invalid LastUpdateTime field.
+	TecINVALID_UPDATE_TIME TxResult = "tecINVALID_UPDATE_TIME"
+
+	// Token pair is not found in Oracle object.
+	TecTOKEN_PAIR_NOT_FOUND TxResult = "tecTOKEN_PAIR_NOT_FOUND"
+
+	// Array is empty.
+	TecARRAY_EMPTY TxResult = "tecARRAY_EMPTY"
+
+	// Array is too large.
+	TecARRAY_TOO_LARGE TxResult = "tecARRAY_TOO_LARGE"
+
+	// Bad credentials.
+	TecBAD_CREDENTIALS TxResult = "tecBAD_CREDENTIALS"
+
+	// Wrong asset given.
+	TecWRONG_ASSET TxResult = "tecWRONG_ASSET"
+
+	// Limit exceeded.
+	TecLIMIT_EXCEEDED TxResult = "tecLIMIT_EXCEEDED"
+
+	// This operation is not allowed against a pseudo-account.
+	TecPSEUDO_ACCOUNT TxResult = "tecPSEUDO_ACCOUNT"
+
+	// The amounts used by the transaction cannot interact.
+	TecPRECISION_LOSS TxResult = "tecPRECISION_LOSS"
+
 	// ------------------------------------------------------------------------------------------------
 	// tef codes ⬇️ - https://xrpl.org/docs/references/protocol/transactions/transaction-results/tef-codes
 	//
@@ -248,6 +357,9 @@ const (
 	// AccountTxnID does not match the account's previous transaction.
 	TefWRONG_PRIOR TxResult = "tefWRONG_PRIOR"
 
+	// The LedgerFixType field has an invalid value.
+	TefINVALID_LEDGER_FIX_TYPE TxResult = "tefINVALID_LEDGER_FIX_TYPE"
+
 	// ------------------------------------------------------------------------------------------------
 	// tel codes ⬇️ - https://xrpl.org/docs/references/protocol/transactions/transaction-results/tel-codes
 	// These codes indicate an error in the local server processing the transaction; it is possible that another server with a different configuration or load level could process the transaction successfully.
@@ -302,6 +414,9 @@ const (
 	// Transaction specifies incorrect NetworkID value for the current network.
 	TelWRONG_NETWORK TxResult = "telWRONG_NETWORK"
 
+	// Unit test RPC failure.
+	TelENV_RPC_FAILED TxResult = "telENV_RPC_FAILED"
+
 	// ------------------------------------------------------------------------------------------------
 	// tem codes ⬇️ - https://xrpl.org/docs/references/protocol/transactions/transaction-results/tem-codes
 	//
@@ -420,6 +535,54 @@ const (
 	// Transaction requires disabled logic or amendment.
 	TemDISABLED TxResult = "temDISABLED"
 
+	// Malformed: Regular key cannot be same as master key.
+	TemBAD_REGKEY TxResult = "temBAD_REGKEY"
+
+	// Malformed: Tick size out of range.
+	TemBAD_TICK_SIZE TxResult = "temBAD_TICK_SIZE"
+
+	// Malformed: A field contains an invalid account ID.
+	TemINVALID_ACCOUNT_ID TxResult = "temINVALID_ACCOUNT_ID"
+
+	// Transaction contains a TicketSequence and a non-zero Sequence.
+	TemSEQ_AND_TICKET TxResult = "temSEQ_AND_TICKET"
+
+	// Malformed: Bridge must have unique door accounts.
+	TemXCHAIN_EQUAL_DOOR_ACCOUNTS TxResult = "temXCHAIN_EQUAL_DOOR_ACCOUNTS"
+
+	// Malformed: Bad cross-chain claim proof.
+	TemXCHAIN_BAD_PROOF TxResult = "temXCHAIN_BAD_PROOF"
+
+	// Malformed: Bad bridge issues.
+	TemXCHAIN_BRIDGE_BAD_ISSUES TxResult = "temXCHAIN_BRIDGE_BAD_ISSUES"
+
+	// Malformed: Bridge owner must be one of the door accounts.
+	TemXCHAIN_BRIDGE_NONDOOR_OWNER TxResult = "temXCHAIN_BRIDGE_NONDOOR_OWNER"
+
+	// Malformed: Bad min account create amount.
+	TemXCHAIN_BRIDGE_BAD_MIN_ACCOUNT_CREATE_AMOUNT TxResult = "temXCHAIN_BRIDGE_BAD_MIN_ACCOUNT_CREATE_AMOUNT"
+
+	// Malformed: Bad reward amount.
+	TemXCHAIN_BRIDGE_BAD_REWARD_AMOUNT TxResult = "temXCHAIN_BRIDGE_BAD_REWARD_AMOUNT"
+
+	// Malformed: No DID data provided.
+	TemEMPTY_DID TxResult = "temEMPTY_DID"
+
+	// Malformed: Array is empty.
+	TemARRAY_EMPTY TxResult = "temARRAY_EMPTY"
+
+	// Malformed: Array is too large.
+	TemARRAY_TOO_LARGE TxResult = "temARRAY_TOO_LARGE"
+
+	// Malformed: Transfer fee is outside valid range.
+	TemBAD_TRANSFER_FEE TxResult = "temBAD_TRANSFER_FEE"
+
+	// Malformed: Invalid inner batch transaction.
+	TemINVALID_INNER_BATCH TxResult = "temINVALID_INNER_BATCH"
+
+	// Malformed: Invalid MPToken.
+	TemBAD_MPT TxResult = "temBAD_MPT"
+
 	// ------------------------------------------------------------------------------------------------
 	// ter codes ⬇️ - https://xrpl.org/docs/references/protocol/transactions/transaction-results/ter-codes
 	//
@@ -469,6 +632,15 @@ const (
 	// Transaction submitted but not yet applied.
 	TerSUBMITTED TxResult = "terSUBMITTED"
 
+	// Failed to allocate an unique account address.
+	TerADDRESS_COLLISION TxResult = "terADDRESS_COLLISION"
+
+	// Delegated account lacks permission to perform this transaction.
+	TerNO_DELEGATE_PERMISSION TxResult = "terNO_DELEGATE_PERMISSION"
+
+	// Fund is locked.
+	TerLOCKED TxResult = "terLOCKED"
+
 	// ------------------------------------------------------------------------------------------------
 	// Success results - https://xrpl.org/docs/references/protocol/transactions/transaction-results/tes-success
 	// ------------------------------------------------------------------------------------------------
@@ -484,3 +656,85 @@ const (
 func (t TxResult) String() string {
 	return string(t)
 }
+
+// TxResultFromCode returns the transaction result with the given numeric code.
+func TxResultFromCode(code int32) (TxResult, error) {
+	name, err := definitions.Get().GetTransactionResultNameByTransactionResultTypeCode(code)
+	if err != nil {
+		return "", err
+	}
+	return TxResult(name), nil
+}
+
+// Category returns the category of the result, given by the prefix of its code.
+func (t TxResult) Category() TxResultCategory {
+	if len(t) < 3 {
+		return TxResultCategoryUnknown
+	}
+	switch category := TxResultCategory(t[:3]); category {
+	case TxResultCategoryTes, TxResultCategoryTec, TxResultCategoryTef,
+		TxResultCategoryTel, TxResultCategoryTem, TxResultCategoryTer:
+		return category
+	default:
+		return TxResultCategoryUnknown
+	}
+}
+
+// Code returns the numeric code of the result, as defined by the binary codec definitions.
+func (t TxResult) Code() (int32, error) {
+	return definitions.Get().GetTransactionResultTypeCodeByTransactionResultName(t.String())
+}
+
+// Description returns the human-readable description rippled uses for the result, or an empty
+// string if the result is unknown.
+func (t TxResult) Description() string {
+	return txResultDescriptions[t]
+}
+
+// IsSuccess reports whether the transaction was applied successfully.
+func (t TxResult) IsSuccess() bool {
+	return t == TesSUCCESS
+}
+
+// ClaimsFee reports whether the transaction is included in a ledger and destroys its transaction
+// cost, which is the case for tes and tec results.
+func (t TxResult) ClaimsFee() bool {
+	category := t.Category()
+	return category == TxResultCategoryTes || category == TxResultCategoryTec
+}
+
+// IsFinal reports whether the result can no longer change. tes and tec results are final once
+// they appear in a validated ledger, and tem results are malformed and never succeed. tef results
+// are final only when MayStillSucceed is false: tefPAST_SEQ and tefALREADY, among others, may
+// mean the transaction has already been applied.
+func (t TxResult) IsFinal() bool {
+	switch t.Category() {
+	case TxResultCategoryTes, TxResultCategoryTec, TxResultCategoryTem:
+		return true
+	case TxResultCategoryTef:
+		return !t.MayStillSucceed()
+	default:
+		return false
+	}
+}
+
+// IsRetryable reports whether submitting the same signed transaction again, later or to a
+// different server, could succeed, which is the case for ter and tel results.
+func (t TxResult) IsRetryable() bool {
+	category := t.Category()
+	return category == TxResultCategoryTer || category == TxResultCategoryTel
+}
+
+// MayStillSucceed reports whether a transaction with this preliminary result could still be
+// validated successfully, following the reliable transaction submission rules. Preliminary
+// results are provisional until the transaction's LastLedgerSequence has passed, except for
+// malformed transactions and tefMAX_LEDGER, which never succeed. tefPAST_SEQ and tefALREADY
+// may mean the transaction itself has already been applied.
+func (t TxResult) MayStillSucceed() bool {
+	switch t.Category() {
+	case TxResultCategoryUnknown, TxResultCategoryTem:
+		return false
+	default:
+		return t != TefMAX_LEDGER
+	}
+}
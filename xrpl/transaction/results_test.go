@@ -3,6 +3,7 @@ package transaction
 import (
 	"testing"
 
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/stretchr/testify/require"
 )
 
@@ -744,3 +745,143 @@ func TestTxResult_String(t *testing.T) {
 		})
 	}
 }
+
+func TestTxResult_Classification(t *testing.T) {
+	tests := []struct {
+		name            string
+		txResult        TxResult
+		category        TxResultCategory
+		isSuccess       bool
+		claimsFee       bool
+		isFinal         bool
+		isRetryable     bool
+		mayStillSucceed bool
+	}{
+		{
+			name:            "tesSUCCESS",
+			txResult:        TesSUCCESS,
+			category:        TxResultCategoryTes,
+			isSuccess:       true,
+			claimsFee:       true,
+			isFinal:         true,
+			mayStillSucceed: true,
+		},
+		{
+			name:            "tecUNFUNDED_PAYMENT",
+			txResult:        TecUNFUNDED_PAYMENT,
+			category:        TxResultCategoryTec,
+			claimsFee:       true,
+			isFinal:         true,
+			mayStillSucceed: true,
+		},
+		{
+			name:            "tefPAST_SEQ",
+			txResult:        TefPAST_SEQ,
+			category:        TxResultCategoryTef,
+			mayStillSucceed: true,
+		},
+		{
+			name:            "tefALREADY",
+			txResult:        TefALREADY,
+			category:        TxResultCategoryTef,
+			mayStillSucceed: true,
+		},
+		{
+			name:     "tefMAX_LEDGER",
+			txResult: TefMAX_LEDGER,
+			category: TxResultCategoryTef,
+			isFinal:  true,
+		},
+		{
+			name:            "telCAN_NOT_QUEUE",
+			txResult:        TelCAN_NOT_QUEUE,
+			category:        TxResultCategoryTel,
+			isRetryable:     true,
+			mayStillSucceed: true,
+		},
+		{
+			name:     "temMALFORMED",
+			txResult: TemMALFORMED,
+			category: TxResultCategoryTem,
+			isFinal:  true,
+		},
+		{
+			name:            "terQUEUED",
+			txResult:        TerQUEUED,
+			category:        TxResultCategoryTer,
+			isRetryable:     true,
+			mayStillSucceed: true,
+		},
+		{
+			name:     "unknown",
+			txResult: TxResult("unknown"),
+			category: TxResultCategoryUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.category, tt.txResult.Category())
+			require.Equal(t, tt.isSuccess, tt.txResult.IsSuccess())
+			require.Equal(t, tt.claimsFee, tt.txResult.ClaimsFee())
+			require.Equal(t, tt.isFinal, tt.txResult.IsFinal())
+			require.Equal(t, tt.isRetryable, tt.txResult.IsRetryable())
+			require.Equal(t, tt.mayStillSucceed, tt.txResult.MayStillSucceed())
+		})
+	}
+}
+
+func TestTxResult_TefConsistency(t *testing.T) {
+	var tef []TxResult
+	for txResult := range txResultDescriptions {
+		if txResult.Category() == TxResultCategoryTef {
+			tef = append(tef, txResult)
+		}
+	}
+	require.NotEmpty(t, tef)
+
+	for _, txResult := range tef {
+		t.Run(txResult.String(), func(t *testing.T) {
+			require.NotEqual(t, txResult.IsFinal(), txResult.MayStillSucceed())
+			require.False(t, txResult.IsSuccess())
+			require.False(t, txResult.ClaimsFee())
+			require.False(t, txResult.IsRetryable())
+		})
+	}
+}
+
+func TestTxResult_Code(t *testing.T) {
+	code, err := TecCLAIM.Code()
+	require.NoError(t, err)
+	require.Equal(t, int32(100), code)
+
+	code, err = TelLOCAL_ERROR.Code()
+	require.NoError(t, err)
+	require.Equal(t, int32(-399), code)
+
+	_, err = TxResult("unknown").Code()
+	require.Error(t, err)
+}
+
+func TestTxResultFromCode(t *testing.T) {
+	result, err := TxResultFromCode(0)
+	require.NoError(t, err)
+	require.Equal(t, TesSUCCESS, result)
+
+	result, err = TxResultFromCode(-89)
+	require.NoError(t, err)
+	require.Equal(t, TerQUEUED, result)
+
+	_, err = TxResultFromCode(1000)
+	require.Error(t, err)
+}
+
+func TestTxResult_Description(t *testing.T) {
+	require.Equal(t, "Fee claimed. Sequence used. No action.", TecCLAIM.Description())
+	require.Equal(t, "The transaction was applied. Only final in a validated ledger.", TesSUCCESS.Description())
+	require.Empty(t, TxResult("unknown").Description())
+
+	for name := range definitions.Get().TransactionResults {
+		require.NotEmpty(t, TxResult(name).Description(), name)
+	}
+}
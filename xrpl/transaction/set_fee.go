@@ -0,0 +1,189 @@
+package transaction
+
+import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/pkg/typecheck"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// SetFee is a pseudo-transaction that marks a change in the transaction cost or reserve
+// requirements as a result of fee voting. It is created by the consensus process and is never
+// signed or submitted.
+//
+// Before the XRPFees amendment, SetFee carries the legacy BaseFee, ReferenceFeeUnits,
+// ReserveBase and ReserveIncrement fields. Once XRPFees is enabled, it carries the
+// BaseFeeDrops, ReserveBaseDrops and ReserveIncrementDrops fields instead.
+//
+// Example:
+//
+// ```json
+//
+//	{
+//	    "Account": "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+//	    "BaseFee": "000000000000000A",
+//	    "Fee": "0",
+//	    "ReferenceFeeUnits": 10,
+//	    "ReserveBase": 20000000,
+//	    "ReserveIncrement": 5000000,
+//	    "Sequence": 0,
+//	    "SigningPubKey": "",
+//	    "TransactionType": "SetFee"
+//	}
+//
+// ```
+type SetFee struct {
+	BaseTx
+	// The index of the ledger version where this pseudo-transaction appears.
+	LedgerSequence *uint32 `json:",omitempty"`
+	// (Legacy) The charge, in drops of XRP, for the reference transaction, as a hexadecimal UInt64.
+	BaseFee string `json:",omitempty"`
+	// (Legacy) The cost, in fee units, of the reference transaction.
+	ReferenceFeeUnits *uint32 `json:",omitempty"`
+	// (Legacy) The base reserve, in drops.
+	ReserveBase *uint32 `json:",omitempty"`
+	// (Legacy) The incremental reserve, in drops.
+	ReserveIncrement *uint32 `json:",omitempty"`
+	// (XRPFees) The charge, in drops of XRP, for the reference transaction.
+	BaseFeeDrops *types.XRPCurrencyAmount `json:",omitempty"`
+	// (XRPFees) The base reserve, in drops.
+	ReserveBaseDrops *types.XRPCurrencyAmount `json:",omitempty"`
+	// (XRPFees) The incremental reserve, in drops.
+	ReserveIncrementDrops *types.XRPCurrencyAmount `json:",omitempty"`
+}
+
+// TxType returns the type of the transaction (SetFee).
+func (*SetFee) TxType() TxType {
+	return SetFeeTx
+}
+
+// Flatten returns the flattened map of the SetFee transaction.
+func (tx *SetFee) Flatten() FlatTransaction {
+	flattened := tx.flattenPseudo()
+
+	flattened["TransactionType"] = tx.TxType().String()
+
+	if tx.LedgerSequence != nil {
+		flattened["LedgerSequence"] = *tx.LedgerSequence
+	}
+	if tx.BaseFee != "" {
+		flattened["BaseFee"] = tx.BaseFee
+	}
+	if tx.ReferenceFeeUnits != nil {
+		flattened["ReferenceFeeUnits"] = *tx.ReferenceFeeUnits
+	}
+	if tx.ReserveBase != nil {
+		flattened["ReserveBase"] = *tx.ReserveBase
+	}
+	if tx.ReserveIncrement != nil {
+		flattened["ReserveIncrement"] = *tx.ReserveIncrement
+	}
+	if tx.BaseFeeDrops != nil {
+		flattened["BaseFeeDrops"] = tx.BaseFeeDrops.Flatten()
+	}
+	if tx.ReserveBaseDrops != nil {
+		flattened["ReserveBaseDrops"] = tx.ReserveBaseDrops.Flatten()
+	}
+	if tx.ReserveIncrementDrops != nil {
+		flattened["ReserveIncrementDrops"] = tx.ReserveIncrementDrops.Flatten()
+	}
+
+	return flattened
+}
+
+// EncodeBinary writes the SetFee to e. It writes the same fields as Flatten.
+func (tx *SetFee) EncodeBinary(e *binarycodec.Encoder) {
+	tx.encodeBinaryPseudo(e)
+	e.TransactionType(tx.TxType().String())
+
+	if tx.LedgerSequence != nil {
+		e.UInt32("LedgerSequence", *tx.LedgerSequence)
+	}
+	if tx.BaseFee != "" {
+		encodeBinaryUInt64(e, "BaseFee", tx.BaseFee)
+	}
+	if tx.ReferenceFeeUnits != nil {
+		e.UInt32("ReferenceFeeUnits", *tx.ReferenceFeeUnits)
+	}
+	if tx.ReserveBase != nil {
+		e.UInt32("ReserveBase", *tx.ReserveBase)
+	}
+	if tx.ReserveIncrement != nil {
+		e.UInt32("ReserveIncrement", *tx.ReserveIncrement)
+	}
+	if tx.BaseFeeDrops != nil {
+		e.XRPAmount("BaseFeeDrops", tx.BaseFeeDrops.Uint64())
+	}
+	if tx.ReserveBaseDrops != nil {
+		e.XRPAmount("ReserveBaseDrops", tx.ReserveBaseDrops.Uint64())
+	}
+	if tx.ReserveIncrementDrops != nil {
+		e.XRPAmount("ReserveIncrementDrops", tx.ReserveIncrementDrops.Uint64())
+	}
+}
+
+// DecodeBinary reads the SetFee from d, skipping fields a SetFee does not have.
+func (tx *SetFee) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerSequence":
+			sequence := d.UInt32()
+			tx.LedgerSequence = &sequence
+		case "BaseFee":
+			tx.BaseFee = decodeBinaryUInt64(d)
+		case "ReferenceFeeUnits":
+			units := d.UInt32()
+			tx.ReferenceFeeUnits = &units
+		case "ReserveBase":
+			reserve := d.UInt32()
+			tx.ReserveBase = &reserve
+		case "ReserveIncrement":
+			increment := d.UInt32()
+			tx.ReserveIncrement = &increment
+		case "BaseFeeDrops":
+			drops := types.XRPCurrencyAmount(d.Amount().Drops)
+			tx.BaseFeeDrops = &drops
+		case "ReserveBaseDrops":
+			drops := types.XRPCurrencyAmount(d.Amount().Drops)
+			tx.ReserveBaseDrops = &drops
+		case "ReserveIncrementDrops":
+			drops := types.XRPCurrencyAmount(d.Amount().Drops)
+			tx.ReserveIncrementDrops = &drops
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// Validate checks that the SetFee transaction is valid. It must carry either the complete
+// legacy field set or the complete XRPFees field set, but not a mix of both.
+func (tx *SetFee) Validate() (bool, error) {
+	if ok, err := tx.BaseTx.Validate(); !ok {
+		return false, err
+	}
+
+	hasLegacy := tx.BaseFee != "" || tx.ReferenceFeeUnits != nil || tx.ReserveBase != nil || tx.ReserveIncrement != nil
+	hasDrops := tx.BaseFeeDrops != nil || tx.ReserveBaseDrops != nil || tx.ReserveIncrementDrops != nil
+
+	switch {
+	case hasLegacy && hasDrops:
+		return false, ErrSetFeeMixedFields
+	case hasLegacy:
+		if tx.BaseFee == "" || tx.ReferenceFeeUnits == nil || tx.ReserveBase == nil || tx.ReserveIncrement == nil {
+			return false, ErrSetFeeMissingFields
+		}
+		if !typecheck.IsStringNumericUint(tx.BaseFee, 16, 64) {
+			return false, ErrSetFeeInvalidBaseFee
+		}
+	case hasDrops:
+		if tx.BaseFeeDrops == nil || tx.ReserveBaseDrops == nil || tx.ReserveIncrementDrops == nil {
+			return false, ErrSetFeeMissingFields
+		}
+	default:
+		return false, ErrSetFeeMissingFields
+	}
+
+	return true, nil
+}
@@ -0,0 +1,144 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetFee_TxType(t *testing.T) {
+	tx := &SetFee{}
+	require.Equal(t, SetFeeTx, tx.TxType())
+}
+
+func TestSetFee_Flatten(t *testing.T) {
+	ledgerSequence := uint32(66462465)
+	baseFee := types.XRPCurrencyAmount(10)
+	reserveBase := types.XRPCurrencyAmount(1000000)
+	reserveIncrement := types.XRPCurrencyAmount(200000)
+
+	tx := &SetFee{
+		BaseTx: BaseTx{
+			Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+			TransactionType: SetFeeTx,
+		},
+		LedgerSequence:        &ledgerSequence,
+		BaseFeeDrops:          &baseFee,
+		ReserveBaseDrops:      &reserveBase,
+		ReserveIncrementDrops: &reserveIncrement,
+	}
+
+	expected := FlatTransaction{
+		"Account":               "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		"TransactionType":       "SetFee",
+		"Fee":                   "0",
+		"Sequence":              uint32(0),
+		"SigningPubKey":         "",
+		"LedgerSequence":        uint32(66462465),
+		"BaseFeeDrops":          "10",
+		"ReserveBaseDrops":      "1000000",
+		"ReserveIncrementDrops": "200000",
+	}
+
+	require.Equal(t, expected, tx.Flatten())
+}
+
+func TestSetFee_Validate(t *testing.T) {
+	baseTx := BaseTx{
+		Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		TransactionType: SetFeeTx,
+	}
+	referenceFeeUnits := uint32(10)
+	reserveBase := uint32(20000000)
+	reserveIncrement := uint32(5000000)
+	baseFeeDrops := types.XRPCurrencyAmount(10)
+	reserveBaseDrops := types.XRPCurrencyAmount(1000000)
+	reserveIncrementDrops := types.XRPCurrencyAmount(200000)
+
+	tests := []struct {
+		name string
+		tx   *SetFee
+		err  error
+	}{
+		{
+			name: "pass - legacy fields",
+			tx: &SetFee{
+				BaseTx:            baseTx,
+				BaseFee:           "000000000000000A",
+				ReferenceFeeUnits: &referenceFeeUnits,
+				ReserveBase:       &reserveBase,
+				ReserveIncrement:  &reserveIncrement,
+			},
+		},
+		{
+			name: "pass - XRPFees fields",
+			tx: &SetFee{
+				BaseTx:                baseTx,
+				BaseFeeDrops:          &baseFeeDrops,
+				ReserveBaseDrops:      &reserveBaseDrops,
+				ReserveIncrementDrops: &reserveIncrementDrops,
+			},
+		},
+		{
+			name: "fail - no fee fields",
+			tx:   &SetFee{BaseTx: baseTx},
+			err:  ErrSetFeeMissingFields,
+		},
+		{
+			name: "fail - incomplete legacy fields",
+			tx: &SetFee{
+				BaseTx:      baseTx,
+				BaseFee:     "000000000000000A",
+				ReserveBase: &reserveBase,
+			},
+			err: ErrSetFeeMissingFields,
+		},
+		{
+			name: "fail - incomplete XRPFees fields",
+			tx: &SetFee{
+				BaseTx:       baseTx,
+				BaseFeeDrops: &baseFeeDrops,
+			},
+			err: ErrSetFeeMissingFields,
+		},
+		{
+			name: "fail - mixed field sets",
+			tx: &SetFee{
+				BaseTx:                baseTx,
+				BaseFee:               "000000000000000A",
+				ReferenceFeeUnits:     &referenceFeeUnits,
+				ReserveBase:           &reserveBase,
+				ReserveIncrement:      &reserveIncrement,
+				BaseFeeDrops:          &baseFeeDrops,
+				ReserveBaseDrops:      &reserveBaseDrops,
+				ReserveIncrementDrops: &reserveIncrementDrops,
+			},
+			err: ErrSetFeeMixedFields,
+		},
+		{
+			name: "fail - invalid base fee",
+			tx: &SetFee{
+				BaseTx:            baseTx,
+				BaseFee:           "not hex",
+				ReferenceFeeUnits: &referenceFeeUnits,
+				ReserveBase:       &reserveBase,
+				ReserveIncrement:  &reserveIncrement,
+			},
+			err: ErrSetFeeInvalidBaseFee,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, err := tt.tx.Validate()
+			if tt.err != nil {
+				require.False(t, valid)
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.True(t, valid)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package transaction
 
 import (
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -48,6 +49,31 @@ func (s *SetRegularKey) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the SetRegularKey to e. It writes the same fields as Flatten.
+func (s *SetRegularKey) EncodeBinary(e *binarycodec.Encoder) {
+	s.BaseTx.encodeBinary(e)
+	e.TransactionType(s.TxType().String())
+
+	if s.RegularKey != "" {
+		e.AccountID("RegularKey", s.RegularKey.String())
+	}
+}
+
+// DecodeBinary reads the SetRegularKey from d, skipping fields a SetRegularKey does not have.
+func (s *SetRegularKey) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "RegularKey":
+			s.RegularKey = types.Address(d.AccountID())
+		default:
+			if !s.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks if the SetRegularKey struct is valid.
 func (s *SetRegularKey) Validate() (bool, error) {
 	ok, err := s.BaseTx.Validate()
@@ -1,11 +1,14 @@
 package transaction
 
 import (
+	"encoding/json"
 	"fmt"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
 const (
@@ -16,6 +19,15 @@ const (
 	MaxSigners = 32
 )
 
+// SignerListSetMetadata represents the resulting metadata of a succeeded SignerListSet transaction.
+// It extends from TxObjMeta.
+type SignerListSetMetadata struct {
+	TxObjMeta
+
+	// SignerListID is the ledger index of the SignerList entry created, replaced or deleted by the transaction.
+	SignerListID *types.Hash256 `json:"-"`
+}
+
 // SignerListSet creates, replaces, or removes a list of signers that can be used to multi-sign a transaction.
 // This transaction type was introduced by the MultiSign amendment.
 //
@@ -92,6 +104,89 @@ func (s *SignerListSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the SignerListSet to e. It writes the same fields as Flatten.
+func (s *SignerListSet) EncodeBinary(e *binarycodec.Encoder) {
+	s.BaseTx.encodeBinary(e)
+	e.TransactionType(s.TxType().String())
+
+	if quorum, ok := s.SignerQuorum.(uint32); ok {
+		e.UInt32("SignerQuorum", quorum)
+	} else if s.SignerQuorum != nil {
+		e.Value("SignerQuorum", s.SignerQuorum)
+	}
+	if len(s.SignerEntries) > 0 {
+		e.BeginArray("SignerEntries")
+		for _, entry := range s.SignerEntries {
+			e.BeginObject("SignerEntry")
+			if entry.SignerEntry.Account != "" {
+				e.AccountID("Account", entry.SignerEntry.Account.String())
+			}
+			if entry.SignerEntry.SignerWeight != 0 {
+				e.UInt16("SignerWeight", entry.SignerEntry.SignerWeight)
+			}
+			if entry.SignerEntry.WalletLocator != "" {
+				e.Hash256("WalletLocator", entry.SignerEntry.WalletLocator.String())
+			}
+			e.EndObject()
+		}
+		e.EndArray()
+	}
+}
+
+// DecodeBinary reads the SignerListSet from d, skipping fields a SignerListSet does not have.
+// SignerQuorum is read as a uint32.
+func (s *SignerListSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "SignerQuorum":
+			s.SignerQuorum = d.UInt32()
+		case "SignerEntries":
+			s.SignerEntries = nil
+			for d.Next() {
+				var entry ledger.SignerEntry
+				for d.Next() {
+					switch d.Field() {
+					case "Account":
+						entry.Account = types.Address(d.AccountID())
+					case "SignerWeight":
+						entry.SignerWeight = d.UInt16()
+					case "WalletLocator":
+						entry.WalletLocator = types.Hash256(d.Hash())
+					default:
+						d.Skip()
+					}
+				}
+				s.SignerEntries = append(s.SignerEntries, ledger.SignerEntryWrapper{SignerEntry: entry})
+			}
+		default:
+			if !s.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for SignerListSet.
+func (s *SignerListSet) UnmarshalJSON(data []byte) error {
+	type Alias SignerListSet
+	aux := &struct {
+		SignerQuorum *uint32
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	s.SignerQuorum = nil
+	if aux.SignerQuorum != nil {
+		s.SignerQuorum = *aux.SignerQuorum
+	}
+	return nil
+}
+
 // Validate checks if the SignerListSet struct is valid.
 func (s *SignerListSet) Validate() (bool, error) {
 	ok, err := s.BaseTx.Validate()
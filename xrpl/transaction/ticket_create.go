@@ -1,5 +1,7 @@
 package transaction
 
+import binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+
 const (
 	// MinTicketCount is the minimum TicketCount value.
 	// https://xrpl.org/docs/references/protocol/transactions/types/ticketcreate#ticketcreate-fields
@@ -9,6 +11,15 @@ const (
 	MaxTicketCount = 250
 )
 
+// TicketCreateMetadata represents the resulting metadata of a succeeded TicketCreate transaction.
+// It extends from TxObjMeta.
+type TicketCreateMetadata struct {
+	TxObjMeta
+
+	// TicketSequences are the sequence numbers of the Tickets created by the transaction.
+	TicketSequences []uint32 `json:"-"`
+}
+
 // TicketCreate transaction sets aside one or more sequence numbers as Tickets.
 //
 // Example:
@@ -50,6 +61,31 @@ func (t *TicketCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the TicketCreate to e. It writes the same fields as Flatten.
+func (t *TicketCreate) EncodeBinary(e *binarycodec.Encoder) {
+	t.BaseTx.encodeBinary(e)
+	e.TransactionType(t.TxType().String())
+
+	if t.TicketCount != 0 {
+		e.UInt32("TicketCount", t.TicketCount)
+	}
+}
+
+// DecodeBinary reads the TicketCreate from d, skipping fields a TicketCreate does not have.
+func (t *TicketCreate) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "TicketCount":
+			t.TicketCount = d.UInt32()
+		default:
+			if !t.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate validates the TicketCreate transaction and makes sure all the fields are correct.
 func (t *TicketCreate) Validate() (bool, error) {
 	_, err := t.BaseTx.Validate()
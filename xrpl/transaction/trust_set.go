@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -27,6 +30,15 @@ const (
 	TfClearDeepFreeze uint32 = 0x00800000
 )
 
+// TrustSetMetadata represents the resulting metadata of a succeeded TrustSet transaction.
+// It extends from TxObjMeta.
+type TrustSetMetadata struct {
+	TxObjMeta
+
+	// TrustLineID is the ledger index of the RippleState entry created, updated or deleted by the transaction.
+	TrustLineID *types.Hash256 `json:"-"`
+}
+
 // TrustSet creates or modifies a trust line linking two accounts.
 type TrustSet struct {
 	// Base transaction fields
@@ -68,6 +80,61 @@ func (t *TrustSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the TrustSet to e. It writes the same fields as Flatten.
+func (t *TrustSet) EncodeBinary(e *binarycodec.Encoder) {
+	t.BaseTx.encodeBinary(e)
+	e.TransactionType(t.TxType().String())
+
+	if t.LimitAmount != nil {
+		encodeBinaryAmount(e, "LimitAmount", t.LimitAmount)
+	}
+	if t.QualityIn != 0 {
+		e.UInt32("QualityIn", t.QualityIn)
+	}
+	if t.QualityOut != 0 {
+		e.UInt32("QualityOut", t.QualityOut)
+	}
+}
+
+// DecodeBinary reads the TrustSet from d, skipping fields a TrustSet does not have.
+func (t *TrustSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LimitAmount":
+			t.LimitAmount = decodeBinaryAmount(d)
+		case "QualityIn":
+			t.QualityIn = d.UInt32()
+		case "QualityOut":
+			t.QualityOut = d.UInt32()
+		default:
+			if !t.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for TrustSet.
+func (t *TrustSet) UnmarshalJSON(data []byte) error {
+	type Alias TrustSet
+	aux := &struct {
+		LimitAmount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(t),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if t.LimitAmount, err = types.UnmarshalCurrencyAmount(aux.LimitAmount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // SetSetAuthFlag sets the TfSetAuth flag, authorizing the other party to hold currency issued by this account. Cannot be unset.
 func (t *TrustSet) SetSetAuthFlag() {
 	t.Flags |= TfSetAuth
@@ -106,6 +106,8 @@ type BaseTx struct {
 	// account it says it is from.
 	//
 	TxnSignature string `json:",omitempty"`
+	// Whether Flags was present and zero in the parsed transaction, so that Flatten keeps it.
+	explicitFlags bool
 }
 
 // TxType returns the transaction type stored in BaseTx.
@@ -113,6 +115,11 @@ func (tx *BaseTx) TxType() TxType {
 	return tx.TransactionType
 }
 
+// keepFlags makes Flatten emit the Flags field if it is an explicit zero.
+func (tx *BaseTx) keepFlags() {
+	tx.explicitFlags = tx.Flags == 0
+}
+
 // Flatten converts BaseTx into a FlatTransaction map for JSON-RPC submission.
 func (tx *BaseTx) Flatten() FlatTransaction {
 	flattened := make(FlatTransaction)
@@ -133,7 +140,7 @@ func (tx *BaseTx) Flatten() FlatTransaction {
 	if tx.AccountTxnID != "" {
 		flattened["AccountTxnID"] = tx.AccountTxnID.String()
 	}
-	if tx.Flags != 0 {
+	if tx.Flags != 0 || tx.explicitFlags {
 		flattened["Flags"] = tx.Flags
 	}
 	if tx.LastLedgerSequence != 0 {
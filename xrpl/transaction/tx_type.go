@@ -78,8 +78,22 @@ const (
 	VaultDepositTx                      TxType = "VaultDeposit"
 	VaultWithdrawTx                     TxType = "VaultWithdraw"
 	VaultClawbackTx                     TxType = "VaultClawback"
+	EnableAmendmentTx                   TxType = "EnableAmendment"
+	SetFeeTx                            TxType = "SetFee"
+	UNLModifyTx                         TxType = "UNLModify"
 )
 
 func (t TxType) String() string {
 	return string(t)
 }
+
+// IsPseudo reports whether the transaction type is a pseudo-transaction. Pseudo-transactions
+// are created by the consensus process and are never signed or submitted.
+func (t TxType) IsPseudo() bool {
+	switch t {
+	case EnableAmendmentTx, SetFeeTx, UNLModifyTx:
+		return true
+	default:
+		return false
+	}
+}
@@ -32,7 +32,7 @@ type CurrencyAmount interface {
 
 // UnmarshalCurrencyAmount parses JSON data into the appropriate CurrencyAmount implementation.
 func UnmarshalCurrencyAmount(data []byte) (CurrencyAmount, error) {
-	if len(data) == 0 {
+	if len(data) == 0 || string(data) == "null" {
 		return nil, nil
 	}
 	switch data[0] {
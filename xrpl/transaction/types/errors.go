@@ -12,12 +12,12 @@ var (
 
 	// ErrInvalidIssuingChainDoorAddress is returned when the issuing chain door address is invalid.
 	ErrInvalidIssuingChainDoorAddress = errors.New("xchain bridge: invalid issuing chain door address")
-	// ErrInvalidIssuingChainIssueAddress is returned when the issuing chain issue address is invalid.
-	ErrInvalidIssuingChainIssueAddress = errors.New("xchain bridge: invalid issuing chain issue address")
+	// ErrInvalidIssuingChainIssueAddress is returned when the issuing chain issue is invalid.
+	ErrInvalidIssuingChainIssueAddress = errors.New("xchain bridge: invalid issuing chain issue")
 	// ErrInvalidLockingChainDoorAddress is returned when the locking chain door address is invalid.
 	ErrInvalidLockingChainDoorAddress = errors.New("xchain bridge: invalid locking chain door address")
-	// ErrInvalidLockingChainIssueAddress is returned when the locking chain issue address is invalid.
-	ErrInvalidLockingChainIssueAddress = errors.New("xchain bridge: invalid locking chain issue address")
+	// ErrInvalidLockingChainIssueAddress is returned when the locking chain issue is invalid.
+	ErrInvalidLockingChainIssueAddress = errors.New("xchain bridge: invalid locking chain issue")
 
 	// raw tx
 
@@ -0,0 +1,33 @@
+//revive:disable:var-naming
+package types
+
+import (
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+)
+
+// Issue identifies an asset without an amount: XRP, or an issued currency and its issuer.
+type Issue struct {
+	Currency string  `json:"currency"`
+	Issuer   Address `json:"issuer,omitempty"`
+}
+
+// Flatten returns a JSON-like map representing the Issue fields. The issuer is omitted for XRP.
+func (i *Issue) Flatten() map[string]any {
+	flattened := make(map[string]any)
+	flattened["currency"] = i.Currency
+	if i.Issuer != "" {
+		flattened["issuer"] = i.Issuer.String()
+	}
+	return flattened
+}
+
+// IsValid reports whether the Issue is XRP without an issuer, or a non-XRP currency with a valid issuer.
+func (i *Issue) IsValid() bool {
+	if i.Currency == "" {
+		return false
+	}
+	if i.Currency == "XRP" {
+		return i.Issuer == ""
+	}
+	return addresscodec.IsValidAddress(i.Issuer.String())
+}
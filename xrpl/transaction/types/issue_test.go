@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssue_Flatten(t *testing.T) {
+	tests := []struct {
+		name  string
+		issue Issue
+		want  map[string]any
+	}{
+		{
+			name:  "pass - XRP",
+			issue: Issue{Currency: "XRP"},
+			want:  map[string]any{"currency": "XRP"},
+		},
+		{
+			name:  "pass - issued currency",
+			issue: Issue{Currency: "USD", Issuer: "rPdYxU9dNkbzC5Y2h4jLbVJ3rMRrk7WVRL"},
+			want:  map[string]any{"currency": "USD", "issuer": "rPdYxU9dNkbzC5Y2h4jLbVJ3rMRrk7WVRL"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.issue.Flatten())
+		})
+	}
+}
+
+func TestIssue_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		issue Issue
+		want  bool
+	}{
+		{
+			name:  "pass - XRP",
+			issue: Issue{Currency: "XRP"},
+			want:  true,
+		},
+		{
+			name:  "pass - issued currency",
+			issue: Issue{Currency: "USD", Issuer: "rPdYxU9dNkbzC5Y2h4jLbVJ3rMRrk7WVRL"},
+			want:  true,
+		},
+		{
+			name:  "fail - empty currency",
+			issue: Issue{Issuer: "rPdYxU9dNkbzC5Y2h4jLbVJ3rMRrk7WVRL"},
+			want:  false,
+		},
+		{
+			name:  "fail - XRP with issuer",
+			issue: Issue{Currency: "XRP", Issuer: "rPdYxU9dNkbzC5Y2h4jLbVJ3rMRrk7WVRL"},
+			want:  false,
+		},
+		{
+			name:  "fail - issued currency without issuer",
+			issue: Issue{Currency: "USD"},
+			want:  false,
+		},
+		{
+			name:  "fail - issued currency with invalid issuer",
+			issue: Issue{Currency: "USD", Issuer: "invalid"},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.issue.IsValid())
+		})
+	}
+}
@@ -12,40 +12,40 @@ type XChainBridge struct {
 	IssuingChainDoor Address
 	// The asset that is minted and burned on the issuing chain. For an IOU-IOU bridge,
 	// the issuer of the asset must be the door account on the issuing chain, to avoid supply issues.
-	IssuingChainIssue Address
+	IssuingChainIssue Issue
 	// The door account on the locking chain.
 	LockingChainDoor Address
 	// The asset that is locked and unlocked on the locking chain.
-	LockingChainIssue Address
+	LockingChainIssue Issue
 }
 
 // FlatXChainBridge is a flattened representation of XChainBridge for JSON serialization.
-type FlatXChainBridge map[string]string
+type FlatXChainBridge = map[string]any
 
-// Flatten returns a FlatXChainBridge mapping fields to their string values.
+// Flatten returns a FlatXChainBridge mapping the doors to their addresses and the issues to their flattened form.
 func (x *XChainBridge) Flatten() FlatXChainBridge {
 	flat := make(FlatXChainBridge)
 
 	flat["IssuingChainDoor"] = x.IssuingChainDoor.String()
-	flat["IssuingChainIssue"] = x.IssuingChainIssue.String()
+	flat["IssuingChainIssue"] = x.IssuingChainIssue.Flatten()
 	flat["LockingChainDoor"] = x.LockingChainDoor.String()
-	flat["LockingChainIssue"] = x.LockingChainIssue.String()
+	flat["LockingChainIssue"] = x.LockingChainIssue.Flatten()
 
 	return flat
 }
 
-// Validate checks each address in the XChainBridge and returns false with an error if any are invalid.
+// Validate checks each door address and issue in the XChainBridge and returns false with an error if any are invalid.
 func (x *XChainBridge) Validate() (bool, error) {
 	if !addresscodec.IsValidAddress(x.IssuingChainDoor.String()) {
 		return false, ErrInvalidIssuingChainDoorAddress
 	}
-	if !addresscodec.IsValidAddress(x.IssuingChainIssue.String()) {
+	if !x.IssuingChainIssue.IsValid() {
 		return false, ErrInvalidIssuingChainIssueAddress
 	}
 	if !addresscodec.IsValidAddress(x.LockingChainDoor.String()) {
 		return false, ErrInvalidLockingChainDoorAddress
 	}
-	if !addresscodec.IsValidAddress(x.LockingChainIssue.String()) {
+	if !x.LockingChainIssue.IsValid() {
 		return false, ErrInvalidLockingChainIssueAddress
 	}
 
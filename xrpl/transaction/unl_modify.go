@@ -0,0 +1,107 @@
+package transaction
+
+import (
+	"encoding/hex"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
+
+const (
+	// UNLModifyValidatorLength is the length, in bytes, of a validator public key in a UNLModify transaction.
+	UNLModifyValidatorLength = 33
+)
+
+// UNLModify is a pseudo-transaction that marks a change to the Negative UNL, indicating that a
+// trusted validator has gone offline or come back online. It is created by the consensus process
+// and is never signed or submitted.
+//
+// Example:
+//
+// ```json
+//
+//	{
+//	    "Account": "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+//	    "Fee": "0",
+//	    "LedgerSequence": 1600000,
+//	    "Sequence": 0,
+//	    "SigningPubKey": "",
+//	    "TransactionType": "UNLModify",
+//	    "UNLModifyDisabling": 1,
+//	    "UNLModifyValidator": "ED6629D456285AE3613B285F65BBFF168D695BA3921F309949AFCD2CA7AFEC16FE"
+//	}
+//
+// ```
+type UNLModify struct {
+	BaseTx
+	// The ledger index where this pseudo-transaction appears.
+	LedgerSequence uint32
+	// If 1, this change represents adding a validator to the Negative UNL. If 0, this change
+	// represents removing a validator from the Negative UNL.
+	UNLModifyDisabling uint8
+	// The validator to add or remove, identified by its master public key.
+	UNLModifyValidator string
+}
+
+// TxType returns the type of the transaction (UNLModify).
+func (*UNLModify) TxType() TxType {
+	return UNLModifyTx
+}
+
+// Flatten returns the flattened map of the UNLModify transaction.
+func (tx *UNLModify) Flatten() FlatTransaction {
+	flattened := tx.flattenPseudo()
+
+	flattened["TransactionType"] = tx.TxType().String()
+	flattened["LedgerSequence"] = tx.LedgerSequence
+	flattened["UNLModifyDisabling"] = tx.UNLModifyDisabling
+	flattened["UNLModifyValidator"] = tx.UNLModifyValidator
+
+	return flattened
+}
+
+// EncodeBinary writes the UNLModify to e. It writes the same fields as Flatten.
+func (tx *UNLModify) EncodeBinary(e *binarycodec.Encoder) {
+	tx.encodeBinaryPseudo(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.UInt32("LedgerSequence", tx.LedgerSequence)
+	e.UInt8("UNLModifyDisabling", tx.UNLModifyDisabling)
+	e.Blob("UNLModifyValidator", tx.UNLModifyValidator)
+}
+
+// DecodeBinary reads the UNLModify from d, skipping fields a UNLModify does not have.
+func (tx *UNLModify) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "LedgerSequence":
+			tx.LedgerSequence = d.UInt32()
+		case "UNLModifyDisabling":
+			tx.UNLModifyDisabling = d.UInt8()
+		case "UNLModifyValidator":
+			tx.UNLModifyValidator = d.Blob()
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// Validate checks that the UNLModify transaction is valid.
+func (tx *UNLModify) Validate() (bool, error) {
+	if ok, err := tx.BaseTx.Validate(); !ok {
+		return false, err
+	}
+
+	if tx.UNLModifyDisabling > 1 {
+		return false, ErrUNLModifyInvalidDisabling
+	}
+
+	validator, err := hex.DecodeString(tx.UNLModifyValidator)
+	if err != nil || len(validator) != UNLModifyValidatorLength {
+		return false, ErrUNLModifyInvalidValidator
+	}
+
+	return true, nil
+}
@@ -0,0 +1,101 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUNLModify_TxType(t *testing.T) {
+	tx := &UNLModify{}
+	require.Equal(t, UNLModifyTx, tx.TxType())
+}
+
+func TestUNLModify_Flatten(t *testing.T) {
+	tx := &UNLModify{
+		BaseTx: BaseTx{
+			Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+			TransactionType: UNLModifyTx,
+		},
+		LedgerSequence:     1600000,
+		UNLModifyDisabling: 1,
+		UNLModifyValidator: "ED6629D456285AE3613B285F65BBFF168D695BA3921F309949AFCD2CA7AFEC16FE",
+	}
+
+	expected := FlatTransaction{
+		"Account":            "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		"TransactionType":    "UNLModify",
+		"Fee":                "0",
+		"Sequence":           uint32(0),
+		"SigningPubKey":      "",
+		"LedgerSequence":     uint32(1600000),
+		"UNLModifyDisabling": uint8(1),
+		"UNLModifyValidator": "ED6629D456285AE3613B285F65BBFF168D695BA3921F309949AFCD2CA7AFEC16FE",
+	}
+
+	require.Equal(t, expected, tx.Flatten())
+}
+
+func TestUNLModify_Validate(t *testing.T) {
+	baseTx := BaseTx{
+		Account:         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		TransactionType: UNLModifyTx,
+	}
+
+	tests := []struct {
+		name string
+		tx   *UNLModify
+		err  error
+	}{
+		{
+			name: "pass - disabling a validator",
+			tx: &UNLModify{
+				BaseTx:             baseTx,
+				LedgerSequence:     1600000,
+				UNLModifyDisabling: 1,
+				UNLModifyValidator: "ED6629D456285AE3613B285F65BBFF168D695BA3921F309949AFCD2CA7AFEC16FE",
+			},
+		},
+		{
+			name: "fail - invalid disabling value",
+			tx: &UNLModify{
+				BaseTx:             baseTx,
+				LedgerSequence:     1600000,
+				UNLModifyDisabling: 2,
+				UNLModifyValidator: "ED6629D456285AE3613B285F65BBFF168D695BA3921F309949AFCD2CA7AFEC16FE",
+			},
+			err: ErrUNLModifyInvalidDisabling,
+		},
+		{
+			name: "fail - validator too short",
+			tx: &UNLModify{
+				BaseTx:             baseTx,
+				LedgerSequence:     1600000,
+				UNLModifyValidator: "ED6629D4",
+			},
+			err: ErrUNLModifyInvalidValidator,
+		},
+		{
+			name: "fail - validator not hex",
+			tx: &UNLModify{
+				BaseTx:             baseTx,
+				LedgerSequence:     1600000,
+				UNLModifyValidator: "not hex",
+			},
+			err: ErrUNLModifyInvalidValidator,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, err := tt.tx.Validate()
+			if tt.err != nil {
+				require.False(t, valid)
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.True(t, valid)
+			require.NoError(t, err)
+		})
+	}
+}
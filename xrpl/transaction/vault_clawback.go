@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -53,6 +56,57 @@ func (tx *VaultClawback) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the VaultClawback to e. It writes the same fields as Flatten.
+func (tx *VaultClawback) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("VaultID", tx.VaultID.String())
+	e.AccountID("Holder", tx.Holder.String())
+	if tx.Amount != nil {
+		encodeBinaryAmount(e, "Amount", tx.Amount)
+	}
+}
+
+// DecodeBinary reads the VaultClawback from d, skipping fields a VaultClawback does not have.
+func (tx *VaultClawback) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "VaultID":
+			tx.VaultID = types.Hash256(d.Hash())
+		case "Holder":
+			tx.Holder = types.Address(d.AccountID())
+		case "Amount":
+			tx.Amount = decodeBinaryAmount(d)
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for VaultClawback.
+func (tx *VaultClawback) UnmarshalJSON(data []byte) error {
+	type Alias VaultClawback
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(tx),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if tx.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks VaultClawback transaction fields and returns false with an error if invalid.
 func (tx *VaultClawback) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
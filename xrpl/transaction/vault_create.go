@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/flag"
 	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
@@ -21,6 +22,18 @@ const (
 	TfVaultShareNonTransferable uint32 = 0x00020000
 )
 
+// VaultCreateMetadata represents the resulting metadata of a succeeded VaultCreate transaction.
+// It extends from TxObjMeta.
+type VaultCreateMetadata struct {
+	TxObjMeta
+
+	// VaultID is the ledger index of the Vault entry created by the transaction.
+	VaultID *types.Hash256 `json:"-"`
+
+	// ShareMPTID is the ID of the MPT issuance of the vault shares.
+	ShareMPTID *types.MPTIssuanceID `json:"-"`
+}
+
 // VaultCreate creates a new Vault object.
 //
 // ```json
@@ -108,6 +121,66 @@ func (tx *VaultCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the VaultCreate to e. It writes the same fields as Flatten.
+func (tx *VaultCreate) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	if tx.Asset != (ledger.Asset{}) {
+		encodeBinaryAsset(e, "Asset", tx.Asset)
+	}
+	if tx.Data != nil && *tx.Data != "" {
+		e.Blob("Data", tx.Data.Value())
+	}
+	if tx.AssetsMaximum != nil && *tx.AssetsMaximum != "" {
+		e.Number("AssetsMaximum", tx.AssetsMaximum.String())
+	}
+	if tx.MPTokenMetadata != nil && *tx.MPTokenMetadata != "" {
+		e.Blob("MPTokenMetadata", *tx.MPTokenMetadata)
+	}
+	if tx.WithdrawalPolicy != nil {
+		e.UInt8("WithdrawalPolicy", tx.WithdrawalPolicy.Value())
+	}
+	if tx.DomainID != nil && *tx.DomainID != "" {
+		e.Hash256("DomainID", *tx.DomainID)
+	}
+	if tx.Scale != nil {
+		e.UInt8("Scale", *tx.Scale)
+	}
+}
+
+// DecodeBinary reads the VaultCreate from d, skipping fields a VaultCreate does not have.
+func (tx *VaultCreate) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Asset":
+			tx.Asset = decodeBinaryAsset(d)
+		case "Data":
+			data := types.Data(d.Blob())
+			tx.Data = &data
+		case "AssetsMaximum":
+			tx.AssetsMaximum = decodeBinaryNumber(d)
+		case "MPTokenMetadata":
+			metadata := d.Blob()
+			tx.MPTokenMetadata = &metadata
+		case "WithdrawalPolicy":
+			policy := types.VaultWithdrawalPolicy(d.UInt8())
+			tx.WithdrawalPolicy = &policy
+		case "DomainID":
+			domainID := d.Hash()
+			tx.DomainID = &domainID
+		case "Scale":
+			scale := d.UInt8()
+			tx.Scale = &scale
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks VaultCreate transaction fields and returns false with an error if invalid.
 func (tx *VaultCreate) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
@@ -1,6 +1,10 @@
 package transaction
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+)
 
 // VaultDelete deletes an existing Vault object.
 //
@@ -35,6 +39,29 @@ func (tx *VaultDelete) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the VaultDelete to e. It writes the same fields as Flatten.
+func (tx *VaultDelete) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("VaultID", tx.VaultID.String())
+}
+
+// DecodeBinary reads the VaultDelete from d, skipping fields a VaultDelete does not have.
+func (tx *VaultDelete) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "VaultID":
+			tx.VaultID = types.Hash256(d.Hash())
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks VaultDelete transaction fields and returns false with an error if invalid.
 func (tx *VaultDelete) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -44,6 +47,54 @@ func (tx *VaultDeposit) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the VaultDeposit to e. It writes the same fields as Flatten.
+func (tx *VaultDeposit) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("VaultID", tx.VaultID.String())
+	if tx.Amount != nil {
+		encodeBinaryAmount(e, "Amount", tx.Amount)
+	}
+}
+
+// DecodeBinary reads the VaultDeposit from d, skipping fields a VaultDeposit does not have.
+func (tx *VaultDeposit) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "VaultID":
+			tx.VaultID = types.Hash256(d.Hash())
+		case "Amount":
+			tx.Amount = decodeBinaryAmount(d)
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for VaultDeposit.
+func (tx *VaultDeposit) UnmarshalJSON(data []byte) error {
+	type Alias VaultDeposit
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(tx),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if tx.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks VaultDeposit transaction fields and returns false with an error if invalid.
 func (tx *VaultDeposit) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
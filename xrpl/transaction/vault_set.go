@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -61,6 +62,46 @@ func (tx *VaultSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// EncodeBinary writes the VaultSet to e. It writes the same fields as Flatten.
+func (tx *VaultSet) EncodeBinary(e *binarycodec.Encoder) {
+	tx.BaseTx.encodeBinary(e)
+	e.TransactionType(tx.TxType().String())
+
+	e.Hash256("VaultID", tx.VaultID.String())
+	if tx.Data != nil && *tx.Data != "" {
+		e.Blob("Data", tx.Data.Value())
+	}
+	if tx.AssetsMaximum != nil && *tx.AssetsMaximum != "" {
+		e.Number("AssetsMaximum", tx.AssetsMaximum.String())
+	}
+	if tx.DomainID != nil && *tx.DomainID != "" {
+		e.Hash256("DomainID", *tx.DomainID)
+	}
+}
+
+// DecodeBinary reads the VaultSet from d, skipping fields a VaultSet does not have.
+func (tx *VaultSet) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "VaultID":
+			tx.VaultID = types.Hash256(d.Hash())
+		case "Data":
+			data := types.Data(d.Blob())
+			tx.Data = &data
+		case "AssetsMaximum":
+			tx.AssetsMaximum = decodeBinaryNumber(d)
+		case "DomainID":
+			domainID := d.Hash()
+			tx.DomainID = &domainID
+		default:
+			if !tx.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
 // Validate checks VaultSet transaction fields and returns false with an error if invalid.
 func (tx *VaultSet) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -74,6 +77,70 @@ func (x *XChainAccountCreateCommit) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// EncodeBinary writes the XChainAccountCreateCommit to e. It writes the same fields as Flatten.
+func (x *XChainAccountCreateCommit) EncodeBinary(e *binarycodec.Encoder) {
+	x.BaseTx.encodeBinary(e)
+	e.TransactionType(x.TxType().String())
+
+	if x.Amount != nil {
+		encodeBinaryAmount(e, "Amount", x.Amount)
+	}
+	if x.Destination != "" {
+		e.AccountID("Destination", x.Destination.String())
+	}
+	if x.SignatureReward != nil {
+		encodeBinaryAmount(e, "SignatureReward", x.SignatureReward)
+	}
+	if x.XChainBridge != (types.XChainBridge{}) {
+		encodeBinaryBridge(e, x.XChainBridge)
+	}
+}
+
+// DecodeBinary reads the XChainAccountCreateCommit from d, skipping fields an XChainAccountCreateCommit does not have.
+func (x *XChainAccountCreateCommit) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			x.Amount = decodeBinaryAmount(d)
+		case "Destination":
+			x.Destination = types.Address(d.AccountID())
+		case "SignatureReward":
+			x.SignatureReward = decodeBinaryAmount(d)
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		default:
+			if !x.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for XChainAccountCreateCommit.
+func (x *XChainAccountCreateCommit) UnmarshalJSON(data []byte) error {
+	type Alias XChainAccountCreateCommit
+	aux := &struct {
+		Amount          json.RawMessage
+		SignatureReward json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	if x.SignatureReward, err = types.UnmarshalCurrencyAmount(aux.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates the XChainAccountCreateCommit transaction.
 func (x *XChainAccountCreateCommit) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
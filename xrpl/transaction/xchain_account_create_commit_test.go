@@ -38,8 +38,8 @@ func TestXChainAccountCreateCommit_Flatten(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected: FlatTransaction{
@@ -51,8 +51,8 @@ func TestXChainAccountCreateCommit_Flatten(t *testing.T) {
 				"XChainBridge": types.FlatXChainBridge{
 					"LockingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					"IssuingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"LockingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"IssuingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"LockingChainIssue": map[string]any{"currency": "XRP"},
+					"IssuingChainIssue": map[string]any{"currency": "XRP"},
 				},
 			},
 		},
@@ -150,8 +150,8 @@ func TestXChainAccountCreateCommit_Validate(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected: true,
@@ -1,12 +1,24 @@
 package transaction
 
 import (
+	"encoding/json"
 	"strconv"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// XChainAddAccountCreateAttestationMetadata represents the resulting metadata of a succeeded XChainAddAccountCreateAttestation transaction.
+// It extends from TxObjMeta.
+type XChainAddAccountCreateAttestationMetadata struct {
+	TxObjMeta
+
+	// XChainOwnedCreateAccountClaimID is the ledger index of the XChainOwnedCreateAccountClaimID entry the
+	// attestation was added to. The entry is deleted when the attestation completes the quorum and the account is created.
+	XChainOwnedCreateAccountClaimID *types.Hash256 `json:"-"`
+}
+
 // XChainAddAccountCreateAttestation provides an attestation that an XChainAccountCreateCommit transaction occurred on the other chain. (Requires the XChainBridge amendment)
 //
 // The signature must be from one of the keys on the door's signer list at the time the signature was provided.
@@ -128,6 +140,103 @@ func (x *XChainAddAccountCreateAttestation) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// EncodeBinary writes the XChainAddAccountCreateAttestation to e. It writes the same fields as Flatten.
+func (x *XChainAddAccountCreateAttestation) EncodeBinary(e *binarycodec.Encoder) {
+	x.BaseTx.encodeBinary(e)
+	e.TransactionType(x.TxType().String())
+
+	if x.Amount != nil {
+		encodeBinaryAmount(e, "Amount", x.Amount)
+	}
+	if x.AttestationRewardAccount != "" {
+		e.AccountID("AttestationRewardAccount", x.AttestationRewardAccount.String())
+	}
+	if x.AttestationSignerAccount != "" {
+		e.AccountID("AttestationSignerAccount", x.AttestationSignerAccount.String())
+	}
+	if x.Destination != "" {
+		e.AccountID("Destination", x.Destination.String())
+	}
+	if x.OtherChainSource != "" {
+		e.AccountID("OtherChainSource", x.OtherChainSource.String())
+	}
+	if x.PublicKey != "" {
+		e.Blob("PublicKey", x.PublicKey)
+	}
+	if x.Signature != "" {
+		e.Blob("Signature", x.Signature)
+	}
+	if x.SignatureReward != nil {
+		encodeBinaryAmount(e, "SignatureReward", x.SignatureReward)
+	}
+	e.UInt8("WasLockingChainSend", x.WasLockingChainSend)
+	if x.XChainAccountCreateCount != "" {
+		encodeBinaryUInt64(e, "XChainAccountCreateCount", x.XChainAccountCreateCount)
+	}
+	if x.XChainBridge != (types.XChainBridge{}) {
+		encodeBinaryBridge(e, x.XChainBridge)
+	}
+}
+
+// DecodeBinary reads the XChainAddAccountCreateAttestation from d, skipping fields an XChainAddAccountCreateAttestation does not have.
+func (x *XChainAddAccountCreateAttestation) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			x.Amount = decodeBinaryAmount(d)
+		case "AttestationRewardAccount":
+			x.AttestationRewardAccount = types.Address(d.AccountID())
+		case "AttestationSignerAccount":
+			x.AttestationSignerAccount = types.Address(d.AccountID())
+		case "Destination":
+			x.Destination = types.Address(d.AccountID())
+		case "OtherChainSource":
+			x.OtherChainSource = types.Address(d.AccountID())
+		case "PublicKey":
+			x.PublicKey = d.Blob()
+		case "Signature":
+			x.Signature = d.Blob()
+		case "SignatureReward":
+			x.SignatureReward = decodeBinaryAmount(d)
+		case "WasLockingChainSend":
+			x.WasLockingChainSend = d.UInt8()
+		case "XChainAccountCreateCount":
+			x.XChainAccountCreateCount = decodeBinaryUInt64(d)
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		default:
+			if !x.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for XChainAddAccountCreateAttestation.
+func (x *XChainAddAccountCreateAttestation) UnmarshalJSON(data []byte) error {
+	type Alias XChainAddAccountCreateAttestation
+	aux := &struct {
+		Amount          json.RawMessage
+		SignatureReward json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	if x.SignatureReward, err = types.UnmarshalCurrencyAmount(aux.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks XChainAddAccountCreateAttestation fields and returns false and an error if invalid.
 func (x *XChainAddAccountCreateAttestation) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
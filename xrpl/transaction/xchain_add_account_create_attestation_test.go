@@ -45,8 +45,8 @@ func TestXChainAddAccountCreateAttestation_Flatten(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected: FlatTransaction{
@@ -65,8 +65,8 @@ func TestXChainAddAccountCreateAttestation_Flatten(t *testing.T) {
 				"XChainBridge": types.FlatXChainBridge{
 					"LockingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					"IssuingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"LockingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"IssuingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"LockingChainIssue": map[string]any{"currency": "XRP"},
+					"IssuingChainIssue": map[string]any{"currency": "XRP"},
 				},
 			},
 		},
@@ -304,8 +304,8 @@ func TestXChainAddAccountCreateAttestation_Validate(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  types.Address("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"),
 					IssuingChainDoor:  types.Address("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"),
-					LockingChainIssue: types.Address("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"),
-					IssuingChainIssue: types.Address("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"),
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected: true,
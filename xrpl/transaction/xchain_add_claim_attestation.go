@@ -1,11 +1,24 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// XChainAddClaimAttestationMetadata represents the resulting metadata of a succeeded XChainAddClaimAttestation transaction.
+// It extends from TxObjMeta.
+type XChainAddClaimAttestationMetadata struct {
+	TxObjMeta
+
+	// XChainOwnedClaimID is the ledger index of the XChainOwnedClaimID entry the attestation was added to.
+	// The entry is deleted when the attestation completes the quorum and the funds are transferred.
+	XChainOwnedClaimID *types.Hash256 `json:"-"`
+}
+
 // XChainAddClaimAttestation transaction provides proof from a witness server,
 // attesting to an XChainCommit transaction.
 //
@@ -143,6 +156,94 @@ func (x *XChainAddClaimAttestation) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// EncodeBinary writes the XChainAddClaimAttestation to e. It writes the same fields as Flatten.
+func (x *XChainAddClaimAttestation) EncodeBinary(e *binarycodec.Encoder) {
+	x.BaseTx.encodeBinary(e)
+	e.TransactionType(x.TxType().String())
+
+	if x.Amount != nil {
+		encodeBinaryAmount(e, "Amount", x.Amount)
+	}
+	if x.AttestationRewardAccount != "" {
+		e.AccountID("AttestationRewardAccount", x.AttestationRewardAccount.String())
+	}
+	if x.AttestationSignerAccount != "" {
+		e.AccountID("AttestationSignerAccount", x.AttestationSignerAccount.String())
+	}
+	if x.Destination != "" {
+		e.AccountID("Destination", x.Destination.String())
+	}
+	if x.OtherChainSource != "" {
+		e.AccountID("OtherChainSource", x.OtherChainSource.String())
+	}
+	if x.PublicKey != "" {
+		e.Blob("PublicKey", x.PublicKey)
+	}
+	if x.Signature != "" {
+		e.Blob("Signature", x.Signature)
+	}
+	e.UInt8("WasLockingChainSend", x.WasLockingChainSend)
+	if x.XChainBridge != (types.XChainBridge{}) {
+		encodeBinaryBridge(e, x.XChainBridge)
+	}
+	if x.XChainClaimID != "" {
+		encodeBinaryUInt64(e, "XChainClaimID", x.XChainClaimID)
+	}
+}
+
+// DecodeBinary reads the XChainAddClaimAttestation from d, skipping fields an XChainAddClaimAttestation does not have.
+func (x *XChainAddClaimAttestation) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			x.Amount = decodeBinaryAmount(d)
+		case "AttestationRewardAccount":
+			x.AttestationRewardAccount = types.Address(d.AccountID())
+		case "AttestationSignerAccount":
+			x.AttestationSignerAccount = types.Address(d.AccountID())
+		case "Destination":
+			x.Destination = types.Address(d.AccountID())
+		case "OtherChainSource":
+			x.OtherChainSource = types.Address(d.AccountID())
+		case "PublicKey":
+			x.PublicKey = d.Blob()
+		case "Signature":
+			x.Signature = d.Blob()
+		case "WasLockingChainSend":
+			x.WasLockingChainSend = d.UInt8()
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		case "XChainClaimID":
+			x.XChainClaimID = decodeBinaryUInt64(d)
+		default:
+			if !x.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for XChainAddClaimAttestation.
+func (x *XChainAddClaimAttestation) UnmarshalJSON(data []byte) error {
+	type Alias XChainAddClaimAttestation
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates the transaction.
 func (x *XChainAddClaimAttestation) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
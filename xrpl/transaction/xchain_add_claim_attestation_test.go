@@ -43,8 +43,8 @@ func TestXChainAddClaimAttestation_Flatten(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 				XChainClaimID: "0000000000000001",
 			},
@@ -62,8 +62,8 @@ func TestXChainAddClaimAttestation_Flatten(t *testing.T) {
 				"XChainBridge": types.FlatXChainBridge{
 					"LockingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					"IssuingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"LockingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"IssuingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"LockingChainIssue": map[string]any{"currency": "XRP"},
+					"IssuingChainIssue": map[string]any{"currency": "XRP"},
 				},
 				"XChainClaimID": "0000000000000001",
 			},
@@ -276,8 +276,8 @@ func TestXChainAddClaimAttestation_Validate(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected: true,
@@ -1,7 +1,10 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -63,7 +66,7 @@ func (x *XChainClaim) TxType() TxType {
 func (x *XChainClaim) Flatten() FlatTransaction {
 	flatTx := x.BaseTx.Flatten()
 
-	flatTx["TransactionType"] = x.TxType()
+	flatTx["TransactionType"] = x.TxType().String()
 
 	if x.Amount != nil {
 		flatTx["Amount"] = x.Amount.Flatten()
@@ -88,6 +91,72 @@ func (x *XChainClaim) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// EncodeBinary writes the XChainClaim to e. It writes the same fields as Flatten.
+func (x *XChainClaim) EncodeBinary(e *binarycodec.Encoder) {
+	x.BaseTx.encodeBinary(e)
+	e.TransactionType(x.TxType().String())
+
+	if x.Amount != nil {
+		encodeBinaryAmount(e, "Amount", x.Amount)
+	}
+	if x.Destination != "" {
+		e.AccountID("Destination", x.Destination.String())
+	}
+	if x.DestinationTag != nil {
+		e.UInt32("DestinationTag", *x.DestinationTag)
+	}
+	if x.XChainBridge != (types.XChainBridge{}) {
+		encodeBinaryBridge(e, x.XChainBridge)
+	}
+	if x.XChainClaimID != "" {
+		encodeBinaryUInt64(e, "XChainClaimID", x.XChainClaimID)
+	}
+}
+
+// DecodeBinary reads the XChainClaim from d, skipping fields an XChainClaim does not have.
+func (x *XChainClaim) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			x.Amount = decodeBinaryAmount(d)
+		case "Destination":
+			x.Destination = types.Address(d.AccountID())
+		case "DestinationTag":
+			tag := d.UInt32()
+			x.DestinationTag = &tag
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		case "XChainClaimID":
+			x.XChainClaimID = decodeBinaryUInt64(d)
+		default:
+			if !x.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for XChainClaim.
+func (x *XChainClaim) UnmarshalJSON(data []byte) error {
+	type Alias XChainClaim
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates the transaction.
 func (x *XChainClaim) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
@@ -27,7 +27,7 @@ func TestXChainClaim_Flatten(t *testing.T) {
 			},
 			expected: FlatTransaction{
 				"Account":         "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
-				"TransactionType": XChainClaimTx,
+				"TransactionType": XChainClaimTx.String(),
 			},
 		},
 		{
@@ -41,23 +41,23 @@ func TestXChainClaim_Flatten(t *testing.T) {
 				DestinationTag: types.DestinationTag(1),
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
-					LockingChainIssue: "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
 					IssuingChainDoor:  "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
-					IssuingChainIssue: "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 				XChainClaimID: "1234567890",
 			},
 			expected: FlatTransaction{
-				"TransactionType": XChainClaimTx,
+				"TransactionType": XChainClaimTx.String(),
 				"Account":         "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
 				"Amount":          types.XRPCurrencyAmount(1000000000).Flatten(),
 				"Destination":     "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
 				"DestinationTag":  uint32(1),
 				"XChainBridge": types.FlatXChainBridge{
 					"LockingChainDoor":  "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
-					"LockingChainIssue": "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+					"LockingChainIssue": map[string]any{"currency": "XRP"},
 					"IssuingChainDoor":  "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
-					"IssuingChainIssue": "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+					"IssuingChainIssue": map[string]any{"currency": "XRP"},
 				},
 				"XChainClaimID": "1234567890",
 			},
@@ -147,9 +147,9 @@ func TestXChainClaim_Validate(t *testing.T) {
 				DestinationTag: types.DestinationTag(1),
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
-					LockingChainIssue: "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
 					IssuingChainDoor:  "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
-					IssuingChainIssue: "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD",
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 				XChainClaimID: "1234567890",
 			},
@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -78,6 +81,66 @@ func (x *XChainCommit) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// EncodeBinary writes the XChainCommit to e. It writes the same fields as Flatten.
+func (x *XChainCommit) EncodeBinary(e *binarycodec.Encoder) {
+	x.BaseTx.encodeBinary(e)
+	e.TransactionType(x.TxType().String())
+
+	if x.Amount != nil {
+		encodeBinaryAmount(e, "Amount", x.Amount)
+	}
+	if x.OtherChainDestination != "" {
+		e.AccountID("OtherChainDestination", x.OtherChainDestination.String())
+	}
+	if x.XChainBridge != (types.XChainBridge{}) {
+		encodeBinaryBridge(e, x.XChainBridge)
+	}
+	if x.XChainClaimID != "" {
+		encodeBinaryUInt64(e, "XChainClaimID", x.XChainClaimID)
+	}
+}
+
+// DecodeBinary reads the XChainCommit from d, skipping fields an XChainCommit does not have.
+func (x *XChainCommit) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Amount":
+			x.Amount = decodeBinaryAmount(d)
+		case "OtherChainDestination":
+			x.OtherChainDestination = types.Address(d.AccountID())
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		case "XChainClaimID":
+			x.XChainClaimID = decodeBinaryUInt64(d)
+		default:
+			if !x.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for XChainCommit.
+func (x *XChainCommit) UnmarshalJSON(data []byte) error {
+	type Alias XChainCommit
+	aux := &struct {
+		Amount json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.Amount, err = types.UnmarshalCurrencyAmount(aux.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks the XChainCommit transaction for correctness and returns whether it is valid.
 func (x *XChainCommit) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
@@ -29,8 +29,8 @@ func TestXChainCommit_Flatten(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 				XChainClaimID: "13f",
 			},
@@ -41,9 +41,9 @@ func TestXChainCommit_Flatten(t *testing.T) {
 				"XChainClaimID":   "13f",
 				"XChainBridge": types.FlatXChainBridge{
 					"LockingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"LockingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"LockingChainIssue": map[string]any{"currency": "XRP"},
 					"IssuingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"IssuingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"IssuingChainIssue": map[string]any{"currency": "XRP"},
 				},
 			},
 		},
@@ -57,8 +57,8 @@ func TestXChainCommit_Flatten(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 				XChainClaimID:         "13f",
 				OtherChainDestination: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
@@ -70,9 +70,9 @@ func TestXChainCommit_Flatten(t *testing.T) {
 				"XChainClaimID":   "13f",
 				"XChainBridge": types.FlatXChainBridge{
 					"LockingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"LockingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"LockingChainIssue": map[string]any{"currency": "XRP"},
 					"IssuingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"IssuingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"IssuingChainIssue": map[string]any{"currency": "XRP"},
 				},
 				"OtherChainDestination": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 			},
@@ -134,8 +134,8 @@ func TestXChainCommit_Validate(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected:    false,
@@ -152,8 +152,8 @@ func TestXChainCommit_Validate(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 				XChainClaimID: "13f",
 			},
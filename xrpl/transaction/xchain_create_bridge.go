@@ -1,6 +1,20 @@
 package transaction
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// XChainCreateBridgeMetadata represents the resulting metadata of a succeeded XChainCreateBridge transaction.
+// It extends from TxObjMeta.
+type XChainCreateBridgeMetadata struct {
+	TxObjMeta
+
+	// BridgeID is the ledger index of the Bridge entry created by the transaction.
+	BridgeID *types.Hash256 `json:"-"`
+}
 
 // XChainCreateBridge creates a new Bridge ledger object and defines a new cross-chain bridge entrance on the chain that the transaction is submitted on.
 // It includes information about door accounts and assets for the bridge.
@@ -67,6 +81,65 @@ func (x *XChainCreateBridge) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// EncodeBinary writes the XChainCreateBridge to e. It writes the same fields as Flatten.
+func (x *XChainCreateBridge) EncodeBinary(e *binarycodec.Encoder) {
+	x.BaseTx.encodeBinary(e)
+	e.TransactionType(x.TxType().String())
+
+	if x.MinAccountCreateAmount != nil {
+		encodeBinaryAmount(e, "MinAccountCreateAmount", x.MinAccountCreateAmount)
+	}
+	if x.SignatureReward != nil {
+		encodeBinaryAmount(e, "SignatureReward", x.SignatureReward)
+	}
+	if x.XChainBridge != (types.XChainBridge{}) {
+		encodeBinaryBridge(e, x.XChainBridge)
+	}
+}
+
+// DecodeBinary reads the XChainCreateBridge from d, skipping fields an XChainCreateBridge does not have.
+func (x *XChainCreateBridge) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "MinAccountCreateAmount":
+			x.MinAccountCreateAmount = decodeBinaryAmount(d)
+		case "SignatureReward":
+			x.SignatureReward = decodeBinaryAmount(d)
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		default:
+			if !x.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for XChainCreateBridge.
+func (x *XChainCreateBridge) UnmarshalJSON(data []byte) error {
+	type Alias XChainCreateBridge
+	aux := &struct {
+		MinAccountCreateAmount json.RawMessage
+		SignatureReward        json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.MinAccountCreateAmount, err = types.UnmarshalCurrencyAmount(aux.MinAccountCreateAmount); err != nil {
+		return err
+	}
+	if x.SignatureReward, err = types.UnmarshalCurrencyAmount(aux.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates the transaction.
 func (x *XChainCreateBridge) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
@@ -28,8 +28,8 @@ func TestXChainCreateBridge_Flatten(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected: FlatTransaction{
@@ -38,9 +38,9 @@ func TestXChainCreateBridge_Flatten(t *testing.T) {
 				"SignatureReward": "0",
 				"XChainBridge": types.FlatXChainBridge{
 					"LockingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"LockingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"LockingChainIssue": map[string]any{"currency": "XRP"},
 					"IssuingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"IssuingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"IssuingChainIssue": map[string]any{"currency": "XRP"},
 				},
 			},
 		},
@@ -55,8 +55,8 @@ func TestXChainCreateBridge_Flatten(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected: FlatTransaction{
@@ -66,9 +66,9 @@ func TestXChainCreateBridge_Flatten(t *testing.T) {
 				"SignatureReward":        "10000",
 				"XChainBridge": types.FlatXChainBridge{
 					"LockingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"LockingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"LockingChainIssue": map[string]any{"currency": "XRP"},
 					"IssuingChainDoor":  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					"IssuingChainIssue": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"IssuingChainIssue": map[string]any{"currency": "XRP"},
 				},
 			},
 		},
@@ -143,8 +143,8 @@ func TestXChainCreateBridge_Validate(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected:    true,
@@ -162,8 +162,8 @@ func TestXChainCreateBridge_Validate(t *testing.T) {
 				XChainBridge: types.XChainBridge{
 					LockingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
 					IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					LockingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-					IssuingChainIssue: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					LockingChainIssue: types.Issue{Currency: "XRP"},
+					IssuingChainIssue: types.Issue{Currency: "XRP"},
 				},
 			},
 			expected:    true,
@@ -1,10 +1,22 @@
 package transaction
 
 import (
+	"encoding/json"
+
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
+// XChainCreateClaimIDMetadata represents the resulting metadata of a succeeded XChainCreateClaimID transaction.
+// It extends from TxObjMeta.
+type XChainCreateClaimIDMetadata struct {
+	TxObjMeta
+
+	// XChainClaimID is the cross-chain claim ID created by the transaction.
+	XChainClaimID string `json:"-"`
+}
+
 // XChainCreateClaimID transaction creates a new cross-chain claim ID that is used for a cross-chain transfer.
 // A cross-chain claim ID represents one cross-chain transfer of value.
 //
@@ -71,6 +83,61 @@ func (x *XChainCreateClaimID) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// EncodeBinary writes the XChainCreateClaimID to e. It writes the same fields as Flatten.
+func (x *XChainCreateClaimID) EncodeBinary(e *binarycodec.Encoder) {
+	x.BaseTx.encodeBinary(e)
+	e.TransactionType(x.TxType().String())
+
+	if x.OtherChainSource != "" {
+		e.AccountID("OtherChainSource", x.OtherChainSource.String())
+	}
+	if x.SignatureReward != nil {
+		encodeBinaryAmount(e, "SignatureReward", x.SignatureReward)
+	}
+	if x.XChainBridge != (types.XChainBridge{}) {
+		encodeBinaryBridge(e, x.XChainBridge)
+	}
+}
+
+// DecodeBinary reads the XChainCreateClaimID from d, skipping fields an XChainCreateClaimID does not have.
+func (x *XChainCreateClaimID) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "OtherChainSource":
+			x.OtherChainSource = types.Address(d.AccountID())
+		case "SignatureReward":
+			x.SignatureReward = decodeBinaryAmount(d)
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		default:
+			if !x.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for XChainCreateClaimID.
+func (x *XChainCreateClaimID) UnmarshalJSON(data []byte) error {
+	type Alias XChainCreateClaimID
+	aux := &struct {
+		SignatureReward json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.SignatureReward, err = types.UnmarshalCurrencyAmount(aux.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks the transaction fields for correctness and returns an error if invalid.
 func (x *XChainCreateClaimID) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
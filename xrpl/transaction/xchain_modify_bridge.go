@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/flag"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -84,6 +87,73 @@ func (x *XChainModifyBridge) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// EncodeBinary writes the XChainModifyBridge to e. It writes the same fields as Flatten.
+func (x *XChainModifyBridge) EncodeBinary(e *binarycodec.Encoder) {
+	// Flags shadows BaseTx.Flags, and Flatten writes it over the BaseTx one when it is set.
+	base := x.BaseTx
+	if x.Flags != 0 {
+		base.Flags = x.Flags
+	}
+	base.encodeBinary(e)
+	e.TransactionType(x.TxType().String())
+
+	if x.MinAccountCreateAmount != nil {
+		encodeBinaryAmount(e, "MinAccountCreateAmount", x.MinAccountCreateAmount)
+	}
+	if x.SignatureReward != nil {
+		encodeBinaryAmount(e, "SignatureReward", x.SignatureReward)
+	}
+	if x.XChainBridge != (types.XChainBridge{}) {
+		encodeBinaryBridge(e, x.XChainBridge)
+	}
+}
+
+// DecodeBinary reads the XChainModifyBridge from d, skipping fields an XChainModifyBridge does not have.
+func (x *XChainModifyBridge) DecodeBinary(d *binarycodec.Decoder) error {
+	for d.Next() {
+		switch d.Field() {
+		case "Flags":
+			x.Flags = d.UInt32()
+			x.keepFlags()
+		case "MinAccountCreateAmount":
+			x.MinAccountCreateAmount = decodeBinaryAmount(d)
+		case "SignatureReward":
+			x.SignatureReward = decodeBinaryAmount(d)
+		case "XChainBridge":
+			x.XChainBridge = decodeBinaryBridge(d)
+		default:
+			if !x.BaseTx.decodeBinaryField(d) {
+				d.Skip()
+			}
+		}
+	}
+	return d.Err()
+}
+
+// UnmarshalJSON implements custom JSON unmarshalling for XChainModifyBridge.
+func (x *XChainModifyBridge) UnmarshalJSON(data []byte) error {
+	type Alias XChainModifyBridge
+	aux := &struct {
+		MinAccountCreateAmount json.RawMessage
+		SignatureReward        json.RawMessage
+		*Alias
+	}{
+		Alias: (*Alias)(x),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if x.MinAccountCreateAmount, err = types.UnmarshalCurrencyAmount(aux.MinAccountCreateAmount); err != nil {
+		return err
+	}
+	if x.SignatureReward, err = types.UnmarshalCurrencyAmount(aux.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks the XChainModifyBridge fields for correctness and returns an error if invalid.
 func (x *XChainModifyBridge) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
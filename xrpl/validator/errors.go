@@ -0,0 +1,53 @@
+package validator
+
+import "errors"
+
+var (
+	// manifest
+
+	// ErrInvalidManifest is returned when a manifest cannot be decoded or misses required fields.
+	ErrInvalidManifest = errors.New("invalid manifest")
+	// ErrInvalidMasterSignature is returned when the master signature of a manifest does not verify against its master key.
+	ErrInvalidMasterSignature = errors.New("invalid manifest master signature")
+	// ErrInvalidEphemeralSignature is returned when the signature of a manifest does not verify against its ephemeral key.
+	ErrInvalidEphemeralSignature = errors.New("invalid manifest ephemeral signature")
+
+	// manifest cache
+
+	// ErrStaleManifest is returned when a manifest is not newer than the known manifest of its master key.
+	ErrStaleManifest = errors.New("manifest sequence is not newer than the known manifest")
+	// ErrEphemeralKeyInUse is returned when the ephemeral key of a manifest is already the master or ephemeral key of another validator.
+	ErrEphemeralKeyInUse = errors.New("manifest ephemeral key is in use by another validator")
+
+	// validator list
+
+	// ErrInvalidPublicKey is returned when a key is neither a hex encoded public key nor a node public key.
+	ErrInvalidPublicKey = errors.New("invalid public key")
+	// ErrInvalidValidatorList is returned when a validator list document or blob cannot be decoded.
+	ErrInvalidValidatorList = errors.New("invalid validator list")
+	// ErrUnsupportedValidatorListVersion is returned when a validator list document is neither version 1 nor version 2.
+	ErrUnsupportedValidatorListVersion = errors.New("unsupported validator list version")
+	// ErrUntrustedPublisher is returned when a validator list is not published by the expected publisher key.
+	ErrUntrustedPublisher = errors.New("validator list publisher is not trusted")
+	// ErrRevokedPublisher is returned when the manifest of a validator list publisher revokes its master key.
+	ErrRevokedPublisher = errors.New("validator list publisher is revoked")
+	// ErrInvalidValidatorListSignature is returned when a validator list blob is not signed by the publisher ephemeral key.
+	ErrInvalidValidatorListSignature = errors.New("invalid validator list signature")
+	// ErrValidatorListExpired is returned when a validator list has expired.
+	ErrValidatorListExpired = errors.New("validator list has expired")
+	// ErrValidatorListNotEffective is returned when a validator list is not effective yet.
+	ErrValidatorListNotEffective = errors.New("validator list is not effective yet")
+
+	// validation
+
+	// ErrInvalidValidation is returned when a validation cannot be decoded or misses required fields.
+	ErrInvalidValidation = errors.New("invalid validation")
+	// ErrInvalidValidationSignature is returned when the signature of a validation does not verify against its signing key.
+	ErrInvalidValidationSignature = errors.New("invalid validation signature")
+	// ErrValidationMismatch is returned when the fields of a validations stream message do not match its signed data.
+	ErrValidationMismatch = errors.New("validation does not match its signed data")
+	// ErrUntrustedValidator is returned when a validation is not signed by a trusted validator.
+	ErrUntrustedValidator = errors.New("validator is not trusted")
+	// ErrRevokedValidator is returned when a validation is signed by a validator whose master key is revoked.
+	ErrRevokedValidator = errors.New("validator is revoked")
+)
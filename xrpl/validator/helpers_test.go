@@ -0,0 +1,169 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/keypairs/interfaces"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/stretchr/testify/require"
+)
+
+type testKey struct {
+	entropy byte
+	private string
+	public  string
+	node    string
+}
+
+// newTestKey derives a deterministic key pair from a single entropy byte.
+func newTestKey(t *testing.T, b byte, alg interfaces.KeypairCryptoAlg) testKey {
+	t.Helper()
+	seed, err := keypairs.GenerateSeed(bytes.Repeat([]byte{b}, addresscodec.FamilySeedLength), alg, nil)
+	require.NoError(t, err)
+	private, public, err := keypairs.DeriveKeypair(seed, false)
+	require.NoError(t, err)
+	pub, err := hex.DecodeString(public)
+	require.NoError(t, err)
+	node, err := addresscodec.EncodeNodePublicKey(pub)
+	require.NoError(t, err)
+	return testKey{entropy: b, private: private, public: public, node: node}
+}
+
+func newTestMasterKey(t *testing.T, b byte) testKey {
+	return newTestKey(t, b, crypto.ED25519())
+}
+
+func newTestEphemeralKey(t *testing.T, b byte) testKey {
+	return newTestKey(t, b, crypto.SECP256K1())
+}
+
+// newTestManifest signs a manifest binding master to ephemeral, and returns its base64 encoding.
+// A nil ephemeral key makes a revocation.
+func newTestManifest(t *testing.T, master testKey, ephemeral *testKey, sequence uint32, domain string) string {
+	t.Helper()
+	e := binarycodec.NewEncoder()
+	e.UInt32("Sequence", sequence)
+	e.Blob("PublicKey", master.public)
+	if ephemeral != nil {
+		e.Blob("SigningPubKey", ephemeral.public)
+	}
+	if domain != "" {
+		e.Blob("Domain", hex.EncodeToString([]byte(domain)))
+	}
+	return signTestManifest(t, e, master, ephemeral)
+}
+
+// newTestVersionedManifest signs a manifest with a Version field, as rippled 2.x manifests may have.
+func newTestVersionedManifest(t *testing.T, master testKey, ephemeral testKey, sequence uint32, version uint16) string {
+	t.Helper()
+	e := binarycodec.NewEncoder()
+	e.UInt16("Version", version)
+	e.UInt32("Sequence", sequence)
+	e.Blob("PublicKey", master.public)
+	e.Blob("SigningPubKey", ephemeral.public)
+	return signTestManifest(t, e, master, &ephemeral)
+}
+
+// signTestManifest adds the master and ephemeral signatures of the fields of e, and returns the
+// base64 encoding of the manifest.
+func signTestManifest(t *testing.T, e *binarycodec.Encoder, master testKey, ephemeral *testKey) string {
+	t.Helper()
+	fields, err := e.Bytes()
+	require.NoError(t, err)
+	signingData := string(append([]byte("MAN\x00"), fields...))
+
+	masterSignature, err := keypairs.Sign(signingData, master.private)
+	require.NoError(t, err)
+	e.Blob("MasterSignature", masterSignature)
+	if ephemeral != nil {
+		signature, err := keypairs.Sign(signingData, ephemeral.private)
+		require.NoError(t, err)
+		e.Blob("Signature", signature)
+	}
+	b, err := e.Bytes()
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+type testValidatorListBlob struct {
+	sequence   uint64
+	effective  int64
+	expiration int64
+	validators []testKey
+}
+
+// newTestValidatorListBlob encodes the contents of a validator list, with a manifest for every
+// validator, and signs them with the publisher ephemeral key.
+func newTestValidatorListBlob(t *testing.T, ephemeral testKey, contents testValidatorListBlob) validatorListBlob {
+	t.Helper()
+	c := validatorListContents{
+		Sequence:   contents.sequence,
+		Effective:  contents.effective,
+		Expiration: contents.expiration,
+	}
+	for _, v := range contents.validators {
+		validatorEphemeral := newTestEphemeralKey(t, v.entropy+100)
+		c.Validators = append(c.Validators, struct {
+			ValidationPublicKey string `json:"validation_public_key"`
+			Manifest            string `json:"manifest,omitempty"`
+		}{
+			ValidationPublicKey: v.public,
+			Manifest:            newTestManifest(t, v, &validatorEphemeral, 1, ""),
+		})
+	}
+	data, err := json.Marshal(c)
+	require.NoError(t, err)
+	signature, err := keypairs.Sign(string(data), ephemeral.private)
+	require.NoError(t, err)
+	return validatorListBlob{Blob: base64.StdEncoding.EncodeToString(data), Signature: signature}
+}
+
+// newTestValidation signs a full or partial validation of a ledger, and returns the validations
+// stream message carrying it.
+func newTestValidation(t *testing.T, signing testKey, ledgerIndex uint32, ledgerHash string, signingTime uint32, full bool) *streamtypes.ValidationStream {
+	t.Helper()
+	flags := uint32(0x80000000)
+	if full {
+		flags |= FullValidationFlag
+	}
+	e := binarycodec.NewEncoder()
+	e.UInt32("Flags", flags)
+	e.UInt32("LedgerSequence", ledgerIndex)
+	e.UInt32("SigningTime", signingTime)
+	e.UInt32("LoadFee", 256)
+	e.UInt64("Cookie", 0x0123456789ABCDEF)
+	e.Hash256("LedgerHash", ledgerHash)
+	e.Hash256("ConsensusHash", strings.Repeat("AB", 32))
+	e.Value("Amendments", []any{strings.Repeat("CD", 32)})
+	e.Blob("SigningPubKey", signing.public)
+	fields, err := e.Bytes()
+	require.NoError(t, err)
+
+	signature, err := keypairs.Sign(string(append([]byte("VAL\x00"), fields...)), signing.private)
+	require.NoError(t, err)
+	e.Blob("Signature", signature)
+	data, err := e.Bytes()
+	require.NoError(t, err)
+
+	return &streamtypes.ValidationStream{
+		Type:                streamtypes.ValidationStreamType,
+		Data:                hex.EncodeToString(data),
+		Flags:               flags,
+		Full:                full,
+		LedgerHash:          common.LedgerHash(ledgerHash),
+		LedgerIndex:         common.LedgerIndex(ledgerIndex),
+		Signature:           signature,
+		SigningTime:         uint64(signingTime),
+		ValidationPublicKey: signing.node,
+	}
+}
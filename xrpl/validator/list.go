@@ -0,0 +1,297 @@
+package validator
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/pkg/hexutil"
+	rippletime "github.com/Peersyst/xrpl-go/xrpl/time"
+)
+
+// ListedValidator is a validator of a validator list.
+type ListedValidator struct {
+	// MasterKey is the master key of the validator, as a node public key.
+	MasterKey string
+	// Manifest is the manifest of the validator published with the list, if any.
+	Manifest *Manifest
+}
+
+// ValidatorList is a validator list (UNL) signed by a publisher, such as vl.ripple.com.
+type ValidatorList struct {
+	// PublisherKey is the master key of the publisher, as a node public key.
+	PublisherKey string
+	// PublisherManifest is the manifest of the ephemeral key the list is signed with.
+	PublisherManifest *Manifest
+	// Sequence increases with every list of the publisher.
+	Sequence uint64
+	// Effective is the time the list becomes valid. It is the zero time for lists that are
+	// valid as soon as they are published.
+	Effective time.Time
+	// Expiration is the time the list stops being valid.
+	Expiration time.Time
+	Validators []ListedValidator
+}
+
+// ValidatorListDiff lists the master keys of the validators added to and removed from a
+// validator list.
+type ValidatorListDiff struct {
+	Added   []string
+	Removed []string
+}
+
+// validatorListDocument is a validator list as served by publishers. Version 1 documents hold a
+// single blob, version 2 documents hold the current list and the lists taking effect next in
+// blobs_v2.
+type validatorListDocument struct {
+	PublicKey string              `json:"public_key"`
+	Manifest  string              `json:"manifest"`
+	Blob      string              `json:"blob,omitempty"`
+	Signature string              `json:"signature,omitempty"`
+	Version   int                 `json:"version"`
+	BlobsV2   []validatorListBlob `json:"blobs_v2,omitempty"`
+}
+
+type validatorListBlob struct {
+	Blob      string `json:"blob"`
+	Signature string `json:"signature"`
+	Manifest  string `json:"manifest,omitempty"`
+}
+
+// validatorListContents is the decoded blob of a validator list. Times are in seconds since
+// the Ripple epoch.
+type validatorListContents struct {
+	Sequence   uint64 `json:"sequence"`
+	Effective  int64  `json:"effective,omitempty"`
+	Expiration int64  `json:"expiration"`
+	Validators []struct {
+		ValidationPublicKey string `json:"validation_public_key"`
+		Manifest            string `json:"manifest,omitempty"`
+	} `json:"validators"`
+}
+
+// ParseValidatorList decodes a validator list document, in version 1 or version 2 format, and
+// verifies it was signed by publisherKey. publisherKey is the master key of the publisher, as a
+// hex encoded public key, the form used by the validator_list_keys setting of rippled, or as a
+// node public key.
+//
+// It verifies the publisher manifest, the signature of every list of the document, and the
+// manifests of the listed validators. It returns the lists ordered by sequence, without
+// checking their effective and expiration times, see ValidatorList.Check and ActiveValidatorList.
+func ParseValidatorList(document []byte, publisherKey string) ([]*ValidatorList, error) {
+	publisher, err := nodePublicKey(publisherKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc validatorListDocument
+	if err := json.Unmarshal(document, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidValidatorList, err)
+	}
+	if documentKey, err := nodePublicKey(doc.PublicKey); err != nil || documentKey != publisher {
+		return nil, ErrUntrustedPublisher
+	}
+
+	var blobs []validatorListBlob
+	switch doc.Version {
+	case 1:
+		blobs = []validatorListBlob{{Blob: doc.Blob, Signature: doc.Signature}}
+	case 2:
+		blobs = doc.BlobsV2
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedValidatorListVersion, doc.Version)
+	}
+	if len(blobs) == 0 {
+		return nil, fmt.Errorf("%w: no blobs", ErrInvalidValidatorList)
+	}
+
+	lists := make([]*ValidatorList, 0, len(blobs))
+	for _, blob := range blobs {
+		manifest := doc.Manifest
+		if blob.Manifest != "" {
+			manifest = blob.Manifest
+		}
+		publisherManifest, err := parsePublisherManifest(manifest, publisher)
+		if err != nil {
+			return nil, err
+		}
+		list, err := parseValidatorListBlob(blob, publisherManifest)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, list)
+	}
+	sort.Slice(lists, func(i, j int) bool { return lists[i].Sequence < lists[j].Sequence })
+	return lists, nil
+}
+
+// VerifyValidatorList decodes and verifies a validator list document like ParseValidatorList,
+// and returns its list active at now.
+func VerifyValidatorList(document []byte, publisherKey string, now time.Time) (*ValidatorList, error) {
+	lists, err := ParseValidatorList(document, publisherKey)
+	if err != nil {
+		return nil, err
+	}
+	return ActiveValidatorList(lists, now)
+}
+
+// ActiveValidatorList returns the list active at now: the list with the greatest sequence among
+// the lists that are effective and have not expired.
+func ActiveValidatorList(lists []*ValidatorList, now time.Time) (*ValidatorList, error) {
+	var (
+		active  *ValidatorList
+		lastErr = fmt.Errorf("%w: no lists", ErrInvalidValidatorList)
+	)
+	for _, l := range lists {
+		if err := l.Check(now); err != nil {
+			lastErr = err
+			continue
+		}
+		if active == nil || l.Sequence > active.Sequence {
+			active = l
+		}
+	}
+	if active == nil {
+		return nil, lastErr
+	}
+	return active, nil
+}
+
+// Check returns an error when the list is not valid at now, because it has expired or is not
+// effective yet.
+func (l *ValidatorList) Check(now time.Time) error {
+	if !now.Before(l.Expiration) {
+		return fmt.Errorf("%w: expired at %s", ErrValidatorListExpired, l.Expiration.Format(time.RFC3339))
+	}
+	if !l.Effective.IsZero() && now.Before(l.Effective) {
+		return fmt.Errorf("%w: effective at %s", ErrValidatorListNotEffective, l.Effective.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// MasterKeys returns the master keys of the validators of the list.
+func (l *ValidatorList) MasterKeys() []string {
+	keys := make([]string, len(l.Validators))
+	for i, v := range l.Validators {
+		keys[i] = v.MasterKey
+	}
+	return keys
+}
+
+// Diff returns the validators of next that are not in l, and the validators of l that are
+// not in next.
+func (l *ValidatorList) Diff(next *ValidatorList) ValidatorListDiff {
+	previous := make(map[string]bool, len(l.Validators))
+	for _, v := range l.Validators {
+		previous[v.MasterKey] = true
+	}
+	current := make(map[string]bool, len(next.Validators))
+	for _, v := range next.Validators {
+		current[v.MasterKey] = true
+	}
+
+	var diff ValidatorListDiff
+	for _, v := range next.Validators {
+		if !previous[v.MasterKey] {
+			diff.Added = append(diff.Added, v.MasterKey)
+		}
+	}
+	for _, v := range l.Validators {
+		if !current[v.MasterKey] {
+			diff.Removed = append(diff.Removed, v.MasterKey)
+		}
+	}
+	return diff
+}
+
+// parsePublisherManifest decodes and verifies the manifest of a publisher.
+func parsePublisherManifest(manifest, publisher string) (*Manifest, error) {
+	m, err := ParseManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if m.MasterKey != publisher {
+		return nil, ErrUntrustedPublisher
+	}
+	if err := m.Verify(); err != nil {
+		return nil, err
+	}
+	if m.Revoked() {
+		return nil, ErrRevokedPublisher
+	}
+	return m, nil
+}
+
+// parseValidatorListBlob verifies the signature of a blob with the ephemeral key of the
+// publisher and decodes it.
+func parseValidatorListBlob(blob validatorListBlob, publisherManifest *Manifest) (*ValidatorList, error) {
+	data, err := base64.StdEncoding.DecodeString(blob.Blob)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidValidatorList, err)
+	}
+	ok, err := keypairs.Validate(string(data), hexutil.EncodeToUpperHex(publisherManifest.ephemeralPublicKey), blob.Signature)
+	if err != nil || !ok {
+		return nil, ErrInvalidValidatorListSignature
+	}
+
+	var contents validatorListContents
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidValidatorList, err)
+	}
+	if contents.Expiration == 0 {
+		return nil, fmt.Errorf("%w: missing expiration", ErrInvalidValidatorList)
+	}
+
+	list := &ValidatorList{
+		PublisherKey:      publisherManifest.MasterKey,
+		PublisherManifest: publisherManifest,
+		Sequence:          contents.Sequence,
+		Expiration:        rippleTime(contents.Expiration),
+		Validators:        make([]ListedValidator, 0, len(contents.Validators)),
+	}
+	if contents.Effective != 0 {
+		list.Effective = rippleTime(contents.Effective)
+	}
+	for _, v := range contents.Validators {
+		masterKey, err := nodePublicKey(v.ValidationPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidValidatorList, err)
+		}
+		validator := ListedValidator{MasterKey: masterKey}
+		if v.Manifest != "" {
+			m, err := ParseManifest(v.Manifest)
+			if err != nil {
+				return nil, err
+			}
+			if m.MasterKey != masterKey {
+				return nil, fmt.Errorf("%w: manifest of %s is for %s", ErrInvalidManifest, masterKey, m.MasterKey)
+			}
+			if err := m.Verify(); err != nil {
+				return nil, err
+			}
+			validator.Manifest = m
+		}
+		list.Validators = append(list.Validators, validator)
+	}
+	return list, nil
+}
+
+// nodePublicKey returns the node public key encoding of a hex encoded or node public key.
+func nodePublicKey(key string) (string, error) {
+	if b, err := hex.DecodeString(key); err == nil && len(b) == addresscodec.NodePublicKeyLength {
+		return addresscodec.EncodeNodePublicKey(b)
+	}
+	if _, err := addresscodec.DecodeNodePublicKey(key); err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPublicKey, key)
+	}
+	return key, nil
+}
+
+func rippleTime(t int64) time.Time {
+	return time.Unix(rippletime.RippleTimeToUnixSeconds(t), 0).UTC()
+}
@@ -0,0 +1,273 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	rippletime "github.com/Peersyst/xrpl-go/xrpl/time"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseValidatorList(t *testing.T) {
+	publisher := newTestMasterKey(t, 10)
+	publisherEphemeral := newTestEphemeralKey(t, 11)
+	publisherManifest := newTestManifest(t, publisher, &publisherEphemeral, 1, "")
+	impostor := newTestMasterKey(t, 12)
+	impostorEphemeral := newTestEphemeralKey(t, 13)
+
+	v1, v2, v3 := newTestMasterKey(t, 20), newTestMasterKey(t, 21), newTestMasterKey(t, 22)
+	current := testValidatorListBlob{sequence: 5, expiration: 800_000_000, validators: []testKey{v1, v2}}
+	next := testValidatorListBlob{sequence: 6, effective: 750_000_000, expiration: 900_000_000, validators: []testKey{v2, v3}}
+
+	document := func(doc validatorListDocument) []byte {
+		b, err := json.Marshal(doc)
+		require.NoError(t, err)
+		return b
+	}
+	v1Document := func(manifest string, blob validatorListBlob) []byte {
+		return document(validatorListDocument{
+			PublicKey: publisher.public,
+			Manifest:  manifest,
+			Blob:      blob.Blob,
+			Signature: blob.Signature,
+			Version:   1,
+		})
+	}
+	forged := newTestValidatorListBlob(t, publisherEphemeral, current)
+	forged.Signature = newTestValidatorListBlob(t, publisherEphemeral, next).Signature
+
+	tests := []struct {
+		name         string
+		document     []byte
+		publisherKey string
+		want         []testValidatorListBlob
+		wantErr      error
+	}{
+		{
+			name:         "pass - version 1",
+			document:     v1Document(publisherManifest, newTestValidatorListBlob(t, publisherEphemeral, current)),
+			publisherKey: publisher.public,
+			want:         []testValidatorListBlob{current},
+		},
+		{
+			name:         "pass - version 1 with node public key",
+			document:     v1Document(publisherManifest, newTestValidatorListBlob(t, publisherEphemeral, current)),
+			publisherKey: publisher.node,
+			want:         []testValidatorListBlob{current},
+		},
+		{
+			name: "pass - version 2",
+			document: document(validatorListDocument{
+				PublicKey: publisher.public,
+				Manifest:  publisherManifest,
+				Version:   2,
+				BlobsV2: []validatorListBlob{
+					newTestValidatorListBlob(t, publisherEphemeral, next),
+					newTestValidatorListBlob(t, publisherEphemeral, current),
+				},
+			}),
+			publisherKey: publisher.public,
+			want:         []testValidatorListBlob{current, next},
+		},
+		{
+			name:         "fail - other publisher",
+			document:     v1Document(publisherManifest, newTestValidatorListBlob(t, publisherEphemeral, current)),
+			publisherKey: impostor.public,
+			wantErr:      ErrUntrustedPublisher,
+		},
+		{
+			name: "fail - manifest of other publisher",
+			document: v1Document(
+				newTestManifest(t, impostor, &impostorEphemeral, 1, ""),
+				newTestValidatorListBlob(t, impostorEphemeral, current),
+			),
+			publisherKey: publisher.public,
+			wantErr:      ErrUntrustedPublisher,
+		},
+		{
+			name:         "fail - revoked publisher",
+			document:     v1Document(newTestManifest(t, publisher, nil, RevokedSequence, ""), newTestValidatorListBlob(t, publisherEphemeral, current)),
+			publisherKey: publisher.public,
+			wantErr:      ErrRevokedPublisher,
+		},
+		{
+			name:         "fail - not signed by publisher ephemeral key",
+			document:     v1Document(publisherManifest, newTestValidatorListBlob(t, impostorEphemeral, current)),
+			publisherKey: publisher.public,
+			wantErr:      ErrInvalidValidatorListSignature,
+		},
+		{
+			name:         "fail - signature of other blob",
+			document:     v1Document(publisherManifest, forged),
+			publisherKey: publisher.public,
+			wantErr:      ErrInvalidValidatorListSignature,
+		},
+		{
+			name:         "fail - unsupported version",
+			document:     document(validatorListDocument{PublicKey: publisher.public, Manifest: publisherManifest, Version: 3}),
+			publisherKey: publisher.public,
+			wantErr:      ErrUnsupportedValidatorListVersion,
+		},
+		{
+			name:         "fail - invalid publisher key",
+			document:     v1Document(publisherManifest, newTestValidatorListBlob(t, publisherEphemeral, current)),
+			publisherKey: "ED00",
+			wantErr:      ErrInvalidPublicKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lists, err := ParseValidatorList(tt.document, tt.publisherKey)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, lists, len(tt.want))
+			for i, want := range tt.want {
+				require.Equal(t, publisher.node, lists[i].PublisherKey)
+				require.Equal(t, publisherEphemeral.node, lists[i].PublisherManifest.EphemeralKey)
+				require.Equal(t, want.sequence, lists[i].Sequence)
+				require.Equal(t, rippletime.RippleTimeToUnixSeconds(want.expiration), lists[i].Expiration.Unix())
+				if want.effective == 0 {
+					require.True(t, lists[i].Effective.IsZero())
+				} else {
+					require.Equal(t, rippletime.RippleTimeToUnixSeconds(want.effective), lists[i].Effective.Unix())
+				}
+				require.Len(t, lists[i].Validators, len(want.validators))
+				for j, v := range want.validators {
+					require.Equal(t, v.node, lists[i].Validators[j].MasterKey)
+					require.Equal(t, v.node, lists[i].Validators[j].Manifest.MasterKey)
+				}
+			}
+		})
+	}
+}
+
+func TestActiveValidatorList(t *testing.T) {
+	publisher := newTestMasterKey(t, 10)
+	publisherEphemeral := newTestEphemeralKey(t, 11)
+	v1, v2, v3 := newTestMasterKey(t, 20), newTestMasterKey(t, 21), newTestMasterKey(t, 22)
+
+	doc, err := json.Marshal(validatorListDocument{
+		PublicKey: publisher.public,
+		Manifest:  newTestManifest(t, publisher, &publisherEphemeral, 1, ""),
+		Version:   2,
+		BlobsV2: []validatorListBlob{
+			newTestValidatorListBlob(t, publisherEphemeral, testValidatorListBlob{sequence: 5, expiration: 800_000_000, validators: []testKey{v1, v2}}),
+			newTestValidatorListBlob(t, publisherEphemeral, testValidatorListBlob{sequence: 6, effective: 750_000_000, expiration: 900_000_000, validators: []testKey{v2, v3}}),
+		},
+	})
+	require.NoError(t, err)
+
+	at := func(rippleTime int64) time.Time {
+		return time.Unix(rippletime.RippleTimeToUnixSeconds(rippleTime), 0)
+	}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		sequence uint64
+		wantErr  error
+	}{
+		{
+			name:     "pass - current list before next list is effective",
+			now:      at(700_000_000),
+			sequence: 5,
+		},
+		{
+			name:     "pass - next list once effective",
+			now:      at(750_000_000),
+			sequence: 6,
+		},
+		{
+			name:     "pass - next list after current list expired",
+			now:      at(850_000_000),
+			sequence: 6,
+		},
+		{
+			name:    "fail - all lists expired",
+			now:     at(900_000_000),
+			wantErr: ErrValidatorListExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := VerifyValidatorList(doc, publisher.public, tt.now)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.sequence, list.Sequence)
+		})
+	}
+
+	lists, err := ParseValidatorList(doc, publisher.public)
+	require.NoError(t, err)
+	require.ErrorIs(t, lists[1].Check(at(700_000_000)), ErrValidatorListNotEffective)
+
+	diff := lists[0].Diff(lists[1])
+	require.Equal(t, []string{v3.node}, diff.Added)
+	require.Equal(t, []string{v1.node}, diff.Removed)
+	require.Equal(t, []string{v2.node, v3.node}, lists[1].MasterKeys())
+
+	manifests := NewManifestCache()
+	require.NoError(t, manifests.AddValidatorList(lists[0]))
+	require.NoError(t, manifests.AddValidatorList(lists[1]))
+	require.Equal(t, 3, manifests.Len())
+}
+
+// TestValidatorList_Recorded verifies validator lists recorded from their publishers with
+// scripts/record-validator-lists.sh, against the publisher keys of the validators-example.txt
+// of rippled. It is skipped for the publishers without a recording.
+func TestValidatorList_Recorded(t *testing.T) {
+	tests := []struct {
+		publisher    string
+		publisherKey string
+	}{
+		{publisher: "vl.ripple.com", publisherKey: "ED2677ABFFD1B33AC6FBC3062B71F1E8397C1505E1C42C64D11AD1B28FF73F4734"},
+		{publisher: "vl.xrplf.org", publisherKey: "ED45D1840EE724BE327ABE9146503D5848EFD5F38B6D5FEDE71E80ACCE5E6E738B"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.publisher, func(t *testing.T) {
+			document, err := os.ReadFile(filepath.Join("testdata", tt.publisher+".json"))
+			if errors.Is(err, fs.ErrNotExist) {
+				t.Skipf("no recording of %s, run scripts/record-validator-lists.sh", tt.publisher)
+			}
+			require.NoError(t, err)
+
+			lists, err := ParseValidatorList(document, tt.publisherKey)
+			require.NoError(t, err)
+			require.NotEmpty(t, lists)
+
+			// The recording is checked at a time fixed by its first list rather than the current
+			// time, at which it expires.
+			first := lists[0]
+			at := first.Expiration.Add(-time.Minute)
+			if !first.Effective.IsZero() {
+				at = first.Effective
+			}
+			list, err := VerifyValidatorList(document, tt.publisherKey, at)
+			require.NoError(t, err)
+			require.NotEmpty(t, list.Validators)
+			for _, v := range list.Validators {
+				if v.Manifest != nil {
+					require.NoError(t, v.Manifest.Verify())
+					require.Equal(t, v.MasterKey, v.Manifest.MasterKey)
+				}
+			}
+
+			_, err = ParseValidatorList(document, newTestMasterKey(t, 1).public)
+			require.ErrorIs(t, err, ErrUntrustedPublisher)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Package validator decodes and verifies the data validators and their publishers publish: the
+// manifests binding validator master keys to ephemeral signing keys, the signed validator lists
+// (UNLs) of publishers, and the validations of ledgers, tallied against a trusted quorum.
+package validator
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/pkg/hexutil"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+)
+
+// RevokedSequence is the sequence of a manifest revoking its master key. A revoked master key
+// can no longer be used, and its validations are no longer trusted.
+const RevokedSequence uint32 = math.MaxUint32
+
+// Manifest is a validator manifest. It binds the long-lived master key of a validator, which
+// identifies it in validator lists, to the ephemeral key it signs validations with. Both keys
+// are node public keys in base58 ("n9...") form.
+//
+// A manifest with a greater Sequence replaces the previous manifest of its master key. A
+// manifest with RevokedSequence revokes the master key and has no ephemeral key. Version is the
+// optional format version of the manifest, zero when the manifest has none.
+type Manifest struct {
+	MasterKey       string
+	EphemeralKey    string
+	Sequence        uint32
+	Version         uint16
+	Domain          string
+	Signature       string
+	MasterSignature string
+
+	hasVersion         bool
+	masterPublicKey    []byte
+	ephemeralPublicKey []byte
+	signingData        []byte
+	raw                []byte
+}
+
+// ParseManifest decodes a base64 encoded manifest, as returned by the manifest command or found
+// in validator lists. It does not verify the manifest signatures, see Verify.
+func ParseManifest(manifest string) (*Manifest, error) {
+	b, err := base64.StdEncoding.DecodeString(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidManifest, err)
+	}
+	return DecodeManifest(b)
+}
+
+// DecodeManifest decodes a manifest from its binary serialization. It does not verify the
+// manifest signatures, see Verify.
+func DecodeManifest(b []byte) (*Manifest, error) {
+	m := &Manifest{raw: b}
+	var hasSequence bool
+
+	d := binarycodec.NewDecoder(b)
+	for d.Next() {
+		switch d.Field() {
+		case "Version":
+			m.Version, m.hasVersion = d.UInt16(), true
+		case "Sequence":
+			m.Sequence, hasSequence = d.UInt32(), true
+		case "PublicKey":
+			m.masterPublicKey = decodeBlob(d)
+		case "SigningPubKey":
+			m.ephemeralPublicKey = decodeBlob(d)
+		case "Domain":
+			m.Domain = string(decodeBlob(d))
+		case "Signature":
+			m.Signature = d.Blob()
+		case "MasterSignature":
+			m.MasterSignature = d.Blob()
+		default:
+			return nil, fmt.Errorf("%w: unexpected field %s", ErrInvalidManifest, d.Field())
+		}
+	}
+	if err := d.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidManifest, err)
+	}
+
+	if !hasSequence || m.masterPublicKey == nil || m.MasterSignature == "" {
+		return nil, fmt.Errorf("%w: missing sequence, public key or master signature", ErrInvalidManifest)
+	}
+	if m.Revoked() {
+		if m.ephemeralPublicKey != nil || m.Signature != "" {
+			return nil, fmt.Errorf("%w: revocation with an ephemeral key", ErrInvalidManifest)
+		}
+	} else {
+		if m.ephemeralPublicKey == nil || m.Signature == "" {
+			return nil, fmt.Errorf("%w: missing ephemeral key or signature", ErrInvalidManifest)
+		}
+		if string(m.ephemeralPublicKey) == string(m.masterPublicKey) {
+			return nil, fmt.Errorf("%w: ephemeral key is the master key", ErrInvalidManifest)
+		}
+	}
+
+	var err error
+	if m.MasterKey, err = addresscodec.EncodeNodePublicKey(m.masterPublicKey); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidManifest, err)
+	}
+	if m.ephemeralPublicKey != nil {
+		if m.EphemeralKey, err = addresscodec.EncodeNodePublicKey(m.ephemeralPublicKey); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidManifest, err)
+		}
+	}
+	if m.signingData, err = m.encodeSigningData(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidManifest, err)
+	}
+	return m, nil
+}
+
+func decodeBlob(d *binarycodec.Decoder) []byte {
+	b, _ := hex.DecodeString(d.Blob())
+	return b
+}
+
+// encodeSigningData returns the data signed by both manifest signatures: the manifest prefix
+// followed by every field but the signatures.
+func (m *Manifest) encodeSigningData() ([]byte, error) {
+	e := binarycodec.NewEncoder()
+	if m.hasVersion {
+		e.UInt16("Version", m.Version)
+	}
+	e.UInt32("Sequence", m.Sequence)
+	e.Blob("PublicKey", hexutil.EncodeToUpperHex(m.masterPublicKey))
+	if m.ephemeralPublicKey != nil {
+		e.Blob("SigningPubKey", hexutil.EncodeToUpperHex(m.ephemeralPublicKey))
+	}
+	if m.Domain != "" {
+		e.Blob("Domain", hex.EncodeToString([]byte(m.Domain)))
+	}
+	fields, err := e.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return append(binary.BigEndian.AppendUint32(nil, hash.ManifestPrefix), fields...), nil
+}
+
+// Revoked reports whether the manifest revokes its master key.
+func (m *Manifest) Revoked() bool {
+	return m.Sequence == RevokedSequence
+}
+
+// Bytes returns the binary serialization of the manifest.
+func (m *Manifest) Bytes() []byte {
+	return m.raw
+}
+
+// String returns the base64 encoding of the manifest.
+func (m *Manifest) String() string {
+	return base64.StdEncoding.EncodeToString(m.raw)
+}
+
+// Verify checks the master signature of the manifest against its master key and, unless the
+// manifest is a revocation, its signature against its ephemeral key.
+func (m *Manifest) Verify() error {
+	ok, err := keypairs.Validate(string(m.signingData), hexutil.EncodeToUpperHex(m.masterPublicKey), m.MasterSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMasterSignature, err)
+	}
+	if !ok {
+		return ErrInvalidMasterSignature
+	}
+	if m.Revoked() {
+		return nil
+	}
+
+	ok, err = keypairs.Validate(string(m.signingData), hexutil.EncodeToUpperHex(m.ephemeralPublicKey), m.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidEphemeralSignature, err)
+	}
+	if !ok {
+		return ErrInvalidEphemeralSignature
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"errors"
+	"sync"
+)
+
+// ManifestCache tracks the latest verified manifest of each master key, so that the ephemeral
+// key a validation is signed with can be mapped to the master key of its validator, and
+// revoked master keys are detected. It is safe for concurrent use.
+type ManifestCache struct {
+	mu        sync.RWMutex
+	manifests map[string]*Manifest
+	masters   map[string]string
+}
+
+// NewManifestCache returns an empty ManifestCache.
+func NewManifestCache() *ManifestCache {
+	return &ManifestCache{
+		manifests: make(map[string]*Manifest),
+		masters:   make(map[string]string),
+	}
+}
+
+// Add verifies a manifest and makes it the manifest of its master key. It returns
+// ErrStaleManifest when the cache already holds a manifest of the master key with the same or
+// a greater sequence, including a revocation, and ErrEphemeralKeyInUse when the ephemeral key
+// belongs to another validator.
+func (c *ManifestCache) Add(m *Manifest) error {
+	if err := m.Verify(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	previous, ok := c.manifests[m.MasterKey]
+	if ok && previous.Sequence >= m.Sequence {
+		return ErrStaleManifest
+	}
+	if m.EphemeralKey != "" {
+		if _, ok := c.manifests[m.EphemeralKey]; ok {
+			return ErrEphemeralKeyInUse
+		}
+		if master, ok := c.masters[m.EphemeralKey]; ok && master != m.MasterKey {
+			return ErrEphemeralKeyInUse
+		}
+	}
+
+	if previous != nil {
+		delete(c.masters, previous.EphemeralKey)
+	}
+	if m.EphemeralKey != "" {
+		c.masters[m.EphemeralKey] = m.MasterKey
+	}
+	c.manifests[m.MasterKey] = m
+	return nil
+}
+
+// AddValidatorList adds the manifests published with the validators of a validator list.
+// Manifests that are not newer than the known manifests are skipped.
+func (c *ManifestCache) AddValidatorList(l *ValidatorList) error {
+	for _, v := range l.Validators {
+		if v.Manifest == nil {
+			continue
+		}
+		if err := c.Add(v.Manifest); err != nil && !errors.Is(err, ErrStaleManifest) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Manifest returns the latest manifest of a master key.
+func (c *ManifestCache) Manifest(masterKey string) (*Manifest, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	m, ok := c.manifests[masterKey]
+	return m, ok
+}
+
+// MasterKey returns the master key of the validator currently signing with an ephemeral key.
+// It returns false when no manifest delegates to the key, for instance because it has been
+// replaced by a newer manifest.
+func (c *ManifestCache) MasterKey(ephemeralKey string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	master, ok := c.masters[ephemeralKey]
+	return master, ok
+}
+
+// EphemeralKey returns the current ephemeral key of a master key. It returns false when the
+// master key has no known manifest or has been revoked.
+func (c *ManifestCache) EphemeralKey(masterKey string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	m, ok := c.manifests[masterKey]
+	if !ok || m.Revoked() {
+		return "", false
+	}
+	return m.EphemeralKey, true
+}
+
+// Revoked reports whether a master key has been revoked.
+func (c *ManifestCache) Revoked(masterKey string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	m, ok := c.manifests[masterKey]
+	return ok && m.Revoked()
+}
+
+// Len returns the number of master keys with a known manifest.
+func (c *ManifestCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.manifests)
+}
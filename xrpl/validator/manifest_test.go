@@ -0,0 +1,185 @@
+package validator
+
+import (
+	"encoding/base64"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseManifest(t *testing.T) {
+	master := newTestMasterKey(t, 1)
+	ephemeral := newTestEphemeralKey(t, 2)
+	other := newTestEphemeralKey(t, 3)
+
+	tamperedSignature := func(field string) string {
+		m, err := ParseManifest(newTestManifest(t, master, &ephemeral, 1, "example.com"))
+		require.NoError(t, err)
+		e := binarycodec.NewEncoder()
+		e.UInt32("Sequence", m.Sequence)
+		e.Blob("PublicKey", master.public)
+		e.Blob("SigningPubKey", ephemeral.public)
+		e.Blob("Domain", "6578616D706C652E636F6D")
+		signature, masterSignature := m.Signature, m.MasterSignature
+		if field == "Signature" {
+			signature = masterSignature
+		} else {
+			masterSignature = signature
+		}
+		e.Blob("Signature", signature)
+		e.Blob("MasterSignature", masterSignature)
+		b, err := e.Bytes()
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(b)
+	}
+
+	tests := []struct {
+		name         string
+		manifest     string
+		want         Manifest
+		revoked      bool
+		wantParseErr error
+		wantErr      error
+	}{
+		{
+			name:     "pass - rippled manifest",
+			manifest: "JAAAAAFxIe3AkJgOyqs3y+UuiAI27Ff3Mrfbt8e7mjdo06bnGEp5XnMhAhRmvCZmWZXlwShVE9qXs2AVCvhVuA/WGYkTX/vVGBGwdkYwRAIgGnYpIGufURojN2cTXakAM7Vwa0GR7o3osdVlZShroXQCIH9R/Lx1v9rdb4YY2n5nrxdnhSSof3U6V/wIHJmeao5ucBJA9D1iAMo7YFCpb245N3Czc0L1R2Xac0YwQ6XdGT+cZ7yw2n8JbdC3hH8Xu9OUqc867Ee6JmlXtyDHzBdY/hdJCQ==",
+			want: Manifest{
+				MasterKey:    "nHUFE9prPXPrHcG3SkwP1UzAQbSphqyQkQK9ATXLZsfkezhhda3p",
+				EphemeralKey: "n9J67zk4B7GpbQV5jRQntbgdKf7TW6894QuG7qq1rE5gvjCu6snA",
+				Sequence:     1,
+			},
+		},
+		{
+			name:     "pass - manifest",
+			manifest: newTestManifest(t, master, &ephemeral, 1, "example.com"),
+			want:     Manifest{MasterKey: master.node, EphemeralKey: ephemeral.node, Sequence: 1, Domain: "example.com"},
+		},
+		{
+			name:     "pass - manifest without domain",
+			manifest: newTestManifest(t, master, &other, 7, ""),
+			want:     Manifest{MasterKey: master.node, EphemeralKey: other.node, Sequence: 7},
+		},
+		{
+			name:     "pass - manifest with version",
+			manifest: newTestVersionedManifest(t, master, ephemeral, 3, 0),
+			want:     Manifest{MasterKey: master.node, EphemeralKey: ephemeral.node, Sequence: 3},
+		},
+		{
+			name:     "pass - manifest with non-zero version",
+			manifest: newTestVersionedManifest(t, master, ephemeral, 4, 1),
+			want:     Manifest{MasterKey: master.node, EphemeralKey: ephemeral.node, Sequence: 4, Version: 1},
+		},
+		{
+			name:     "pass - revocation",
+			manifest: newTestManifest(t, master, nil, RevokedSequence, ""),
+			want:     Manifest{MasterKey: master.node, Sequence: RevokedSequence},
+			revoked:  true,
+		},
+		{
+			name:     "fail - ephemeral signature",
+			manifest: tamperedSignature("Signature"),
+			want:     Manifest{MasterKey: master.node, EphemeralKey: ephemeral.node, Sequence: 1, Domain: "example.com"},
+			wantErr:  ErrInvalidEphemeralSignature,
+		},
+		{
+			name:     "fail - master signature",
+			manifest: tamperedSignature("MasterSignature"),
+			want:     Manifest{MasterKey: master.node, EphemeralKey: ephemeral.node, Sequence: 1, Domain: "example.com"},
+			wantErr:  ErrInvalidMasterSignature,
+		},
+		{
+			name:         "fail - missing ephemeral key",
+			manifest:     newTestManifest(t, master, nil, 1, ""),
+			wantParseErr: ErrInvalidManifest,
+		},
+		{
+			name:         "fail - ephemeral key is the master key",
+			manifest:     newTestManifest(t, master, &master, 1, ""),
+			wantParseErr: ErrInvalidManifest,
+		},
+		{
+			name:         "fail - invalid base64",
+			manifest:     "not base64!",
+			wantParseErr: ErrInvalidManifest,
+		},
+		{
+			name:         "fail - truncated",
+			manifest:     "JAAAAAFxIe0=",
+			wantParseErr: ErrInvalidManifest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseManifest(tt.manifest)
+			if tt.wantParseErr != nil {
+				require.ErrorIs(t, err, tt.wantParseErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want.MasterKey, m.MasterKey)
+			require.Equal(t, tt.want.EphemeralKey, m.EphemeralKey)
+			require.Equal(t, tt.want.Sequence, m.Sequence)
+			require.Equal(t, tt.want.Version, m.Version)
+			require.Equal(t, tt.want.Domain, m.Domain)
+			require.Equal(t, tt.revoked, m.Revoked())
+			require.Equal(t, tt.manifest, m.String())
+
+			err = m.Verify()
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManifestCache(t *testing.T) {
+	master := newTestMasterKey(t, 1)
+	ephemeral1 := newTestEphemeralKey(t, 2)
+	ephemeral2 := newTestEphemeralKey(t, 3)
+	otherMaster := newTestMasterKey(t, 4)
+
+	parse := func(manifest string) *Manifest {
+		m, err := ParseManifest(manifest)
+		require.NoError(t, err)
+		return m
+	}
+
+	c := NewManifestCache()
+	require.NoError(t, c.Add(parse(newTestManifest(t, master, &ephemeral1, 1, ""))))
+	got, ok := c.MasterKey(ephemeral1.node)
+	require.True(t, ok)
+	require.Equal(t, master.node, got)
+
+	// A stale manifest does not replace the current one.
+	require.ErrorIs(t, c.Add(parse(newTestManifest(t, master, &ephemeral2, 1, ""))), ErrStaleManifest)
+
+	// Another validator cannot claim an ephemeral key in use.
+	require.ErrorIs(t, c.Add(parse(newTestManifest(t, otherMaster, &ephemeral1, 1, ""))), ErrEphemeralKeyInUse)
+
+	// A newer manifest rotates the ephemeral key.
+	require.NoError(t, c.Add(parse(newTestManifest(t, master, &ephemeral2, 2, ""))))
+	_, ok = c.MasterKey(ephemeral1.node)
+	require.False(t, ok)
+	got, ok = c.EphemeralKey(master.node)
+	require.True(t, ok)
+	require.Equal(t, ephemeral2.node, got)
+
+	// A revocation removes the ephemeral key and cannot be replaced.
+	require.NoError(t, c.Add(parse(newTestManifest(t, master, nil, RevokedSequence, ""))))
+	require.True(t, c.Revoked(master.node))
+	_, ok = c.MasterKey(ephemeral2.node)
+	require.False(t, ok)
+	_, ok = c.EphemeralKey(master.node)
+	require.False(t, ok)
+	require.ErrorIs(t, c.Add(parse(newTestManifest(t, master, &ephemeral1, 3, ""))), ErrStaleManifest)
+
+	m, ok := c.Manifest(master.node)
+	require.True(t, ok)
+	require.True(t, m.Revoked())
+	require.Equal(t, 1, c.Len())
+}
@@ -0,0 +1,220 @@
+package validator
+
+import (
+	"sort"
+	"sync"
+
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+)
+
+const (
+	// DefaultValidationHistory is the number of ledger indexes a ValidationTracker keeps
+	// validations for.
+	DefaultValidationHistory = 256
+)
+
+// Quorum returns the number of trusted validators that must validate a ledger for it to be
+// fully validated: 80% of the trusted validators, rounded up, as rippled computes it.
+func Quorum(trusted int) int {
+	return (trusted*4 + 4) / 5
+}
+
+// ValidatedLedger is a ledger validated by a quorum of trusted validators.
+type ValidatedLedger struct {
+	LedgerHash  string
+	LedgerIndex uint32
+	// Validators are the master keys of the trusted validators that validated the ledger when
+	// it reached quorum.
+	Validators []string
+}
+
+// ValidatorStats counts, over the ledgers kept by a ValidationTracker that reached quorum, the
+// ledgers a trusted validator validated, validated with another hash, and did not validate.
+// A validator that keeps disagreeing with the quorum is on a fork.
+type ValidatorStats struct {
+	MasterKey string
+	Agreed    int
+	Disagreed int
+	Missed    int
+}
+
+// ValidationTracker tallies the full validations of a set of trusted validators per ledger,
+// and reports the ledgers validated by a quorum of them. It is safe for concurrent use.
+//
+// Validations signed with an ephemeral key are attributed to the master key of the validator
+// through the manifests of a ManifestCache, so the manifests of the trusted validators, such
+// as the ones published with their validator list, must be added to the cache.
+type ValidationTracker struct {
+	mu        sync.Mutex
+	trusted   map[string]bool
+	quorum    int
+	manifests *ManifestCache
+	history   int
+
+	// validations maps ledger indexes to the latest validation of each trusted validator, and
+	// validated to the ledger hash that reached quorum.
+	validations map[uint32]map[string]*Validation
+	validated   map[uint32]string
+	latest      uint32
+}
+
+// NewValidationTracker returns a ValidationTracker trusting the validators with the given
+// master keys, with the quorum returned by Quorum.
+func NewValidationTracker(trusted []string, manifests *ManifestCache) *ValidationTracker {
+	t := &ValidationTracker{
+		trusted:     make(map[string]bool, len(trusted)),
+		manifests:   manifests,
+		history:     DefaultValidationHistory,
+		validations: make(map[uint32]map[string]*Validation),
+		validated:   make(map[uint32]string),
+	}
+	for _, key := range trusted {
+		t.trusted[key] = true
+	}
+	t.quorum = Quorum(len(t.trusted))
+	return t
+}
+
+// Quorum returns the number of trusted validators that must validate a ledger.
+func (t *ValidationTracker) Quorum() int {
+	return t.quorum
+}
+
+// MasterKey returns the master key of the trusted validator signing with signingKey. A trusted
+// validator without a manifest signs with its master key.
+func (t *ValidationTracker) MasterKey(signingKey string) (string, error) {
+	masterKey, ok := t.manifests.MasterKey(signingKey)
+	if !ok {
+		masterKey = signingKey
+	}
+	if !t.trusted[masterKey] {
+		return "", ErrUntrustedValidator
+	}
+	if t.manifests.Revoked(masterKey) {
+		return "", ErrRevokedValidator
+	}
+	if _, ok := t.manifests.Manifest(masterKey); ok && masterKey == signingKey {
+		// Validators with a manifest must sign with their ephemeral key.
+		return "", ErrUntrustedValidator
+	}
+	return masterKey, nil
+}
+
+// Add verifies a validation with Verify and tallies it. It returns the validated ledger when the
+// validation makes its ledger reach quorum, and nil otherwise. Partial validations, validations
+// older than the kept history and repeated validations are ignored. It returns
+// ErrInvalidValidationSignature or ErrValidationMismatch for validations that do not verify, and
+// ErrUntrustedValidator or ErrRevokedValidator for validations not signed by a trusted validator.
+func (t *ValidationTracker) Add(v *Validation) (*ValidatedLedger, error) {
+	if err := v.Verify(); err != nil {
+		return nil, err
+	}
+	return t.add(v)
+}
+
+// AddStream verifies a message of the validations stream with ParseValidationStream and tallies
+// it like Add. It can be called from the handler registered with the OnValidationReceived
+// method of the websocket client.
+func (t *ValidationTracker) AddStream(s *streamtypes.ValidationStream) (*ValidatedLedger, error) {
+	v, err := ParseValidationStream(s)
+	if err != nil {
+		return nil, err
+	}
+	return t.add(v)
+}
+
+// add tallies a verified validation.
+func (t *ValidationTracker) add(v *Validation) (*ValidatedLedger, error) {
+	masterKey, err := t.MasterKey(v.SigningKey)
+	if err != nil {
+		return nil, err
+	}
+	if !v.Full() {
+		return nil, nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.latest >= uint32(t.history) && v.LedgerIndex <= t.latest-uint32(t.history) {
+		return nil, nil
+	}
+	byValidator, ok := t.validations[v.LedgerIndex]
+	if !ok {
+		byValidator = make(map[string]*Validation)
+		t.validations[v.LedgerIndex] = byValidator
+	}
+	// A validator changing its vote for a ledger index replaces its previous validation.
+	if previous, ok := byValidator[masterKey]; ok && !v.SigningTime.After(previous.SigningTime) {
+		return nil, nil
+	}
+	byValidator[masterKey] = v
+	t.advance(v.LedgerIndex)
+
+	if _, ok := t.validated[v.LedgerIndex]; ok {
+		return nil, nil
+	}
+	var validators []string
+	for key, validation := range byValidator {
+		if validation.LedgerHash == v.LedgerHash {
+			validators = append(validators, key)
+		}
+	}
+	if len(validators) < t.quorum {
+		return nil, nil
+	}
+	sort.Strings(validators)
+	t.validated[v.LedgerIndex] = v.LedgerHash
+	return &ValidatedLedger{LedgerHash: v.LedgerHash, LedgerIndex: v.LedgerIndex, Validators: validators}, nil
+}
+
+// advance moves the latest ledger index and forgets the validations out of the kept history.
+func (t *ValidationTracker) advance(ledgerIndex uint32) {
+	if ledgerIndex <= t.latest {
+		return
+	}
+	t.latest = ledgerIndex
+	if t.latest < uint32(t.history) {
+		return
+	}
+	oldest := t.latest - uint32(t.history)
+	for index := range t.validations {
+		if index <= oldest {
+			delete(t.validations, index)
+			delete(t.validated, index)
+		}
+	}
+}
+
+// ValidatedLedgerHash returns the hash of the ledger validated by quorum at a ledger index.
+func (t *ValidationTracker) ValidatedLedgerHash(ledgerIndex uint32) (string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	h, ok := t.validated[ledgerIndex]
+	return h, ok
+}
+
+// Stats returns the agreement statistics of every trusted validator over the kept ledgers that
+// reached quorum, ordered by master key.
+func (t *ValidationTracker) Stats() []ValidatorStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats := make([]ValidatorStats, 0, len(t.trusted))
+	for key := range t.trusted {
+		s := ValidatorStats{MasterKey: key}
+		for index, ledgerHash := range t.validated {
+			switch v, ok := t.validations[index][key]; {
+			case !ok:
+				s.Missed++
+			case v.LedgerHash == ledgerHash:
+				s.Agreed++
+			default:
+				s.Disagreed++
+			}
+		}
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].MasterKey < stats[j].MasterKey })
+	return stats
+}
@@ -0,0 +1,186 @@
+package validator
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuorum(t *testing.T) {
+	tests := []struct {
+		trusted int
+		want    int
+	}{
+		{trusted: 1, want: 1},
+		{trusted: 4, want: 4},
+		{trusted: 5, want: 4},
+		{trusted: 10, want: 8},
+		{trusted: 35, want: 28},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, Quorum(tt.trusted))
+	}
+}
+
+func TestValidationTracker(t *testing.T) {
+	manifests := NewManifestCache()
+	var (
+		masters    []testKey
+		ephemerals []testKey
+		trusted    []string
+	)
+	for i := byte(0); i < 5; i++ {
+		master, ephemeral := newTestMasterKey(t, 40+i), newTestEphemeralKey(t, 50+i)
+		m, err := ParseManifest(newTestManifest(t, master, &ephemeral, 1, ""))
+		require.NoError(t, err)
+		require.NoError(t, manifests.Add(m))
+		masters, ephemerals, trusted = append(masters, master), append(ephemerals, ephemeral), append(trusted, master.node)
+	}
+	// The last trusted validator signs with its master key, without a manifest.
+	unlisted := newTestMasterKey(t, 60)
+	trusted = append(trusted, unlisted.node)
+
+	tracker := NewValidationTracker(trusted, manifests)
+	require.Equal(t, 5, tracker.Quorum())
+
+	hashA, hashB := strings.Repeat("AA", 32), strings.Repeat("BB", 32)
+	add := func(signing testKey, ledgerIndex uint32, ledgerHash string, full bool) (*ValidatedLedger, error) {
+		return tracker.AddStream(newTestValidation(t, signing, ledgerIndex, ledgerHash, 700_000_000+ledgerIndex, full))
+	}
+
+	// Four validations of ledger 100 do not reach quorum, a partial validation does not count.
+	for _, signing := range ephemerals[:4] {
+		ledger, err := add(signing, 100, hashA, true)
+		require.NoError(t, err)
+		require.Nil(t, ledger)
+	}
+	ledger, err := add(ephemerals[4], 100, hashA, false)
+	require.NoError(t, err)
+	require.Nil(t, ledger)
+
+	// The fifth trusted validation reaches quorum.
+	ledger, err = add(unlisted, 100, hashA, true)
+	require.NoError(t, err)
+	require.NotNil(t, ledger)
+	require.Equal(t, hashA, ledger.LedgerHash)
+	require.Equal(t, uint32(100), ledger.LedgerIndex)
+	require.Len(t, ledger.Validators, 5)
+
+	// A validation on a fork is tallied without changing the validated ledger.
+	ledger, err = add(ephemerals[4], 100, hashB, true)
+	require.NoError(t, err)
+	require.Nil(t, ledger)
+	validatedHash, ok := tracker.ValidatedLedgerHash(100)
+	require.True(t, ok)
+	require.Equal(t, hashA, validatedHash)
+
+	// Untrusted validators, and validators with a manifest signing with their master key, are rejected.
+	_, err = add(newTestEphemeralKey(t, 70), 100, hashA, true)
+	require.ErrorIs(t, err, ErrUntrustedValidator)
+	_, err = add(masters[0], 100, hashA, true)
+	require.ErrorIs(t, err, ErrUntrustedValidator)
+
+	m, err := ParseManifest(newTestManifest(t, masters[3], nil, RevokedSequence, ""))
+	require.NoError(t, err)
+	require.NoError(t, manifests.Add(m))
+	_, err = add(masters[3], 101, hashA, true)
+	require.ErrorIs(t, err, ErrRevokedValidator)
+
+	stats := map[string]ValidatorStats{}
+	for _, s := range tracker.Stats() {
+		stats[s.MasterKey] = s
+	}
+	require.Len(t, stats, 6)
+	require.Equal(t, ValidatorStats{MasterKey: masters[0].node, Agreed: 1}, stats[masters[0].node])
+	require.Equal(t, ValidatorStats{MasterKey: masters[4].node, Disagreed: 1}, stats[masters[4].node])
+	require.Equal(t, ValidatorStats{MasterKey: unlisted.node, Agreed: 1}, stats[unlisted.node])
+
+	// Ledgers older than the kept history are forgotten.
+	_, err = add(ephemerals[0], 100+DefaultValidationHistory, hashB, true)
+	require.NoError(t, err)
+	_, ok = tracker.ValidatedLedgerHash(100)
+	require.False(t, ok)
+	ledger, err = add(ephemerals[1], 100, hashA, true)
+	require.NoError(t, err)
+	require.Nil(t, ledger)
+}
+
+func TestValidationTracker_Add(t *testing.T) {
+	signing := newTestMasterKey(t, 80)
+	hashA, hashB := strings.Repeat("AA", 32), strings.Repeat("BB", 32)
+
+	decode := func(t *testing.T) *Validation {
+		t.Helper()
+		data, err := hex.DecodeString(newTestValidation(t, signing, 100, hashA, 700_000_100, true).Data)
+		require.NoError(t, err)
+		v, err := DecodeValidation(data)
+		require.NoError(t, err)
+		return v
+	}
+
+	tests := []struct {
+		name    string
+		v       func(t *testing.T) *Validation
+		wantErr error
+	}{
+		{
+			name: "pass - decoded validation",
+			v:    decode,
+		},
+		{
+			name: "fail - unsigned validation",
+			v: func(*testing.T) *Validation {
+				return &Validation{LedgerHash: hashA, LedgerIndex: 100, Flags: FullValidationFlag, SigningKey: signing.node}
+			},
+			wantErr: ErrInvalidValidationSignature,
+		},
+		{
+			name: "fail - tampered ledger hash",
+			v: func(t *testing.T) *Validation {
+				v := decode(t)
+				v.LedgerHash = hashB
+				return v
+			},
+			wantErr: ErrValidationMismatch,
+		},
+		{
+			name: "fail - tampered signing key",
+			v: func(t *testing.T) *Validation {
+				v := decode(t)
+				v.SigningKey = newTestMasterKey(t, 81).node
+				return v
+			},
+			wantErr: ErrValidationMismatch,
+		},
+		{
+			name: "fail - tampered signature",
+			v: func(t *testing.T) *Validation {
+				v := decode(t)
+				v.Signature = strings.Repeat("00", len(v.Signature)/2)
+				return v
+			},
+			wantErr: ErrInvalidValidationSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewValidationTracker([]string{signing.node}, NewManifestCache())
+
+			ledger, err := tracker.Add(tt.v(t))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, ledger)
+				_, ok := tracker.ValidatedLedgerHash(100)
+				require.False(t, ok)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, ledger)
+			require.Equal(t, hashA, ledger.LedgerHash)
+		})
+	}
+}
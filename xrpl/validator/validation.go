@@ -0,0 +1,177 @@
+package validator
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+)
+
+const (
+	// FullValidationFlag is set on full validations, which vote for a ledger. Partial
+	// validations only signal that the validator is online.
+	FullValidationFlag uint32 = 0x00000001
+)
+
+// Validation is a ledger validation, the vote of a validator for the hash of a ledger.
+type Validation struct {
+	LedgerHash    string
+	LedgerIndex   uint32
+	SigningTime   time.Time
+	Flags         uint32
+	ConsensusHash string
+	ValidatedHash string
+	Cookie        uint64
+	// SigningKey is the key the validation is signed with, as a node public key. For validators
+	// using a manifest it is their ephemeral key.
+	SigningKey string
+	Signature  string
+
+	signingPublicKey string
+	signingData      []byte
+	// signed holds the decoded fields the signature covers, so Verify detects changes to the
+	// exported fields after decoding.
+	signed signedValidation
+}
+
+// signedValidation is the part of a validation read by ValidationTracker.
+type signedValidation struct {
+	ledgerHash  string
+	ledgerIndex uint32
+	signingTime time.Time
+	flags       uint32
+	signingKey  string
+}
+
+// DecodeValidation decodes a validation from its binary serialization. It does not verify the
+// validation signature, see Verify.
+func DecodeValidation(b []byte) (*Validation, error) {
+	v := &Validation{}
+	var hasLedgerIndex bool
+
+	d := binarycodec.NewDecoder(b)
+	e := binarycodec.NewEncoder()
+	for d.Next() {
+		switch field := d.Field(); field {
+		case "Flags":
+			v.Flags = d.UInt32()
+			e.UInt32(field, v.Flags)
+		case "LedgerSequence":
+			v.LedgerIndex, hasLedgerIndex = d.UInt32(), true
+			e.UInt32(field, v.LedgerIndex)
+		case "SigningTime":
+			signingTime := d.UInt32()
+			v.SigningTime = rippleTime(int64(signingTime))
+			e.UInt32(field, signingTime)
+		case "Cookie":
+			v.Cookie = d.UInt64()
+			e.UInt64(field, v.Cookie)
+		case "LedgerHash":
+			v.LedgerHash = d.Hash()
+			e.Hash256(field, v.LedgerHash)
+		case "ConsensusHash":
+			v.ConsensusHash = d.Hash()
+			e.Hash256(field, v.ConsensusHash)
+		case "ValidatedHash":
+			v.ValidatedHash = d.Hash()
+			e.Hash256(field, v.ValidatedHash)
+		case "SigningPubKey":
+			v.signingPublicKey = d.Blob()
+			e.Blob(field, v.signingPublicKey)
+		case "Signature":
+			v.Signature = d.Blob()
+		default:
+			e.Value(field, d.Value())
+		}
+	}
+	if err := d.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidValidation, err)
+	}
+	if v.LedgerHash == "" || !hasLedgerIndex || v.signingPublicKey == "" || v.Signature == "" {
+		return nil, fmt.Errorf("%w: missing ledger hash, ledger sequence, signing key or signature", ErrInvalidValidation)
+	}
+
+	publicKey, err := hex.DecodeString(v.signingPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidValidation, err)
+	}
+	if v.SigningKey, err = addresscodec.EncodeNodePublicKey(publicKey); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidValidation, err)
+	}
+	fields, err := e.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidValidation, err)
+	}
+	v.signingData = append(binary.BigEndian.AppendUint32(nil, hash.ValidationPrefix), fields...)
+	v.signed = v.signedFields()
+	return v, nil
+}
+
+// ParseValidationStream decodes the data of a message of the validations stream, verifies its
+// signature, and checks it matches the fields of the message.
+func ParseValidationStream(s *streamtypes.ValidationStream) (*Validation, error) {
+	if s.Data == "" {
+		return nil, fmt.Errorf("%w: missing data", ErrInvalidValidation)
+	}
+	data, err := hex.DecodeString(s.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidValidation, err)
+	}
+	v, err := DecodeValidation(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.Verify(); err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(string(s.LedgerHash), v.LedgerHash) ||
+		uint32(s.LedgerIndex) != v.LedgerIndex ||
+		s.ValidationPublicKey != v.SigningKey ||
+		s.Full != v.Full() {
+		return nil, ErrValidationMismatch
+	}
+	return v, nil
+}
+
+// Full reports whether the validation is a full validation, voting for its ledger.
+func (v *Validation) Full() bool {
+	return v.Flags&FullValidationFlag != 0
+}
+
+// Verify checks the signature of the validation against its signing key, and that the ledger
+// hash, ledger index, signing time, flags and signing key are the ones that were signed.
+// Validations not returned by DecodeValidation or ParseValidationStream do not verify.
+func (v *Validation) Verify() error {
+	if v.signingData == nil {
+		return fmt.Errorf("%w: missing signed data", ErrInvalidValidationSignature)
+	}
+	if v.signedFields() != v.signed {
+		return ErrValidationMismatch
+	}
+	ok, err := keypairs.Validate(string(v.signingData), v.signingPublicKey, v.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidValidationSignature, err)
+	}
+	if !ok {
+		return ErrInvalidValidationSignature
+	}
+	return nil
+}
+
+func (v *Validation) signedFields() signedValidation {
+	return signedValidation{
+		ledgerHash:  strings.ToUpper(v.LedgerHash),
+		ledgerIndex: v.LedgerIndex,
+		signingTime: v.SigningTime,
+		flags:       v.Flags,
+		signingKey:  v.SigningKey,
+	}
+}
@@ -0,0 +1,94 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseValidationStream(t *testing.T) {
+	signing := newTestEphemeralKey(t, 30)
+	other := newTestEphemeralKey(t, 31)
+	ledgerHash := strings.Repeat("1A", 32)
+
+	modified := func(modify func(s *streamtypes.ValidationStream)) *streamtypes.ValidationStream {
+		s := newTestValidation(t, signing, 100, ledgerHash, 700_000_000, true)
+		modify(s)
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		stream  *streamtypes.ValidationStream
+		full    bool
+		wantErr error
+	}{
+		{
+			name:   "pass - full validation",
+			stream: newTestValidation(t, signing, 100, ledgerHash, 700_000_000, true),
+			full:   true,
+		},
+		{
+			name:   "pass - partial validation",
+			stream: newTestValidation(t, signing, 100, ledgerHash, 700_000_000, false),
+		},
+		{
+			name:   "pass - ed25519 signing key",
+			stream: newTestValidation(t, newTestMasterKey(t, 32), 100, ledgerHash, 700_000_000, true),
+			full:   true,
+		},
+		{
+			name: "fail - tampered data",
+			stream: modified(func(s *streamtypes.ValidationStream) {
+				// Change the ledger sequence in the signed data.
+				s.Data = strings.Replace(s.Data, "2600000064", "2600000065", 1)
+				s.LedgerIndex = 101
+			}),
+			wantErr: ErrInvalidValidationSignature,
+		},
+		{
+			name: "fail - ledger hash mismatch",
+			stream: modified(func(s *streamtypes.ValidationStream) {
+				s.LedgerHash = common.LedgerHash(strings.Repeat("2B", 32))
+			}),
+			wantErr: ErrValidationMismatch,
+		},
+		{
+			name: "fail - signing key mismatch",
+			stream: modified(func(s *streamtypes.ValidationStream) {
+				s.ValidationPublicKey = other.node
+			}),
+			wantErr: ErrValidationMismatch,
+		},
+		{
+			name:    "fail - missing data",
+			stream:  modified(func(s *streamtypes.ValidationStream) { s.Data = "" }),
+			wantErr: ErrInvalidValidation,
+		},
+		{
+			name:    "fail - truncated data",
+			stream:  modified(func(s *streamtypes.ValidationStream) { s.Data = s.Data[:40] }),
+			wantErr: ErrInvalidValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := ParseValidationStream(tt.stream)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, ledgerHash, v.LedgerHash)
+			require.Equal(t, uint32(100), v.LedgerIndex)
+			require.Equal(t, tt.stream.ValidationPublicKey, v.SigningKey)
+			require.Equal(t, uint64(0x0123456789ABCDEF), v.Cookie)
+			require.Equal(t, tt.full, v.Full())
+			require.Equal(t, int64(946_684_800+700_000_000), v.SigningTime.Unix())
+		})
+	}
+}
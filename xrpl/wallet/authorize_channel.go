@@ -1,10 +1,7 @@
 package wallet
 
 import (
-	"encoding/hex"
-
 	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
-	"github.com/Peersyst/xrpl-go/keypairs"
 )
 
 // AuthorizeChannel returns a signature authorizing the redemption of a specific
@@ -13,8 +10,10 @@ import (
 // channelID identifies the payment channel.
 // amount is the amount to redeem, expressed in drops.
 //
+// signer is the signer authorizing the claim, usually the channel source.
+//
 // Returns the signature or an error if the signature cannot be created.
-func AuthorizeChannel(channelID, amount string, wallet Wallet) (string, error) {
+func AuthorizeChannel(channelID, amount string, signer Signer) (string, error) {
 	encodedData, err := binarycodec.EncodeForSigningClaim(map[string]any{
 		"Channel": channelID,
 		"Amount":  amount,
@@ -22,13 +21,5 @@ func AuthorizeChannel(channelID, amount string, wallet Wallet) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	hexData, err := hex.DecodeString(encodedData)
-	if err != nil {
-		return "", err
-	}
-	signedData, err := keypairs.Sign(string(hexData), wallet.PrivateKey)
-	if err != nil {
-		return "", err
-	}
-	return signedData, nil
+	return signEncoded(signer, encodedData)
 }
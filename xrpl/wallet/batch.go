@@ -1,10 +1,7 @@
 package wallet
 
 import (
-	"encoding/hex"
-
 	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
-	"github.com/Peersyst/xrpl-go/keypairs"
 	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -22,10 +19,10 @@ type SignMultiBatchOptions struct {
 }
 
 // SignMultiBatch signs a multi-account Batch transaction.
-// It takes a wallet, a batch transaction, and a set of options.
+// It takes a signer, such as a Wallet, a batch transaction, and a set of options.
 // It returns an error if the transaction is invalid.
-func SignMultiBatch(wallet Wallet, tx *transaction.FlatTransaction, opts *SignMultiBatchOptions) error {
-	batchAccount := wallet.ClassicAddress.String()
+func SignMultiBatch(signer Signer, tx *transaction.FlatTransaction, opts *SignMultiBatchOptions) error {
+	batchAccount := signer.GetAddress().String()
 	var multisignAddress string
 
 	if opts != nil {
@@ -36,7 +33,7 @@ func SignMultiBatch(wallet Wallet, tx *transaction.FlatTransaction, opts *SignMu
 		if opts.MultisignAccount != "" {
 			multisignAddress = opts.MultisignAccount
 		} else if opts.Multisign {
-			multisignAddress = wallet.ClassicAddress.String()
+			multisignAddress = signer.GetAddress().String()
 		}
 	}
 
@@ -75,12 +72,7 @@ func SignMultiBatch(wallet Wallet, tx *transaction.FlatTransaction, opts *SignMu
 		return err
 	}
 
-	hexBatch, err := hex.DecodeString(encodedBatch)
-	if err != nil {
-		return err
-	}
-
-	signature, err := keypairs.Sign(string(hexBatch), wallet.PrivateKey)
+	signature, err := signEncoded(signer, encodedBatch)
 	if err != nil {
 		return err
 	}
@@ -95,7 +87,7 @@ func SignMultiBatch(wallet Wallet, tx *transaction.FlatTransaction, opts *SignMu
 					{
 						SignerData: types.SignerData{
 							Account:       types.Address(multisignAddress),
-							SigningPubKey: wallet.PublicKey,
+							SigningPubKey: signer.GetPublicKey(),
 							TxnSignature:  signature,
 						},
 					},
@@ -106,7 +98,7 @@ func SignMultiBatch(wallet Wallet, tx *transaction.FlatTransaction, opts *SignMu
 		batchSigner = types.BatchSigner{
 			BatchSigner: types.BatchSignerData{
 				Account:       types.Address(batchAccount),
-				SigningPubKey: wallet.PublicKey,
+				SigningPubKey: signer.GetPublicKey(),
 				TxnSignature:  signature,
 			},
 		}
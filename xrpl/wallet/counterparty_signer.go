@@ -13,15 +13,17 @@ import (
 type SignLoanSetByCounterpartyOptions struct {
 	// Multisign indicates the wallet should sign as a multisig account.
 	Multisign bool
-	// MultisignAccount is the address to sign as (overrides wallet.ClassicAddress when set).
+	// MultisignAccount is the address to sign as (overrides the signer address when set).
 	MultisignAccount string
+	// Codec encodes the transaction. Defaults to binarycodec.DefaultCodec().
+	Codec *binarycodec.Codec
 }
 
 // SignLoanSetByCounterparty signs a LoanSet transaction as the counterparty/borrower.
 // The LoanBroker must have already signed the transaction (TxnSignature and SigningPubKey must be set).
 // The result is stored in CounterpartySignature on the transaction map.
 func SignLoanSetByCounterparty(
-	w Wallet,
+	w Signer,
 	tx *transaction.FlatTransaction,
 	opts *SignLoanSetByCounterpartyOptions,
 ) (txBlob string, txHash string, err error) {
@@ -39,9 +41,11 @@ func SignLoanSetByCounterparty(
 		return "", "", ErrBrokerMustSignFirst
 	}
 
-	multisign, counterpartyAddr := resolveMultisignOpts(w.ClassicAddress.String(), opts)
+	multisign, counterpartyAddr := resolveMultisignOpts(w.GetAddress().String(), opts)
 
-	sig, err := encodeAndSign(&w, *tx, multisign, counterpartyAddr)
+	codec := resolveCodec(opts)
+
+	sig, err := encodeAndSign(w, codec, *tx, multisign, counterpartyAddr)
 	if err != nil {
 		return "", "", err
 	}
@@ -53,7 +57,7 @@ func SignLoanSetByCounterparty(
 				map[string]any{
 					"Signer": map[string]any{
 						"Account":       counterpartyAddr,
-						"SigningPubKey": w.PublicKey,
+						"SigningPubKey": w.GetPublicKey(),
 						"TxnSignature":  sig,
 					},
 				},
@@ -61,18 +65,18 @@ func SignLoanSetByCounterparty(
 		}
 	} else {
 		counterpartySignatureMap = map[string]any{
-			"SigningPubKey": w.PublicKey,
+			"SigningPubKey": w.GetPublicKey(),
 			"TxnSignature":  sig,
 		}
 	}
 	(*tx)["CounterpartySignature"] = counterpartySignatureMap
 
-	txBlob, err = binarycodec.Encode(*tx)
+	txBlob, err = codec.Encode(*tx)
 	if err != nil {
 		return "", "", err
 	}
 
-	txHash, err = hash.SignTxBlob(txBlob)
+	txHash, err = hash.SignTxBlobWithCodec(txBlob, codec)
 	if err != nil {
 		return "", "", err
 	}
@@ -84,11 +88,11 @@ func SignLoanSetByCounterparty(
 // This is a convenience wrapper around SignLoanSetByCounterparty for callers that have a serialized blob
 // rather than a FlatTransaction.
 func SignLoanSetByCounterpartyBlob(
-	w Wallet,
+	w Signer,
 	blob string,
 	opts *SignLoanSetByCounterpartyOptions,
 ) (tx transaction.FlatTransaction, txBlob string, txHash string, err error) {
-	decoded, err := binarycodec.Decode(blob)
+	decoded, err := resolveCodec(opts).Decode(blob)
 	if err != nil {
 		return nil, "", "", err
 	}
@@ -167,18 +171,18 @@ func CombineLoanSetCounterpartySignersBlob(blobs []string) (transaction.FlatTran
 }
 
 // encodeAndSign encodes tx for signing (multisig or single) and returns the hex signature.
-func encodeAndSign(w *Wallet, tx transaction.FlatTransaction, multisign bool, addr string) (string, error) {
+func encodeAndSign(w Signer, codec *binarycodec.Codec, tx transaction.FlatTransaction, multisign bool, addr string) (string, error) {
 	var encoded string
 	var err error
 	if multisign {
-		encoded, err = binarycodec.EncodeForMultisigning(tx, addr)
+		encoded, err = codec.EncodeForMultisigning(tx, addr)
 	} else {
-		encoded, err = binarycodec.EncodeForSigning(tx)
+		encoded, err = codec.EncodeForSigning(tx)
 	}
 	if err != nil {
 		return "", err
 	}
-	return w.computeSignature(encoded)
+	return signEncoded(w, encoded)
 }
 
 // resolveMultisignOpts returns the multisign flag and the counterparty address from opts.
@@ -194,6 +198,14 @@ func resolveMultisignOpts(defaultAddr string, opts *SignLoanSetByCounterpartyOpt
 	return false, defaultAddr
 }
 
+// resolveCodec returns the codec set in opts, or the default codec.
+func resolveCodec(opts *SignLoanSetByCounterpartyOptions) *binarycodec.Codec {
+	if opts != nil && opts.Codec != nil {
+		return opts.Codec
+	}
+	return binarycodec.DefaultCodec()
+}
+
 // assertTransactionsEqual returns an error if any transaction in the slice differs from the first,
 // ignoring CounterpartySignature.Signers.
 func assertTransactionsEqual(transactions []transaction.FlatTransaction) error {
@@ -7,12 +7,23 @@ var (
 
 	// ErrNilTransaction is returned when a nil transaction map is provided.
 	ErrNilTransaction = errors.New("transaction cannot be nil")
+	// ErrNilSigner is returned when a nil signer, or a nil pointer held in a Signer, is provided.
+	ErrNilSigner = errors.New("signer cannot be nil")
 
 	// address
 
 	// ErrAddressHasTag is returned when an X-address carries an embedded tag (zero or non-zero).
 	ErrAddressHasTag = errors.New("x-address must not carry a tag")
 
+	// hd
+
+	// ErrInvalidWordCount is returned when generating a mnemonic with an unsupported word count.
+	ErrInvalidWordCount = errors.New("mnemonic word count must be 12, 15, 18, 21 or 24")
+	// ErrInvalidDerivationPath is returned when a derivation path is malformed.
+	ErrInvalidDerivationPath = errors.New("invalid derivation path")
+	// ErrHardenedDerivationRequired is returned when an ed25519 derivation path has non-hardened levels.
+	ErrHardenedDerivationRequired = errors.New("ed25519 derivation paths must only have hardened levels")
+
 	// batch
 
 	// ErrBatchAccountNotFound is returned when the batch account is not found in the transaction.
@@ -0,0 +1,319 @@
+package wallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/hexutil"
+	"github.com/Peersyst/xrpl-go/pkg/random"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	bip32 "github.com/bsv-blockchain/go-sdk/compat/bip32"
+	"github.com/bsv-blockchain/go-sdk/compat/bip39"
+	chaincfg "github.com/bsv-blockchain/go-sdk/transaction/chaincfg"
+)
+
+const (
+	// DefaultDerivationPath is the BIP44 path of the first secp256k1 account, used by xrpl.js and
+	// Ledger.
+	DefaultDerivationPath = "m/44'/144'/0'/0/0"
+	// DefaultEd25519DerivationPath is the SLIP-0010 path of the first ed25519 account. SLIP-0010
+	// only derives hardened ed25519 keys, so every level of the path is hardened.
+	DefaultEd25519DerivationPath = "m/44'/144'/0'/0'/0'"
+
+	bip44Purpose  = 44
+	xrpCoinType   = 144
+	slip10Ed25519 = "ed25519 seed"
+)
+
+var nilHDPrivateKeyID = [4]byte{0x00, 0x00, 0x00, 0x00}
+
+type mnemonicConfig struct {
+	passphrase   string
+	path         string
+	accountIndex uint32
+	addressIndex uint32
+	indexSet     bool
+	alg          interfaces.CryptoImplementation
+}
+
+// MnemonicOpt configures how a wallet is derived from a mnemonic.
+type MnemonicOpt func(c *mnemonicConfig)
+
+// WithBIP39Passphrase derives the wallet with a BIP39 passphrase, sometimes called the 25th word.
+// Non-ASCII passphrases must be NFKD normalized.
+func WithBIP39Passphrase(passphrase string) MnemonicOpt {
+	return func(c *mnemonicConfig) {
+		c.passphrase = passphrase
+	}
+}
+
+// WithDerivationPath derives the wallet at a path such as "m/44'/144'/0'/0/0", where hardened
+// levels end with ' or h. It cannot be combined with WithAccountIndex or WithAddressIndex.
+func WithDerivationPath(path string) MnemonicOpt {
+	return func(c *mnemonicConfig) {
+		c.path = path
+	}
+}
+
+// WithAccountIndex derives the wallet of an account index of the default path,
+// m/44'/144'/account'/0/0, which is how BIP44 hardware wallets such as Ledger number XRP accounts.
+// Xaman accounts are not derived from mnemonics; import them with FromSecretNumbers or their family seed.
+func WithAccountIndex(account uint32) MnemonicOpt {
+	return func(c *mnemonicConfig) {
+		c.accountIndex = account
+		c.indexSet = true
+	}
+}
+
+// WithAddressIndex derives the wallet of an address index of the default path,
+// m/44'/144'/0'/0/index.
+func WithAddressIndex(index uint32) MnemonicOpt {
+	return func(c *mnemonicConfig) {
+		c.addressIndex = index
+		c.indexSet = true
+	}
+}
+
+// WithKeyType derives a wallet of a key type: secp256k1 keys with BIP32, the default, or
+// ed25519 keys with SLIP-0010. The default ed25519 path is DefaultEd25519DerivationPath.
+func WithKeyType(alg interfaces.CryptoImplementation) MnemonicOpt {
+	return func(c *mnemonicConfig) {
+		c.alg = alg
+	}
+}
+
+// GenerateMnemonic generates a random BIP39 mnemonic of 12, 15, 18, 21 or 24 words.
+func GenerateMnemonic(wordCount int) (string, error) {
+	if wordCount < 12 || wordCount > 24 || wordCount%3 != 0 {
+		return "", ErrInvalidWordCount
+	}
+	entropy, err := random.NewRandomizer().GenerateBytes(wordCount * 4 / 3)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// FromMnemonic derives a Wallet from a bip39 mnemonic, by default at DefaultDerivationPath with
+// no BIP39 passphrase, as xrpl.js does. Use FromRFC1751 for RFC1751 mnemonics.
+func FromMnemonic(mnemonic string, opts ...MnemonicOpt) (*Wallet, error) {
+	wallets, err := fromMnemonic(mnemonic, 0, 1, false, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &wallets[0], nil
+}
+
+// FromMnemonicRange derives count wallets from a bip39 mnemonic, at the address indexes first to
+// first+count-1 of the derivation path, for instance to generate deposit addresses. The address
+// index is the last level of the path, and keeps its hardening.
+func FromMnemonicRange(mnemonic string, first, count uint32, opts ...MnemonicOpt) ([]Wallet, error) {
+	return fromMnemonic(mnemonic, first, count, true, opts)
+}
+
+func fromMnemonic(mnemonic string, first, count uint32, ranged bool, opts []MnemonicOpt) ([]Wallet, error) {
+	c := mnemonicConfig{alg: crypto.SECP256K1()}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	ed25519 := c.alg == crypto.ED25519()
+
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, bip39.ErrInvalidMnemonic
+	}
+
+	path, err := c.derivationPath(ed25519)
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 && ranged {
+		return nil, fmt.Errorf("%w: missing address index level", ErrInvalidDerivationPath)
+	}
+	if ranged && uint64(first)+uint64(count) > bip32.HardenedKeyStart {
+		return nil, fmt.Errorf("%w: address index out of range", ErrInvalidDerivationPath)
+	}
+
+	seed := bip39.NewSeed(mnemonic, c.passphrase)
+	var node hdNode
+	if ed25519 {
+		node = newSlip10Ed25519Master(seed)
+	} else {
+		params := &chaincfg.Params{
+			HDPrivateKeyID: nilHDPrivateKeyID,
+		}
+		master, err := bip32.NewMaster(seed, params)
+		if err != nil {
+			return nil, err
+		}
+		node = secp256k1Node{master}
+	}
+
+	if !ranged {
+		for _, index := range path {
+			if node, err = node.child(index); err != nil {
+				return nil, err
+			}
+		}
+		w, err := node.wallet()
+		if err != nil {
+			return nil, err
+		}
+		return []Wallet{w}, nil
+	}
+
+	parent := node
+	for _, index := range path[:len(path)-1] {
+		if parent, err = parent.child(index); err != nil {
+			return nil, err
+		}
+	}
+	hardened := path[len(path)-1] & bip32.HardenedKeyStart
+	wallets := make([]Wallet, 0, count)
+	for i := range count {
+		child, err := parent.child((first + i) | hardened)
+		if err != nil {
+			return nil, err
+		}
+		w, err := child.wallet()
+		if err != nil {
+			return nil, err
+		}
+		wallets = append(wallets, w)
+	}
+	return wallets, nil
+}
+
+// derivationPath returns the levels of the derivation path of the config.
+func (c *mnemonicConfig) derivationPath(ed25519 bool) ([]uint32, error) {
+	if c.path != "" && c.indexSet {
+		return nil, fmt.Errorf("%w: account or address index set with a derivation path", ErrInvalidDerivationPath)
+	}
+
+	var path []uint32
+	if c.path != "" {
+		var err error
+		if path, err = parseDerivationPath(c.path); err != nil {
+			return nil, err
+		}
+	} else {
+		if c.accountIndex >= bip32.HardenedKeyStart || c.addressIndex >= bip32.HardenedKeyStart {
+			return nil, fmt.Errorf("%w: index out of range", ErrInvalidDerivationPath)
+		}
+		path = []uint32{
+			bip44Purpose + bip32.HardenedKeyStart,
+			xrpCoinType + bip32.HardenedKeyStart,
+			c.accountIndex + bip32.HardenedKeyStart,
+			0,
+			c.addressIndex,
+		}
+		if ed25519 {
+			path[3] |= bip32.HardenedKeyStart
+			path[4] |= bip32.HardenedKeyStart
+		}
+	}
+
+	if ed25519 {
+		for _, index := range path {
+			if index < bip32.HardenedKeyStart {
+				return nil, ErrHardenedDerivationRequired
+			}
+		}
+	}
+	return path, nil
+}
+
+// parseDerivationPath parses a derivation path such as "m/44'/144'/0'/0/0".
+func parseDerivationPath(path string) ([]uint32, error) {
+	levels := strings.Split(path, "/")
+	if levels[0] != "m" {
+		return nil, fmt.Errorf("%w: %q must start with m", ErrInvalidDerivationPath, path)
+	}
+
+	indexes := make([]uint32, 0, len(levels)-1)
+	for _, level := range levels[1:] {
+		var hardened uint32
+		if trimmed, ok := strings.CutSuffix(level, "'"); ok {
+			level, hardened = trimmed, bip32.HardenedKeyStart
+		} else if trimmed, ok := strings.CutSuffix(level, "h"); ok {
+			level, hardened = trimmed, bip32.HardenedKeyStart
+		}
+		index, err := strconv.ParseUint(level, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidDerivationPath, path)
+		}
+		indexes = append(indexes, uint32(index)|hardened)
+	}
+	return indexes, nil
+}
+
+// hdNode is a node of a hierarchical deterministic key tree.
+type hdNode interface {
+	child(index uint32) (hdNode, error)
+	wallet() (Wallet, error)
+}
+
+// secp256k1Node is a BIP32 secp256k1 node.
+type secp256k1Node struct {
+	key *bip32.ExtendedKey
+}
+
+func (n secp256k1Node) child(index uint32) (hdNode, error) {
+	key, err := n.key.Child(index)
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1Node{key}, nil
+}
+
+func (n secp256k1Node) wallet() (Wallet, error) {
+	ecPriv, err := n.key.ECPrivKey()
+	if err != nil {
+		return Wallet{}, err
+	}
+	pubKey := hexutil.EncodeToUpperHex(ecPriv.PubKey().Compressed())
+	classicAddr, err := keypairs.DeriveClassicAddress(pubKey)
+	if err != nil {
+		return Wallet{}, err
+	}
+	return Wallet{
+		PublicKey:      pubKey,
+		PrivateKey:     "00" + strings.ToUpper(ecPriv.Hex()),
+		ClassicAddress: types.Address(classicAddr),
+	}, nil
+}
+
+// slip10Ed25519Node is a SLIP-0010 ed25519 node.
+type slip10Ed25519Node struct {
+	key       []byte
+	chainCode []byte
+}
+
+func newSlip10Ed25519Master(seed []byte) slip10Ed25519Node {
+	mac := hmac.New(sha512.New, []byte(slip10Ed25519))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	return slip10Ed25519Node{key: sum[:32], chainCode: sum[32:]}
+}
+
+func (n slip10Ed25519Node) child(index uint32) (hdNode, error) {
+	if index < bip32.HardenedKeyStart {
+		return nil, ErrHardenedDerivationRequired
+	}
+	mac := hmac.New(sha512.New, n.chainCode)
+	mac.Write([]byte{0})
+	mac.Write(n.key)
+	mac.Write(binary.BigEndian.AppendUint32(nil, index))
+	sum := mac.Sum(nil)
+	return slip10Ed25519Node{key: sum[:32], chainCode: sum[32:]}, nil
+}
+
+func (n slip10Ed25519Node) wallet() (Wallet, error) {
+	return FromPrivateKey("ED"+hexutil.EncodeToUpperHex(n.key), "")
+}
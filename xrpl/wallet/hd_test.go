@@ -0,0 +1,409 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	bip32 "github.com/bsv-blockchain/go-sdk/compat/bip32"
+	"github.com/bsv-blockchain/go-sdk/compat/bip39"
+	chaincfg "github.com/bsv-blockchain/go-sdk/transaction/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+// testBIP39Mnemonic is the mnemonic of the BIP39 test vectors for the all-zero entropy.
+const testBIP39Mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+const testMnemonic = "midnight help already frost arena force omit physical please dwarf envelope royal dice surge eight often muscle tired blast begin waste fat rescue debate"
+
+func TestGenerateMnemonic(t *testing.T) {
+	for _, wordCount := range []int{12, 15, 18, 21, 24} {
+		mnemonic, err := GenerateMnemonic(wordCount)
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(mnemonic), wordCount)
+		require.True(t, bip39.IsMnemonicValid(mnemonic))
+
+		_, err = FromMnemonic(mnemonic)
+		require.NoError(t, err)
+	}
+
+	for _, wordCount := range []int{0, 11, 13, 27} {
+		_, err := GenerateMnemonic(wordCount)
+		require.ErrorIs(t, err, ErrInvalidWordCount)
+	}
+}
+
+// Test vector 1 of SLIP-0010 for ed25519.
+func TestSlip10Ed25519(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	levels := []struct {
+		index     uint32
+		chainCode string
+		key       string
+	}{
+		{chainCode: "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", key: "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{index: 0, chainCode: "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", key: "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{index: 1, chainCode: "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14", key: "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		{index: 2, chainCode: "2e69929e00b5ab250f49c3fb1c12f252de4fed2c1db88387094a0f8c4c9ccd6c", key: "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"},
+		{index: 2, chainCode: "8f6d87f93d750e0efccda017d662a1b31a266e4a6f5993b15f5c1f07f74dd5cc", key: "30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662"},
+		{index: 1000000000, chainCode: "68789923a0cac2cd5a29172a475fe9e0fb14cd6adb5ad98a3fa70333e7afa230", key: "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793"},
+	}
+
+	var node hdNode = newSlip10Ed25519Master(seed)
+	for i, level := range levels {
+		if i > 0 {
+			node, err = node.child(level.index + bip32.HardenedKeyStart)
+			require.NoError(t, err)
+		}
+		n := node.(slip10Ed25519Node)
+		require.Equal(t, level.chainCode, hex.EncodeToString(n.chainCode))
+		require.Equal(t, level.key, hex.EncodeToString(n.key))
+	}
+
+	w, err := newSlip10Ed25519Master(seed).wallet()
+	require.NoError(t, err)
+	require.Equal(t, "EDA4B2856BFEC510ABAB89753FAC1AC0E1112364E7D250545963F135F2A33188ED", w.PublicKey)
+
+	_, err = node.child(0)
+	require.ErrorIs(t, err, ErrHardenedDerivationRequired)
+}
+
+// Test vector 1 of BIP32 for secp256k1.
+func TestBIP32Secp256k1(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	levels := []struct {
+		index uint32
+		key   string
+	}{
+		{key: "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{index: bip32.HardenedKeyStart, key: "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{index: 1, key: "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{index: bip32.HardenedKeyStart + 2, key: "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+		{index: 2, key: "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"},
+		{index: 1000000000, key: "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	}
+
+	master, err := bip32.NewMaster(seed, &chaincfg.Params{HDPrivateKeyID: nilHDPrivateKeyID})
+	require.NoError(t, err)
+	var node hdNode = secp256k1Node{master}
+	for i, level := range levels {
+		if i > 0 {
+			node, err = node.child(level.index)
+			require.NoError(t, err)
+		}
+		w, err := node.wallet()
+		require.NoError(t, err)
+		require.Equal(t, "00"+strings.ToUpper(level.key), w.PrivateKey)
+	}
+}
+
+// deriveWallet derives the wallet at the given path levels from a BIP39 seed with the BIP32 and
+// SLIP-0010 nodes checked by TestBIP32Secp256k1 and TestSlip10Ed25519.
+func deriveWallet(t *testing.T, seed []byte, ed25519 bool, path ...uint32) Wallet {
+	t.Helper()
+	var node hdNode = newSlip10Ed25519Master(seed)
+	if !ed25519 {
+		master, err := bip32.NewMaster(seed, &chaincfg.Params{HDPrivateKeyID: nilHDPrivateKeyID})
+		require.NoError(t, err)
+		node = secp256k1Node{master}
+	}
+	for _, index := range path {
+		var err error
+		node, err = node.child(index)
+		require.NoError(t, err)
+	}
+	w, err := node.wallet()
+	require.NoError(t, err)
+	return w
+}
+
+// hardened returns the hardened derivation index of i.
+func hardened(i uint32) uint32 {
+	return i + bip32.HardenedKeyStart
+}
+
+// Published vectors:
+//   - rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3 is the address Ledger and xrpl.js derive for testBIP39Mnemonic
+//     on m/44'/144'/0'/0/0.
+//   - rpa9S5fRbS2ZAf2cdFGtezhGYgom1iD4yh is the address of testMnemonic in the xrpl.js Wallet.fromMnemonic tests.
+func TestFromMnemonic_Vectors(t *testing.T) {
+	testcases := []struct {
+		name      string
+		mnemonic  string
+		address   string
+		publicKey string
+	}{
+		{
+			name:      "pass - default path",
+			mnemonic:  testBIP39Mnemonic,
+			address:   "rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3",
+			publicKey: "031D68BC1A142E6766B2BDFB006CCFE135EF2E0E2E94ABB5CF5C9AB6104776FBAE",
+		},
+		{
+			name:      "pass - xrpl.js mnemonic",
+			mnemonic:  testMnemonic,
+			address:   "rpa9S5fRbS2ZAf2cdFGtezhGYgom1iD4yh",
+			publicKey: "028E831F16FD85ABEDA7577B6F4F26500FAB80AEA54B8A89EEC6FA44BCC7AF5678",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := FromMnemonic(tc.mnemonic)
+			require.NoError(t, err)
+			require.Equal(t, tc.address, w.ClassicAddress.String())
+			require.Equal(t, tc.publicKey, w.PublicKey)
+		})
+	}
+}
+
+// The seeds are the "TREZOR" passphrase seeds of the BIP39 reference test vectors
+// (trezor/python-mnemonic vectors.json).
+func TestFromMnemonic_Passphrase(t *testing.T) {
+	testcases := []struct {
+		name     string
+		mnemonic string
+		seed     string
+	}{
+		{
+			name:     "pass - all-zero entropy",
+			mnemonic: testBIP39Mnemonic,
+			seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			name:     "pass - 7f entropy",
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+			seed:     "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			seed, err := hex.DecodeString(tc.seed)
+			require.NoError(t, err)
+			require.Equal(t, seed, bip39.NewSeed(tc.mnemonic, "TREZOR"))
+
+			w, err := FromMnemonic(tc.mnemonic, WithBIP39Passphrase("TREZOR"))
+			require.NoError(t, err)
+			require.Equal(t, deriveWallet(t, seed, false, hardened(44), hardened(144), hardened(0), 0, 0), *w)
+
+			withoutPassphrase, err := FromMnemonic(tc.mnemonic)
+			require.NoError(t, err)
+			require.NotEqual(t, withoutPassphrase.ClassicAddress, w.ClassicAddress)
+		})
+	}
+}
+
+// There are no published XRPL vectors for account indexes, address indexes or ed25519 mnemonic
+// wallets, so these check that the options select the expected path of the BIP32 and SLIP-0010
+// nodes checked against their reference vectors.
+func TestFromMnemonic_Options(t *testing.T) {
+	seed := bip39.NewSeed(testBIP39Mnemonic, "")
+
+	testcases := []struct {
+		name    string
+		opts    []MnemonicOpt
+		ed25519 bool
+		path    []uint32
+	}{
+		{
+			name: "pass - account index",
+			opts: []MnemonicOpt{WithAccountIndex(1)},
+			path: []uint32{hardened(44), hardened(144), hardened(1), 0, 0},
+		},
+		{
+			name: "pass - address index",
+			opts: []MnemonicOpt{WithAddressIndex(2)},
+			path: []uint32{hardened(44), hardened(144), hardened(0), 0, 2},
+		},
+		{
+			name: "pass - account and address index",
+			opts: []MnemonicOpt{WithAccountIndex(1), WithAddressIndex(2)},
+			path: []uint32{hardened(44), hardened(144), hardened(1), 0, 2},
+		},
+		{
+			name: "pass - derivation path",
+			opts: []MnemonicOpt{WithDerivationPath("m/44h/144h/0h/1/3")},
+			path: []uint32{hardened(44), hardened(144), hardened(0), 1, 3},
+		},
+		{
+			name:    "pass - ed25519",
+			opts:    []MnemonicOpt{WithKeyType(crypto.ED25519())},
+			ed25519: true,
+			path:    []uint32{hardened(44), hardened(144), hardened(0), hardened(0), hardened(0)},
+		},
+		{
+			name:    "pass - ed25519 account and address index",
+			opts:    []MnemonicOpt{WithKeyType(crypto.ED25519()), WithAccountIndex(1), WithAddressIndex(2)},
+			ed25519: true,
+			path:    []uint32{hardened(44), hardened(144), hardened(1), hardened(0), hardened(2)},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := FromMnemonic(testBIP39Mnemonic, tc.opts...)
+			require.NoError(t, err)
+			require.Equal(t, deriveWallet(t, seed, tc.ed25519, tc.path...), *w)
+		})
+	}
+
+	t.Run("pass - equivalent options", func(t *testing.T) {
+		fromMnemonic := func(opts ...MnemonicOpt) Wallet {
+			w, err := FromMnemonic(testBIP39Mnemonic, opts...)
+			require.NoError(t, err)
+			return *w
+		}
+		defaultWallet := fromMnemonic()
+		require.Equal(t, defaultWallet, fromMnemonic(WithDerivationPath(DefaultDerivationPath)))
+		require.Equal(t, defaultWallet, fromMnemonic(WithDerivationPath("m/44h/144h/0h/0/0")))
+		require.Equal(t, defaultWallet, fromMnemonic(WithAccountIndex(0), WithAddressIndex(0)))
+
+		ed := fromMnemonic(WithKeyType(crypto.ED25519()))
+		require.Equal(t, crypto.ED25519(), ed.KeyType())
+		require.Empty(t, ed.Seed)
+		require.Equal(t, fromMnemonic(WithKeyType(crypto.ED25519()), WithDerivationPath(DefaultEd25519DerivationPath)), ed)
+		expected, err := FromPrivateKey(ed.PrivateKey, "")
+		require.NoError(t, err)
+		require.Equal(t, expected, ed)
+	})
+}
+
+func TestFromMnemonic_Errors(t *testing.T) {
+	testcases := []struct {
+		name        string
+		mnemonic    string
+		opts        []MnemonicOpt
+		expectedErr error
+	}{
+		{
+			name:        "fail - invalid mnemonic",
+			mnemonic:    "midnight help already frost arena force omit physical please dwarf envelope royal",
+			expectedErr: bip39.ErrInvalidMnemonic,
+		},
+		{
+			name:        "fail - path without root",
+			mnemonic:    testMnemonic,
+			opts:        []MnemonicOpt{WithDerivationPath("44'/144'/0'/0/0")},
+			expectedErr: ErrInvalidDerivationPath,
+		},
+		{
+			name:        "fail - path with an empty level",
+			mnemonic:    testMnemonic,
+			opts:        []MnemonicOpt{WithDerivationPath("m/44'//0'/0/0")},
+			expectedErr: ErrInvalidDerivationPath,
+		},
+		{
+			name:        "fail - path index out of range",
+			mnemonic:    testMnemonic,
+			opts:        []MnemonicOpt{WithDerivationPath("m/44'/144'/2147483648'/0/0")},
+			expectedErr: ErrInvalidDerivationPath,
+		},
+		{
+			name:        "fail - account index out of range",
+			mnemonic:    testMnemonic,
+			opts:        []MnemonicOpt{WithAccountIndex(bip32.HardenedKeyStart)},
+			expectedErr: ErrInvalidDerivationPath,
+		},
+		{
+			name:        "fail - path and address index",
+			mnemonic:    testMnemonic,
+			opts:        []MnemonicOpt{WithDerivationPath(DefaultDerivationPath), WithAddressIndex(1)},
+			expectedErr: ErrInvalidDerivationPath,
+		},
+		{
+			name:        "fail - non-hardened ed25519 path",
+			mnemonic:    testMnemonic,
+			opts:        []MnemonicOpt{WithKeyType(crypto.ED25519()), WithDerivationPath(DefaultDerivationPath)},
+			expectedErr: ErrHardenedDerivationRequired,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := FromMnemonic(tc.mnemonic, tc.opts...)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestFromMnemonicRange(t *testing.T) {
+	seed := bip39.NewSeed(testBIP39Mnemonic, "")
+
+	testcases := []struct {
+		name    string
+		opts    []MnemonicOpt
+		first   uint32
+		ed25519 bool
+		parent  []uint32
+		last    func(i uint32) uint32
+	}{
+		{
+			name:   "pass - secp256k1 default path",
+			first:  0,
+			parent: []uint32{hardened(44), hardened(144), hardened(0), 0},
+			last:   func(i uint32) uint32 { return i },
+		},
+		{
+			name:   "pass - secp256k1 account index",
+			opts:   []MnemonicOpt{WithAccountIndex(3)},
+			first:  5,
+			parent: []uint32{hardened(44), hardened(144), hardened(3), 0},
+			last:   func(i uint32) uint32 { return i },
+		},
+		{
+			name:   "pass - custom path",
+			opts:   []MnemonicOpt{WithDerivationPath("m/44'/144'/0'/1/0")},
+			first:  10,
+			parent: []uint32{hardened(44), hardened(144), hardened(0), 1},
+			last:   func(i uint32) uint32 { return i },
+		},
+		{
+			name:    "pass - ed25519",
+			opts:    []MnemonicOpt{WithKeyType(crypto.ED25519())},
+			first:   1,
+			ed25519: true,
+			parent:  []uint32{hardened(44), hardened(144), hardened(0), hardened(0)},
+			last:    hardened,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			wallets, err := FromMnemonicRange(testBIP39Mnemonic, tc.first, 3, tc.opts...)
+			require.NoError(t, err)
+			require.Len(t, wallets, 3)
+			for i, w := range wallets {
+				path := append(append([]uint32{}, tc.parent...), tc.last(tc.first+uint32(i)))
+				require.Equal(t, deriveWallet(t, seed, tc.ed25519, path...), w)
+			}
+		})
+	}
+
+	t.Run("pass - first wallet of the default range", func(t *testing.T) {
+		wallets, err := FromMnemonicRange(testBIP39Mnemonic, 0, 1)
+		require.NoError(t, err)
+		require.Equal(t, "rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3", wallets[0].ClassicAddress.String())
+	})
+
+	t.Run("pass - no wallets", func(t *testing.T) {
+		wallets, err := FromMnemonicRange(testMnemonic, 0, 0)
+		require.NoError(t, err)
+		require.Empty(t, wallets)
+	})
+
+	t.Run("fail - index out of range", func(t *testing.T) {
+		_, err := FromMnemonicRange(testMnemonic, bip32.HardenedKeyStart-1, 2)
+		require.ErrorIs(t, err, ErrInvalidDerivationPath)
+	})
+
+	t.Run("fail - root path", func(t *testing.T) {
+		_, err := FromMnemonicRange(testMnemonic, 0, 1, WithDerivationPath("m"))
+		require.ErrorIs(t, err, ErrInvalidDerivationPath)
+	})
+}
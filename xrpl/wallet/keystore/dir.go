@@ -0,0 +1,197 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+const fileExtension = ".json"
+
+// Entry describes a wallet stored in a Dir.
+type Entry struct {
+	Path       string
+	Address    string
+	PublicKey  string
+	KeyType    string
+	RegularKey bool
+}
+
+// Dir is a keystore directory holding one keystore file per address, named after the address.
+type Dir struct {
+	path string
+	opts []Option
+}
+
+// OpenDir opens the keystore directory at path, creating it readable by the current user only
+// if it does not exist. The options apply to the wallets stored in it.
+func OpenDir(path string, opts ...Option) (*Dir, error) {
+	if err := os.MkdirAll(path, 0o700); err != nil {
+		return nil, err
+	}
+	return &Dir{path: path, opts: opts}, nil
+}
+
+// Path returns the path of the keystore file of an address.
+func (d *Dir) Path(address string) (string, error) {
+	if !addresscodec.IsValidClassicAddress(address) {
+		return "", ErrInvalidAddress
+	}
+	return filepath.Join(d.path, address+fileExtension), nil
+}
+
+// Store encrypts a wallet with a passphrase and stores it under its address. It returns
+// ErrKeyExists if a key is already stored for the address.
+func (d *Dir) Store(w wallet.Wallet, passphrase string) error {
+	path, err := d.Path(w.ClassicAddress.String())
+	if err != nil {
+		return err
+	}
+	data, err := Encrypt(w, passphrase, d.opts...)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			return ErrKeyExists
+		}
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	return f.Close()
+}
+
+// List returns the wallets stored in the directory, sorted by address. Their keys are not
+// decrypted.
+func (d *Dir) List() ([]Entry, error) {
+	files, err := os.ReadDir(d.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, file := range files {
+		address, ok := strings.CutSuffix(file.Name(), fileExtension)
+		if !ok || file.IsDir() || !addresscodec.IsValidClassicAddress(address) {
+			continue
+		}
+		path := filepath.Join(d.path, file.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		f, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name(), err)
+		}
+		if f.Address != address {
+			return nil, fmt.Errorf("%s: %w: address %s", file.Name(), ErrInvalidKeystore, f.Address)
+		}
+		entries = append(entries, Entry{
+			Path:       path,
+			Address:    f.Address,
+			PublicKey:  f.PublicKey,
+			KeyType:    f.KeyType,
+			RegularKey: f.RegularKey,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Address < entries[j].Address
+	})
+	return entries, nil
+}
+
+// Load decrypts the wallet stored under an address with its passphrase.
+func (d *Dir) Load(address, passphrase string) (wallet.Wallet, error) {
+	path, err := d.existingPath(address)
+	if err != nil {
+		return wallet.Wallet{}, err
+	}
+	w, err := Load(path, passphrase)
+	if err != nil {
+		return wallet.Wallet{}, err
+	}
+	if w.ClassicAddress.String() != address {
+		return wallet.Wallet{}, ErrKeyMismatch
+	}
+	return w, nil
+}
+
+// ChangePassphrase encrypts the wallet stored under an address with a new passphrase.
+func (d *Dir) ChangePassphrase(address, oldPassphrase, newPassphrase string) error {
+	w, err := d.Load(address, oldPassphrase)
+	if err != nil {
+		return err
+	}
+	path, err := d.Path(address)
+	if err != nil {
+		return err
+	}
+	return Save(path, w, newPassphrase, d.opts...)
+}
+
+// Delete removes the wallet stored under an address.
+func (d *Dir) Delete(address string) error {
+	path, err := d.existingPath(address)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// Import stores a private key in the keypairs format: "ED" followed by 32 bytes for ed25519
+// keys, and 32 bytes, optionally prefixed with "00", for secp256k1 keys. If masterAddress is
+// set, the key is stored as the regular key of that account.
+func (d *Dir) Import(privateKey, masterAddress, passphrase string) (wallet.Wallet, error) {
+	w, err := wallet.FromPrivateKey(privateKey, masterAddress)
+	if err != nil {
+		return wallet.Wallet{}, err
+	}
+	if err := d.Store(w, passphrase); err != nil {
+		return wallet.Wallet{}, err
+	}
+	return w, nil
+}
+
+// Export decrypts the wallet stored under an address and returns its private key in the
+// keypairs format.
+func (d *Dir) Export(address, passphrase string) (string, error) {
+	w, err := d.Load(address, passphrase)
+	if err != nil {
+		return "", err
+	}
+	return w.PrivateKey, nil
+}
+
+func (d *Dir) existingPath(address string) (string, error) {
+	path, err := d.Path(address)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", ErrKeyNotFound
+		}
+		return "", err
+	}
+	return path, nil
+}
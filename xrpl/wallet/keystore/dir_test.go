@@ -0,0 +1,109 @@
+package keystore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDir(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys")
+	d, err := OpenDir(path, fastScrypt)
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+
+	edWallet, err := wallet.FromSeed("sEd7io6yt5dFJrcePgRiFVHvmkJhJD1", "")
+	require.NoError(t, err)
+	require.NoError(t, d.Store(edWallet, passphrase))
+	require.ErrorIs(t, d.Store(edWallet, passphrase), ErrKeyExists)
+
+	imported, err := d.Import("4265A28F3E18340A490421D47B2EB8DBC2C0BF2C24CEFEA971B61CED2CABD233", "rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93", passphrase)
+	require.NoError(t, err)
+	require.Equal(t, "03AEEFE1E8ED4BBC009DE996AC03A8C6B5713B1554794056C66E5B8D1753C7DD0E", imported.PublicKey)
+
+	// Files that are not keystore files are ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(path, "notes.txt"), []byte("notes"), 0o600))
+
+	entries, err := d.List()
+	require.NoError(t, err)
+	require.Equal(t, []Entry{
+		{
+			Path:       filepath.Join(path, "rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93.json"),
+			Address:    "rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93",
+			PublicKey:  "03AEEFE1E8ED4BBC009DE996AC03A8C6B5713B1554794056C66E5B8D1753C7DD0E",
+			KeyType:    KeyTypeSecp256k1,
+			RegularKey: true,
+		},
+		{
+			Path:      filepath.Join(path, "rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD.json"),
+			Address:   "rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD",
+			PublicKey: "EDC9DA1AA7513D891B58B3C9BEBAE3EB12620AFF4ABBA806B23BB3FA62109CE87F",
+			KeyType:   KeyTypeEd25519,
+		},
+	}, entries)
+
+	loaded, err := d.Load("rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD", passphrase)
+	require.NoError(t, err)
+	require.Equal(t, edWallet, loaded)
+
+	exported, err := d.Export("rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93", passphrase)
+	require.NoError(t, err)
+	require.Equal(t, "004265A28F3E18340A490421D47B2EB8DBC2C0BF2C24CEFEA971B61CED2CABD233", exported)
+	exported, err = d.Export("rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD", passphrase)
+	require.NoError(t, err)
+	require.Equal(t, edWallet.PrivateKey, exported)
+
+	require.NoError(t, d.ChangePassphrase("rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD", passphrase, "new passphrase"))
+	_, err = d.Load("rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD", passphrase)
+	require.ErrorIs(t, err, ErrDecryptionFailed)
+	loaded, err = d.Load("rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD", "new passphrase")
+	require.NoError(t, err)
+	require.Equal(t, edWallet, loaded)
+
+	require.NoError(t, d.Delete("rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD"))
+	entries, err = d.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestDir_Errors(t *testing.T) {
+	d, err := OpenDir(t.TempDir(), fastScrypt)
+	require.NoError(t, err)
+
+	t.Run("fail - key not found", func(t *testing.T) {
+		_, err := d.Load("rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD", passphrase)
+		require.ErrorIs(t, err, ErrKeyNotFound)
+		require.ErrorIs(t, d.Delete("rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD"), ErrKeyNotFound)
+	})
+
+	t.Run("fail - invalid address", func(t *testing.T) {
+		_, err := d.Load("../rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD", passphrase)
+		require.ErrorIs(t, err, ErrInvalidAddress)
+	})
+
+	t.Run("fail - invalid private key", func(t *testing.T) {
+		_, err := d.Import("EDC9DA1AA7513D891B58B3C9BEBAE3EB12620AFF4ABBA806B23BB3FA62109CE87F00", "", passphrase)
+		require.Error(t, err)
+		entries, err := d.List()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("fail - file stored under another address", func(t *testing.T) {
+		w, err := wallet.FromSeed("sEd7io6yt5dFJrcePgRiFVHvmkJhJD1", "")
+		require.NoError(t, err)
+		path, err := d.Path("rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93")
+		require.NoError(t, err)
+		require.NoError(t, Save(path, w, passphrase, fastScrypt))
+
+		_, err = d.List()
+		require.ErrorIs(t, err, ErrInvalidKeystore)
+		_, err = d.Load("rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93", passphrase)
+		require.ErrorIs(t, err, ErrKeyMismatch)
+	})
+}
@@ -0,0 +1,41 @@
+package keystore
+
+import "errors"
+
+var (
+	// file
+
+	// ErrUnsupportedVersion is returned when a keystore file has an unsupported version.
+	ErrUnsupportedVersion = errors.New("unsupported keystore version")
+	// ErrUnsupportedKDF is returned when a keystore file uses an unsupported key derivation function.
+	ErrUnsupportedKDF = errors.New("unsupported key derivation function")
+	// ErrUnsupportedCipher is returned when a keystore file uses an unsupported cipher.
+	ErrUnsupportedCipher = errors.New("unsupported cipher")
+	// ErrInvalidKDFParams is returned when the key derivation function parameters are invalid.
+	ErrInvalidKDFParams = errors.New("invalid key derivation function parameters")
+	// ErrInvalidKeystore is returned when a keystore file is malformed.
+	ErrInvalidKeystore = errors.New("invalid keystore file")
+
+	// decryption
+
+	// ErrDecryptionFailed is returned when a keystore file cannot be decrypted, either because
+	// the passphrase is wrong or because the file was modified.
+	ErrDecryptionFailed = errors.New("wrong passphrase or corrupted keystore file")
+	// ErrKeyMismatch is returned when the decrypted key does not match the public key or the
+	// address of the keystore file.
+	ErrKeyMismatch = errors.New("decrypted key does not match the keystore file")
+
+	// wallet
+
+	// ErrMissingKey is returned when encrypting a wallet with neither a seed nor a private key.
+	ErrMissingKey = errors.New("wallet has no seed or private key")
+
+	// directory
+
+	// ErrKeyExists is returned when storing a wallet whose address is already in the keystore.
+	ErrKeyExists = errors.New("a key is already stored for this address")
+	// ErrKeyNotFound is returned when no key is stored for an address.
+	ErrKeyNotFound = errors.New("no key stored for this address")
+	// ErrInvalidAddress is returned when an address is not a valid classic address.
+	ErrInvalidAddress = errors.New("invalid classic address")
+)
@@ -0,0 +1,433 @@
+// Package keystore stores wallets in files encrypted with a passphrase, so that seeds and
+// private keys are never written to disk in clear.
+//
+// A keystore file is a JSON object holding the address, public key and key type of the wallet
+// in clear, and its seed, or its private key for wallets without a seed, encrypted with
+// AES-256-GCM or XChaCha20-Poly1305 under a key derived from the passphrase with scrypt or
+// argon2id. The clear fields are authenticated along with the encrypted key, so a modified
+// file fails to decrypt.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// Version is the version of the keystore file format.
+	Version = 1
+
+	// KDFScrypt derives the encryption key with scrypt.
+	KDFScrypt = "scrypt"
+	// KDFArgon2id derives the encryption key with argon2id.
+	KDFArgon2id = "argon2id"
+
+	// CipherAES256GCM encrypts the key with AES-256-GCM.
+	CipherAES256GCM = "aes-256-gcm"
+	// CipherXChaCha20Poly1305 encrypts the key with XChaCha20-Poly1305.
+	CipherXChaCha20Poly1305 = "xchacha20-poly1305"
+
+	// KeyTypeEd25519 is the key type of ed25519 wallets.
+	KeyTypeEd25519 = "ed25519"
+	// KeyTypeSecp256k1 is the key type of secp256k1 wallets.
+	KeyTypeSecp256k1 = "secp256k1"
+
+	// DefaultScryptN is the default scrypt CPU/memory cost.
+	DefaultScryptN = 1 << 18
+	// DefaultScryptR is the default scrypt block size.
+	DefaultScryptR = 8
+	// DefaultScryptP is the default scrypt parallelization.
+	DefaultScryptP = 1
+
+	// MaxScryptN is the maximum scrypt CPU/memory cost.
+	MaxScryptN = 1 << 20
+	// MaxScryptR is the maximum scrypt block size.
+	MaxScryptR = 32
+	// MaxScryptP is the maximum scrypt parallelization.
+	MaxScryptP = 16
+	// MaxArgon2idTime is the maximum number of argon2id passes.
+	MaxArgon2idTime = 16
+	// MaxArgon2idThreads is the maximum argon2id parallelism.
+	MaxArgon2idThreads = 16
+	// MaxKDFMemory is the maximum memory in KiB the key derivation function may use, 1 GiB. scrypt
+	// uses 128*N*R bytes.
+	MaxKDFMemory = 1 << 20
+
+	keyLength  = 32
+	saltLength = 32
+)
+
+// File is a keystore file.
+type File struct {
+	Version int `json:"version"`
+	// Address is the classic address of the account the key signs for.
+	Address   string `json:"address"`
+	PublicKey string `json:"public_key"`
+	KeyType   string `json:"key_type"`
+	// RegularKey is set when the key is the regular key of Address rather than its master key.
+	RegularKey bool   `json:"regular_key,omitempty"`
+	Crypto     Crypto `json:"crypto"`
+}
+
+// Crypto holds the encrypted key of a keystore file and the parameters to decrypt it.
+type Crypto struct {
+	KDF        string    `json:"kdf"`
+	KDFParams  KDFParams `json:"kdf_params"`
+	Cipher     string    `json:"cipher"`
+	Nonce      string    `json:"nonce"`
+	Ciphertext string    `json:"ciphertext"`
+}
+
+// KDFParams are the parameters of the key derivation function. N, R and P are set for scrypt,
+// and Time, Memory (in KiB) and Threads for argon2id.
+type KDFParams struct {
+	Salt    string `json:"salt"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// secret is the encrypted content of a keystore file.
+type secret struct {
+	Seed       string `json:"seed,omitempty"`
+	PrivateKey string `json:"private_key,omitempty"`
+}
+
+type config struct {
+	kdf    string
+	params KDFParams
+	cipher string
+}
+
+// Option configures how a wallet is encrypted.
+type Option func(c *config)
+
+// WithScrypt derives the encryption key with scrypt, using the CPU/memory cost n, which must be
+// a power of two, the block size r and the parallelization p, bounded by MaxScryptN, MaxScryptR,
+// MaxScryptP and MaxKDFMemory. This is the default, with DefaultScryptN, DefaultScryptR and
+// DefaultScryptP.
+func WithScrypt(n, r, p int) Option {
+	return func(c *config) {
+		c.kdf = KDFScrypt
+		c.params = KDFParams{N: n, R: r, P: p}
+	}
+}
+
+// WithArgon2id derives the encryption key with argon2id, using time passes over memory KiB and
+// threads threads, bounded by MaxArgon2idTime, MaxKDFMemory and MaxArgon2idThreads.
+func WithArgon2id(time, memory uint32, threads uint8) Option {
+	return func(c *config) {
+		c.kdf = KDFArgon2id
+		c.params = KDFParams{Time: time, Memory: memory, Threads: threads}
+	}
+}
+
+// WithCipher encrypts the key with a cipher, CipherAES256GCM by default or
+// CipherXChaCha20Poly1305.
+func WithCipher(name string) Option {
+	return func(c *config) {
+		c.cipher = name
+	}
+}
+
+// Encrypt encrypts a wallet with a passphrase and returns the keystore file. The seed of the
+// wallet is stored if it has one, and its private key otherwise. Wallets whose address is not
+// derived from their public key are stored as regular keys of that address.
+func Encrypt(w wallet.Wallet, passphrase string, opts ...Option) ([]byte, error) {
+	c := config{
+		kdf:    KDFScrypt,
+		params: KDFParams{N: DefaultScryptN, R: DefaultScryptR, P: DefaultScryptP},
+		cipher: CipherAES256GCM,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	var s secret
+	switch {
+	case w.Seed != "":
+		s.Seed = w.Seed
+	case w.PrivateKey != "":
+		s.PrivateKey = w.PrivateKey
+	default:
+		return nil, ErrMissingKey
+	}
+
+	masterAddress, err := keypairs.DeriveClassicAddress(w.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	f := File{
+		Version:    Version,
+		Address:    w.ClassicAddress.String(),
+		PublicKey:  w.PublicKey,
+		KeyType:    keyTypeName(w),
+		RegularKey: masterAddress != w.ClassicAddress.String(),
+		Crypto: Crypto{
+			KDF:       c.kdf,
+			KDFParams: c.params,
+			Cipher:    c.cipher,
+		},
+	}
+	// Check that the stored key is the key of the wallet, so that the file can be loaded back.
+	if _, err := f.wallet(s); err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	f.Crypto.KDFParams.Salt = hex.EncodeToString(salt)
+
+	key, err := deriveKey(passphrase, f.Crypto.KDF, f.Crypto.KDFParams, salt)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(f.Crypto.Cipher, key)
+	clear(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	f.Crypto.Nonce = hex.EncodeToString(nonce)
+
+	plaintext, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	ad, err := f.additionalData()
+	if err != nil {
+		return nil, err
+	}
+	f.Crypto.Ciphertext = hex.EncodeToString(aead.Seal(nil, nonce, plaintext, ad))
+	clear(plaintext)
+
+	return json.MarshalIndent(f, "", "  ")
+}
+
+// Decrypt decrypts a keystore file with its passphrase and returns the wallet it holds.
+func Decrypt(data []byte, passphrase string) (wallet.Wallet, error) {
+	f, err := Parse(data)
+	if err != nil {
+		return wallet.Wallet{}, err
+	}
+
+	salt, err := hex.DecodeString(f.Crypto.KDFParams.Salt)
+	if err != nil || len(salt) == 0 {
+		return wallet.Wallet{}, fmt.Errorf("%w: salt", ErrInvalidKeystore)
+	}
+	nonce, err := hex.DecodeString(f.Crypto.Nonce)
+	if err != nil {
+		return wallet.Wallet{}, fmt.Errorf("%w: nonce", ErrInvalidKeystore)
+	}
+	ciphertext, err := hex.DecodeString(f.Crypto.Ciphertext)
+	if err != nil {
+		return wallet.Wallet{}, fmt.Errorf("%w: ciphertext", ErrInvalidKeystore)
+	}
+
+	key, err := deriveKey(passphrase, f.Crypto.KDF, f.Crypto.KDFParams, salt)
+	if err != nil {
+		return wallet.Wallet{}, err
+	}
+	aead, err := newAEAD(f.Crypto.Cipher, key)
+	clear(key)
+	if err != nil {
+		return wallet.Wallet{}, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return wallet.Wallet{}, fmt.Errorf("%w: nonce", ErrInvalidKeystore)
+	}
+
+	ad, err := f.additionalData()
+	if err != nil {
+		return wallet.Wallet{}, err
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, ad)
+	if err != nil {
+		return wallet.Wallet{}, ErrDecryptionFailed
+	}
+	defer clear(plaintext)
+
+	var s secret
+	if err := json.Unmarshal(plaintext, &s); err != nil {
+		return wallet.Wallet{}, ErrDecryptionFailed
+	}
+	return f.wallet(s)
+}
+
+// Parse parses a keystore file without decrypting it.
+func Parse(data []byte) (*File, error) {
+	var f File
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeystore, err)
+	}
+	if f.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, f.Version)
+	}
+	return &f, nil
+}
+
+// Save encrypts a wallet with a passphrase and writes the keystore file to path, readable by
+// the current user only. An existing file is replaced.
+func Save(path string, w wallet.Wallet, passphrase string, opts ...Option) error {
+	data, err := Encrypt(w, passphrase, opts...)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, data)
+}
+
+// Load reads the keystore file at path and decrypts it with its passphrase.
+func Load(path string, passphrase string) (wallet.Wallet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return wallet.Wallet{}, err
+	}
+	return Decrypt(data, passphrase)
+}
+
+// ChangePassphrase decrypts the keystore file at path with its passphrase and encrypts it again
+// with a new passphrase. The options apply to the new encryption, the defaults being used when
+// none are given.
+func ChangePassphrase(path string, oldPassphrase, newPassphrase string, opts ...Option) error {
+	w, err := Load(path, oldPassphrase)
+	if err != nil {
+		return err
+	}
+	return Save(path, w, newPassphrase, opts...)
+}
+
+// wallet rebuilds the wallet of a keystore file from its secret, and checks that it matches the
+// public key and address of the file.
+func (f *File) wallet(s secret) (wallet.Wallet, error) {
+	var masterAddress string
+	if f.RegularKey {
+		masterAddress = f.Address
+	}
+
+	var w wallet.Wallet
+	var err error
+	switch {
+	case s.Seed != "":
+		w, err = wallet.FromSeed(s.Seed, masterAddress)
+	case s.PrivateKey != "":
+		w, err = wallet.FromPrivateKey(s.PrivateKey, masterAddress)
+	default:
+		return wallet.Wallet{}, ErrMissingKey
+	}
+	if err != nil {
+		return wallet.Wallet{}, ErrKeyMismatch
+	}
+	if w.PublicKey != f.PublicKey || w.ClassicAddress.String() != f.Address || keyTypeName(w) != f.KeyType {
+		return wallet.Wallet{}, ErrKeyMismatch
+	}
+	return w, nil
+}
+
+// additionalData returns the data authenticated along with the encrypted key: the file without
+// its ciphertext.
+func (f *File) additionalData() ([]byte, error) {
+	header := *f
+	header.Crypto.Ciphertext = ""
+	return json.Marshal(header)
+}
+
+// deriveKey derives the encryption key. The parameters may come from an untrusted file, so they
+// are bounded before deriving to keep a crafted file from exhausting memory or CPU.
+func deriveKey(passphrase, kdf string, params KDFParams, salt []byte) ([]byte, error) {
+	switch kdf {
+	case KDFScrypt:
+		if params.N <= 1 || params.N&(params.N-1) != 0 || params.R <= 0 || params.P <= 0 {
+			return nil, ErrInvalidKDFParams
+		}
+		if params.N > MaxScryptN || params.R > MaxScryptR || params.P > MaxScryptP ||
+			params.N/8*params.R > MaxKDFMemory {
+			return nil, fmt.Errorf("%w: scrypt cost above the maximum", ErrInvalidKDFParams)
+		}
+		key, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, keyLength)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidKDFParams, err)
+		}
+		return key, nil
+	case KDFArgon2id:
+		if params.Time == 0 || params.Threads == 0 || params.Memory < 8*uint32(params.Threads) {
+			return nil, ErrInvalidKDFParams
+		}
+		if params.Time > MaxArgon2idTime || params.Threads > MaxArgon2idThreads || params.Memory > MaxKDFMemory {
+			return nil, fmt.Errorf("%w: argon2id cost above the maximum", ErrInvalidKDFParams)
+		}
+		return argon2.IDKey([]byte(passphrase), salt, params.Time, params.Memory, params.Threads, keyLength), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKDF, kdf)
+	}
+}
+
+func newAEAD(name string, key []byte) (cipher.AEAD, error) {
+	switch name {
+	case CipherAES256GCM:
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	case CipherXChaCha20Poly1305:
+		return chacha20poly1305.NewX(key)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCipher, name)
+	}
+}
+
+func keyTypeName(w wallet.Wallet) string {
+	if w.KeyType() == crypto.ED25519() {
+		return KeyTypeEd25519
+	}
+	return KeyTypeSecp256k1
+}
+
+// writeFile writes data to path through a temporary file in the same directory, so that an
+// existing file is either kept or fully replaced.
+func writeFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,309 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+const passphrase = "correct horse battery staple"
+
+// fastScrypt keeps the tests fast; real keystores use the default parameters.
+var fastScrypt = WithScrypt(1<<10, 8, 1)
+
+func mnemonicWallet(t *testing.T) wallet.Wallet {
+	t.Helper()
+	w, err := wallet.FromMnemonic("midnight help already frost arena force omit physical please dwarf envelope royal dice surge eight often muscle tired blast begin waste fat rescue debate")
+	require.NoError(t, err)
+	return *w
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	edWallet, err := wallet.FromSeed("sEd7io6yt5dFJrcePgRiFVHvmkJhJD1", "")
+	require.NoError(t, err)
+	secpWallet, err := wallet.FromSeed("snGHNrPbHrdUcszeuDEigMdC1Lyyd", "")
+	require.NoError(t, err)
+	regularKeyWallet, err := wallet.FromSeed("sh8i92YRnEjJy3fpFkL8txQSCVo79", "rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name       string
+		wallet     wallet.Wallet
+		opts       []Option
+		keyType    string
+		regularKey bool
+	}{
+		{
+			name:    "pass - ed25519 seed with scrypt and AES-256-GCM",
+			wallet:  edWallet,
+			opts:    []Option{fastScrypt},
+			keyType: KeyTypeEd25519,
+		},
+		{
+			name:    "pass - secp256k1 seed with argon2id and XChaCha20-Poly1305",
+			wallet:  secpWallet,
+			opts:    []Option{WithArgon2id(1, 64, 1), WithCipher(CipherXChaCha20Poly1305)},
+			keyType: KeyTypeSecp256k1,
+		},
+		{
+			name:    "pass - mnemonic private key",
+			wallet:  mnemonicWallet(t),
+			opts:    []Option{fastScrypt},
+			keyType: KeyTypeSecp256k1,
+		},
+		{
+			name:       "pass - regular key",
+			wallet:     regularKeyWallet,
+			opts:       []Option{fastScrypt},
+			keyType:    KeyTypeSecp256k1,
+			regularKey: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := Encrypt(tc.wallet, passphrase, tc.opts...)
+			require.NoError(t, err)
+
+			require.NotContains(t, string(data), tc.wallet.PrivateKey)
+			if tc.wallet.Seed != "" {
+				require.NotContains(t, string(data), tc.wallet.Seed)
+			}
+
+			f, err := Parse(data)
+			require.NoError(t, err)
+			require.Equal(t, Version, f.Version)
+			require.Equal(t, tc.wallet.ClassicAddress.String(), f.Address)
+			require.Equal(t, tc.wallet.PublicKey, f.PublicKey)
+			require.Equal(t, tc.keyType, f.KeyType)
+			require.Equal(t, tc.regularKey, f.RegularKey)
+
+			w, err := Decrypt(data, passphrase)
+			require.NoError(t, err)
+			require.Equal(t, tc.wallet, w)
+		})
+	}
+}
+
+func TestEncrypt_Errors(t *testing.T) {
+	w, err := wallet.FromSeed("sEd7io6yt5dFJrcePgRiFVHvmkJhJD1", "")
+	require.NoError(t, err)
+	other, err := wallet.FromSeed("snGHNrPbHrdUcszeuDEigMdC1Lyyd", "")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name        string
+		wallet      wallet.Wallet
+		opts        []Option
+		expectedErr error
+	}{
+		{
+			name:        "fail - no key",
+			wallet:      wallet.Wallet{PublicKey: w.PublicKey, ClassicAddress: w.ClassicAddress},
+			opts:        []Option{fastScrypt},
+			expectedErr: ErrMissingKey,
+		},
+		{
+			name:        "fail - private key of another wallet",
+			wallet:      wallet.Wallet{PublicKey: w.PublicKey, PrivateKey: other.PrivateKey, ClassicAddress: w.ClassicAddress},
+			opts:        []Option{fastScrypt},
+			expectedErr: ErrKeyMismatch,
+		},
+		{
+			name:        "fail - scrypt cost not a power of two",
+			wallet:      w,
+			opts:        []Option{WithScrypt(1000, 8, 1)},
+			expectedErr: ErrInvalidKDFParams,
+		},
+		{
+			name:        "fail - argon2id without threads",
+			wallet:      w,
+			opts:        []Option{WithArgon2id(1, 64, 0)},
+			expectedErr: ErrInvalidKDFParams,
+		},
+		{
+			name:        "fail - scrypt cost above the maximum",
+			wallet:      w,
+			opts:        []Option{WithScrypt(MaxScryptN*2, 8, 1)},
+			expectedErr: ErrInvalidKDFParams,
+		},
+		{
+			name:        "fail - unsupported cipher",
+			wallet:      w,
+			opts:        []Option{fastScrypt, WithCipher("aes-128-cbc")},
+			expectedErr: ErrUnsupportedCipher,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Encrypt(tc.wallet, passphrase, tc.opts...)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestDecrypt_Errors(t *testing.T) {
+	w, err := wallet.FromSeed("sEd7io6yt5dFJrcePgRiFVHvmkJhJD1", "")
+	require.NoError(t, err)
+	data, err := Encrypt(w, passphrase, fastScrypt)
+	require.NoError(t, err)
+
+	modify := func(edit func(f *File)) []byte {
+		f, err := Parse(data)
+		require.NoError(t, err)
+		edit(f)
+		modified, err := json.Marshal(f)
+		require.NoError(t, err)
+		return modified
+	}
+
+	testcases := []struct {
+		name        string
+		data        []byte
+		passphrase  string
+		expectedErr error
+	}{
+		{
+			name:        "fail - wrong passphrase",
+			data:        data,
+			passphrase:  "wrong",
+			expectedErr: ErrDecryptionFailed,
+		},
+		{
+			name: "fail - modified address",
+			data: modify(func(f *File) {
+				f.Address = "rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93"
+				f.RegularKey = true
+			}),
+			passphrase:  passphrase,
+			expectedErr: ErrDecryptionFailed,
+		},
+		{
+			name: "fail - modified ciphertext",
+			data: modify(func(f *File) {
+				ciphertext, err := hex.DecodeString(f.Crypto.Ciphertext)
+				require.NoError(t, err)
+				ciphertext[0] ^= 1
+				f.Crypto.Ciphertext = hex.EncodeToString(ciphertext)
+			}),
+			passphrase:  passphrase,
+			expectedErr: ErrDecryptionFailed,
+		},
+		{
+			name:        "fail - unsupported version",
+			data:        modify(func(f *File) { f.Version = 2 }),
+			passphrase:  passphrase,
+			expectedErr: ErrUnsupportedVersion,
+		},
+		{
+			name:        "fail - unsupported kdf",
+			data:        modify(func(f *File) { f.Crypto.KDF = "pbkdf2" }),
+			passphrase:  passphrase,
+			expectedErr: ErrUnsupportedKDF,
+		},
+		{
+			name: "fail - hostile scrypt cost",
+			data: modify(func(f *File) {
+				f.Crypto.KDFParams.N = 1 << 30
+			}),
+			passphrase:  passphrase,
+			expectedErr: ErrInvalidKDFParams,
+		},
+		{
+			name: "fail - hostile scrypt memory",
+			data: modify(func(f *File) {
+				f.Crypto.KDFParams.N = MaxScryptN
+				f.Crypto.KDFParams.R = MaxScryptR
+			}),
+			passphrase:  passphrase,
+			expectedErr: ErrInvalidKDFParams,
+		},
+		{
+			name: "fail - hostile scrypt parallelization",
+			data: modify(func(f *File) {
+				f.Crypto.KDFParams.P = 1 << 20
+			}),
+			passphrase:  passphrase,
+			expectedErr: ErrInvalidKDFParams,
+		},
+		{
+			name: "fail - hostile argon2id memory",
+			data: modify(func(f *File) {
+				f.Crypto.KDF = KDFArgon2id
+				f.Crypto.KDFParams = KDFParams{Salt: f.Crypto.KDFParams.Salt, Time: 1, Memory: 1 << 31, Threads: 1}
+			}),
+			passphrase:  passphrase,
+			expectedErr: ErrInvalidKDFParams,
+		},
+		{
+			name: "fail - hostile argon2id time",
+			data: modify(func(f *File) {
+				f.Crypto.KDF = KDFArgon2id
+				f.Crypto.KDFParams = KDFParams{Salt: f.Crypto.KDFParams.Salt, Time: 1 << 30, Memory: 64, Threads: 1}
+			}),
+			passphrase:  passphrase,
+			expectedErr: ErrInvalidKDFParams,
+		},
+		{
+			name: "fail - hostile argon2id threads",
+			data: modify(func(f *File) {
+				f.Crypto.KDF = KDFArgon2id
+				f.Crypto.KDFParams = KDFParams{Salt: f.Crypto.KDFParams.Salt, Time: 1, Memory: 8 * 255, Threads: 255}
+			}),
+			passphrase:  passphrase,
+			expectedErr: ErrInvalidKDFParams,
+		},
+		{
+			name:        "fail - invalid nonce",
+			data:        modify(func(f *File) { f.Crypto.Nonce = "00" }),
+			passphrase:  passphrase,
+			expectedErr: ErrInvalidKeystore,
+		},
+		{
+			name:        "fail - not a keystore file",
+			data:        []byte("seed=sEd7io6yt5dFJrcePgRiFVHvmkJhJD1"),
+			passphrase:  passphrase,
+			expectedErr: ErrInvalidKeystore,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Decrypt(tc.data, tc.passphrase)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestSaveLoadChangePassphrase(t *testing.T) {
+	w := mnemonicWallet(t)
+	path := filepath.Join(t.TempDir(), "wallet.json")
+
+	require.NoError(t, Save(path, w, passphrase, fastScrypt))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	loaded, err := Load(path, passphrase)
+	require.NoError(t, err)
+	require.Equal(t, w, loaded)
+
+	require.NoError(t, ChangePassphrase(path, passphrase, "new passphrase", WithArgon2id(1, 64, 1)))
+	_, err = Load(path, passphrase)
+	require.ErrorIs(t, err, ErrDecryptionFailed)
+	loaded, err = Load(path, "new passphrase")
+	require.NoError(t, err)
+	require.Equal(t, w, loaded)
+
+	require.ErrorIs(t, ChangePassphrase(path, passphrase, "other"), ErrDecryptionFailed)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
@@ -0,0 +1,26 @@
+package remote
+
+import "errors"
+
+var (
+	// protocol
+
+	// ErrLineTooLong is returned when a request or response line exceeds MaxLineSize.
+	ErrLineTooLong = errors.New("remote signer line too long")
+
+	// client
+
+	// ErrRemoteSigner is returned when the remote signer reports an error.
+	ErrRemoteSigner = errors.New("remote signer error")
+	// ErrUnexpectedResponse is returned when a response does not answer the pending request.
+	ErrUnexpectedResponse = errors.New("unexpected remote signer response")
+	// ErrInvalidKeyType is returned when the remote signer reports an unknown key type.
+	ErrInvalidKeyType = errors.New("invalid remote signer key type")
+
+	// server
+
+	// ErrUnknownMethod is returned to clients calling a method the server does not implement.
+	ErrUnknownMethod = errors.New("unknown method")
+	// ErrServerClosed is returned by Serve after Close is called.
+	ErrServerClosed = errors.New("remote signer server closed")
+)
@@ -0,0 +1,97 @@
+// Package remote implements a wallet.Signer backed by a signing process reached over a local
+// socket, so that the process submitting transactions never holds the private key, and the
+// reference Server running in that signing process.
+//
+// The protocol exchanges one JSON object per line. A request carries an id, a method and, for
+// sign, the hex encoded message to sign:
+//
+//	{"id":1,"method":"info"}
+//	{"id":2,"method":"sign","message":"5354580012..."}
+//
+// and its response the same id with either a result or an error:
+//
+//	{"id":1,"result":{"address":"r...","public_key":"ED...","key_type":"ed25519"}}
+//	{"id":2,"result":{"signature":"..."}}
+//	{"id":3,"error":"unknown method"}
+//
+// A line is at most MaxLineSize bytes long. The server answers a longer request with an error and
+// closes the connection.
+//
+// The server signs any message it receives: access to the socket must be restricted, for
+// instance with the permissions of a Unix socket file.
+package remote
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+)
+
+const (
+	// MethodInfo returns the address, public key and key type of the signer.
+	MethodInfo = "info"
+	// MethodSign signs a message.
+	MethodSign = "sign"
+
+	// KeyTypeEd25519 is the key type of ed25519 signers.
+	KeyTypeEd25519 = "ed25519"
+	// KeyTypeSecp256k1 is the key type of secp256k1 signers.
+	KeyTypeSecp256k1 = "secp256k1"
+
+	// MaxLineSize is the maximum size in bytes of a request or response line, newline included.
+	// It leaves room for a hex encoded message to sign twice the size of the largest transaction
+	// rippled accepts.
+	MaxLineSize = 4 << 20
+)
+
+// Request is a request sent to the signing process.
+type Request struct {
+	ID      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Message string `json:"message,omitempty"`
+}
+
+// Response is the response of the signing process to a Request.
+type Response struct {
+	ID     uint64  `json:"id"`
+	Result *Result `json:"result,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// Result is the result of a successful Request. Info requests set the address, public key and
+// key type, and sign requests the signature.
+type Result struct {
+	Address   string `json:"address,omitempty"`
+	PublicKey string `json:"public_key,omitempty"`
+	KeyType   string `json:"key_type,omitempty"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// newLineScanner returns a scanner reading the lines of r up to MaxLineSize bytes.
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxLineSize)
+	return scanner
+}
+
+// keyTypeName returns the protocol name of a key type.
+func keyTypeName(alg interfaces.CryptoImplementation) string {
+	if alg == crypto.ED25519() {
+		return KeyTypeEd25519
+	}
+	return KeyTypeSecp256k1
+}
+
+// keyTypeFromName returns the key type with a protocol name.
+func keyTypeFromName(name string) (interfaces.CryptoImplementation, error) {
+	switch name {
+	case KeyTypeEd25519:
+		return crypto.ED25519(), nil
+	case KeyTypeSecp256k1:
+		return crypto.SECP256K1(), nil
+	default:
+		return nil, ErrInvalidKeyType
+	}
+}
@@ -0,0 +1,223 @@
+package remote
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+var errDenied = errors.New("signing denied")
+
+type deniedSigner struct {
+	wallet.Wallet
+}
+
+func (deniedSigner) SignMessage(_ []byte) (string, error) {
+	return "", errDenied
+}
+
+// serve starts a Server signing with signer on a Unix socket and returns the socket path.
+func serve(t *testing.T, signer wallet.Signer) (*Server, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "signer.sock")
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+
+	server := NewServer(signer)
+	done := make(chan error, 1)
+	go func() { done <- server.Serve(l) }()
+	t.Cleanup(func() {
+		_ = server.Close()
+		require.ErrorIs(t, <-done, ErrServerClosed)
+	})
+	return server, path
+}
+
+func paymentTx(account types.Address) map[string]any {
+	return map[string]any{
+		"Account":         account.String(),
+		"TransactionType": "Payment",
+		"Amount":          "15",
+		"Destination":     "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+		"Flags":           uint32(0),
+		"Fee":             "12",
+		"Sequence":        uint32(1798962),
+	}
+}
+
+func TestSigner(t *testing.T) {
+	testcases := []struct {
+		name    string
+		seed    string
+		keyType interfaces.CryptoImplementation
+	}{
+		{
+			name:    "pass - ed25519",
+			seed:    "sEdSuqBPSQaood2DmNYVkwWTn1oQTj2",
+			keyType: crypto.ED25519(),
+		},
+		{
+			name:    "pass - secp256k1",
+			seed:    "snGHNrPbHrdUcszeuDEigMdC1Lyyd",
+			keyType: crypto.SECP256K1(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := wallet.FromSeed(tc.seed, "")
+			require.NoError(t, err)
+			_, path := serve(t, w)
+
+			s, err := Dial("unix", path)
+			require.NoError(t, err)
+			defer s.Close()
+
+			require.Equal(t, w.ClassicAddress, s.GetAddress())
+			require.Equal(t, w.PublicKey, s.GetPublicKey())
+			require.Equal(t, tc.keyType, s.KeyType())
+
+			blob, hash, err := wallet.Sign(s, paymentTx(s.GetAddress()))
+			require.NoError(t, err)
+			expectedBlob, expectedHash, err := w.Sign(paymentTx(w.ClassicAddress))
+			require.NoError(t, err)
+			require.Equal(t, expectedBlob, blob)
+			require.Equal(t, expectedHash, hash)
+
+			blob, _, err = wallet.Multisign(s, paymentTx(s.GetAddress()))
+			require.NoError(t, err)
+			expectedBlob, _, err = w.Multisign(paymentTx(w.ClassicAddress))
+			require.NoError(t, err)
+			require.Equal(t, expectedBlob, blob)
+		})
+	}
+}
+
+func TestSigner_Concurrent(t *testing.T) {
+	w, err := wallet.FromSeed("sEdSuqBPSQaood2DmNYVkwWTn1oQTj2", "")
+	require.NoError(t, err)
+	_, path := serve(t, w)
+
+	s, err := Dial("unix", path)
+	require.NoError(t, err)
+	defer s.Close()
+
+	expected, err := w.SignMessage([]byte("message"))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sig, err := s.SignMessage([]byte("message"))
+			require.NoError(t, err)
+			require.Equal(t, expected, sig)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSigner_Errors(t *testing.T) {
+	w, err := wallet.FromSeed("sEdSuqBPSQaood2DmNYVkwWTn1oQTj2", "")
+	require.NoError(t, err)
+
+	t.Run("fail - signing error", func(t *testing.T) {
+		_, path := serve(t, deniedSigner{w})
+		s, err := Dial("unix", path)
+		require.NoError(t, err)
+		defer s.Close()
+
+		_, _, err = wallet.Sign(s, paymentTx(s.GetAddress()))
+		require.ErrorIs(t, err, ErrRemoteSigner)
+		require.ErrorContains(t, err, errDenied.Error())
+	})
+
+	t.Run("fail - unknown method", func(t *testing.T) {
+		_, path := serve(t, w)
+		conn, err := net.Dial("unix", path)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		require.NoError(t, json.NewEncoder(conn).Encode(&Request{ID: 7, Method: "export"}))
+		scanner := bufio.NewScanner(conn)
+		require.True(t, scanner.Scan())
+		var resp Response
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &resp))
+		require.Equal(t, uint64(7), resp.ID)
+		require.Nil(t, resp.Result)
+		require.Equal(t, ErrUnknownMethod.Error(), resp.Error)
+	})
+
+	t.Run("fail - request line too long", func(t *testing.T) {
+		_, path := serve(t, w)
+		s, err := Dial("unix", path)
+		require.NoError(t, err)
+		defer s.Close()
+
+		_, err = s.SignMessage(make([]byte, MaxLineSize/2))
+		require.ErrorIs(t, err, ErrLineTooLong)
+
+		// The request is rejected before being sent, so the connection is still usable.
+		_, _, err = wallet.Sign(s, paymentTx(s.GetAddress()))
+		require.NoError(t, err)
+	})
+
+	t.Run("fail - server receives a line too long", func(t *testing.T) {
+		_, path := serve(t, w)
+		conn, err := net.Dial("unix", path)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		go func() { _, _ = conn.Write([]byte(strings.Repeat("a", MaxLineSize) + "\n")) }()
+		scanner := bufio.NewScanner(conn)
+		require.True(t, scanner.Scan())
+		var resp Response
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &resp))
+		require.Equal(t, ErrLineTooLong.Error(), resp.Error)
+		require.False(t, scanner.Scan())
+	})
+
+	t.Run("fail - response line too long", func(t *testing.T) {
+		client, server := net.Pipe()
+		defer server.Close()
+		go func() {
+			_, _ = bufio.NewReader(server).ReadBytes('\n')
+			_, _ = server.Write([]byte(`{"id":1,"result":{"address":"` + strings.Repeat("r", MaxLineSize) + `"}}` + "\n"))
+		}()
+
+		_, err := NewSigner(client)
+		require.ErrorIs(t, err, ErrLineTooLong)
+	})
+
+	t.Run("fail - server closed", func(t *testing.T) {
+		server, path := serve(t, w)
+		s, err := Dial("unix", path)
+		require.NoError(t, err)
+		defer s.Close()
+
+		require.NoError(t, server.Close())
+		_, err = s.SignMessage([]byte("message"))
+		require.Error(t, err)
+
+		_, err = Dial("unix", path)
+		require.Error(t, err)
+	})
+
+	t.Run("fail - no signer listening", func(t *testing.T) {
+		_, err := Dial("unix", filepath.Join(t.TempDir(), "missing.sock"))
+		require.Error(t, err)
+	})
+}
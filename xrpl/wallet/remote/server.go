@@ -0,0 +1,158 @@
+package remote
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// Server serves a wallet.Signer, such as a Wallet holding the private key in the signing
+// process, to the remote Signer clients connecting to it.
+type Server struct {
+	signer wallet.Signer
+
+	mu        sync.Mutex
+	listeners map[net.Listener]struct{}
+	conns     map[net.Conn]struct{}
+	closed    bool
+	wg        sync.WaitGroup
+}
+
+// NewServer returns a Server signing with signer.
+func NewServer(signer wallet.Signer) *Server {
+	return &Server{
+		signer:    signer,
+		listeners: make(map[net.Listener]struct{}),
+		conns:     make(map[net.Conn]struct{}),
+	}
+}
+
+// Serve accepts connections on l and serves each of them in its own goroutine, until the
+// listener fails or Close is called, in which case it returns ErrServerClosed.
+func (s *Server) Serve(l net.Listener) error {
+	if !s.track(l, nil) {
+		return ErrServerClosed
+	}
+	defer s.untrack(l, nil)
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return ErrServerClosed
+			}
+			return err
+		}
+		if !s.track(nil, conn) {
+			conn.Close()
+			return ErrServerClosed
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer s.untrack(nil, conn)
+			s.serveConn(conn)
+		}()
+	}
+}
+
+// Close stops the listeners and closes the open connections, and waits for the connections
+// being served to return.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	var errs []error
+	for l := range s.listeners {
+		errs = append(errs, l.Close())
+	}
+	for conn := range s.conns {
+		errs = append(errs, conn.Close())
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return errors.Join(errs...)
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	scanner := newLineScanner(conn)
+	encoder := json.NewEncoder(conn)
+	for scanner.Scan() {
+		var req Request
+		resp := Response{}
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			resp.Error = err.Error()
+		} else {
+			resp.ID = req.ID
+			resp.Result, err = s.handle(&req)
+			if err != nil {
+				resp.Error = err.Error()
+			}
+		}
+		if err := encoder.Encode(&resp); err != nil {
+			return
+		}
+	}
+	// The rest of an oversized line cannot be told apart from the next request.
+	if errors.Is(scanner.Err(), bufio.ErrTooLong) {
+		_ = encoder.Encode(&Response{Error: ErrLineTooLong.Error()})
+	}
+}
+
+func (s *Server) handle(req *Request) (*Result, error) {
+	switch req.Method {
+	case MethodInfo:
+		return &Result{
+			Address:   s.signer.GetAddress().String(),
+			PublicKey: s.signer.GetPublicKey(),
+			KeyType:   keyTypeName(s.signer.KeyType()),
+		}, nil
+	case MethodSign:
+		message, err := hex.DecodeString(req.Message)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := s.signer.SignMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return &Result{Signature: signature}, nil
+	default:
+		return nil, ErrUnknownMethod
+	}
+}
+
+func (s *Server) track(l net.Listener, conn net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	if l != nil {
+		s.listeners[l] = struct{}{}
+	}
+	if conn != nil {
+		s.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (s *Server) untrack(l net.Listener, conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.listeners, l)
+	delete(s.conns, conn)
+}
+
+func (s *Server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
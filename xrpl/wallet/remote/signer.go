@@ -0,0 +1,140 @@
+package remote
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+var _ wallet.Signer = (*Signer)(nil)
+
+// Signer is a wallet.Signer that sends the messages to sign to a signing process over a
+// connection. It can be used wherever a wallet.Signer is accepted, such as wallet.Sign or the
+// Wallet of the client SubmitOptions. It is safe for concurrent use; requests are sent one at a
+// time.
+type Signer struct {
+	address   types.Address
+	publicKey string
+	keyType   interfaces.CryptoImplementation
+
+	mu      sync.Mutex
+	conn    net.Conn
+	scanner *bufio.Scanner
+	nextID  uint64
+}
+
+// Dial connects to a signing process, for instance on a Unix socket with network "unix" and the
+// socket path as address, and fetches its address, public key and key type.
+func Dial(network, address string) (*Signer, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewSigner(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewSigner returns a Signer using an open connection to a signing process, and fetches its
+// address, public key and key type.
+func NewSigner(conn net.Conn) (*Signer, error) {
+	s := &Signer{
+		conn:    conn,
+		scanner: newLineScanner(conn),
+	}
+	info, err := s.call(&Request{Method: MethodInfo})
+	if err != nil {
+		return nil, err
+	}
+	if s.keyType, err = keyTypeFromName(info.KeyType); err != nil {
+		return nil, err
+	}
+	s.address = types.Address(info.Address)
+	s.publicKey = info.PublicKey
+	return s, nil
+}
+
+// GetAddress returns the address of the remote signer.
+func (s *Signer) GetAddress() types.Address {
+	return s.address
+}
+
+// GetPublicKey returns the public key of the remote signer.
+func (s *Signer) GetPublicKey() string {
+	return s.publicKey
+}
+
+// KeyType returns the key type of the remote signer.
+func (s *Signer) KeyType() interfaces.CryptoImplementation {
+	return s.keyType
+}
+
+// SignMessage sends a message to the signing process and returns its signature.
+func (s *Signer) SignMessage(message []byte) (string, error) {
+	result, err := s.call(&Request{Method: MethodSign, Message: hex.EncodeToString(message)})
+	if err != nil {
+		return "", err
+	}
+	return result.Signature, nil
+}
+
+// Close closes the connection to the signing process.
+func (s *Signer) Close() error {
+	return s.conn.Close()
+}
+
+func (s *Signer) call(req *Request) (*Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	req.ID = s.nextID
+	line, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(line)+1 > MaxLineSize {
+		return nil, fmt.Errorf("%w: request of %d bytes", ErrLineTooLong, len(line)+1)
+	}
+	if _, err := s.conn.Write(append(line, '\n')); err != nil {
+		return nil, err
+	}
+	if !s.scanner.Scan() {
+		err := s.scanner.Err()
+		switch {
+		case errors.Is(err, bufio.ErrTooLong):
+			// The rest of the response cannot be told apart from the next one.
+			s.conn.Close()
+			return nil, fmt.Errorf("%w: response", ErrLineTooLong)
+		case err != nil:
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: connection closed", ErrUnexpectedResponse)
+	}
+
+	var resp Response
+	if err := json.Unmarshal(s.scanner.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnexpectedResponse, err)
+	}
+	if resp.ID != req.ID {
+		return nil, fmt.Errorf("%w: id %d, expected %d", ErrUnexpectedResponse, resp.ID, req.ID)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteSigner, resp.Error)
+	}
+	if resp.Result == nil {
+		return nil, fmt.Errorf("%w: missing result", ErrUnexpectedResponse)
+	}
+	return resp.Result, nil
+}
@@ -0,0 +1,203 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"maps"
+	"reflect"
+	"strings"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+var _ Signer = Wallet{}
+
+// Signer signs transactions and other XRPL signing data with a key it does not need to expose,
+// such as a key held in an HSM, a KMS or a separate signing service. Wallet is the in-memory
+// implementation.
+type Signer interface {
+	// GetAddress returns the account the signer signs for.
+	GetAddress() types.Address
+	// GetPublicKey returns the hex encoded public key of the signer, as set in SigningPubKey.
+	GetPublicKey() string
+	// KeyType returns the algorithm of the signer key, crypto.ED25519() or crypto.SECP256K1().
+	KeyType() interfaces.CryptoImplementation
+	// SignMessage signs a message and returns the hex encoded signature. The message is the full
+	// signing data, such as the output of EncodeForSigning: ed25519 keys sign it as is, and
+	// secp256k1 keys sign its SHA-512Half digest with a canonical DER encoded ECDSA signature.
+	SignMessage(message []byte) (string, error)
+}
+
+// IsNilSigner reports whether s is nil or a nil pointer held in a Signer, such as a nil *Wallet,
+// which would panic when used.
+func IsNilSigner(s Signer) bool {
+	if s == nil {
+		return true
+	}
+	v := reflect.ValueOf(s)
+	return v.Kind() == reflect.Pointer && v.IsNil()
+}
+
+// Sign signs a transaction offline with a signer, returning the transaction blob and its hash.
+// The transaction is signed using an internal copy and the provided map is not mutated.
+func Sign(s Signer, tx map[string]any) (string, string, error) {
+	return SignWithCodec(s, tx, binarycodec.DefaultCodec())
+}
+
+// SignWithCodec signs a transaction offline like Sign, encoding it with the given codec.
+func SignWithCodec(s Signer, tx map[string]any, codec *binarycodec.Codec) (string, string, error) {
+	if IsNilSigner(s) {
+		return "", "", ErrNilSigner
+	}
+	if tx == nil {
+		return "", "", ErrNilTransaction
+	}
+	if err := transaction.FlatTransaction(tx).RequireNotPseudo(); err != nil {
+		return "", "", err
+	}
+
+	signTx := maps.Clone(tx)
+	signTx["SigningPubKey"] = s.GetPublicKey()
+
+	encodedTx, err := codec.EncodeForSigning(signTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	signature, err := signEncoded(s, encodedTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	signTx["TxnSignature"] = signature
+
+	txBlob, err := codec.Encode(signTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	txHash, err := hash.SignTxBlobWithCodec(txBlob, codec)
+	if err != nil {
+		return "", "", err
+	}
+
+	return txBlob, txHash, nil
+}
+
+// SignTx signs a typed transaction offline with a signer, returning the transaction blob and its
+// hash. The transaction is serialized with its EncodeBinary method rather than flattened and
+// encoded with the map-based codec, and is signed using a copy: tx is not mutated.
+func SignTx(s Signer, tx transaction.Tx) (string, string, error) {
+	return SignTxWithCodec(s, tx, binarycodec.DefaultCodec())
+}
+
+// SignTxWithCodec signs a typed transaction offline like SignTx, encoding it with the given codec.
+func SignTxWithCodec(s Signer, tx transaction.Tx, codec *binarycodec.Codec) (string, string, error) {
+	if IsNilSigner(s) {
+		return "", "", ErrNilSigner
+	}
+	if tx == nil || (reflect.ValueOf(tx).Kind() == reflect.Pointer && reflect.ValueOf(tx).IsNil()) {
+		return "", "", ErrNilTransaction
+	}
+	if tx.TxType().IsPseudo() {
+		return "", "", transaction.ErrPseudoTransaction
+	}
+
+	signTx, err := transaction.WithSignature(tx, s.GetPublicKey(), "")
+	if err != nil {
+		return "", "", err
+	}
+
+	encodedTx, err := transaction.EncodeBinaryForSigningWithCodec(signTx, codec)
+	if err != nil {
+		return "", "", err
+	}
+
+	signature, err := s.SignMessage(encodedTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	signTx, err = transaction.WithSignature(tx, s.GetPublicKey(), signature)
+	if err != nil {
+		return "", "", err
+	}
+
+	blob, err := transaction.EncodeBinaryWithCodec(signTx, codec)
+	if err != nil {
+		return "", "", err
+	}
+	txBlob := strings.ToUpper(hex.EncodeToString(blob))
+
+	txHash, err := hash.SignTxBlobWithCodec(txBlob, codec)
+	if err != nil {
+		return "", "", err
+	}
+
+	return txBlob, txHash, nil
+}
+
+// Multisign signs a multisigned transaction offline with a signer, returning the signed
+// transaction blob and its transaction hash.
+// The transaction is signed using an internal copy and the provided map is not mutated.
+func Multisign(s Signer, tx map[string]any) (string, string, error) {
+	return MultisignWithCodec(s, tx, binarycodec.DefaultCodec())
+}
+
+// MultisignWithCodec signs a multisigned transaction offline like Multisign, encoding it with the given codec.
+func MultisignWithCodec(s Signer, tx map[string]any, codec *binarycodec.Codec) (string, string, error) {
+	if IsNilSigner(s) {
+		return "", "", ErrNilSigner
+	}
+	if tx == nil {
+		return "", "", ErrNilTransaction
+	}
+	if err := transaction.FlatTransaction(tx).RequireNotPseudo(); err != nil {
+		return "", "", err
+	}
+
+	signTx := maps.Clone(tx)
+	// For regular multisigning, SigningPubKey must be empty per XRPL protocol.
+	signTx["SigningPubKey"] = ""
+	encodedTx, err := codec.EncodeForMultisigning(signTx, s.GetAddress().String())
+	if err != nil {
+		return "", "", err
+	}
+
+	signature, err := signEncoded(s, encodedTx)
+	if err != nil {
+		return "", "", err
+	}
+
+	signer := types.Signer{
+		SignerData: types.SignerData{
+			Account:       s.GetAddress(),
+			TxnSignature:  signature,
+			SigningPubKey: s.GetPublicKey(),
+		},
+	}
+
+	signTx["Signers"] = []any{signer.Flatten()}
+	blob, err := codec.Encode(signTx)
+	if err != nil {
+		return "", "", err
+	}
+	blobHash, err := hash.SignTxBlobWithCodec(blob, codec)
+	if err != nil {
+		return "", "", err
+	}
+
+	return blob, blobHash, nil
+}
+
+// signEncoded signs hex encoded signing data with a signer.
+func signEncoded(s Signer, encoded string) (string, error) {
+	message, err := hex.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+	return s.SignMessage(message)
+}
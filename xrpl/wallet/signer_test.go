@@ -0,0 +1,145 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+// keyStoreSigner is a Signer that does not expose its private key, as an HSM would.
+type keyStoreSigner struct {
+	wallet   Wallet
+	messages []string
+	err      error
+}
+
+func (s *keyStoreSigner) GetAddress() types.Address                { return s.wallet.ClassicAddress }
+func (s *keyStoreSigner) GetPublicKey() string                     { return s.wallet.PublicKey }
+func (s *keyStoreSigner) KeyType() interfaces.CryptoImplementation { return s.wallet.KeyType() }
+
+func (s *keyStoreSigner) SignMessage(message []byte) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	s.messages = append(s.messages, hex.EncodeToString(message))
+	return s.wallet.SignMessage(message)
+}
+
+func TestWallet_KeyType(t *testing.T) {
+	edWallet, err := FromSeed("sEdSuqBPSQaood2DmNYVkwWTn1oQTj2", "")
+	require.NoError(t, err)
+	require.Equal(t, crypto.ED25519(), edWallet.KeyType())
+
+	secpWallet, err := FromSeed("snGHNrPbHrdUcszeuDEigMdC1Lyyd", "")
+	require.NoError(t, err)
+	require.Equal(t, crypto.SECP256K1(), secpWallet.KeyType())
+}
+
+func TestSigner(t *testing.T) {
+	paymentTx := func(w Wallet) map[string]any {
+		return map[string]any{
+			"Account":         w.ClassicAddress.String(),
+			"TransactionType": "Payment",
+			"Amount":          "15",
+			"Destination":     "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+			"Flags":           uint32(0),
+			"Fee":             "12",
+			"Sequence":        uint32(1798962),
+		}
+	}
+
+	for _, seed := range []string{"sEdSuqBPSQaood2DmNYVkwWTn1oQTj2", "snGHNrPbHrdUcszeuDEigMdC1Lyyd"} {
+		w, err := FromSeed(seed, "")
+		require.NoError(t, err)
+
+		t.Run("pass - Sign matches the wallet "+seed, func(t *testing.T) {
+			s := &keyStoreSigner{wallet: w}
+			blob, hash, err := Sign(s, paymentTx(w))
+			require.NoError(t, err)
+
+			expectedBlob, expectedHash, err := w.Sign(paymentTx(w))
+			require.NoError(t, err)
+			require.Equal(t, expectedBlob, blob)
+			require.Equal(t, expectedHash, hash)
+			require.Len(t, s.messages, 1)
+		})
+
+		t.Run("pass - SignTx matches the wallet "+seed, func(t *testing.T) {
+			tx := &transaction.Payment{
+				BaseTx:      transaction.BaseTx{Account: w.ClassicAddress, Fee: 12, Sequence: 1798962},
+				Amount:      types.XRPCurrencyAmount(15),
+				Destination: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+			}
+			s := &keyStoreSigner{wallet: w}
+			blob, hash, err := SignTx(s, tx)
+			require.NoError(t, err)
+
+			expectedBlob, expectedHash, err := w.SignTx(tx)
+			require.NoError(t, err)
+			require.Equal(t, expectedBlob, blob)
+			require.Equal(t, expectedHash, hash)
+			require.Len(t, s.messages, 1)
+		})
+
+		t.Run("pass - Multisign matches the wallet "+seed, func(t *testing.T) {
+			s := &keyStoreSigner{wallet: w}
+			blob, hash, err := Multisign(s, paymentTx(w))
+			require.NoError(t, err)
+
+			expectedBlob, expectedHash, err := w.Multisign(paymentTx(w))
+			require.NoError(t, err)
+			require.Equal(t, expectedBlob, blob)
+			require.Equal(t, expectedHash, hash)
+		})
+
+		t.Run("pass - AuthorizeChannel matches the wallet "+seed, func(t *testing.T) {
+			s := &keyStoreSigner{wallet: w}
+			sig, err := AuthorizeChannel("5DB01B7FFED6B67E6B0414DED11E051D2EE2B7619CE0EAA6286D67A3A4D5BDB3", "1000000", s)
+			require.NoError(t, err)
+
+			expected, err := AuthorizeChannel("5DB01B7FFED6B67E6B0414DED11E051D2EE2B7619CE0EAA6286D67A3A4D5BDB3", "1000000", w)
+			require.NoError(t, err)
+			require.Equal(t, expected, sig)
+		})
+	}
+
+	t.Run("fail - signer error", func(t *testing.T) {
+		w, err := FromSeed("sEdSuqBPSQaood2DmNYVkwWTn1oQTj2", "")
+		require.NoError(t, err)
+		errDenied := errors.New("signing denied")
+
+		s := &keyStoreSigner{wallet: w, err: errDenied}
+		_, _, err = Sign(s, paymentTx(w))
+		require.ErrorIs(t, err, errDenied)
+		_, _, err = Multisign(s, paymentTx(w))
+		require.ErrorIs(t, err, errDenied)
+	})
+
+	t.Run("fail - nil signer", func(t *testing.T) {
+		w, err := FromSeed("sEdSuqBPSQaood2DmNYVkwWTn1oQTj2", "")
+		require.NoError(t, err)
+
+		for _, s := range []Signer{nil, (*Wallet)(nil), (*keyStoreSigner)(nil)} {
+			require.True(t, IsNilSigner(s))
+			_, _, err = Sign(s, paymentTx(w))
+			require.ErrorIs(t, err, ErrNilSigner)
+			_, _, err = Multisign(s, paymentTx(w))
+			require.ErrorIs(t, err, ErrNilSigner)
+		}
+		require.False(t, IsNilSigner(w))
+		require.False(t, IsNilSigner(&w))
+	})
+
+	t.Run("fail - nil transaction", func(t *testing.T) {
+		s := &keyStoreSigner{}
+		_, _, err := Sign(s, nil)
+		require.ErrorIs(t, err, ErrNilTransaction)
+		require.Empty(t, s.messages)
+	})
+}
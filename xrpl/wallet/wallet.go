@@ -3,26 +3,18 @@
 package wallet
 
 import (
-	"encoding/hex"
-	"fmt"
-	"maps"
 	"strings"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
 	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/keypairs"
-	"github.com/Peersyst/xrpl-go/pkg/hexutil"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
 	"github.com/Peersyst/xrpl-go/pkg/random"
-	"github.com/Peersyst/xrpl-go/xrpl/hash"
 	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
-	bip32 "github.com/bsv-blockchain/go-sdk/compat/bip32"
-	"github.com/bsv-blockchain/go-sdk/compat/bip39"
-	chaincfg "github.com/bsv-blockchain/go-sdk/transaction/chaincfg"
 )
 
-var nilHDPrivateKeyID = [4]byte{0x00, 0x00, 0x00, 0x00}
-
 // Wallet is a utility for deriving a wallet composed of a keypair (publicKey/privateKey).
 // It can be derived from a seed, mnemonic, or entropy, and supports offline signing and verification.
 type Wallet struct {
@@ -76,162 +68,131 @@ func FromSecret(seed string) (Wallet, error) {
 	return FromSeed(seed, "")
 }
 
-// FromMnemonic derives a Wallet from a bip39 or RFC1751 mnemonic (defaults to bip39).
-func FromMnemonic(mnemonic string) (*Wallet, error) {
-	// Validate the mnemonic
-	if !bip39.IsMnemonicValid(mnemonic) {
-		return nil, bip39.ErrInvalidMnemonic
+// FromRFC1751 derives a Wallet from the 12 RFC1751 words of a seed, as returned by rippled's
+// wallet_propose as master_key, using the given algorithm.
+func FromRFC1751(mnemonic string, alg interfaces.CryptoImplementation) (Wallet, error) {
+	seed, err := keypairs.SeedFromRFC1751(mnemonic, alg)
+	if err != nil {
+		return Wallet{}, err
 	}
+	return FromSeed(seed, "")
+}
 
-	// Generate seed from mnemonic
-	seed := bip39.NewSeed(mnemonic, "")
-
-	// Derive the master key
-
-	params := &chaincfg.Params{
-		HDPrivateKeyID: nilHDPrivateKeyID,
-	}
-	masterKey, err := bip32.NewMaster(seed, params)
+// FromPassphrase derives a Wallet from a passphrase as rippled's wallet_propose does, using the
+// given algorithm. Such a wallet is only as strong as its passphrase: use New for new accounts.
+func FromPassphrase(passphrase string, alg interfaces.CryptoImplementation) (Wallet, error) {
+	seed, err := keypairs.GenerateSeedFromPassphrase(passphrase, alg)
 	if err != nil {
-		return nil, err
+		return Wallet{}, err
 	}
+	return FromSeed(seed, "")
+}
 
-	// Derive the key using the path m/44'/144'/0'/0/0
-	path := []uint32{
-		44 + bip32.HardenedKeyStart,
-		144 + bip32.HardenedKeyStart,
-		bip32.HardenedKeyStart,
-		0,
-		0,
+// FromSecretNumbers derives a Wallet from the 8 blocks of 6 digits of XLS-12 secret numbers, as
+// used by Xaman, using the given algorithm. Xaman accounts are secp256k1 accounts.
+func FromSecretNumbers(secret string, alg interfaces.CryptoImplementation) (Wallet, error) {
+	seed, err := keypairs.SeedFromSecretNumbers(secret, alg)
+	if err != nil {
+		return Wallet{}, err
 	}
+	return FromSeed(seed, "")
+}
 
-	key := masterKey
-	for _, childNum := range path {
-		key, err = key.Child(childNum)
-		if err != nil {
-			return nil, err
-		}
+// FromPrivateKey derives a Wallet from a private key in the keypairs format: "ED" followed by
+// 32 bytes for ed25519 keys, and 32 bytes, optionally prefixed with "00", for secp256k1 keys.
+// If masterAddress is set, the key is the regular key of that account.
+func FromPrivateKey(privateKey string, masterAddress string) (Wallet, error) {
+	privKey := strings.ToUpper(privateKey)
+	if len(privKey) == 64 {
+		privKey = "00" + privKey
 	}
-
-	// Convert the private key to the format expected by the XRPL library
-	ecPriv, err := key.ECPrivKey()
+	pubKey, err := keypairs.DerivePublicKey(privKey)
 	if err != nil {
-		return nil, err
+		return Wallet{}, err
 	}
 
-	privKey := strings.ToUpper(ecPriv.Hex())
-	pubKey := hexutil.EncodeToUpperHex(ecPriv.PubKey().Compressed())
-
-	// Derive classic address
-	classicAddr, err := keypairs.DeriveClassicAddress(pubKey)
-	if err != nil {
-		return nil, err
+	var classicAddr types.Address
+	if masterAddress != "" {
+		classicAddr, err = ensureClassicAddress(masterAddress)
+		if err != nil {
+			return Wallet{}, err
+		}
+	} else {
+		addr, err := keypairs.DeriveClassicAddress(pubKey)
+		if err != nil {
+			return Wallet{}, err
+		}
+		classicAddr = types.Address(addr)
 	}
 
-	return &Wallet{
+	return Wallet{
 		PublicKey:      pubKey,
-		PrivateKey:     fmt.Sprintf("00%s", privKey),
-		ClassicAddress: types.Address(classicAddr),
-		Seed:           "", // We don't have the seed in this case
+		PrivateKey:     privKey,
+		ClassicAddress: classicAddr,
 	}, nil
 }
 
 // Sign signs a transaction offline, returning the transaction blob and its signature.
 // The transaction is signed using an internal copy and the provided map is not mutated.
-// TODO: Refactor to accept a `Transaction` object instead of a map.
+// Use SignTx to sign a typed transaction without flattening it.
 func (w *Wallet) Sign(tx map[string]any) (string, string, error) {
-	if tx == nil {
-		return "", "", ErrNilTransaction
-	}
+	return Sign(w, tx)
+}
 
-	signTx := maps.Clone(tx)
-	signTx["SigningPubKey"] = w.PublicKey
+// SignWithCodec signs a transaction offline like Sign, encoding it with the given codec.
+func (w *Wallet) SignWithCodec(tx map[string]any, codec *binarycodec.Codec) (string, string, error) {
+	return SignWithCodec(w, tx, codec)
+}
 
-	encodedTx, err := binarycodec.EncodeForSigning(signTx)
-	if err != nil {
-		return "", "", err
-	}
+// SignTx signs a typed transaction offline through its binary encoding, returning the
+// transaction blob and its hash. The transaction is not mutated.
+func (w *Wallet) SignTx(tx transaction.Tx) (string, string, error) {
+	return SignTx(w, tx)
+}
 
-	txHash, err := w.computeSignature(encodedTx)
-	if err != nil {
-		return "", "", err
-	}
+// SignTxWithCodec signs a typed transaction offline like SignTx, encoding it with the given codec.
+func (w *Wallet) SignTxWithCodec(tx transaction.Tx, codec *binarycodec.Codec) (string, string, error) {
+	return SignTxWithCodec(w, tx, codec)
+}
 
-	signTx["TxnSignature"] = txHash
+// GetAddress returns the classic address of the wallet.
+func (w Wallet) GetAddress() types.Address {
+	return types.Address(w.ClassicAddress)
+}
 
-	txBlob, err := binarycodec.Encode(signTx)
-	if err != nil {
-		return "", "", err
-	}
+// GetPublicKey returns the public key of the wallet.
+func (w Wallet) GetPublicKey() string {
+	return w.PublicKey
+}
 
-	txHash, err = hash.SignTxBlob(txBlob)
-	if err != nil {
-		return "", "", err
+// KeyType returns the algorithm of the wallet key, read from the prefix of its public key.
+func (w Wallet) KeyType() interfaces.CryptoImplementation {
+	if strings.HasPrefix(strings.ToUpper(w.PublicKey), "ED") {
+		return crypto.ED25519()
 	}
-
-	return txBlob, txHash, nil
+	return crypto.SECP256K1()
 }
 
-// GetAddress returns the classic address of the wallet.
-func (w *Wallet) GetAddress() types.Address {
-	return types.Address(w.ClassicAddress)
+// SignMessage signs a message with the wallet private key.
+func (w Wallet) SignMessage(message []byte) (string, error) {
+	return keypairs.Sign(string(message), w.PrivateKey)
 }
 
 // Multisign signs a multisigned transaction offline, returning the signed transaction blob and its transaction hash.
 // The transaction is signed using an internal copy and the provided map is not mutated.
 func (w *Wallet) Multisign(tx map[string]any) (string, string, error) {
-	if tx == nil {
-		return "", "", ErrNilTransaction
-	}
-
-	signTx := maps.Clone(tx)
-	// For regular multisigning, SigningPubKey must be empty per XRPL protocol.
-	signTx["SigningPubKey"] = ""
-	encodedTx, err := binarycodec.EncodeForMultisigning(signTx, w.ClassicAddress.String())
-	if err != nil {
-		return "", "", err
-	}
-
-	txHash, err := w.computeSignature(encodedTx)
-	if err != nil {
-		return "", "", err
-	}
-
-	signer := types.Signer{
-		SignerData: types.SignerData{
-			Account:       w.ClassicAddress,
-			TxnSignature:  txHash,
-			SigningPubKey: w.PublicKey,
-		},
-	}
-
-	signTx["Signers"] = []any{signer.Flatten()}
-	blob, err := binarycodec.Encode(signTx)
-	if err != nil {
-		return "", "", err
-	}
-	blobHash, err := hash.SignTxBlob(blob)
-	if err != nil {
-		return "", "", err
-	}
+	return Multisign(w, tx)
+}
 
-	return blob, blobHash, nil
+// MultisignWithCodec signs a multisigned transaction offline like Multisign, encoding it with the given codec.
+func (w *Wallet) MultisignWithCodec(tx map[string]any, codec *binarycodec.Codec) (string, string, error) {
+	return MultisignWithCodec(w, tx, codec)
 }
 
 // Computes the signature of a transaction.
 // Returns the signature of the transaction. If an error occurs, it will return an error.
 func (w *Wallet) computeSignature(encodedTx string) (string, error) {
-	hexTx, err := hex.DecodeString(encodedTx)
-	if err != nil {
-		return "", err
-	}
-
-	txHash, err := keypairs.Sign(string(hexTx), w.PrivateKey)
-	if err != nil {
-		return "", err
-	}
-
-	return txHash, nil
+	return signEncoded(w, encodedTx)
 }
 
 // ComputeSignature is the public wrapper for computeSignature, exposed for
@@ -4,6 +4,12 @@ import (
 	"maps"
 	"testing"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/pkg/secretnumbers"
+	"github.com/Peersyst/xrpl-go/xrpl/interfaces"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -163,6 +169,162 @@ func TestNewWalletFromMnemonic(t *testing.T) {
 	}
 }
 
+func TestNewWalletFromRFC1751AndPassphrase(t *testing.T) {
+	testCases := []struct {
+		name           string
+		mnemonic       string
+		passphrase     string
+		alg            interfaces.CryptoImplementation
+		seed           string
+		publicKey      string
+		classicAddress types.Address
+	}{
+		{
+			name:           "pass - secp256k1",
+			mnemonic:       "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE",
+			passphrase:     "masterpassphrase",
+			alg:            crypto.SECP256K1(),
+			seed:           "snoPBrXtMeMyMHUVTgbuqAfg1SUTb",
+			publicKey:      "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+			classicAddress: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		},
+		{
+			name:           "pass - ed25519",
+			mnemonic:       "SCAT BERN ISLE FOR ROIL BUS SOAK AQUA FREE FOR DRAM BRIG",
+			passphrase:     "REINDEER FLOTILLA",
+			alg:            crypto.ED25519(),
+			seed:           "sEd7x9VQjZepnQZNZLAkwWVe8hvwiWK",
+			publicKey:      "ED54C3F5BEDA8BD588B203D23A27398FAD9D20F88A974007D6994659CD7273FE1D",
+			classicAddress: "r4qV6xTXerqaZav3MJfSY79ynmc1BSBev1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fromMnemonic, err := FromRFC1751(tc.mnemonic, tc.alg)
+			require.NoError(t, err)
+			require.Equal(t, tc.seed, fromMnemonic.Seed)
+			require.Equal(t, tc.publicKey, fromMnemonic.PublicKey)
+			require.Equal(t, tc.classicAddress, fromMnemonic.ClassicAddress)
+
+			fromPassphrase, err := FromPassphrase(tc.passphrase, tc.alg)
+			require.NoError(t, err)
+			require.Equal(t, fromMnemonic, fromPassphrase)
+		})
+	}
+
+	t.Run("fail - invalid RFC1751 mnemonic", func(t *testing.T) {
+		_, err := FromRFC1751("I IRE BOND BOW TRIO LAID", crypto.SECP256K1())
+		require.Error(t, err)
+	})
+}
+
+func TestNewWalletFromSecretNumbers(t *testing.T) {
+	secret := "399150 474506 009147 088773 432160 282843 253738 605430"
+	testCases := []struct {
+		name           string
+		alg            interfaces.CryptoImplementation
+		seed           string
+		publicKey      string
+		privateKey     string
+		classicAddress types.Address
+	}{
+		{
+			name:           "pass - secp256k1",
+			alg:            crypto.SECP256K1(),
+			seed:           "sh1HiK7SwjS1VxFdXi7qeMHRedrYX",
+			publicKey:      "03BFC2F7AE242C3493187FA0B72BE97B2DF71194FB772E507FF9DEA0AD13CA1625",
+			privateKey:     "00B6FE8507D977E46E988A8A94DB3B8B35E404B60F8B11AC5213FA8B5ABC8A8D19",
+			classicAddress: "rQKQsPeE3iTRyfUypLhuq74gZdcRdwWqDp",
+		},
+		{
+			name:           "pass - ed25519",
+			alg:            crypto.ED25519(),
+			seed:           "sEd77GiNwRkBYwqzZiTrmh21oovzSAC",
+			publicKey:      "ED8079E575450E256C496578480020A33E19B579D58A2DB8FF13FC6B05B9229DE3",
+			privateKey:     "EDD2AF6288A903DED9860FC62E778600A985BDF804E40BD8266505553E3222C3DA",
+			classicAddress: "rHnnXF4oYodLonx7P7MV4WaqPUvBWzskEw",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := FromSecretNumbers(secret, tc.alg)
+			require.NoError(t, err)
+			require.Equal(t, tc.seed, w.Seed)
+			require.Equal(t, tc.publicKey, w.PublicKey)
+			require.Equal(t, tc.privateKey, w.PrivateKey)
+			require.Equal(t, tc.classicAddress, w.ClassicAddress)
+		})
+	}
+
+	t.Run("fail - invalid checksum", func(t *testing.T) {
+		_, err := FromSecretNumbers("399150 474506 009147 088773 432160 282843 253738 605431", crypto.SECP256K1())
+		require.ErrorIs(t, err, secretnumbers.ErrInvalidChecksum)
+	})
+}
+
+func TestNewWalletFromPrivateKey(t *testing.T) {
+	testCases := []struct {
+		name           string
+		privateKey     string
+		masterAddress  string
+		publicKey      string
+		expectedKey    string
+		classicAddress types.Address
+		expectedErr    bool
+	}{
+		{
+			name:           "pass - ed25519 private key",
+			privateKey:     "EDE01A1644C9FDE0367A7A285CA69798066C131C1133E1128B170CA65AEA5C6D19",
+			publicKey:      "EDC9DA1AA7513D891B58B3C9BEBAE3EB12620AFF4ABBA806B23BB3FA62109CE87F",
+			expectedKey:    "EDE01A1644C9FDE0367A7A285CA69798066C131C1133E1128B170CA65AEA5C6D19",
+			classicAddress: "rn5M6BQCmQAzBxms9A84qEpx1Fdn9y7jdD",
+		},
+		{
+			name:           "pass - lower case secp256k1 private key from a mnemonic",
+			privateKey:     "00c503fc86436d384f37f946e8de3b8d9b4d09961424b7abef47dee229a499d557",
+			publicKey:      "028E831F16FD85ABEDA7577B6F4F26500FAB80AEA54B8A89EEC6FA44BCC7AF5678",
+			expectedKey:    "00C503FC86436D384F37F946E8DE3B8D9B4D09961424B7ABEF47DEE229A499D557",
+			classicAddress: "rpa9S5fRbS2ZAf2cdFGtezhGYgom1iD4yh",
+		},
+		{
+			name:           "pass - raw secp256k1 regular key",
+			privateKey:     "4265A28F3E18340A490421D47B2EB8DBC2C0BF2C24CEFEA971B61CED2CABD233",
+			masterAddress:  "rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93",
+			publicKey:      "03AEEFE1E8ED4BBC009DE996AC03A8C6B5713B1554794056C66E5B8D1753C7DD0E",
+			expectedKey:    "004265A28F3E18340A490421D47B2EB8DBC2C0BF2C24CEFEA971B61CED2CABD233",
+			classicAddress: "rUAi7pipxGpYfPNg3LtPcf2ApiS8aw9A93",
+		},
+		{
+			name:        "fail - public key",
+			privateKey:  "EDC9DA1AA7513D891B58B3C9BEBAE3EB12620AFF4ABBA806B23BB3FA62109CE87F00",
+			expectedErr: true,
+		},
+		{
+			name:          "fail - master x-address with tag",
+			privateKey:    "EDE01A1644C9FDE0367A7A285CA69798066C131C1133E1128B170CA65AEA5C6D19",
+			masterAddress: "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu",
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wallet, err := FromPrivateKey(tc.privateKey, tc.masterAddress)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.publicKey, wallet.PublicKey)
+			require.Equal(t, tc.expectedKey, wallet.PrivateKey)
+			require.Equal(t, tc.classicAddress, wallet.ClassicAddress)
+			require.Empty(t, wallet.Seed)
+		})
+	}
+}
+
 func TestSign(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -228,6 +390,161 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignWithCodec(t *testing.T) {
+	wallet := &Wallet{
+		PublicKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+		PrivateKey:     "ED0A961B472E78B89F1AE6A7CC4FB55FD083B36661D3D124E1BA29998346AE1AA1",
+		ClassicAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+	}
+	tx := map[string]any{
+		"Account":         "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+		"TransactionType": "Payment",
+		"Amount":          "15",
+		"Destination":     "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+		"Flags":           uint32(0),
+		"Fee":             "12",
+		"Sequence":        uint32(1798962),
+	}
+
+	t.Run("signs with the given codec", func(t *testing.T) {
+		txBlob, hash, err := wallet.SignWithCodec(tx, binarycodec.NewCodec(definitions.Get()))
+		require.NoError(t, err)
+
+		expectedBlob, expectedHash, err := wallet.Sign(tx)
+		require.NoError(t, err)
+		assert.Equal(t, expectedBlob, txBlob)
+		assert.Equal(t, expectedHash, hash)
+	})
+
+	t.Run("fails when the codec does not know a field", func(t *testing.T) {
+		codec, err := binarycodec.NewCodecFromJSON([]byte(`{
+			"TYPES": {"UInt32": 2},
+			"FIELDS": [["Flags", {"nth": 2, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}]],
+			"TRANSACTION_TYPES": {"Payment": 0}
+		}`))
+		require.NoError(t, err)
+
+		_, _, err = wallet.SignWithCodec(tx, codec)
+		require.Error(t, err)
+	})
+}
+
+func TestSignTx(t *testing.T) {
+	payment := func(w Wallet) *transaction.Payment {
+		return &transaction.Payment{
+			BaseTx: transaction.BaseTx{
+				Account:  w.ClassicAddress,
+				Fee:      types.XRPCurrencyAmount(12),
+				Sequence: 1798962,
+				Memos: []types.MemoWrapper{
+					{Memo: types.Memo{MemoType: "657363726F77", MemoData: "457363726F77"}},
+				},
+			},
+			Amount:      types.XRPCurrencyAmount(15),
+			Destination: "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+		}
+	}
+
+	for _, seed := range []string{"sEdSuqBPSQaood2DmNYVkwWTn1oQTj2", "snGHNrPbHrdUcszeuDEigMdC1Lyyd"} {
+		w, err := FromSeed(seed, "")
+		require.NoError(t, err)
+
+		t.Run("pass - matches Sign of the flattened transaction "+seed, func(t *testing.T) {
+			tx := payment(w)
+			txBlob, hash, err := w.SignTx(tx)
+			require.NoError(t, err)
+
+			expectedBlob, expectedHash, err := w.Sign(tx.Flatten())
+			require.NoError(t, err)
+			assert.Equal(t, expectedBlob, txBlob)
+			assert.Equal(t, expectedHash, hash)
+			assert.Equal(t, payment(w), tx, "SignTx must not mutate the transaction")
+		})
+
+		t.Run("pass - signs with the given codec "+seed, func(t *testing.T) {
+			txBlob, hash, err := w.SignTxWithCodec(payment(w), binarycodec.NewCodec(definitions.Get()))
+			require.NoError(t, err)
+
+			expectedBlob, expectedHash, err := w.SignTx(payment(w))
+			require.NoError(t, err)
+			assert.Equal(t, expectedBlob, txBlob)
+			assert.Equal(t, expectedHash, hash)
+		})
+	}
+
+	t.Run("pass - replaces a previous signature", func(t *testing.T) {
+		w, err := FromSeed("sEdSuqBPSQaood2DmNYVkwWTn1oQTj2", "")
+		require.NoError(t, err)
+		tx := payment(w)
+		tx.SigningPubKey = "028E831F16FD85ABEDA7577B6F4F26500FAB80AEA54B8A89EEC6FA44BCC7AF5678"
+		tx.TxnSignature = "DEADBEEF"
+
+		txBlob, hash, err := w.SignTx(tx)
+		require.NoError(t, err)
+
+		expectedBlob, expectedHash, err := w.SignTx(payment(w))
+		require.NoError(t, err)
+		assert.Equal(t, expectedBlob, txBlob)
+		assert.Equal(t, expectedHash, hash)
+	})
+
+	t.Run("fail - nil transaction", func(t *testing.T) {
+		w := &Wallet{}
+		_, _, err := w.SignTx(nil)
+		require.ErrorIs(t, err, ErrNilTransaction)
+		_, _, err = w.SignTx((*transaction.Payment)(nil))
+		require.ErrorIs(t, err, ErrNilTransaction)
+	})
+
+	t.Run("fail - pseudo-transaction", func(t *testing.T) {
+		w := &Wallet{}
+		_, _, err := w.SignTx(&transaction.EnableAmendment{
+			Amendment:      "42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE",
+			LedgerSequence: 21225473,
+		})
+		require.ErrorIs(t, err, transaction.ErrPseudoTransaction)
+	})
+}
+
+func TestMultisignWithCodec(t *testing.T) {
+	wallet := &Wallet{
+		PublicKey:      "EDE5638D8055CCD45EBF7F5FFD59FC1703D6BC00800BBA19F158119DAA1A52A8D5",
+		PrivateKey:     "ED0A961B472E78B89F1AE6A7CC4FB55FD083B36661D3D124E1BA29998346AE1AA1",
+		ClassicAddress: "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+	}
+	tx := map[string]any{
+		"Account":         "rDwvihpE48E48F8rvNrqTb2UGWv62xqYTg",
+		"TransactionType": "Payment",
+		"Amount":          "15",
+		"Destination":     "raJB6EHNSJa3jV7FqWNrAhcL6FEDE3PGc5",
+		"Flags":           uint32(0),
+		"Fee":             "24",
+		"Sequence":        uint32(1798962),
+	}
+
+	t.Run("multisigns with the given codec", func(t *testing.T) {
+		txBlob, hash, err := wallet.MultisignWithCodec(tx, binarycodec.NewCodec(definitions.Get()))
+		require.NoError(t, err)
+
+		expectedBlob, expectedHash, err := wallet.Multisign(tx)
+		require.NoError(t, err)
+		assert.Equal(t, expectedBlob, txBlob)
+		assert.Equal(t, expectedHash, hash)
+	})
+
+	t.Run("fails when the codec does not know a field", func(t *testing.T) {
+		codec, err := binarycodec.NewCodecFromJSON([]byte(`{
+			"TYPES": {"UInt32": 2},
+			"FIELDS": [["Flags", {"nth": 2, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}]],
+			"TRANSACTION_TYPES": {"Payment": 0}
+		}`))
+		require.NoError(t, err)
+
+		_, _, err = wallet.MultisignWithCodec(tx, codec)
+		require.Error(t, err)
+	})
+}
+
 func TestSignRejectsNilTransaction(t *testing.T) {
 	wallet := &Wallet{}
 
@@ -279,6 +596,22 @@ func TestMultisignRejectsNilTransaction(t *testing.T) {
 	assert.Empty(t, hash)
 }
 
+func TestSignAndMultisignRejectPseudoTransactions(t *testing.T) {
+	wallet := &Wallet{}
+	tx := map[string]any{
+		"Account":         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		"TransactionType": "EnableAmendment",
+		"Amendment":       "42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE",
+		"LedgerSequence":  uint32(21225473),
+	}
+
+	_, _, err := wallet.Sign(tx)
+	require.ErrorIs(t, err, transaction.ErrPseudoTransaction)
+
+	_, _, err = wallet.Multisign(tx)
+	require.ErrorIs(t, err, transaction.ErrPseudoTransaction)
+}
+
 func TestEnsureClassicAddress(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -6,22 +6,27 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/pkg/typecheck"
+	"github.com/Peersyst/xrpl-go/xrpl"
 	"github.com/Peersyst/xrpl-go/xrpl/currency"
 	"github.com/Peersyst/xrpl-go/xrpl/hash"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
 	transaction "github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/go-viper/mapstructure/v2"
 
+	ledgerentries "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
@@ -32,7 +37,9 @@ import (
 	ws "github.com/gorilla/websocket"
 
 	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/internal/autofill"
 	"github.com/Peersyst/xrpl-go/xrpl/internal/clientconfig"
+	"github.com/Peersyst/xrpl-go/xrpl/internal/codeccache"
 )
 
 const (
@@ -46,9 +53,9 @@ const (
 	// Networks with ID above this restricted number are expected to specify an accurate NetworkID field
 	// in every transaction to that chain to prevent replay attacks.
 	// Mainnet and testnet are exceptions. More context: https://github.com/XRPLF/rippled/pull/4370
-	RestrictedNetworks = 1024
+	RestrictedNetworks = autofill.RestrictedNetworks
 	// RequiredNetworkIDVersion is the minimum XRPL server build version after which specifying NetworkID is required for restricted networks.
-	RequiredNetworkIDVersion = "1.11.0"
+	RequiredNetworkIDVersion = autofill.RequiredNetworkIDVersion
 )
 
 var (
@@ -83,6 +90,7 @@ type Client struct {
 	pendingResponses     map[uint64]chan *ClientResponse
 
 	idCounter atomic.Uint64
+	codecs    codeccache.Cache
 	NetworkID uint32
 }
 
@@ -157,6 +165,8 @@ func (c *Client) cancelLifecycle() {
 // Do not call Connect synchronously from a stream or error handler. If a handler
 // needs to reconnect, start Connect in a separate goroutine or coordinate it
 // outside the handler callback.
+// When a definitions check is configured, Connect compares the server's definitions with the
+// embedded ones and disconnects if the check fails.
 func (c *Client) Connect() error {
 	err := c.conn.Connect()
 	if err != nil {
@@ -164,6 +174,11 @@ func (c *Client) Connect() error {
 	}
 	ctx := c.resetLifecycle()
 	go c.readMessages(ctx)
+
+	if err := c.codecs.Check(c.GetServerDefinitions, c.cfg.definitionsCheck, "websocket"); err != nil {
+		_ = c.Disconnect()
+		return err
+	}
 	return nil
 }
 
@@ -204,6 +219,10 @@ func (c *Client) Autofill(tx *transaction.FlatTransaction) error {
 		return err
 	}
 
+	if err := tx.RequireNotPseudo(); err != nil {
+		return err
+	}
+
 	if err := tx.NormalizeFlags(); err != nil {
 		return err
 	}
@@ -272,6 +291,89 @@ func (c *Client) AutofillMultisigned(tx *transaction.FlatTransaction, nSigners u
 	return nil
 }
 
+// GetNetworkSnapshot returns a snapshot of the network and of the given accounts, to
+// autofill their transactions offline with xrpl.AutofillOffline. The snapshot includes
+// the fee cushion and max fee of the client.
+func (c *Client) GetNetworkSnapshot(accounts ...types.Address) (*xrpl.NetworkSnapshot, error) {
+	info, err := c.GetServerInfo(&server.InfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := c.GetServerState(&server.StateRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerIndex, err := c.GetLedgerIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &xrpl.NetworkSnapshot{
+		NetworkID:     c.NetworkID,
+		ServerVersion: info.Info.BuildVersion,
+		LedgerIndex:   ledgerIndex.Uint32(),
+		BaseFeeXRP:    info.Info.ValidatedLedger.BaseFeeXRP,
+		LoadFactor:    info.Info.LoadFactor,
+		ReserveBase:   uint64(state.State.ValidatedLedger.ReserveBase),
+		ReserveInc:    uint64(state.State.ValidatedLedger.ReserveInc),
+		FeeCushion:    c.cfg.feeCushion,
+		MaxFeeXRP:     c.cfg.maxFeeXRP,
+		Accounts:      make(map[types.Address]*xrpl.AccountSnapshot, len(accounts)),
+	}
+
+	for _, address := range accounts {
+		accountSnapshot, err := c.getAccountSnapshot(address)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Accounts[address] = accountSnapshot
+	}
+
+	return snapshot, nil
+}
+
+// GetMultisignCoordinator returns a coordinator to collect the signatures of a multisigned
+// transaction, checked against the current SignerList of the transaction account and the
+// regular keys of its signers.
+// It returns xrpl.ErrNoSignerList if the account has no SignerList.
+func (c *Client) GetMultisignCoordinator(tx transaction.FlatTransaction) (*xrpl.MultisignCoordinator, error) {
+	address, ok := tx["Account"].(string)
+	if !ok || address == "" {
+		return nil, xrpl.ErrMissingAccountInTransaction
+	}
+
+	info, err := c.GetAccountInfo(&account.InfoRequest{
+		Account:     types.Address(address),
+		LedgerIndex: common.Validated,
+		SignerLists: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(info.SignerLists) == 0 {
+		return nil, xrpl.ErrNoSignerList
+	}
+
+	signerList := info.SignerLists[0]
+	signers := xrpl.MultisignSignersFromSignerList(signerList)
+	for i := range signers {
+		if err := c.setSignerKeys(&signers[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return xrpl.NewMultisignCoordinatorWithCodec(tx, signerList.SignerQuorum, signers, c.codecs.Codec())
+}
+
+// Preclaim runs xrpl.Preclaim against the current ledger state, so a transaction bound to
+// fail is rejected before it is submitted and any fee is burned.
+// A failed check is returned as xrpl.ErrPreclaim.
+func (c *Client) Preclaim(tx transaction.FlatTransaction) error {
+	return xrpl.Preclaim(tx, c.preclaimSource())
+}
+
 // FundWallet funds a wallet with XRP from the faucet and polls the validated
 // ledger until the account's balance increases. It returns
 // ErrFundWalletBalanceNotUpdated if the balance fails to update within the
@@ -286,7 +388,7 @@ func (c *Client) FundWallet(wallet *wallet.Wallet) error {
 	// brand-new account) is treated as a zero balance so polling can still
 	// detect the faucet deposit.
 	startBalance, err := c.getXrpDropsBalance(wallet.ClassicAddress, common.Validated)
-	if err != nil && !isFundWalletActNotFound(err) {
+	if err != nil && !isActNotFound(err) {
 		return err
 	}
 
@@ -298,7 +400,7 @@ func (c *Client) FundWallet(wallet *wallet.Wallet) error {
 		time.Sleep(fundWalletPollInterval)
 		balance, err := c.getXrpDropsBalance(wallet.ClassicAddress, common.Validated)
 		if err != nil {
-			if isFundWalletActNotFound(err) {
+			if isActNotFound(err) {
 				continue
 			}
 			return err
@@ -311,11 +413,16 @@ func (c *Client) FundWallet(wallet *wallet.Wallet) error {
 	return ErrFundWalletBalanceNotUpdated
 }
 
-func isFundWalletActNotFound(err error) bool {
+func isActNotFound(err error) bool {
 	var wsErr *ErrorWebsocketClientXrplResponse
 	return errors.As(err, &wsErr) && wsErr.Type == actNotFound
 }
 
+func isEntryNotFound(err error) bool {
+	var wsErr *ErrorWebsocketClientXrplResponse
+	return errors.As(err, &wsErr) && wsErr.Type == entryNotFound
+}
+
 // Request sends a request to the server and returns the response.
 // This function is used to send requests to the server.
 // It returns the response from the server.
@@ -360,11 +467,15 @@ func (c *Client) Request(req interfaces.Request) (*ClientResponse, error) {
 // or a signing public key, and then submits it using a submission request.
 // The failHard flag determines how strictly errors are handled.
 func (c *Client) SubmitTxBlob(txBlob string, failHard bool) (*requests.SubmitResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
+	tx, err := c.codecs.Codec().Decode(txBlob)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := transaction.FlatTransaction(tx).RequireNotPseudo(); err != nil {
+		return nil, err
+	}
+
 	_, okTxSig := tx["TxSignature"].(string)
 	_, okPubKey := tx["SigningPubKey"].(string)
 
@@ -387,6 +498,12 @@ func (c *Client) SubmitTx(tx transaction.FlatTransaction, opts *wstypes.SubmitOp
 		return nil, err
 	}
 
+	if opts.Preclaim {
+		if err := c.Preclaim(tx); err != nil {
+			return nil, err
+		}
+	}
+
 	return c.submitRequest(&requests.SubmitRequest{
 		TxBlob:   txBlob,
 		FailHard: opts.FailHard,
@@ -397,10 +514,15 @@ func (c *Client) SubmitTx(tx transaction.FlatTransaction, opts *wstypes.SubmitOp
 // This function is used to send multisigned transactions to the server.
 // It returns the response from the server.
 func (c *Client) SubmitMultisigned(txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
+	tx, err := c.codecs.Codec().Decode(txBlob)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := transaction.FlatTransaction(tx).RequireNotPseudo(); err != nil {
+		return nil, err
+	}
+
 	signers, okSigners := tx["Signers"].([]any)
 
 	if okSigners && len(signers) > 0 {
@@ -424,7 +546,7 @@ func (c *Client) SubmitMultisigned(txBlob string, failHard bool) (*requests.Subm
 // and then waits until the transaction is confirmed in a ledger. It returns
 // the transaction response if the submission is successful.
 func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.TxResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
+	tx, err := c.codecs.Codec().Decode(txBlob)
 	if err != nil {
 		return nil, err
 	}
@@ -442,7 +564,7 @@ func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.Tx
 		return nil, &ClientError{ErrorString: "transaction failed to submit with engine result: " + txResponse.EngineResult}
 	}
 
-	txHash, err := hash.SignTxBlob(txBlob)
+	txHash, err := hash.SignTxBlobWithCodec(txBlob, c.codecs.Codec())
 	if err != nil {
 		return nil, err
 	}
@@ -461,11 +583,101 @@ func (c *Client) SubmitTxAndWait(tx transaction.FlatTransaction, opts *wstypes.S
 		return nil, err
 	}
 
+	if opts.Preclaim {
+		if err := c.Preclaim(tx); err != nil {
+			return nil, err
+		}
+	}
+
 	// Delegate to SubmitTxBlobAndWait to handle submission, engine result check,
 	// ledger sequence validation, and waiting for confirmation.
 	return c.SubmitTxBlobAndWait(txBlob, opts.FailHard)
 }
 
+// MultisignTx signs a multisigned transaction with one of its signers, encoding it with the
+// definitions loaded by the client. It returns the signed transaction blob and its hash, to be
+// combined with xrpl.MultisignWithCodec or added to a coordinator from GetMultisignCoordinator.
+func (c *Client) MultisignTx(signer wallet.Signer, tx transaction.FlatTransaction) (string, string, error) {
+	return wallet.MultisignWithCodec(signer, tx, c.codecs.Codec())
+}
+
+// NewBundle returns an empty bundle for the network of the client, encoding its transactions
+// with the definitions loaded by the client.
+func (c *Client) NewBundle(expiration time.Time) *xrpl.Bundle {
+	return xrpl.NewBundleWithCodec(c.NetworkID, expiration, c.codecs.Codec())
+}
+
+// ParseBundle parses a JSON encoded bundle like xrpl.ParseBundle, decoding its transactions
+// with the definitions loaded by the client.
+func (c *Client) ParseBundle(data []byte) (*xrpl.Bundle, error) {
+	return xrpl.ParseBundleWithCodec(data, c.codecs.Codec())
+}
+
+// ReplaceTx replaces a transaction that is still pending in the transaction queue or open ledger.
+// original is either the signed transaction blob or its hash. With ReplaceWithFeeBump the original
+// is re-signed with a higher fee, with ReplaceWithNoop a no-op AccountSet consuming the same
+// Sequence or TicketSequence is signed instead. The replacement fee follows the transaction queue's
+// fee-escalation rules: it exceeds the original fee by more than QueueRetryFeePercent and is never
+// below the current open ledger fee. The replacement is signed with opts.Wallet and submitted, and
+// both transactions are polled until one of them is validated.
+func (c *Client) ReplaceTx(original string, opts *wstypes.ReplaceOptions) (*wstypes.ReplaceResult, error) {
+	if opts == nil || wallet.IsNilSigner(opts.Wallet) {
+		return nil, ErrMissingWallet
+	}
+
+	originalBlob, err := c.getOriginalTxBlob(original)
+	if err != nil {
+		return nil, err
+	}
+
+	originalTx, err := c.codecs.Codec().Decode(originalBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	originalHash, err := hash.SignTxBlobWithCodec(originalBlob, c.codecs.Codec())
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := c.getReplacementFee(originalTx)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement, err := buildReplacementTx(originalTx, opts.Strategy, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := replacement["LastLedgerSequence"]; !ok {
+		if err := c.setLastLedgerSequence(&replacement); err != nil {
+			return nil, err
+		}
+	}
+
+	replacementBlob, replacementHash, err := wallet.SignWithCodec(opts.Wallet, replacement, c.codecs.Codec())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.submitRequest(&requests.SubmitRequest{
+		TxBlob:   replacementBlob,
+		FailHard: opts.FailHard,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.EngineResult != transaction.TesSUCCESS.String() && res.EngineResult != transaction.TerQUEUED.String() {
+		return nil, &ClientError{ErrorString: "replacement transaction failed to submit with engine result: " + res.EngineResult}
+	}
+
+	lastLedgerSequence, _ := replacement["LastLedgerSequence"].(uint32)
+
+	return c.waitForReplacement(originalHash, replacementHash, lastLedgerSequence)
+}
+
 func (c *Client) waitForTransaction(txHash string, lastLedgerSequence uint32) (*requests.TxResponse, error) {
 	var txResponse *requests.TxResponse
 
@@ -560,54 +772,17 @@ func (c *Client) formatRequest(req interfaces.Request, id uint64, marker any) ([
 	return json.Marshal(m)
 }
 
-// TODO: Implement this when IsValidXAddress is implemented
-func (c *Client) getClassicAccountAndTag(address string) (string, uint32) {
-	return address, 0
-}
-
 func (c *Client) convertTransactionAddressToClassicAddress(tx *transaction.FlatTransaction, fieldName string) {
-	if address, ok := (*tx)[fieldName].(string); ok {
-		classicAddress, _ := c.getClassicAccountAndTag(address)
-		(*tx)[fieldName] = classicAddress
-	}
+	autofill.ConvertAddress(tx, fieldName)
 }
 
 func (c *Client) validateTransactionAddress(tx *transaction.FlatTransaction, addressField, tagField string) error {
-	classicAddress, tag := c.getClassicAccountAndTag((*tx)[addressField].(string))
-	(*tx)[addressField] = classicAddress
-
-	if tag != uint32(0) {
-		if txTag, ok := (*tx)[tagField].(uint32); ok && txTag != tag {
-			return fmt.Errorf("the %s, if present, must be equal to the tag of the %s", addressField, tagField)
-		}
-		(*tx)[tagField] = tag
-	}
-
-	return nil
+	return autofill.ValidateAddress(tx, addressField, tagField)
 }
 
 // Sets valid addresses for the transaction.
 func (c *Client) setValidTransactionAddresses(tx *transaction.FlatTransaction) error {
-	// Validate if "Account" address is an xAddress
-	if err := c.validateTransactionAddress(tx, "Account", "SourceTag"); err != nil {
-		return err
-	}
-
-	if _, ok := (*tx)["Destination"]; ok {
-		if err := c.validateTransactionAddress(tx, "Destination", "DestinationTag"); err != nil {
-			return err
-		}
-	}
-
-	// DepositPreuaht
-	c.convertTransactionAddressToClassicAddress(tx, "Authorize")
-	c.convertTransactionAddressToClassicAddress(tx, "Unauthorize")
-	// EscrowCancel, EscrowFinish
-	c.convertTransactionAddressToClassicAddress(tx, "Owner")
-	// SetRegularKey
-	c.convertTransactionAddressToClassicAddress(tx, "RegularKey")
-
-	return nil
+	return autofill.SetValidAddresses(tx)
 }
 
 // Sets the next valid sequence number for a given transaction.
@@ -615,141 +790,41 @@ func (c *Client) setTransactionNextValidSequenceNumber(tx *transaction.FlatTrans
 	if _, ok := (*tx)["Account"].(string); !ok {
 		return ErrMissingAccountInTransaction
 	}
-	res, err := c.GetAccountInfo(&account.InfoRequest{
-		Account:     types.Address((*tx)["Account"].(string)),
-		LedgerIndex: common.LedgerTitle("current"),
+	return autofill.Sequence(tx, func(acc string) (uint32, error) {
+		res, err := c.GetAccountInfo(&account.InfoRequest{
+			Account:     types.Address(acc),
+			LedgerIndex: common.LedgerTitle("current"),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.AccountData.Sequence, nil
 	})
-	if err != nil {
-		return err
-	}
-
-	(*tx)["Sequence"] = uint32(res.AccountData.Sequence)
-	return nil
 }
 
-// Calculates the current transaction fee for the ledger.
-// Note: This is a public API that can be called directly.
-func (c *Client) getFeeXrp(cushion float32) (string, error) {
+// fetchNetworkFee fetches the network fee in drops from the server info,
+// applying the load factor, the fee cushion and the max fee of the client.
+func (c *Client) fetchNetworkFee() (uint64, error) {
 	res, err := c.GetServerInfo(&server.InfoRequest{})
 	if err != nil {
-		return "", err
+		return 0, err
 	}
 
 	if res.Info.ValidatedLedger.BaseFeeXRP == 0 {
-		return "", ErrCouldNotGetBaseFeeXrp
-	}
-
-	loadFactor := res.Info.LoadFactor
-	if res.Info.LoadFactor == 0 {
-		loadFactor = 1
-	}
-
-	fee := res.Info.ValidatedLedger.BaseFeeXRP * float32(loadFactor) * cushion
-
-	if fee > c.cfg.maxFeeXRP {
-		fee = c.cfg.maxFeeXRP
+		return 0, ErrCouldNotGetBaseFeeXrp
 	}
 
-	// Round fee to NUM_DECIMAL_PLACES
-	roundedFee := float32(math.Round(float64(fee)*math.Pow10(currency.MaxFractionLength))) / float32(math.Pow10(currency.MaxFractionLength))
-
-	// Convert the rounded fee back to a string with NUM_DECIMAL_PLACES
-	return fmt.Sprintf("%.*f", currency.MaxFractionLength, roundedFee), nil
+	return autofill.NetworkFee(res.Info.ValidatedLedger.BaseFeeXRP, res.Info.LoadFactor, c.cfg.feeCushion, c.cfg.maxFeeXRP)
 }
 
 // Calculates the fee per transaction type.
-//
-// Enhanced implementation that replicates calculateFeePerTransactionType logic,
-// including special cases for EscrowFinish, AccountDelete, AMMCreate, Batch, and multi-signing.
+// The fee rules are shared with offline autofill, see autofill.CalculateFee.
 func (c *Client) calculateFeePerTransactionType(tx *transaction.FlatTransaction, nSigners uint64) error {
-	// Get base network fee
-	netFeeXRP, err := c.getFeeXrp(c.cfg.feeCushion)
-	if err != nil {
-		return err
-	}
-
-	netFeeDrops, err := currency.XrpToDrops(netFeeXRP)
-	if err != nil {
-		return err
-	}
-
-	// Convert to uint64 for calculations
-	baseFeeUint, err := strconv.ParseUint(netFeeDrops, 10, 64)
-	if err != nil {
-		return err
-	}
-
-	baseFee := baseFeeUint
-
-	// Get transaction type
-	transactionType := ""
-	if txType, ok := (*tx)["TransactionType"]; ok {
-		if str, ok := txType.(string); ok {
-			transactionType = str
-		}
-	}
-
-	// Check if this is a special transaction cost type
-	isSpecialTxCost := transactionType == "AccountDelete" || transactionType == "AMMCreate"
-
-	switch transactionType {
-	case "EscrowFinish":
-		if fulfillment, ok := (*tx)["Fulfillment"]; ok && fulfillment != nil {
-			if fulfillmentStr, ok := fulfillment.(string); ok && fulfillmentStr != "" {
-				fulfillmentBytesSize := (len(fulfillmentStr) + 1) / 2 // Math.ceil(length / 2)
-				if fulfillmentBytesSize < 0 {
-					return ErrInvalidFulfillmentLength
-				}
-				// BaseFee × (33 + ceil(Fulfillment size in bytes / 16))
-				chunks := (uint64(fulfillmentBytesSize) + 15) / 16 // ceil division
-				baseFee = baseFeeUint * (33 + chunks)
-			}
-		}
-	case "AccountDelete", "AMMCreate":
-		reserveFee, err := c.fetchOwnerReserveFee()
-		if err != nil {
-			return err
-		}
-		baseFee = reserveFee
-	case "Batch":
-		rawTxFees, err := c.calculateBatchFees(tx)
-		if err != nil {
-			return err
-		}
-		baseFee = baseFeeUint*2 + rawTxFees
-	case "LoanSet":
-		// For LoanSet, account for counterparty signers
-		counterPartySignersCount, err := c.fetchCounterPartySignersCount(*tx)
-		if err != nil {
-			return err
-		}
-		baseFee = baseFeeUint + (baseFeeUint * counterPartySignersCount)
-	}
-
-	// Multi-signed Transaction: BaseFee × (1 + Number of Signatures Provided)
-	if nSigners > 0 {
-		signersFee := baseFeeUint * nSigners
-		baseFee += signersFee
-	}
-
-	// Apply max fee limit (but not for special transaction cost types)
-	var totalFee uint64
-	if isSpecialTxCost {
-		totalFee = baseFee
-	} else {
-		maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", c.cfg.maxFeeXRP))
-		if err != nil {
-			return err
-		}
-		maxFeeUint, err := strconv.ParseUint(maxFeeDrops, 10, 64)
-		if err != nil {
-			return err
-		}
-		totalFee = min(baseFee, maxFeeUint)
-	}
-
-	(*tx)["Fee"] = strconv.FormatUint(totalFee, 10)
-	return nil
+	return autofill.CalculateFee(tx, nSigners, c.cfg.maxFeeXRP, autofill.FeeSource{
+		NetworkFee:               c.fetchNetworkFee,
+		OwnerReserve:             c.fetchOwnerReserveFee,
+		CounterpartySignersCount: c.fetchCounterPartySignersCount,
+	})
 }
 
 // Sets the latest validated ledger sequence for the transaction.
@@ -793,6 +868,170 @@ func (c *Client) checkPaymentAmounts(tx *transaction.FlatTransaction) error {
 	return nil
 }
 
+// getOriginalTxBlob returns the signed blob of the transaction to replace.
+// original is returned as is unless it is a transaction hash, in which case the blob is
+// fetched from the server. It fails if the transaction has already been validated.
+func (c *Client) getOriginalTxBlob(original string) (string, error) {
+	if len(original) != 64 || !typecheck.IsHex(original) {
+		return original, nil
+	}
+
+	res, err := c.Request(&requests.TxRequest{
+		Transaction: original,
+		Binary:      true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var txResponse requests.TxResponse
+	if err := res.GetResult(&txResponse); err != nil {
+		return "", err
+	}
+
+	if txResponse.Validated {
+		return "", ErrOriginalTransactionAlreadyValidated
+	}
+	if txResponse.TxBlob == "" {
+		return "", ErrTransactionNotFound
+	}
+
+	return txResponse.TxBlob, nil
+}
+
+// getReplacementFee returns the fee, in drops, that a replacement of originalTx must pay
+// to be accepted by the transaction queue. It errors if that fee exceeds the client's max fee.
+func (c *Client) getReplacementFee(originalTx map[string]any) (uint64, error) {
+	feeStr, ok := originalTx["Fee"].(string)
+	if !ok {
+		return 0, ErrFeeFieldMissing
+	}
+	originalFee, err := strconv.ParseUint(feeStr, 10, 64)
+	if err != nil {
+		return 0, ErrFailedToParseFee{
+			Fee: feeStr,
+			Err: err,
+		}
+	}
+
+	res, err := c.GetFee(&server.FeeRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	fee := replacementFee(originalFee, res.Drops.OpenLedgerFee.Uint64())
+
+	maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", c.cfg.maxFeeXRP))
+	if err != nil {
+		return 0, err
+	}
+	maxFee, err := strconv.ParseUint(maxFeeDrops, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if fee > maxFee {
+		return 0, ErrReplacementFeeExceedsMaxFee
+	}
+
+	return fee, nil
+}
+
+// replacementFee applies the transaction queue's fee-escalation rules: a transaction replacing
+// a queued one with the same sequence must pay strictly more than QueueRetryFeePercent over the
+// original fee, and a transaction is only applied to the open ledger if it pays the open ledger fee.
+func replacementFee(originalFee, openLedgerFee uint64) uint64 {
+	fee := originalFee + originalFee*commonconstants.QueueRetryFeePercent/100 + 1
+	return max(fee, openLedgerFee)
+}
+
+// buildReplacementTx builds the unsigned replacement of originalTx for the given strategy and fee.
+// Both strategies keep the original's Sequence or TicketSequence, LastLedgerSequence and NetworkID,
+// so the replacement and the original can never both be validated.
+func buildReplacementTx(originalTx map[string]any, strategy wstypes.ReplaceStrategy, fee uint64) (transaction.FlatTransaction, error) {
+	account, ok := originalTx["Account"].(string)
+	if !ok {
+		return nil, ErrMissingAccountInTransaction
+	}
+	sequence, _ := originalTx["Sequence"].(uint32)
+	ticketSequence, _ := originalTx["TicketSequence"].(uint32)
+	if sequence == 0 && ticketSequence == 0 {
+		return nil, ErrMissingSequenceInTransaction
+	}
+
+	switch strategy {
+	case wstypes.ReplaceWithFeeBump:
+		if _, ok := originalTx["Signers"]; ok {
+			return nil, ErrCannotFeeBumpMultisignedTransaction
+		}
+		replacement := transaction.FlatTransaction(maps.Clone(originalTx))
+		delete(replacement, "TxnSignature")
+		delete(replacement, "SigningPubKey")
+		replacement["Fee"] = strconv.FormatUint(fee, 10)
+		return replacement, nil
+	case wstypes.ReplaceWithNoop:
+		lastLedgerSequence, _ := originalTx["LastLedgerSequence"].(uint32)
+		networkID, _ := originalTx["NetworkID"].(uint32)
+		noop := transaction.AccountSet{
+			BaseTx: transaction.BaseTx{
+				Account:            types.Address(account),
+				TransactionType:    transaction.AccountSetTx,
+				Fee:                types.XRPCurrencyAmount(fee),
+				Sequence:           sequence,
+				TicketSequence:     ticketSequence,
+				LastLedgerSequence: lastLedgerSequence,
+				NetworkID:          networkID,
+			},
+		}
+		return noop.Flatten(), nil
+	default:
+		return nil, ErrInvalidReplaceStrategy
+	}
+}
+
+// waitForReplacement polls the original and replacement transactions until one of them is
+// validated, or until the ledger passes lastLedgerSequence.
+func (c *Client) waitForReplacement(originalHash, replacementHash string, lastLedgerSequence uint32) (*wstypes.ReplaceResult, error) {
+	for range c.cfg.maxRetries {
+		for _, txHash := range []string{replacementHash, originalHash} {
+			res, err := c.Request(&requests.TxRequest{
+				Transaction: txHash,
+			})
+			if err != nil {
+				if strings.Contains(err.Error(), txnNotFound) {
+					continue
+				}
+				return nil, err
+			}
+
+			var txResponse requests.TxResponse
+			if err := res.GetResult(&txResponse); err != nil {
+				return nil, err
+			}
+
+			if txResponse.Validated {
+				return &wstypes.ReplaceResult{
+					OriginalHash:    originalHash,
+					ReplacementHash: replacementHash,
+					Replaced:        txHash == replacementHash,
+					TxResponse:      &txResponse,
+				}, nil
+			}
+		}
+
+		currentLedger, err := c.GetLedgerIndex()
+		if err != nil {
+			return nil, err
+		}
+		if lastLedgerSequence != 0 && currentLedger.Uint32() > lastLedgerSequence {
+			break
+		}
+
+		time.Sleep(c.cfg.retryDelay)
+	}
+
+	return nil, ErrTransactionNotFound
+}
+
 func (c *Client) registerPendingResponse(id uint64) chan *ClientResponse {
 	responseChan := make(chan *ClientResponse, 1)
 
@@ -985,21 +1224,25 @@ func reconnectDelay(attempt int) time.Duration {
 
 // getSignedTx ensures the transaction is fully signed and returns the transaction blob.
 // If the transaction is already signed, it encodes and returns it. Otherwise, it autofills (if enabled)
-// and signs the transaction using the provided wallet.
-func (c *Client) getSignedTx(tx transaction.FlatTransaction, autofill bool, wallet *wallet.Wallet) (string, error) {
+// and signs the transaction using the provided signer.
+func (c *Client) getSignedTx(tx transaction.FlatTransaction, autofill bool, signer wallet.Signer) (string, error) {
+	if err := tx.RequireNotPseudo(); err != nil {
+		return "", err
+	}
+
 	// Check if the transaction is already signed: both fields must be non-empty.
 	sig, sigOk := tx["TxSignature"].(string)
 	pubKey, pubKeyOk := tx["SigningPubKey"].(string)
 	if sigOk && sig != "" && pubKeyOk && pubKey != "" {
-		blob, err := binarycodec.Encode(tx)
+		blob, err := c.codecs.Codec().Encode(tx)
 		if err != nil {
 			return "", err
 		}
 		return blob, nil
 	}
 
-	// If not signed, ensure a wallet is provided.
-	if wallet == nil {
+	// If not signed, ensure a signer is provided.
+	if wallet.IsNilSigner(signer) {
 		return "", ErrMissingWallet
 	}
 
@@ -1011,7 +1254,7 @@ func (c *Client) getSignedTx(tx transaction.FlatTransaction, autofill bool, wall
 	}
 
 	// Sign the transaction.
-	txBlob, _, err := wallet.Sign(tx)
+	txBlob, _, err := wallet.SignWithCodec(signer, tx, c.codecs.Codec())
 	if err != nil {
 		return "", err
 	}
@@ -1094,269 +1337,180 @@ func (c *Client) fetchCounterPartySignersCount(tx transaction.FlatTransaction) (
 	return 1, nil
 }
 
-// calculateBatchFees calculates the total fees for all inner transactions in a Batch.
-// Replicates the JavaScript logic for Batch transaction fee calculation.
-func (c *Client) calculateBatchFees(tx *transaction.FlatTransaction) (uint64, error) {
-	var totalFees uint64
-
-	// Get RawTransactions from the batch transaction
-	rawTransactions, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return 0, ErrRawTransactionsFieldMissing
+// getAccountSnapshot fetches the sequence, unused tickets and signer list size of an account.
+func (c *Client) getAccountSnapshot(address types.Address) (*xrpl.AccountSnapshot, error) {
+	info, err := c.GetAccountInfo(&account.InfoRequest{
+		Account:     address,
+		LedgerIndex: common.LedgerTitle("current"),
+		SignerLists: true,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Iterate through each raw transaction
-	for _, rawTx := range rawTransactions {
-		// Extract the actual transaction from the wrapper
-		innerTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return 0, ErrRawTransactionFieldMissing
-		}
+	accountSnapshot := &xrpl.AccountSnapshot{
+		Sequence: info.AccountData.Sequence,
+	}
+	if len(info.SignerLists) > 0 {
+		accountSnapshot.SignerListSize = uint32(len(info.SignerLists[0].SignerEntries))
+	}
 
-		// Calculate fee for this inner transaction (no multi-signing for inner transactions)
-		innerTxFlat := transaction.FlatTransaction(innerTx)
-		err := c.calculateFeePerTransactionType(&innerTxFlat, 0)
+	var marker any
+	for {
+		objects, err := c.GetAccountObjects(&account.ObjectsRequest{
+			Account:     address,
+			Type:        account.TicketObject,
+			LedgerIndex: common.LedgerTitle("current"),
+			Marker:      marker,
+		})
 		if err != nil {
-			return 0, err
-		}
-
-		// Extract the calculated fee
-		feeStr, ok := innerTx["Fee"].(string)
-		if !ok {
-			return 0, ErrFeeFieldMissing
+			return nil, err
 		}
 
-		innerTx["Fee"] = "0"
-
-		// Convert fee string to uint64 and add to total
-		feeUint, err := strconv.ParseUint(feeStr, 10, 64)
-		if err != nil {
-			return 0, ErrFailedToParseFee{
-				Fee: feeStr,
-				Err: err,
+		for _, object := range objects.AccountObjects {
+			switch ticketSequence := object["TicketSequence"].(type) {
+			case json.Number:
+				sequence, err := strconv.ParseUint(ticketSequence.String(), 10, 32)
+				if err != nil {
+					return nil, err
+				}
+				accountSnapshot.Tickets = append(accountSnapshot.Tickets, uint32(sequence))
+			case float64:
+				accountSnapshot.Tickets = append(accountSnapshot.Tickets, uint32(ticketSequence))
+			case uint32:
+				accountSnapshot.Tickets = append(accountSnapshot.Tickets, ticketSequence)
 			}
 		}
 
-		totalFees += feeUint
-	}
-
-	return totalFees, nil
-}
-
-type validatedInnerTx struct {
-	rawTx   map[string]any
-	account string
-}
-
-func (c *Client) autofillRawTransactions(tx *transaction.FlatTransaction) error {
-	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return ErrRawTransactionsFieldIsNotAnArray
-	}
-
-	var outerNetworkID *uint32
-	if outer := (*tx)["NetworkID"]; outer != nil {
-		outerNetworkIDUint, ok := outer.(uint32)
-		if !ok {
-			return ErrNetworkIDFieldIsNotAUint32
-		}
-		if outerNetworkIDUint != c.NetworkID {
-			return ErrNetworkIDFieldMismatch
+		if objects.Marker == nil {
+			break
 		}
-		outerNetworkID = &outerNetworkIDUint
+		marker = objects.Marker
 	}
+	slices.Sort(accountSnapshot.Tickets)
 
-	inners := make([]validatedInnerTx, 0, len(rawTxs))
-	for _, rawTx := range rawTxs {
-		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return ErrRawTransactionFieldIsNotAnObject
-		}
-
-		acc, ok := innerRawTx["Account"].(string)
-		if !ok {
-			return ErrAccountFieldIsNotAString
-		}
-
-		if fee := innerRawTx["Fee"]; fee != nil && fee != "0" {
-			return types.ErrBatchInnerTransactionInvalid
-		}
-
-		if signingPubKey := innerRawTx["SigningPubKey"]; signingPubKey != nil && signingPubKey != "" {
-			return ErrSigningPubKeyFieldMustBeEmpty
-		}
-
-		if innerRawTx["TxnSignature"] != nil {
-			return ErrTxnSignatureFieldMustBeEmpty
-		}
-		if innerRawTx["Signers"] != nil {
-			return ErrSignersFieldMustBeEmpty
-		}
-
-		if networkID := innerRawTx["NetworkID"]; networkID != nil {
-			innerNetworkID, ok := networkID.(uint32)
-			if !ok {
-				return ErrNetworkIDFieldIsNotAUint32
-			}
-			if innerNetworkID != c.NetworkID {
-				return ErrNetworkIDFieldMismatch
-			}
-			if outerNetworkID != nil && innerNetworkID != *outerNetworkID {
-				return ErrNetworkIDFieldMismatch
-			}
-		}
-
-		inners = append(inners, validatedInnerTx{rawTx: innerRawTx, account: acc})
-	}
+	return accountSnapshot, nil
+}
 
-	needsNetworkID, err := c.txNeedsNetworkID()
+// setSignerKeys sets the regular key and master key status of a signer from its account root.
+// Signers without an account on the ledger can only sign with their master key.
+func (c *Client) setSignerKeys(signer *xrpl.MultisignSigner) error {
+	info, err := c.GetAccountInfo(&account.InfoRequest{
+		Account:     signer.Account,
+		LedgerIndex: common.Validated,
+	})
 	if err != nil {
-		return err
-	}
-
-	accountSeq := make(map[string]uint32, len(inners))
-
-	for _, inner := range inners {
-		innerRawTx := inner.rawTx
-		if innerRawTx["Fee"] == nil {
-			innerRawTx["Fee"] = "0"
-		}
-
-		if innerRawTx["SigningPubKey"] == nil {
-			innerRawTx["SigningPubKey"] = ""
-		}
-
-		if innerRawTx["NetworkID"] == nil && needsNetworkID {
-			innerRawTx["NetworkID"] = c.NetworkID
-		}
-
-		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
-			acc := inner.account
-
-			if accountSeq[acc] != 0 {
-				innerRawTx["Sequence"] = accountSeq[acc]
-				accountSeq[acc]++
-			} else {
-				accountInfo, err := c.GetAccountInfo(&account.InfoRequest{
-					Account: types.Address(acc),
-				})
-				if err != nil {
-					return err
-				}
-				var seq uint32
-				if innerRawTx["Account"] == (*tx)["Account"] {
-					seq = accountInfo.AccountData.Sequence + 1
-				} else {
-					seq = accountInfo.AccountData.Sequence
-				}
-				accountSeq[acc] = seq + 1
-				innerRawTx["Sequence"] = seq
-			}
+		if isActNotFound(err) {
+			return nil
 		}
+		return err
 	}
 
+	signer.RegularKey = info.AccountData.RegularKey
+	signer.MasterDisabled = info.AccountData.Flags&ledgerentries.LsfDisableMaster != 0
 	return nil
 }
 
-// isNotLaterRippledVersion determines whether the source rippled version is not later than the target rippled version.
-// Example usage: isNotLaterRippledVersion("1.10.0", "1.11.0") returns true.
-//
-//	isNotLaterRippledVersion("1.10.0", "1.10.0-b1") returns false.
-func isNotLaterRippledVersion(source, target string) bool {
-	if source == target {
-		return true
-	}
-
-	sourceDecomp := strings.Split(source, ".")
-	targetDecomp := strings.Split(target, ".")
-
-	if len(sourceDecomp) < 3 || len(targetDecomp) < 3 {
-		return false
-	}
-
-	sourceMajor, err := strconv.Atoi(sourceDecomp[0])
-	if err != nil {
-		return false
-	}
-	sourceMinor, err := strconv.Atoi(sourceDecomp[1])
-	if err != nil {
-		return false
-	}
-	targetMajor, err := strconv.Atoi(targetDecomp[0])
-	if err != nil {
-		return false
-	}
-	targetMinor, err := strconv.Atoi(targetDecomp[1])
-	if err != nil {
-		return false
-	}
-
-	// Compare major version
-	if sourceMajor != targetMajor {
-		return sourceMajor < targetMajor
-	}
-
-	// Compare minor version
-	if sourceMinor != targetMinor {
-		return sourceMinor < targetMinor
-	}
-
-	sourcePatch := strings.Split(sourceDecomp[2], "-")
-	targetPatch := strings.Split(targetDecomp[2], "-")
-
-	sourcePatchVersion, err := strconv.Atoi(sourcePatch[0])
-	if err != nil {
-		return false
-	}
-	targetPatchVersion, err := strconv.Atoi(targetPatch[0])
-	if err != nil {
-		return false
-	}
-
-	// Compare patch version
-	if sourcePatchVersion != targetPatchVersion {
-		return sourcePatchVersion < targetPatchVersion
-	}
-
-	// Compare release version
-	if len(sourcePatch) != len(targetPatch) {
-		return len(sourcePatch) > len(targetPatch)
-	}
-
-	if len(sourcePatch) == 2 {
-		// Compare different release types
-		if !strings.HasPrefix(sourcePatch[1], string(targetPatch[1][0])) {
-			return sourcePatch[1] < targetPatch[1]
-		}
-
-		// Compare beta version
-		if strings.HasPrefix(sourcePatch[1], "b") {
-			sourceBeta, err := strconv.Atoi(sourcePatch[1][1:])
+// preclaimSource returns the ledger state lookups used by Preclaim.
+func (c *Client) preclaimSource() xrpl.PreclaimSource {
+	return xrpl.PreclaimSource{
+		AccountRoot: func(address types.Address) (*ledgerentries.AccountRoot, error) {
+			info, err := c.GetAccountInfo(&account.InfoRequest{
+				Account:     address,
+				LedgerIndex: common.Current,
+			})
 			if err != nil {
-				return false
+				if isActNotFound(err) {
+					return nil, nil
+				}
+				return nil, err
 			}
-			targetBeta, err := strconv.Atoi(targetPatch[1][1:])
+			return &info.AccountData, nil
+		},
+		Reserves: func() (uint64, uint64, error) {
+			state, err := c.GetServerState(&server.StateRequest{})
 			if err != nil {
-				return false
+				return 0, 0, err
 			}
-			return sourceBeta < targetBeta
-		}
-
-		// Compare rc version
-		if strings.HasPrefix(sourcePatch[1], "rc") {
-			sourceRC, err := strconv.Atoi(sourcePatch[1][2:])
+			return uint64(state.State.ValidatedLedger.ReserveBase), uint64(state.State.ValidatedLedger.ReserveInc), nil
+		},
+		DepositAuthorized: func(source, destination types.Address) (bool, error) {
+			res, err := c.GetDepositAuthorized(&path.DepositAuthorizedRequest{
+				SourceAccount:      source,
+				DestinationAccount: destination,
+				LedgerIndex:        common.Current,
+			})
 			if err != nil {
-				return false
+				return false, err
 			}
-			targetRC, err := strconv.Atoi(targetPatch[1][2:])
+			return res.DepositAuthorized, nil
+		},
+		TrustLine: func(address, issuer types.Address, currency string) (*accounttypes.TrustLine, error) {
+			lines, err := c.GetAccountLines(&account.LinesRequest{
+				Account:     address,
+				Peer:        issuer,
+				LedgerIndex: common.Current,
+			})
+			if err != nil {
+				if isActNotFound(err) {
+					return nil, nil
+				}
+				return nil, err
+			}
+			for _, line := range lines.Lines {
+				if line.Currency == currency {
+					return &line, nil
+				}
+			}
+			return nil, nil
+		},
+		MPTokenIssuance: func(mptIssuanceID string) (ledgerentries.FlatLedgerObject, error) {
+			index, err := hash.MPTokenIssuance(mptIssuanceID)
+			if err != nil {
+				return nil, err
+			}
+			return c.getLedgerEntryOrNil(index)
+		},
+		MPToken: func(mptIssuanceID string, holder types.Address) (ledgerentries.FlatLedgerObject, error) {
+			index, err := hash.MPToken(mptIssuanceID, holder.String())
 			if err != nil {
-				return false
+				return nil, err
 			}
-			return sourceRC < targetRC
+			return c.getLedgerEntryOrNil(index)
+		},
+	}
+}
+
+// getLedgerEntryOrNil returns the ledger entry with the given index in the current ledger,
+// or nil if it does not exist.
+func (c *Client) getLedgerEntryOrNil(index string) (ledgerentries.FlatLedgerObject, error) {
+	res, err := c.GetLedgerEntry(&ledger.EntryRequest{
+		Index:       index,
+		LedgerIndex: common.Current,
+	})
+	if err != nil {
+		if isEntryNotFound(err) {
+			return nil, nil
 		}
+		return nil, err
 	}
+	return res.Node, nil
+}
 
-	return false
+func (c *Client) autofillRawTransactions(tx *transaction.FlatTransaction) error {
+	return autofill.RawTransactions(tx, autofill.BatchSource{
+		NetworkID:      c.NetworkID,
+		NeedsNetworkID: c.txNeedsNetworkID,
+		AccountSequence: func(acc string) (uint32, error) {
+			accountInfo, err := c.GetAccountInfo(&account.InfoRequest{
+				Account: types.Address(acc),
+			})
+			if err != nil {
+				return 0, err
+			}
+			return accountInfo.AccountData.Sequence, nil
+		},
+	})
 }
 
 // txNeedsNetworkID determines if the transaction required a networkID to be valid.
@@ -1368,9 +1522,7 @@ func (c *Client) txNeedsNetworkID() (bool, error) {
 			return false, err
 		}
 
-		if res.Info.BuildVersion != "" {
-			return isNotLaterRippledVersion(RequiredNetworkIDVersion, res.Info.BuildVersion), nil
-		}
+		return autofill.TxNeedsNetworkID(c.NetworkID, res.Info.BuildVersion), nil
 	}
 	return false, nil
 }
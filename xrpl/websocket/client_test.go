@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl"
 	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
 	clientconfigtestutil "github.com/Peersyst/xrpl-go/xrpl/internal/clientconfig/testutil"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
@@ -18,6 +20,7 @@ import (
 	"github.com/Peersyst/xrpl-go/xrpl/wallet"
 	"github.com/Peersyst/xrpl-go/xrpl/websocket/interfaces"
 	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	wstypes "github.com/Peersyst/xrpl-go/xrpl/websocket/types"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 )
@@ -1157,6 +1160,16 @@ func TestClient_checkAccountDeleteBlockers(t *testing.T) {
 	}
 }
 
+func TestClient_SubmitTxBlob_PseudoTransaction(t *testing.T) {
+	cl := NewClient(*NewClientConfig())
+
+	_, err := cl.SubmitTxBlob("1200642400000000260143E001501342426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE684000000000000000730081140000000000000000000000000000000000000000", false)
+	require.ErrorIs(t, err, transaction.ErrPseudoTransaction)
+
+	_, err = cl.SubmitMultisigned("1200642400000000260143E001501342426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE684000000000000000730081140000000000000000000000000000000000000000", false)
+	require.ErrorIs(t, err, transaction.ErrPseudoTransaction)
+}
+
 func TestClient_Autofill(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1171,6 +1184,14 @@ func TestClient_Autofill(t *testing.T) {
 			},
 			expectedErr: transaction.ErrTransactionTypeMissing,
 		},
+		{
+			name: "fail - pseudo-transaction",
+			tx: transaction.FlatTransaction{
+				"Account":         "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+				"TransactionType": "SetFee",
+			},
+			expectedErr: transaction.ErrPseudoTransaction,
+		},
 		{
 			name: "fail - invalid Flags type",
 			tx: transaction.FlatTransaction{
@@ -2464,3 +2485,480 @@ func swapReconnectDelays(base, maxDelay time.Duration) func() {
 		reconnectBaseDelay, reconnectMaxDelay = prevBase, prevMax
 	}
 }
+
+func TestClient_ReplaceTx(t *testing.T) {
+	w, err := wallet.FromSeed("sEdTCFHBquP36KursdZ17ZiuZenJZHg", "")
+	require.NoError(t, err)
+
+	original := map[string]any{
+		"TransactionType":    "Payment",
+		"Account":            w.ClassicAddress.String(),
+		"Destination":        "ra5nK24KXen9AHvsdFTKHSANinZseWnPcX",
+		"Amount":             "1000",
+		"Fee":                "10",
+		"Sequence":           uint32(3),
+		"LastLedgerSequence": uint32(100),
+	}
+	originalBlob, originalHash, err := w.Sign(original)
+	require.NoError(t, err)
+
+	signedOriginal, err := binarycodec.Decode(originalBlob)
+	require.NoError(t, err)
+	replacement, err := buildReplacementTx(signedOriginal, wstypes.ReplaceWithNoop, 5000)
+	require.NoError(t, err)
+	_, replacementHash, err := w.Sign(replacement)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		serverMessages   []map[string]any
+		opts             *wstypes.ReplaceOptions
+		expectedReplaced bool
+		expectedErr      error
+	}{
+		{
+			name: "pass - replacement validated",
+			serverMessages: []map[string]any{
+				{"id": 1, "result": map[string]any{"drops": map[string]any{"open_ledger_fee": "5000"}}},
+				{"id": 2, "result": map[string]any{"engine_result": "tesSUCCESS"}},
+				{"id": 3, "result": map[string]any{"hash": replacementHash, "validated": true}},
+			},
+			opts: &wstypes.ReplaceOptions{
+				Strategy: wstypes.ReplaceWithNoop,
+				Wallet:   &w,
+			},
+			expectedReplaced: true,
+		},
+		{
+			name: "fail - replacement fee exceeds max fee",
+			serverMessages: []map[string]any{
+				{"id": 1, "result": map[string]any{"drops": map[string]any{"open_ledger_fee": "3000000"}}},
+			},
+			opts: &wstypes.ReplaceOptions{
+				Strategy: wstypes.ReplaceWithNoop,
+				Wallet:   &w,
+			},
+			expectedErr: ErrReplacementFeeExceedsMaxFee,
+		},
+		{
+			name:        "fail - missing wallet",
+			opts:        &wstypes.ReplaceOptions{},
+			expectedErr: ErrMissingWallet,
+		},
+		{
+			name:        "fail - nil wallet pointer",
+			opts:        &wstypes.ReplaceOptions{Wallet: (*wallet.Wallet)(nil)},
+			expectedErr: ErrMissingWallet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			res, err := cl.ReplaceTx(originalBlob, tt.opts)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, originalHash, res.OriginalHash)
+			require.Equal(t, replacementHash, res.ReplacementHash)
+			require.Equal(t, tt.expectedReplaced, res.Replaced)
+		})
+	}
+}
+
+func TestClient_GetNetworkSnapshot(t *testing.T) {
+	const testAddr = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+
+	tests := []struct {
+		name             string
+		serverMessages   []map[string]any
+		expectedSnapshot *xrpl.NetworkSnapshot
+		expectedErr      bool
+	}{
+		{
+			name: "pass - network and account state",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"info": map[string]any{
+							"build_version": "2.4.0",
+							"load_factor":   1,
+							"validated_ledger": map[string]any{
+								"base_fee_xrp": 0.00001,
+							},
+						},
+					},
+				},
+				{
+					"id": 2,
+					"result": map[string]any{
+						"state": map[string]any{
+							"validated_ledger": map[string]any{
+								"reserve_base": 1000000,
+								"reserve_inc":  200000,
+							},
+						},
+					},
+				},
+				{
+					"id": 3,
+					"result": map[string]any{
+						"ledger_index": 1000,
+					},
+				},
+				{
+					"id": 4,
+					"result": map[string]any{
+						"account_data": map[string]any{
+							"Account":  testAddr,
+							"Sequence": 42,
+						},
+						"signer_lists": []any{
+							map[string]any{
+								"SignerEntries": []any{
+									map[string]any{"SignerEntry": map[string]any{"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "SignerWeight": 1}},
+									map[string]any{"SignerEntry": map[string]any{"Account": "rLHzPsX6oXkzU2qL12kHCH8G8cnZv1rBJh", "SignerWeight": 1}},
+								},
+							},
+						},
+					},
+				},
+				{
+					"id": 5,
+					"result": map[string]any{
+						"account_objects": []any{
+							map[string]any{"LedgerEntryType": "Ticket", "TicketSequence": 50},
+						},
+						"marker": "next",
+					},
+				},
+				{
+					"id": 6,
+					"result": map[string]any{
+						"account_objects": []any{
+							map[string]any{"LedgerEntryType": "Ticket", "TicketSequence": 45},
+						},
+					},
+				},
+			},
+			expectedSnapshot: &xrpl.NetworkSnapshot{
+				ServerVersion: "2.4.0",
+				LedgerIndex:   1000,
+				BaseFeeXRP:    0.00001,
+				LoadFactor:    1,
+				ReserveBase:   1000000,
+				ReserveInc:    200000,
+				FeeCushion:    DefaultFeeCushion,
+				MaxFeeXRP:     DefaultMaxFeeXRP,
+				Accounts: map[types.Address]*xrpl.AccountSnapshot{
+					testAddr: {
+						Sequence:       42,
+						Tickets:        []uint32{45, 50},
+						SignerListSize: 2,
+					},
+				},
+			},
+		},
+		{
+			name: "fail - server info error",
+			serverMessages: []map[string]any{
+				{
+					"id":    1,
+					"error": "noNetwork",
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			snapshot, err := cl.GetNetworkSnapshot(testAddr)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSnapshot, snapshot)
+		})
+	}
+}
+
+func TestClient_GetMultisignCoordinator(t *testing.T) {
+	const (
+		testAddr    = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+		signerAddrA = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+		signerAddrB = "rLHzPsX6oXkzU2qL12kHCH8G8cnZv1rBJh"
+	)
+	tx := transaction.FlatTransaction{
+		"TransactionType": "AccountSet",
+		"Account":         testAddr,
+		"Fee":             "36",
+		"Sequence":        uint32(10),
+	}
+
+	tests := []struct {
+		name            string
+		serverMessages  []map[string]any
+		expectedQuorum  uint32
+		expectedSigners []xrpl.MultisignSigner
+		expectedErr     error
+	}{
+		{
+			name: "pass - signer list with regular keys",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"account_data": map[string]any{
+							"Account":  testAddr,
+							"Sequence": 10,
+						},
+						"signer_lists": []any{
+							map[string]any{
+								"SignerQuorum": 2,
+								"SignerEntries": []any{
+									map[string]any{"SignerEntry": map[string]any{"Account": signerAddrA, "SignerWeight": 1}},
+									map[string]any{"SignerEntry": map[string]any{"Account": signerAddrB, "SignerWeight": 2}},
+								},
+							},
+						},
+					},
+				},
+				{
+					"id": 2,
+					"result": map[string]any{
+						"account_data": map[string]any{
+							"Account":    signerAddrA,
+							"Flags":      1048576,
+							"RegularKey": signerAddrB,
+						},
+					},
+				},
+				{
+					"id":    3,
+					"error": actNotFound,
+				},
+			},
+			expectedQuorum: 2,
+			expectedSigners: []xrpl.MultisignSigner{
+				{Account: signerAddrA, Weight: 1, RegularKey: signerAddrB, MasterDisabled: true},
+				{Account: signerAddrB, Weight: 2},
+			},
+		},
+		{
+			name: "fail - no signer list",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"account_data": map[string]any{
+							"Account":  testAddr,
+							"Sequence": 10,
+						},
+					},
+				},
+			},
+			expectedErr: xrpl.ErrNoSignerList,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			coordinator, err := cl.GetMultisignCoordinator(tx)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedQuorum, coordinator.Quorum())
+			require.Equal(t, tt.expectedSigners, coordinator.Signers())
+			require.Equal(t, []types.Address{signerAddrA, signerAddrB}, coordinator.MissingSigners())
+		})
+	}
+}
+
+func TestClient_Preclaim(t *testing.T) {
+	const (
+		senderAddr = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+		destAddr   = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	)
+	payment := func(amount any) transaction.FlatTransaction {
+		return transaction.FlatTransaction{
+			"TransactionType": "Payment",
+			"Account":         senderAddr,
+			"Destination":     destAddr,
+			"Amount":          amount,
+			"Fee":             "12",
+		}
+	}
+	accountInfoMsg := func(id int, address string, flags uint32) map[string]any {
+		return map[string]any{
+			"id": id,
+			"result": map[string]any{
+				"account_data": map[string]any{
+					"Account": address,
+					"Balance": "50000000",
+					"Flags":   flags,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		tx             transaction.FlatTransaction
+		serverMessages []map[string]any
+		expectedErr    error
+	}{
+		{
+			name: "pass - XRP payment",
+			tx:   payment("1000000"),
+			serverMessages: []map[string]any{
+				accountInfoMsg(1, senderAddr, 0),
+				accountInfoMsg(2, destAddr, 0),
+				{
+					"id": 3,
+					"result": map[string]any{
+						"state": map[string]any{
+							"validated_ledger": map[string]any{
+								"reserve_base": 1000000,
+								"reserve_inc":  200000,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "fail - source not found",
+			tx:   payment("1000000"),
+			serverMessages: []map[string]any{
+				{
+					"id":    1,
+					"error": actNotFound,
+				},
+			},
+			expectedErr: xrpl.ErrPreclaim{Reason: xrpl.PreclaimSourceNotFound, Result: transaction.TerNO_ACCOUNT, Account: senderAddr},
+		},
+		{
+			name: "fail - destination tag needed",
+			tx:   payment("1000000"),
+			serverMessages: []map[string]any{
+				accountInfoMsg(1, senderAddr, 0),
+				accountInfoMsg(2, destAddr, 131072),
+			},
+			expectedErr: xrpl.ErrPreclaim{Reason: xrpl.PreclaimDestinationTagNeeded, Result: transaction.TecDST_TAG_NEEDED, Account: destAddr},
+		},
+		{
+			name: "fail - MPT issuance not found",
+			tx:   payment(map[string]any{"mpt_issuance_id": "000000018A5182C75B3FB0B57A011EF619BD7B0307398CC1", "value": "10"}),
+			serverMessages: []map[string]any{
+				accountInfoMsg(1, senderAddr, 0),
+				accountInfoMsg(2, destAddr, 0),
+				{
+					"id":    3,
+					"error": entryNotFound,
+				},
+			},
+			expectedErr: xrpl.ErrPreclaim{Reason: xrpl.PreclaimMPTIssuanceNotFound, Result: transaction.TecOBJECT_NOT_FOUND, Account: senderAddr},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			err := cl.Preclaim(tt.tx)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_ConnectDefinitionsCheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		check          commonconstants.DefinitionsCheck
+		serverMessages []map[string]any
+		expectedErr    error
+		expectedHash   string
+	}{
+		{
+			name:  "fail on mismatch",
+			check: commonconstants.DefinitionsCheckFail,
+			serverMessages: []map[string]any{
+				{"id": 1, "result": map[string]any{"hash": "AB"}},
+			},
+			expectedErr: ErrDefinitionsMismatch,
+		},
+		{
+			name:  "matching definitions",
+			check: commonconstants.DefinitionsCheckFail,
+			serverMessages: []map[string]any{
+				{"id": 1, "result": map[string]any{"hash": binarycodec.DefaultCodec().Definitions().Hash}},
+			},
+			expectedHash: binarycodec.DefaultCodec().Definitions().Hash,
+		},
+		{
+			name:  "load on mismatch",
+			check: commonconstants.DefinitionsCheckLoad,
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"hash":  "AB",
+						"TYPES": map[string]any{"UInt32": 2},
+						"FIELDS": []any{
+							[]any{"Flags", map[string]any{"nth": 2, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}},
+						},
+						"TRANSACTION_TYPES": map[string]any{"Payment": 0},
+					},
+				},
+			},
+			expectedHash: "AB",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws := &testutil.MockWebSocketServer{Msgs: tt.serverMessages}
+			s := ws.TestWebSocketServer(func(c *websocket.Conn) {
+				writeMessagesAfterRequests(t, c, tt.serverMessages)
+			})
+			defer s.Close()
+
+			url, _ := testutil.ConvertHTTPToWS(s.URL)
+			cl := NewClient(NewClientConfig().
+				WithHost(url).
+				WithTimeout(1 * time.Second).
+				WithDefinitionsCheck(tt.check))
+
+			err := cl.Connect()
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.False(t, cl.IsConnected())
+				return
+			}
+
+			require.NoError(t, err)
+			defer cl.Disconnect()
+			require.Equal(t, tt.expectedHash, cl.codecs.Codec().Definitions().Hash)
+		})
+	}
+}
@@ -10,8 +10,8 @@ import (
 
 const defaultMaxResponseSize int64 = 16 * 1024 * 1024
 
-// SetLogger overrides the *log.Logger used for SDK-emitted warnings (currently
-// just the insecure-scheme warning). Pass nil to silence the warnings entirely.
+// SetLogger overrides the *log.Logger used for SDK-emitted warnings (insecure-scheme
+// and definitions mismatch warnings). Pass nil to silence the warnings entirely.
 // The default logger writes to stdlib's log.Default(), preserving prior behavior.
 // The logger is shared across xrpl-go's client packages; calling SetLogger here
 // or in xrpl/rpc has the same effect.
@@ -35,6 +35,9 @@ type ClientConfig struct {
 
 	// Faucet config
 	faucetProvider common.FaucetProvider
+
+	// Definitions config
+	definitionsCheck common.DefinitionsCheck
 }
 
 // NewClientConfig returns a ClientConfig initialized with default settings.
@@ -119,3 +122,11 @@ func (wc ClientConfig) WithMaxResponseSize(maxResponseSize int64) ClientConfig {
 	wc.maxResponseSize = maxResponseSize
 	return wc
 }
+
+// WithDefinitionsCheck sets how the websocket client reacts when the server's binary codec
+// definitions differ from the embedded ones. The check runs on Connect.
+// Default: common.DefinitionsCheckNone
+func (wc ClientConfig) WithDefinitionsCheck(check common.DefinitionsCheck) ClientConfig {
+	wc.definitionsCheck = check
+	return wc
+}
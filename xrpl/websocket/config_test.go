@@ -56,6 +56,11 @@ func TestWithFaucetProvider(t *testing.T) {
 	require.NotNil(t, config.faucetProvider)
 }
 
+func TestWithDefinitionsCheck(t *testing.T) {
+	config := NewClientConfig().WithDefinitionsCheck(common.DefinitionsCheckFail)
+	require.Equal(t, common.DefinitionsCheckFail, config.definitionsCheck)
+}
+
 func TestWithTimeout(t *testing.T) {
 	config := NewClientConfig().WithTimeout(10 * time.Second)
 	require.Equal(t, 10*time.Second, config.timeout)
@@ -3,6 +3,9 @@ package websocket
 import (
 	"errors"
 	"fmt"
+
+	"github.com/Peersyst/xrpl-go/xrpl/internal/autofill"
+	"github.com/Peersyst/xrpl-go/xrpl/internal/codeccache"
 )
 
 const (
@@ -10,6 +13,8 @@ const (
 	txnNotFound = "txnNotFound"
 	// actNotFound is the error message returned by the xrpl node when requesting for a not found account.
 	actNotFound = "actNotFound"
+	// entryNotFound is the error message returned by the xrpl node when requesting for a not found ledger entry.
+	entryNotFound = "entryNotFound"
 )
 
 var (
@@ -26,32 +31,45 @@ var (
 	// ErrMissingAccountInTransaction is returned when the Account field is missing from a transaction.
 	ErrMissingAccountInTransaction = errors.New("missing Account in transaction")
 	// ErrInvalidFulfillmentLength is returned when the fulfillment length is invalid.
-	ErrInvalidFulfillmentLength = errors.New("invalid fulfillment length")
+	ErrInvalidFulfillmentLength = autofill.ErrInvalidFulfillmentLength
+
+	// replace
+
+	// ErrOriginalTransactionAlreadyValidated is returned when the transaction to replace has already been validated.
+	ErrOriginalTransactionAlreadyValidated = errors.New("original transaction has already been validated")
+	// ErrMissingSequenceInTransaction is returned when a transaction has neither a Sequence nor a TicketSequence.
+	ErrMissingSequenceInTransaction = errors.New("missing Sequence or TicketSequence in transaction")
+	// ErrCannotFeeBumpMultisignedTransaction is returned when a fee bump is requested for a multisigned transaction.
+	ErrCannotFeeBumpMultisignedTransaction = errors.New("cannot fee bump a multisigned transaction with a single wallet")
+	// ErrInvalidReplaceStrategy is returned when the replace strategy is unknown.
+	ErrInvalidReplaceStrategy = errors.New("invalid replace strategy")
+	// ErrReplacementFeeExceedsMaxFee is returned when the fee required to replace a transaction exceeds the client's max fee.
+	ErrReplacementFeeExceedsMaxFee = errors.New("replacement fee exceeds max fee")
 
 	// fields
 
 	// ErrRawTransactionsFieldIsNotAnArray is returned when the RawTransactions field is not an array type.
-	ErrRawTransactionsFieldIsNotAnArray = errors.New("field RawTransactions must be an array")
+	ErrRawTransactionsFieldIsNotAnArray = autofill.ErrRawTransactionsFieldIsNotAnArray
 	// ErrRawTransactionFieldIsNotAnObject is returned when the RawTransaction field is not an object type.
-	ErrRawTransactionFieldIsNotAnObject = errors.New("field RawTransaction must be an object")
+	ErrRawTransactionFieldIsNotAnObject = autofill.ErrRawTransactionFieldIsNotAnObject
 	// ErrSigningPubKeyFieldMustBeEmpty is returned when the SigningPubKey field should be empty but isn't.
-	ErrSigningPubKeyFieldMustBeEmpty = errors.New("field SigningPubKey must be empty")
+	ErrSigningPubKeyFieldMustBeEmpty = autofill.ErrSigningPubKeyFieldMustBeEmpty
 	// ErrTxnSignatureFieldMustBeEmpty is returned when the TxnSignature field should be empty but isn't.
-	ErrTxnSignatureFieldMustBeEmpty = errors.New("field TxnSignature must be empty")
+	ErrTxnSignatureFieldMustBeEmpty = autofill.ErrTxnSignatureFieldMustBeEmpty
 	// ErrSignersFieldMustBeEmpty is returned when the Signers field should be empty but isn't.
-	ErrSignersFieldMustBeEmpty = errors.New("field Signers must be empty")
+	ErrSignersFieldMustBeEmpty = autofill.ErrSignersFieldMustBeEmpty
 	// ErrAccountFieldIsNotAString is returned when the Account field is not a string type.
-	ErrAccountFieldIsNotAString = errors.New("field Account must be a string")
+	ErrAccountFieldIsNotAString = autofill.ErrAccountFieldIsNotAString
 	// ErrNetworkIDFieldIsNotAUint32 is returned when the NetworkID field is set but not a uint32.
-	ErrNetworkIDFieldIsNotAUint32 = errors.New("field NetworkID must be a uint32")
+	ErrNetworkIDFieldIsNotAUint32 = autofill.ErrNetworkIDFieldIsNotAUint32
 	// ErrNetworkIDFieldMismatch is returned when the NetworkID field does not match the expected NetworkID.
-	ErrNetworkIDFieldMismatch = errors.New("field NetworkID must match expected NetworkID")
+	ErrNetworkIDFieldMismatch = autofill.ErrNetworkIDFieldMismatch
 	// ErrRawTransactionsFieldMissing is returned when the RawTransactions field is missing from a Batch transaction.
-	ErrRawTransactionsFieldMissing = errors.New("RawTransactions field missing from Batch transaction")
+	ErrRawTransactionsFieldMissing = autofill.ErrRawTransactionsFieldMissing
 	// ErrRawTransactionFieldMissing is returned when the RawTransaction field is missing from a wrapper.
-	ErrRawTransactionFieldMissing = errors.New("RawTransaction field missing from wrapper")
+	ErrRawTransactionFieldMissing = autofill.ErrRawTransactionFieldMissing
 	// ErrFeeFieldMissing is returned when the fee field is missing after calculation.
-	ErrFeeFieldMissing = errors.New("fee field missing after calculation")
+	ErrFeeFieldMissing = autofill.ErrFeeFieldMissing
 
 	// client
 
@@ -100,6 +118,12 @@ var (
 
 	// ErrNotConnected is returned when attempting to perform operations on a connection that is not established.
 	ErrNotConnected = errors.New("connection is not connected")
+
+	// definitions
+
+	// ErrDefinitionsMismatch is returned when the server's binary codec definitions differ from the
+	// embedded ones and the client is configured with common.DefinitionsCheckFail.
+	ErrDefinitionsMismatch = codeccache.ErrDefinitionsMismatch
 )
 
 // Dynamic errors
@@ -135,12 +159,7 @@ func (e ErrMaxReconnectionAttemptsReached) Error() string {
 }
 
 // ErrFailedToParseFee is returned when fee parsing fails.
-type ErrFailedToParseFee struct {
-	Fee string
-	Err error
-}
+type ErrFailedToParseFee = autofill.ErrFailedToParseFee
 
-// Error implements the error interface for ErrFailedToParseFee
-func (e ErrFailedToParseFee) Error() string {
-	return fmt.Sprintf("failed to parse fee %s: %v", e.Fee, e.Err)
-}
+// ErrMismatchedTag is returned when a transaction tag field does not match the expected value.
+type ErrMismatchedTag = autofill.ErrMismatchedTag
@@ -1,7 +1,9 @@
 package websocket
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	ledgerentries "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/amm"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/channel"
@@ -11,6 +13,7 @@ import (
 	"github.com/Peersyst/xrpl-go/xrpl/queries/oracle"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/vault"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -66,6 +69,20 @@ func (c *Client) GetAccountObjects(req *account.ObjectsRequest) (*account.Object
 	return &acr, nil
 }
 
+// GetTypedAccountObjects retrieves the objects owned by an account as their concrete ledger object types.
+// It returns the objects along with the marker to resume from, if any.
+func (c *Client) GetTypedAccountObjects(req *account.ObjectsRequest) ([]ledgerentries.Object, any, error) {
+	res, err := c.GetAccountObjects(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	objects, err := res.Objects()
+	if err != nil {
+		return nil, nil, err
+	}
+	return objects, res.Marker, nil
+}
+
 // GetXrpBalance retrieves the XRP balance of a given account address.
 // It returns the balance as a string in XRP (not drops) and any error encountered.
 func (c *Client) GetXrpBalance(address types.Address) (string, error) {
@@ -289,6 +306,21 @@ func (c *Client) GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, e
 	return &lr, nil
 }
 
+// GetTypedLedgerData retrieves the state objects of a ledger as their concrete ledger object types,
+// decoding binary state objects when the request sets Binary.
+// It returns the objects along with the marker to resume from, if any.
+func (c *Client) GetTypedLedgerData(req *ledger.DataRequest) ([]ledgerentries.Object, any, error) {
+	res, err := c.GetLedgerData(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	objects, err := res.Objects()
+	if err != nil {
+		return nil, nil, err
+	}
+	return objects, res.Marker, nil
+}
+
 // GetLedger retrieves information about a specific ledger version.
 // It takes a Request as input and returns a Response containing the ledger information,
 // along with any error encountered.
@@ -321,6 +353,34 @@ func (c *Client) GetLedgerEntry(req *ledger.EntryRequest) (*ledger.EntryResponse
 	return &ler, nil
 }
 
+// GetTypedLedgerEntry retrieves a specific ledger entry as its concrete ledger object type,
+// decoding the binary entry when the request sets Binary.
+func (c *Client) GetTypedLedgerEntry(req *ledger.EntryRequest) (ledgerentries.Object, error) {
+	res, err := c.GetLedgerEntry(req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Object()
+}
+
+// Transaction queries
+
+// GetTx retrieves a transaction by its hash.
+// It takes a TxRequest as input and returns a TxResponse containing the transaction,
+// along with any error encountered.
+func (c *Client) GetTx(req *transactions.TxRequest) (*transactions.TxResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var tr transactions.TxResponse
+	err = res.GetResult(&tr)
+	if err != nil {
+		return nil, err
+	}
+	return &tr, nil
+}
+
 // NFT queries
 
 // GetNFTBuyOffers retrieves all buy offers for a specific NFT.
@@ -471,6 +531,29 @@ func (c *Client) GetServerInfo(req *server.InfoRequest) (*server.InfoResponse, e
 	return &sir, err
 }
 
+// GetServerDefinitions retrieves the binary codec definitions the server uses.
+// It takes a DefinitionsRequest as input and returns a DefinitionsResponse,
+// along with any error encountered.
+func (c *Client) GetServerDefinitions(req *server.DefinitionsRequest) (*server.DefinitionsResponse, error) {
+	res, err := c.Request(req)
+	if err != nil {
+		return nil, err
+	}
+	var dr server.DefinitionsResponse
+	err = res.GetResult(&dr)
+	if err != nil {
+		return nil, err
+	}
+	return &dr, err
+}
+
+// GetCodec returns a binary codec built from the server's definitions.
+// Codecs are cached by definitions hash, so the full definitions are only
+// downloaded again when the server reports a hash the client has not seen.
+func (c *Client) GetCodec() (*binarycodec.Codec, error) {
+	return c.codecs.Fetch(c.GetServerDefinitions)
+}
+
 // GetAllFeatures retrieves information about all features supported by the server.
 // It takes a FeatureAllRequest as input and returns a FeatureAllResponse,
 // along with any error encountered.
@@ -26,6 +26,7 @@ import (
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
 	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
 )
 
 func setupTestClient(t *testing.T, messages []map[string]any) (*Client, func()) {
@@ -1394,6 +1395,60 @@ func TestClient_GetLedgerData(t *testing.T) {
 	}
 }
 
+func TestClient_GetTypedLedgerData(t *testing.T) {
+	cl, cleanup := setupTestClient(t, []map[string]any{
+		{
+			"id": 1,
+			"result": map[string]any{
+				"ledger_hash":  "abc123",
+				"ledger_index": "123",
+				"marker":       "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC322",
+				"state": []map[string]any{
+					{
+						"data":  "1100612200000000240000000125000022C52D00000000558D7F42ED0621FBCFAE55CC6F2A9403A2AFB205708CCBA3109BB61DB8DDA261B46240000000160DC0808114712B799C79D1EEE3094B59EF9920C7FEB3CE4499",
+						"index": "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC321",
+					},
+				},
+			},
+		},
+	})
+	defer cleanup()
+
+	objects, marker, err := cl.GetTypedLedgerData(&ledgerqueries.DataRequest{Binary: true})
+	require.NoError(t, err)
+	require.Equal(t, "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC322", marker)
+	require.Len(t, objects, 1)
+
+	accountRoot, ok := objects[0].(*ledger.AccountRoot)
+	require.True(t, ok)
+	require.Equal(t, types.Address("rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7"), accountRoot.Account)
+}
+
+func TestClient_GetTypedLedgerEntry(t *testing.T) {
+	cl, cleanup := setupTestClient(t, []map[string]any{
+		{
+			"id": 1,
+			"result": map[string]any{
+				"index": "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC321",
+				"node": map[string]any{
+					"LedgerEntryType": "Ticket",
+					"Account":         "rBKPS4oLSaV2KVVuHH8EpQqMGgGefGFQs7",
+					"TicketSequence":  3,
+				},
+				"validated": true,
+			},
+		},
+	})
+	defer cleanup()
+
+	obj, err := cl.GetTypedLedgerEntry(&ledgerqueries.EntryRequest{
+		Index: "E6DBAFC99223B42257915A63DFC6B0C032D4070F9A574B255AD97466726FC321",
+	})
+	require.NoError(t, err)
+	require.IsType(t, &ledger.Ticket{}, obj)
+	require.Equal(t, uint32(3), obj.(*ledger.Ticket).TicketSequence)
+}
+
 func TestClient_GetLedger(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -2156,6 +2211,88 @@ func TestClient_GetRipplePathFind(t *testing.T) {
 	}
 }
 
+func TestClient_GetServerDefinitions(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		expected       *server.DefinitionsResponse
+		expectedErr    error
+	}{
+		{
+			name: "Valid server definitions",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"hash":  "AB",
+						"TYPES": map[string]any{"UInt32": float64(2)},
+						"FIELDS": []any{
+							[]any{"Flags", map[string]any{
+								"nth":            float64(2),
+								"isVLEncoded":    false,
+								"isSerialized":   true,
+								"isSigningField": true,
+								"type":           "UInt32",
+							}},
+						},
+						"TRANSACTION_TYPES": map[string]any{"Payment": float64(0)},
+					},
+				},
+			},
+			expected: &server.DefinitionsResponse{
+				Hash:  "AB",
+				Types: map[string]int32{"UInt32": 2},
+				Fields: []servertypes.DefinitionField{
+					{
+						Name: "Flags",
+						Info: servertypes.DefinitionFieldInfo{
+							Nth:            2,
+							IsSerialized:   true,
+							IsSigningField: true,
+							Type:           "UInt32",
+						},
+					},
+				},
+				TransactionTypes: map[string]int32{"Payment": 0},
+			},
+		},
+		{
+			name: "error response",
+			serverMessages: []map[string]any{
+				{
+					"id":    1,
+					"error": "incorrect id",
+				},
+			},
+			expected:    nil,
+			expectedErr: ErrIncorrectID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			result, err := cl.GetServerDefinitions(&server.DefinitionsRequest{})
+
+			if tt.expectedErr != nil {
+				if err == nil || err.Error() != tt.expectedErr.Error() {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+
+			if !reflect.DeepEqual(tt.expected, result) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestClient_GetAllFeatures(t *testing.T) {
 	tests := []struct {
 		name           string
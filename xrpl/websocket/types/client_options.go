@@ -2,12 +2,41 @@
 package types
 
 import (
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/wallet"
 )
 
 // SubmitOptions configures transaction submission options over WebSocket, including autofill, wallet and fail-hard.
 type SubmitOptions struct {
 	Autofill bool
-	Wallet   *wallet.Wallet
+	Wallet   wallet.Signer
 	FailHard bool
+	// Preclaim runs the client Preclaim checks on the signed transaction before submitting it.
+	Preclaim bool
+}
+
+// ReplaceStrategy selects how a pending transaction is replaced.
+type ReplaceStrategy int
+
+const (
+	// ReplaceWithFeeBump resubmits a copy of the original transaction with a higher fee.
+	ReplaceWithFeeBump ReplaceStrategy = iota
+	// ReplaceWithNoop submits a no-op AccountSet that consumes the original's Sequence or TicketSequence.
+	ReplaceWithNoop
+)
+
+// ReplaceOptions configures the replacement of a pending transaction over WebSocket.
+type ReplaceOptions struct {
+	Strategy ReplaceStrategy
+	Wallet   wallet.Signer
+	FailHard bool
+}
+
+// ReplaceResult reports which of the original and replacement transactions was validated.
+type ReplaceResult struct {
+	OriginalHash    string
+	ReplacementHash string
+	// Replaced is true when the replacement was validated, false when the original was.
+	Replaced   bool
+	TxResponse *requests.TxResponse
 }